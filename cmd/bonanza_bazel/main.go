@@ -8,6 +8,7 @@ import (
 
 	"bonanza.build/pkg/bazelclient/arguments"
 	commands_build "bonanza.build/pkg/bazelclient/commands/build"
+	commands_clean "bonanza.build/pkg/bazelclient/commands/clean"
 	commands_info "bonanza.build/pkg/bazelclient/commands/info"
 	commands_license "bonanza.build/pkg/bazelclient/commands/license"
 	commands_version "bonanza.build/pkg/bazelclient/commands/version"
@@ -68,14 +69,26 @@ func main() {
 	}
 
 	switch typedCmd := cmd.(type) {
+	case *arguments.AqueryCommand:
+		commands_build.DoAquery(typedCmd, workspacePath)
 	case *arguments.BuildCommand:
 		commands_build.DoBuild(typedCmd, workspacePath)
+	case *arguments.CleanCommand:
+		commands_clean.DoClean(typedCmd, workspacePath)
+	case *arguments.CqueryCommand:
+		commands_build.DoCquery(typedCmd, workspacePath)
 	case *arguments.HelpCommand:
 		panic("HELP")
 	case *arguments.InfoCommand:
 		commands_info.DoInfo(typedCmd, workspacePath)
 	case *arguments.LicenseCommand:
 		commands_license.DoLicense()
+	case *arguments.QueryCommand:
+		commands_build.DoQuery(typedCmd, workspacePath)
+	case *arguments.RunCommand:
+		commands_build.DoRun(typedCmd, workspacePath)
+	case *arguments.TestCommand:
+		commands_build.DoTest(typedCmd, workspacePath)
 	case *arguments.VersionCommand:
 		commands_version.DoVersion(typedCmd)
 	default:
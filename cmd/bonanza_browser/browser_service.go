@@ -1303,11 +1303,20 @@ func (s *BrowserService) doOperation(w http.ResponseWriter, r *http.Request) (g.
 	rawInvocationIDs := invocationName.GetIds()
 	invocationIDs := make([]g.Node, 0, len(rawInvocationIDs))
 	for _, rawInvocationID := range rawInvocationIDs {
+		// Link to the list of operations belonging to the same
+		// invocation, so that all actions of a single build can
+		// be inspected together.
+		marshaledInvocationID, err := protojson.Marshal(rawInvocationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal invocation ID: %w", err)
+		}
 		invocationIDs = append(
 			invocationIDs,
 			h.Li(
 				h.A(
-					h.Href("TODO"),
+					h.Href("../operations/ALL?"+url.Values{
+						"filter_invocation_id": {string(marshaledInvocationID)},
+					}.Encode()),
 					g.Text(protojson.Format(rawInvocationID)),
 				),
 			),
@@ -1689,6 +1698,7 @@ func (s *BrowserService) doOperations(w http.ResponseWriter, r *http.Request) (g
 						g.Text(operation.Name),
 					),
 				),
+				h.Td(h.Class("text-right"), g.Textf("%d", operation.Priority)),
 				h.Td(h.Class("text-right"), g.Text(timeoutToText(operation.Timeout, now))),
 			),
 		)
@@ -1715,6 +1725,9 @@ func (s *BrowserService) doOperations(w http.ResponseWriter, r *http.Request) (g
 						h.Th(
 							h.Class("font-mono"),
 						),
+						h.Th(
+							g.Text("Priority"),
+						),
 						h.Th(
 							g.Text("Operation timeout"),
 						),
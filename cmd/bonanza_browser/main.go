@@ -52,8 +52,9 @@ func main() {
 		if err != nil {
 			return util.StatusWrap(err, "Failed to create storage gRPC client")
 		}
-		objectDownloader := object_grpc.NewGRPCDownloader(
+		objectDownloader := object_grpc.NewGRPCDownloaderFromConfiguration(
 			object_pb.NewDownloaderClient(storageGRPCClient),
+			configuration.StorageBatching,
 		)
 		if configuration.LocalObjectStore != nil {
 			localObjectStore, err := object_local.NewStoreFromConfiguration(
@@ -66,6 +67,7 @@ func main() {
 			objectDownloader = object_readcaching.NewDownloader(
 				objectDownloader,
 				localObjectStore,
+				/* prefetchSemaphore = */ nil,
 			)
 		}
 
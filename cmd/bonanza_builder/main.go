@@ -63,8 +63,9 @@ func main() {
 			return util.StatusWrap(err, "Failed to create storage gRPC client")
 		}
 		objectDownloader := object_existenceprecondition.NewDownloader(
-			object_grpc.NewGRPCDownloader(
+			object_grpc.NewGRPCDownloaderFromConfiguration(
 				object_pb.NewDownloaderClient(storageGRPCClient),
+				configuration.StorageBatching,
 			),
 		)
 		if configuration.LocalObjectStore != nil {
@@ -75,9 +76,14 @@ func main() {
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create local object store")
 			}
+			var prefetchSemaphore *semaphore.Weighted
+			if concurrency := configuration.LocalObjectStorePrefetchConcurrency; concurrency > 0 {
+				prefetchSemaphore = semaphore.NewWeighted(concurrency)
+			}
 			objectDownloader = object_readcaching.NewDownloader(
 				objectDownloader,
 				localObjectStore,
+				prefetchSemaphore,
 			)
 		}
 
@@ -104,6 +110,13 @@ func main() {
 		if err != nil {
 			return util.StatusWrap(err, "Failed to parse execution client certificate chain")
 		}
+		additionalForwardedMetadataKeys := make(map[string]struct{}, len(configuration.AdditionalForwardedRequestMetadataHeaders))
+		for _, name := range configuration.AdditionalForwardedRequestMetadataHeaders {
+			if err := remoteexecution.ValidateMetadataKey(name); err != nil {
+				return util.StatusWrapf(err, "Invalid additional forwarded request metadata header %#v", name)
+			}
+			additionalForwardedMetadataKeys[name] = struct{}{}
+		}
 
 		remoteWorkerConnection, err := grpcClientFactory.NewClientFromConfiguration(configuration.RemoteWorkerGrpcClient, dependenciesGroup)
 		if err != nil {
@@ -153,6 +166,7 @@ func main() {
 						dag_pb.NewUploaderClient(storageGRPCClient),
 						semaphore.NewWeighted(int64(runtime.NumCPU())),
 						clock.SystemClock,
+						additionalForwardedMetadataKeys,
 					),
 				),
 			),
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"bonanza.build/pkg/proto/configuration/bonanza_bundle"
+	bundle_pb "bonanza.build/pkg/proto/storage/bundle"
+	dag_pb "bonanza.build/pkg/proto/storage/dag"
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	tag_pb "bonanza.build/pkg/proto/storage/tag"
+	"bonanza.build/pkg/storage/bundle"
+	"bonanza.build/pkg/storage/dag"
+	"bonanza.build/pkg/storage/object"
+	object_grpc "bonanza.build/pkg/storage/object/grpc"
+	tag_grpc "bonanza.build/pkg/storage/tag/grpc"
+
+	"github.com/buildbarn/bb-storage/pkg/global"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func main() {
+	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		if len(os.Args) != 2 {
+			return status.Error(codes.InvalidArgument, "Usage: bonanza_bundle bonanza_bundle.jsonnet")
+		}
+		var configuration bonanza_bundle.ApplicationConfiguration
+		if err := util.UnmarshalConfigurationFromFile(os.Args[1], &configuration); err != nil {
+			return util.StatusWrapf(err, "Failed to read configuration from %s", os.Args[1])
+		}
+		_, grpcClientFactory, err := global.ApplyConfiguration(configuration.Global, dependenciesGroup)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to apply global configuration options")
+		}
+
+		grpcClient, err := grpcClientFactory.NewClientFromConfiguration(configuration.GrpcClient, dependenciesGroup)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create gRPC client")
+		}
+
+		switch operation := configuration.Operation.(type) {
+		case *bonanza_bundle.ApplicationConfiguration_ExportBundle:
+			return exportBundle(ctx, operation.ExportBundle, grpcClient)
+		case *bonanza_bundle.ApplicationConfiguration_ImportBundle:
+			return importBundle(ctx, operation.ImportBundle, grpcClient)
+		default:
+			return status.Error(codes.InvalidArgument, "No operation provided")
+		}
+	})
+}
+
+func exportBundle(ctx context.Context, configuration *bonanza_bundle.ExportConfiguration, grpcClient grpc.ClientConnInterface) error {
+	namespace, err := object.NewNamespace(configuration.Namespace)
+	if err != nil {
+		return util.StatusWrap(err, "Invalid namespace")
+	}
+	if configuration.DownloadConcurrency <= 0 {
+		return status.Error(codes.InvalidArgument, "Download concurrency must be positive")
+	}
+
+	// Determine the references of the root objects, resolving tags
+	// if needed.
+	tagResolver := tag_grpc.NewGRPCResolver(tag_pb.NewResolverClient(grpcClient))
+	roots := make([]*bundle_pb.Header_Root, 0, len(configuration.Roots))
+	rootReferences := make([]object.LocalReference, 0, len(configuration.Roots))
+	for i, root := range configuration.Roots {
+		var rootReference object.LocalReference
+		if len(root.Reference) > 0 {
+			rootReference, err = namespace.ReferenceFormat.NewLocalReference(root.Reference)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid reference for root at index %d", i)
+			}
+		} else if root.Tag != nil {
+			rootReference, _, err = tagResolver.ResolveTag(ctx, namespace, root.Tag)
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to resolve tag for root at index %d", i)
+			}
+		} else {
+			return status.Errorf(codes.InvalidArgument, "Root at index %d has neither a reference nor a tag", i)
+		}
+		roots = append(roots, &bundle_pb.Header_Root{
+			Reference: rootReference.GetRawReference(),
+			Tag:       root.Tag,
+		})
+		rootReferences = append(rootReferences, rootReference)
+	}
+
+	f, err := os.Create(configuration.OutputPath)
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to create bundle %#v", configuration.OutputPath)
+	}
+	defer f.Close()
+	writer, err := bundle.NewWriter(f, &bundle_pb.Header{
+		Namespace: namespace.ToProto(),
+		Roots:     roots,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Exporting %d DAGs", len(rootReferences))
+	if err := bundle.ExportDAGs(
+		ctx,
+		object_grpc.NewGRPCDownloaderFromConfiguration(
+			object_pb.NewDownloaderClient(grpcClient),
+			configuration.DownloadBatching,
+		),
+		namespace,
+		rootReferences,
+		writer,
+		semaphore.NewWeighted(configuration.DownloadConcurrency),
+	); err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return util.StatusWrapf(err, "Failed to flush bundle %#v", configuration.OutputPath)
+	}
+	if err := f.Close(); err != nil {
+		return util.StatusWrapf(err, "Failed to close bundle %#v", configuration.OutputPath)
+	}
+	log.Print("Done")
+	return nil
+}
+
+func importBundle(ctx context.Context, configuration *bonanza_bundle.ImportConfiguration, grpcClient grpc.ClientConnInterface) error {
+	if configuration.ObjectContentsWalkerConcurrency <= 0 {
+		return status.Error(codes.InvalidArgument, "Object contents walker concurrency must be positive")
+	}
+
+	f, err := os.Open(configuration.InputPath)
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to open bundle %#v", configuration.InputPath)
+	}
+	defer f.Close()
+	fileInfo, err := f.Stat()
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to obtain size of bundle %#v", configuration.InputPath)
+	}
+	reader, err := bundle.NewReader(f, fileInfo.Size())
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to read bundle %#v", configuration.InputPath)
+	}
+
+	namespace := reader.GetNamespace()
+	if configuration.InstanceName != "" {
+		namespace.InstanceName = object.NewInstanceName(configuration.InstanceName)
+	}
+
+	dagUploaderClient := dag_pb.NewUploaderClient(grpcClient)
+	objectContentsWalkerSemaphore := semaphore.NewWeighted(configuration.ObjectContentsWalkerConcurrency)
+	maximumUnfinalizedParentsLimit := object.NewLimit(configuration.MaximumUnfinalizedParentsLimit)
+	roots := reader.GetRoots()
+	for i, root := range roots {
+		rootReference, err := namespace.NewGlobalReference(root.Reference)
+		if err != nil {
+			return util.StatusWrapf(err, "Invalid reference for root at index %d", i)
+		}
+		log.Printf("Importing DAG %d of %d with root %s", i+1, len(roots), rootReference.LocalReference)
+		if err := dag.UploadTaggedDAG(
+			ctx,
+			dagUploaderClient,
+			rootReference,
+			root.Tag,
+			bundle.NewObjectContentsWalker(reader, rootReference.LocalReference),
+			objectContentsWalkerSemaphore,
+			maximumUnfinalizedParentsLimit,
+		); err != nil {
+			return util.StatusWrapf(err, "Failed to import DAG with root %s", rootReference.LocalReference)
+		}
+	}
+	log.Print("Done")
+	return nil
+}
@@ -58,8 +58,9 @@ func main() {
 			return util.StatusWrap(err, "Failed to create storage gRPC client")
 		}
 		objectDownloader := object_existenceprecondition.NewDownloader(
-			object_grpc.NewGRPCDownloader(
+			object_grpc.NewGRPCDownloaderFromConfiguration(
 				object_pb.NewDownloaderClient(storageGRPCClient),
+				configuration.StorageBatching,
 			),
 		)
 		if configuration.LocalObjectStore != nil {
@@ -70,9 +71,14 @@ func main() {
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create local object store")
 			}
+			var prefetchSemaphore *semaphore.Weighted
+			if concurrency := configuration.LocalObjectStorePrefetchConcurrency; concurrency > 0 {
+				prefetchSemaphore = semaphore.NewWeighted(concurrency)
+			}
 			objectDownloader = object_readcaching.NewDownloader(
 				objectDownloader,
 				localObjectStore,
+				prefetchSemaphore,
 			)
 		}
 
@@ -98,6 +104,14 @@ func main() {
 			return util.StatusWrap(err, "Failed to create cache directory")
 		}
 
+		var gitCloner *model_fetch.GitCloner
+		if gitConfiguration := configuration.Git; gitConfiguration != nil {
+			gitCloner = model_fetch.NewGitCloner(
+				gitConfiguration.ExecutablePath,
+				gitConfiguration.WorkingDirectoryPath,
+			)
+		}
+
 		remoteWorkerConnection, err := grpcClientFactory.NewClientFromConfiguration(configuration.RemoteWorkerGrpcClient, dependenciesGroup)
 		if err != nil {
 			return util.StatusWrap(err, "Failed to create remote worker RPC client")
@@ -136,6 +150,7 @@ func main() {
 							&http.Client{Transport: roundTripper},
 							filePool,
 							cacheDirectory,
+							gitCloner,
 						),
 					),
 				),
@@ -3,23 +3,39 @@ package main
 import (
 	"context"
 	"os"
+	"runtime"
 	"time"
 
 	buildqueuestate_pb "bonanza.build/pkg/proto/buildqueuestate"
 	"bonanza.build/pkg/proto/configuration/bonanza_scheduler"
 	remoteexecution_pb "bonanza.build/pkg/proto/remoteexecution"
 	remoteworker_pb "bonanza.build/pkg/proto/remoteworker"
+	dag_pb "bonanza.build/pkg/proto/storage/dag"
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	tag_pb "bonanza.build/pkg/proto/storage/tag"
 	"bonanza.build/pkg/scheduler"
+	"bonanza.build/pkg/scheduler/initialsizeclass"
+	"bonanza.build/pkg/scheduler/journal"
 	"bonanza.build/pkg/scheduler/routing"
+	"bonanza.build/pkg/storage/object"
+	object_grpc "bonanza.build/pkg/storage/object/grpc"
+	tag_grpc "bonanza.build/pkg/storage/tag/grpc"
 
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	auth_configuration "github.com/buildbarn/bb-storage/pkg/auth/configuration"
 	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
+	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/auth"
 	"github.com/buildbarn/bb-storage/pkg/random"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/google/uuid"
 
+	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -39,10 +55,35 @@ func main() {
 			return util.StatusWrap(err, "Failed to apply global configuration options")
 		}
 
+		// Optionally, store statistics on previous executions of
+		// actions, so that initial size class analysis can be
+		// feedback driven.
+		var previousExecutionStatsStore initialsizeclass.PreviousExecutionStatsStore
+		if storeConfiguration := configuration.PreviousExecutionStatsStore; storeConfiguration != nil {
+			storageGRPCClient, err := grpcClientFactory.NewClientFromConfiguration(storeConfiguration.StorageGrpcClient, dependenciesGroup)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create storage gRPC client")
+			}
+			namespace, err := object.NewNamespace(storeConfiguration.Namespace)
+			if err != nil {
+				return util.StatusWrap(err, "Invalid previous execution stats store namespace")
+			}
+			previousExecutionStatsStore = initialsizeclass.NewStorageBackedPreviousExecutionStatsStore(
+				tag_grpc.NewGRPCResolver(tag_pb.NewResolverClient(storageGRPCClient)),
+				object_grpc.NewGRPCDownloaderFromConfiguration(
+					object_pb.NewDownloaderClient(storageGRPCClient),
+					storeConfiguration.StorageBatching,
+				),
+				dag_pb.NewUploaderClient(storageGRPCClient),
+				namespace,
+				semaphore.NewWeighted(int64(runtime.NumCPU())),
+			)
+		}
+
 		// Create an action router that is responsible for analyzing
 		// incoming execution requests and determining how they are
 		// scheduled.
-		actionRouter, err := routing.NewActionRouterFromConfiguration(configuration.ActionRouter)
+		actionRouter, err := routing.NewActionRouterFromConfiguration(configuration.ActionRouter, previousExecutionStatsStore)
 		if err != nil {
 			return util.StatusWrap(err, "Failed to create action router")
 		}
@@ -52,6 +93,30 @@ func main() {
 			return util.StatusWrap(err, "Invalid platform queue with no workers timeout")
 		}
 
+		authorizerFactory := auth_configuration.DefaultAuthorizerFactory
+		newAuthorizer := func(authorizerConfiguration *auth_pb.AuthorizerConfiguration) (auth.Authorizer, error) {
+			if authorizerConfiguration == nil {
+				// Permit all requests if no authorizer is
+				// configured, so that configurations written
+				// prior to authorization being supported
+				// remain valid.
+				return auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }), nil
+			}
+			return authorizerFactory.NewAuthorizerFromConfiguration(authorizerConfiguration, dependenciesGroup, grpcClientFactory)
+		}
+		executeAuthorizer, err := newAuthorizer(configuration.ExecuteAuthorizer)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create execute authorizer")
+		}
+		modifyDrainsAuthorizer, err := newAuthorizer(configuration.ModifyDrainsAuthorizer)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create modify drains authorizer")
+		}
+		killOperationsAuthorizer, err := newAuthorizer(configuration.KillOperationsAuthorizer)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create kill operations authorizer")
+		}
+
 		// Create in-memory build queue.
 		generator := random.NewFastSingleThreadedGenerator()
 		buildQueue := scheduler.NewInMemoryBuildQueue(
@@ -74,6 +139,9 @@ func main() {
 				VerificationPrivateKeyRefreshInterval: time.Hour,
 			},
 			actionRouter,
+			executeAuthorizer,
+			modifyDrainsAuthorizer,
+			killOperationsAuthorizer,
 		)
 
 		// Create predeclared platform queues.
@@ -97,6 +165,42 @@ func main() {
 			}
 		}
 
+		// Optionally, restore operations from a journal, and
+		// periodically write snapshots of operations back to it.
+		// This permits clients to reattach to operations after
+		// the scheduler is restarted.
+		if journalConfiguration := configuration.Journal; journalConfiguration != nil {
+			snapshotInterval := journalConfiguration.SnapshotInterval
+			if err := snapshotInterval.CheckValid(); err != nil {
+				return util.StatusWrap(err, "Invalid journal snapshot interval")
+			}
+			journalDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(journalConfiguration.DirectoryPath))
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to open journal directory %#v", journalConfiguration.DirectoryPath)
+			}
+			directoryBackedJournal := journal.NewDirectoryBackedJournal(journalDirectory)
+			snapshot, err := directoryBackedJournal.ReadSnapshot()
+			if err != nil {
+				return util.StatusWrap(err, "Failed to read journal snapshot")
+			}
+			if err := buildQueue.RestoreSnapshot(snapshot); err != nil {
+				return util.StatusWrap(err, "Failed to restore operations from journal snapshot")
+			}
+
+			periodicSnapshotter := journal.NewPeriodicSnapshotter(
+				buildQueue,
+				directoryBackedJournal,
+				clock.SystemClock,
+				util.DefaultErrorLogger,
+				snapshotInterval.AsDuration(),
+			)
+			dependenciesGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				for periodicSnapshotter.WriteNextSnapshot(ctx) {
+				}
+				return nil
+			})
+		}
+
 		// Spawn gRPC servers for client and worker traffic.
 		if err := bb_grpc.NewServersFromConfigurationAndServe(
 			configuration.ClientGrpcServers,
@@ -8,21 +8,25 @@ import (
 	dag_pb "bonanza.build/pkg/proto/storage/dag"
 	object_pb "bonanza.build/pkg/proto/storage/object"
 	tag_pb "bonanza.build/pkg/proto/storage/tag"
+	"bonanza.build/pkg/storage/cluster"
 	"bonanza.build/pkg/storage/dag"
 	"bonanza.build/pkg/storage/object"
-	object_grpc "bonanza.build/pkg/storage/object/grpc"
+	object_authorizing "bonanza.build/pkg/storage/object/authorizing"
 	object_leaserenewing "bonanza.build/pkg/storage/object/leaserenewing"
-	object_mirrored "bonanza.build/pkg/storage/object/mirrored"
-	object_sharded "bonanza.build/pkg/storage/object/sharded"
+	object_replicated "bonanza.build/pkg/storage/object/replicated"
 	"bonanza.build/pkg/storage/tag"
-	tag_grpc "bonanza.build/pkg/storage/tag/grpc"
+	tag_authorizing "bonanza.build/pkg/storage/tag/authorizing"
 	tag_leaserenewing "bonanza.build/pkg/storage/tag/leaserenewing"
-	tag_mirrored "bonanza.build/pkg/storage/tag/mirrored"
-	tag_sharded "bonanza.build/pkg/storage/tag/sharded"
+	tag_replicated "bonanza.build/pkg/storage/tag/replicated"
 
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	auth_configuration "github.com/buildbarn/bb-storage/pkg/auth/configuration"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
+	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/auth"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"golang.org/x/sync/semaphore"
@@ -50,20 +54,38 @@ func main() {
 		}
 		maximumUnfinalizedParentsLimit := object.NewLimit(configuration.MaximumUnfinalizedParentsLimit)
 
-		// Construct object and tag stores for mirrored replicas.
-		objectStoreA, tagStoreA, err := createShardsForReplica(grpcClientFactory, configuration.ShardsReplicaA, dependenciesGroup)
-		if err != nil {
-			return util.StatusWrap(err, "Failed to create storage shards for replica A")
+		// Construct object and tag stores for each replica.
+		replicasCount := len(configuration.Replicas)
+		if replicasCount == 0 {
+			return status.Error(codes.InvalidArgument, "No replicas provided")
 		}
-		objectStoreB, tagStoreB, err := createShardsForReplica(grpcClientFactory, configuration.ShardsReplicaB, dependenciesGroup)
-		if err != nil {
-			return util.StatusWrap(err, "Failed to create storage shards for replica B")
+		readQuorum := int(configuration.ReadQuorum)
+		if readQuorum < 1 || readQuorum > replicasCount {
+			return status.Errorf(codes.InvalidArgument, "Read quorum must be between 1 and %d", replicasCount)
+		}
+		writeQuorum := int(configuration.WriteQuorum)
+		if writeQuorum < 1 || writeQuorum > replicasCount {
+			return status.Errorf(codes.InvalidArgument, "Write quorum must be between 1 and %d", replicasCount)
+		}
+		objectStores := make([]object.Store[object.GlobalReference, []byte], 0, replicasCount)
+		tagResolvers := make([]tag.Resolver[object.Namespace], 0, replicasCount)
+		tagUpdaters := make([]tag.Updater[object.GlobalReference, []byte], 0, replicasCount)
+		tagListers := make([]tag.Lister, 0, replicasCount)
+		for i, replica := range configuration.Replicas {
+			objectStore, tagStore, tagLister, err := cluster.NewReplicaFromConfiguration(grpcClientFactory, replica, configuration.ShardBatching, dependenciesGroup)
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to create replica %d", i)
+			}
+			objectStores = append(objectStores, objectStore)
+			tagResolvers = append(tagResolvers, tagStore)
+			tagUpdaters = append(tagUpdaters, tagStore)
+			tagListers = append(tagListers, tagLister)
 		}
 
-		// Combine mirrored replicas together.
-		objectDownloader := object_mirrored.NewDownloader(objectStoreA, objectStoreB)
+		// Combine replicas together.
+		objectDownloader := object_replicated.NewDownloader(objectStores, readQuorum, util.DefaultErrorLogger)
 		objectUploader := object_leaserenewing.NewUploader(
-			object_mirrored.NewUploader(objectStoreA, objectStoreB),
+			object_replicated.NewUploader(objectStores, writeQuorum),
 			semaphore.NewWeighted(configuration.ObjectStoreConcurrency),
 			maximumUnfinalizedParentsLimit,
 		)
@@ -73,35 +95,93 @@ func main() {
 			return nil
 		})
 
-		tagUpdater := tag_mirrored.NewUpdater(tagStoreA, tagStoreB)
+		replicatedTagResolver := tag_replicated.NewResolver(tagResolvers, readQuorum)
+		replicatedTagUpdater := tag_replicated.NewUpdater(tagUpdaters, writeQuorum)
 		tagResolver := tag_leaserenewing.NewResolver(
-			tag.NewStore(
-				tag_mirrored.NewResolver(tagStoreA, tagStoreB),
-				tagUpdater,
-			),
+			tag.NewStore(replicatedTagResolver, replicatedTagUpdater),
 			objectUploader,
 		)
 
+		// If enabled, periodically repair DAGs referenced by all
+		// tags contained in the replicas.
+		if replicaRepair := configuration.ReplicaRepair; replicaRepair != nil {
+			interval := replicaRepair.Interval
+			if err := interval.CheckValid(); err != nil {
+				return util.StatusWrap(err, "Invalid replica repair interval")
+			}
+			if replicaRepair.ObjectStoreConcurrency <= 0 {
+				return status.Error(codes.InvalidArgument, "Object store concurrency for replica repair must be positive")
+			}
+			tagRepairer := tag_replicated.NewRepairer(
+				tagListers,
+				replicatedTagResolver,
+				replicatedTagUpdater,
+				object_replicated.NewRepairer(objectStores, replicaRepair.ObjectStoreConcurrency, util.DefaultErrorLogger),
+				util.DefaultErrorLogger,
+			)
+
+			dependenciesGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				for {
+					if _, err := tagRepairer.RepairAllTags(ctx); err != nil {
+						return nil
+					}
+					t, tChan := clock.SystemClock.NewTimer(interval.AsDuration())
+					select {
+					case <-tChan:
+					case <-ctx.Done():
+						t.Stop()
+						return nil
+					}
+				}
+			})
+		}
+
+		// Only permit clients to access namespaces for which they
+		// are authorized.
+		authorizerFactory := auth_configuration.DefaultAuthorizerFactory
+		newAuthorizer := func(authorizerConfiguration *auth_pb.AuthorizerConfiguration) (auth.Authorizer, error) {
+			if authorizerConfiguration == nil {
+				// Permit all requests if no authorizer is
+				// configured, so that configurations written
+				// prior to authorization being supported
+				// remain valid.
+				return auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }), nil
+			}
+			return authorizerFactory.NewAuthorizerFromConfiguration(authorizerConfiguration, dependenciesGroup, grpcClientFactory)
+		}
+		readAuthorizer, err := newAuthorizer(configuration.ReadAuthorizer)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create read authorizer")
+		}
+		writeAuthorizer, err := newAuthorizer(configuration.WriteAuthorizer)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create write authorizer")
+		}
+
 		if err := bb_grpc.NewServersFromConfigurationAndServe(
 			configuration.GrpcServers,
 			func(s grpc.ServiceRegistrar) {
 				// Services for downloading DAGs.
 				object_pb.RegisterDownloaderServer(
 					s,
-					object.NewDownloaderServer(objectDownloader),
+					object.NewDownloaderServer(
+						object_authorizing.NewDownloader(objectDownloader, readAuthorizer),
+					),
 				)
 				tag_pb.RegisterResolverServer(
 					s,
-					tag.NewResolverServer(tagResolver),
+					tag.NewResolverServer(
+						tag_authorizing.NewResolver(tagResolver, readAuthorizer),
+					),
 				)
 
 				// Services for uploading DAGs.
 				dag_pb.RegisterUploaderServer(
 					s,
 					dag.NewUploaderServer(
-						objectUploader,
+						object_authorizing.NewUploader(objectUploader, writeAuthorizer),
 						semaphore.NewWeighted(configuration.ObjectStoreConcurrency),
-						tagUpdater,
+						tag_authorizing.NewUpdater(replicatedTagUpdater, writeAuthorizer),
 						configuration.MaximumUnfinalizedDagsCount,
 						maximumUnfinalizedParentsLimit,
 					),
@@ -117,58 +197,3 @@ func main() {
 		return nil
 	})
 }
-
-func createShardsForReplica(grpcClientFactory bb_grpc.ClientFactory, shards map[string]*bonanza_storage_frontend.ApplicationConfiguration_Shard, dependenciesGroup program.Group) (object.Store[object.GlobalReference, []byte], tag.Store[object.Namespace, object.GlobalReference, []byte], error) {
-	// Create object & tag stores for each shard.
-	shardNames := make([]string, 0, len(shards))
-	weightedShards := make([]object_sharded.WeightedShard, 0, len(shards))
-	objectDownloaders := make([]object.Downloader[object.GlobalReference], 0, len(shards))
-	objectUploaders := make([]object.Uploader[object.GlobalReference, []byte], 0, len(shards))
-	tagResolvers := make([]tag.Resolver[object.Namespace], 0, len(shards))
-	tagUpdaters := make([]tag.Updater[object.GlobalReference, []byte], 0, len(shards))
-	for key, shard := range shards {
-		grpcClient, err := grpcClientFactory.NewClientFromConfiguration(shard.Client, dependenciesGroup)
-		if err != nil {
-			return nil, nil, util.StatusWrapf(err, "Failed to create gRPC client for shard with key %#v", key)
-		}
-
-		shardNames = append(shardNames, key)
-		weightedShards = append(weightedShards, object_sharded.WeightedShard{
-			Key:    []byte(key),
-			Weight: shard.Weight,
-		})
-		objectDownloaders = append(objectDownloaders, object_grpc.NewGRPCDownloader(
-			object_pb.NewDownloaderClient(grpcClient),
-		))
-		objectUploaders = append(objectUploaders, object_grpc.NewGRPCUploader(
-			object_pb.NewUploaderClient(grpcClient),
-		))
-		tagResolvers = append(tagResolvers, tag_grpc.NewGRPCResolver(
-			tag_pb.NewResolverClient(grpcClient),
-		))
-		tagUpdaters = append(tagUpdaters, tag_grpc.NewGRPCUpdater(
-			tag_pb.NewUpdaterClient(grpcClient),
-		))
-	}
-
-	// If we have multiple stores, instantiate the sharded backend.
-	switch len(shards) {
-	case 0:
-		return nil, nil, status.Error(codes.InvalidArgument, "No shards provided")
-	case 1:
-		return object.NewStore(objectDownloaders[0], objectUploaders[0]),
-			tag.NewStore(tagResolvers[0], tagUpdaters[0]),
-			nil
-	default:
-		picker := object_sharded.NewWeightedRendezvousPicker(weightedShards)
-		return object.NewStore(
-				object_sharded.NewShardedDownloader(objectDownloaders, shardNames, picker),
-				object_sharded.NewShardedUploader[object.GlobalReference, []byte](objectUploaders, shardNames, picker),
-			),
-			tag.NewStore(
-				tag_sharded.NewShardedResolver(tagResolvers, shardNames, picker),
-				tag_sharded.NewShardedUpdater[object.GlobalReference, []byte](tagUpdaters, shardNames, picker),
-			),
-			nil
-	}
-}
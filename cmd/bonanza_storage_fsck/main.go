@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"bonanza.build/pkg/proto/configuration/bonanza_storage_fsck"
+	object_local "bonanza.build/pkg/storage/object/local"
+
+	"github.com/buildbarn/bb-storage/pkg/global"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func main() {
+	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		if len(os.Args) != 2 {
+			return status.Error(codes.InvalidArgument, "Usage: bonanza_storage_fsck bonanza_storage_fsck.jsonnet")
+		}
+		var configuration bonanza_storage_fsck.ApplicationConfiguration
+		if err := util.UnmarshalConfigurationFromFile(os.Args[1], &configuration); err != nil {
+			return util.StatusWrapf(err, "Failed to read configuration from %s", os.Args[1])
+		}
+		if _, _, err := global.ApplyConfiguration(configuration.Global, dependenciesGroup); err != nil {
+			return util.StatusWrap(err, "Failed to apply global configuration options")
+		}
+
+		log.Print("Checking local object store")
+		result, err := object_local.CheckStoreFromConfiguration(ctx, configuration.LocalObjectStore, configuration.DiscardCorruptedObjects)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to check local object store")
+		}
+		log.Printf("Found %d valid and %d corrupted objects", result.ValidObjects, result.CorruptedObjects)
+		if result.CorruptedObjects > 0 {
+			if !configuration.DiscardCorruptedObjects {
+				return status.Errorf(codes.DataLoss, "Local object store contains %d corrupted objects", result.CorruptedObjects)
+			}
+			log.Print("Discarded data up to and including the last corrupted object")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"bonanza.build/pkg/proto/configuration/bonanza_storage_resharder"
+	tag_local_pb "bonanza.build/pkg/proto/storage/tag/local"
+	"bonanza.build/pkg/storage/cluster"
+	"bonanza.build/pkg/storage/object"
+	object_replicated "bonanza.build/pkg/storage/object/replicated"
+	"bonanza.build/pkg/storage/tag"
+	tag_local "bonanza.build/pkg/storage/tag/local"
+	tag_replicated "bonanza.build/pkg/storage/tag/replicated"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/global"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func main() {
+	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		if len(os.Args) != 2 {
+			return status.Error(codes.InvalidArgument, "Usage: bonanza_storage_resharder bonanza_storage_resharder.jsonnet")
+		}
+		var configuration bonanza_storage_resharder.ApplicationConfiguration
+		if err := util.UnmarshalConfigurationFromFile(os.Args[1], &configuration); err != nil {
+			return util.StatusWrapf(err, "Failed to read configuration from %s", os.Args[1])
+		}
+		_, grpcClientFactory, err := global.ApplyConfiguration(configuration.Global, dependenciesGroup)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to apply global configuration options")
+		}
+
+		if configuration.Replica == nil || len(configuration.Replica.PreviousShards) == 0 {
+			return status.Error(codes.InvalidArgument, "No previous shards provided")
+		}
+		objectStore, tagStore, _, err := cluster.NewReplicaFromConfiguration(grpcClientFactory, configuration.Replica, configuration.ShardBatching, dependenciesGroup)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create replica")
+		}
+
+		// Read tags from the persistent state of the previous set
+		// of shards.
+		var tags []*tag_local_pb.TagState
+		for _, stateDirectoryPath := range configuration.TagStateDirectoryPaths {
+			stateDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(stateDirectoryPath))
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to open persistent state directory %#v", stateDirectoryPath)
+			}
+			persistentState, err := tag_local.NewDirectoryBackedPersistentStateStore(stateDirectory).ReadPersistentState()
+			stateDirectory.Close()
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to read persistent state from directory %#v", stateDirectoryPath)
+			}
+			tags = append(tags, persistentState.Tags...)
+		}
+		if len(tags) == 0 {
+			return status.Error(codes.InvalidArgument, "No tags found in the persistent state directories")
+		}
+
+		// Copy all objects reachable from the tags to the current
+		// set of shards, and write the tags with the leases of
+		// the copied objects.
+		tagLister, err := tag_local.NewStore(clock.SystemClock, 0, 0, tags)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to load tags from persistent state")
+		}
+		tagRepairer := tag_replicated.NewRepairer(
+			[]tag.Lister{tagLister},
+			tagStore,
+			tag_replicated.NewUpdater([]tag.Updater[object.GlobalReference, []byte]{tagStore}, 1),
+			object_replicated.NewRepairer(
+				[]object.Store[object.GlobalReference, []byte]{objectStore},
+				configuration.ObjectStoreConcurrency,
+				util.DefaultErrorLogger,
+			),
+			util.DefaultErrorLogger,
+		)
+		log.Printf("Copying objects reachable from %d tags", len(tags))
+		failuresCount, err := tagRepairer.RepairAllTags(ctx)
+		if err != nil {
+			return err
+		}
+		if failuresCount > 0 {
+			// Ensure the tool terminates with a non-zero exit
+			// code, so that the migration is not considered
+			// complete.
+			return status.Errorf(codes.Internal, "Failed to copy objects reachable from %d tags", failuresCount)
+		}
+		log.Print("Done")
+		return nil
+	})
+}
@@ -14,6 +14,7 @@ import (
 	object_leasemarshaling "bonanza.build/pkg/storage/object/leasemarshaling"
 	object_local "bonanza.build/pkg/storage/object/local"
 	object_namespacemapping "bonanza.build/pkg/storage/object/namespacemapping"
+	object_quota "bonanza.build/pkg/storage/object/quota"
 	"bonanza.build/pkg/storage/tag"
 	tag_leasemarshaling "bonanza.build/pkg/storage/tag/leasemarshaling"
 	tag_local "bonanza.build/pkg/storage/tag/local"
@@ -99,8 +100,28 @@ func main() {
 				"LeasesMap",
 			),
 		)
-		tagStore := tag_local.NewStore()
+		tagStore, err := tag_local.NewStoreFromConfiguration(
+			dependenciesGroup,
+			configuration.LocalObjectStore,
+			clock.SystemClock,
+			leaseCompletenessDuration.AsDuration(),
+		)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create local tag store")
+		}
 		leaseMarshaler := object_flatbacked.LeaseMarshaler
+		objectUploader, err := object_quota.NewUploaderFromConfiguration(
+			object_leasemarshaling.NewUploader(
+				object_namespacemapping.NewNamespaceRemovingUploader[object.GlobalReference](
+					flatBackedObjectStore,
+				),
+				leaseMarshaler,
+			),
+			configuration.Quota,
+		)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create quota enforcing uploader")
+		}
 
 		if err := bb_grpc.NewServersFromConfigurationAndServe(
 			configuration.GrpcServers,
@@ -115,14 +136,7 @@ func main() {
 				)
 				object_pb.RegisterUploaderServer(
 					s,
-					object.NewUploaderServer(
-						object_leasemarshaling.NewUploader(
-							object_namespacemapping.NewNamespaceRemovingUploader[object.GlobalReference](
-								flatBackedObjectStore,
-							),
-							leaseMarshaler,
-						),
-					),
+					object.NewUploaderServer(objectUploader),
 				)
 				tag_pb.RegisterResolverServer(
 					s,
@@ -139,6 +153,10 @@ func main() {
 						),
 					),
 				)
+				tag_pb.RegisterListerServer(
+					s,
+					tag.NewListerServer(tagStore),
+				)
 			},
 			siblingsGroup,
 			grpcClientFactory,
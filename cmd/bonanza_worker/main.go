@@ -64,8 +64,9 @@ func main() {
 			return util.StatusWrap(err, "Failed to create storage gRPC client")
 		}
 		objectDownloader := object_existenceprecondition.NewDownloader(
-			object_grpc.NewGRPCDownloader(
+			object_grpc.NewGRPCDownloaderFromConfiguration(
 				object_pb.NewDownloaderClient(storageGRPCClient),
+				configuration.StorageBatching,
 			),
 		)
 		if configuration.LocalObjectStore != nil {
@@ -76,9 +77,14 @@ func main() {
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create local object store")
 			}
+			var prefetchSemaphore *semaphore.Weighted
+			if concurrency := configuration.LocalObjectStorePrefetchConcurrency; concurrency > 0 {
+				prefetchSemaphore = semaphore.NewWeighted(concurrency)
+			}
 			objectDownloader = object_readcaching.NewDownloader(
 				objectDownloader,
 				localObjectStore,
+				prefetchSemaphore,
 			)
 		}
 
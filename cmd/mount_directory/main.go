@@ -83,7 +83,10 @@ func main() {
 			parsedObjectPool,
 			model_parser.NewDownloadingParsedObjectReader(
 				object_namespacemapping.NewNamespaceAddingDownloader(
-					object_grpc.NewGRPCDownloader(object_pb.NewDownloaderClient(grpcClient)),
+					object_grpc.NewGRPCDownloaderFromConfiguration(
+						object_pb.NewDownloaderClient(grpcClient),
+						configuration.Batching,
+					),
 					namespace,
 				),
 			),
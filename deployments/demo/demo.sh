@@ -16,6 +16,8 @@ set -eu
 
 export STATE_PATH="${HOME}/bonanza_demo"
 mkdir -p "${STATE_PATH}/bonanza_fetcher_cache"
+mkdir -p "${STATE_PATH}/bonanza_fetcher_git"
+mkdir -p "${STATE_PATH}/bonanza_scheduler_journal"
 rm -rf "${STATE_PATH}/bonanza_builder_filepool" "${STATE_PATH}/bonanza_worker_filepool"
 umount "${STATE_PATH}/bonanza_worker_mount" || true
 mkdir -p "${STATE_PATH}/bonanza_worker_mount" || true
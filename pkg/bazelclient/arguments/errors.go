@@ -99,6 +99,19 @@ func (e FlagInvalidEnumValueError) Error() string {
 	return sb.String()
 }
 
+// FlagInvalidIntegerValueError is returned when the user invokes a
+// command with a flag that only accepts integer values, but the value
+// that is provided is not an integer (e.g.,
+// "bazel test --runs_per_test=many").
+type FlagInvalidIntegerValueError struct {
+	Flag  string
+	Value string
+}
+
+func (e FlagInvalidIntegerValueError) Error() string {
+	return fmt.Sprintf("flag %s only accepts integer values, not %#v", e.Flag, e.Value)
+}
+
 // ConfigValueNotRecognizedError is returned when the user provides the
 // --config flag with a configuration name that is not declared in any
 // of the bazelrc files.
@@ -14,11 +14,20 @@
 package main
 
 var enumTypes = map[string][]string{
+	"AqueryOutput": {
+		"text",
+		"jsonproto",
+	},
 	"Color": {
 		"yes",
 		"no",
 		"auto",
 	},
+	"CqueryOutput": {
+		"label",
+		"label_kind",
+		"starlark",
+	},
 	"HelpVerbosity": {
 		"long",
 		"medium",
@@ -30,6 +39,28 @@ var enumTypes = map[string][]string{
 		"refresh",
 		"error",
 	},
+	"QueryOutput": {
+		"label",
+		"label_kind",
+		"build",
+		"proto",
+		"graph",
+	},
+	"ReferenceFormat": {
+		"sha256_v1",
+		"sha512_256_v1",
+	},
+	"RemoteDownloadOutputs": {
+		"all",
+		"minimal",
+		"toplevel",
+	},
+	"TestOutput": {
+		"summary",
+		"errors",
+		"all",
+		"streamed",
+	},
 }
 
 var startupFlags = []flag{
@@ -139,6 +170,18 @@ var commonFlags = []flag{
 		description: "A 128, 192 or 256 bit AES key that is used to encrypt files and directories prior to uploading them to storage.",
 		flagType:    stringFlagType{},
 	},
+	{
+		longName:    "remote_exec_header",
+		description: "Specify a header that will be included in execution requests: --remote_exec_header=Name=Value. Multiple headers can be passed by specifying the flag multiple times. These headers are also included in execution requests that are issued by the builder on behalf of the build. The builder only forwards headers whose names start with bonanza- or that are permitted by its configuration.",
+		flagType:    stringListFlagType{},
+	},
+	{
+		longName:    "remote_execution_priority",
+		description: "The relative priority of actions to be executed remotely, including actions that are dispatched by the builder on behalf of the build. The semantics of the priority values are server dependent.",
+		flagType: intFlagType{
+			defaultValue: 0,
+		},
+	},
 	{
 		longName:    "remote_executor",
 		description: "A URI of a bonanza_scheduler endpoint. The supported schemas are grpc, grpcs (grpc with TLS enabled) and unix (local UNIX sockets). Specify grpc:// or unix: schema to disable TLS.",
@@ -169,6 +212,14 @@ var commonFlags = []flag{
 		description: "Value to pass as instance_name in the remote execution API.",
 		flagType:    stringFlagType{},
 	},
+	{
+		longName:    "remote_reference_format",
+		description: "The reference format to use when storing objects in the remote cache. Only objects stored using the same reference format can be shared between builds.",
+		flagType: enumFlagType{
+			enumType:     "ReferenceFormat",
+			defaultValue: "sha256_v1",
+		},
+	},
 	{
 		longName:    "repo_platform",
 		description: "A label of a platform() target that is used to determine the platform that is used to execute repository rules and module extensions. If this argument is not provided, repository rules and module extensions cannot be evaluated.",
@@ -192,6 +243,20 @@ var commonFlags = []flag{
 }
 
 var commands = map[string]command{
+	"aquery": {
+		ancestor: "build",
+		flags: []flag{
+			{
+				longName:    "output",
+				description: "The format in which the aquery results should be printed. Allowed values for aquery are: text, jsonproto.",
+				flagType: enumFlagType{
+					enumType:     "AqueryOutput",
+					defaultValue: "text",
+				},
+			},
+		},
+		takesArguments: true,
+	},
 	"build": {
 		ancestor: "common",
 		flags: []flag{
@@ -213,11 +278,47 @@ var commands = map[string]command{
 				description: "The labels of the platform rules describing the target platforms for the current command.",
 				flagType:    stringFlagType{},
 			},
+			{
+				longName:    "remote_download_outputs",
+				description: "If set to 'minimal' doesn't download any remote build outputs to the local machine. If set to 'toplevel' only downloads the outputs of top-level targets. If set to 'all' downloads the outputs of all actions that were performed as part of the build. Outputs are placed in the output base, and are made accessible through the bazel-bin and bazel-out convenience symlinks in the workspace directory.",
+				flagType: enumFlagType{
+					enumType:     "RemoteDownloadOutputs",
+					defaultValue: "toplevel",
+				},
+			},
 		},
 		takesArguments: true,
 	},
 	"clean": {
 		ancestor: "build",
+		flags: []flag{
+			{
+				longName:    "expunge",
+				description: "If true, clean removes the entire output base for this workspace, including the state that is used to perform incremental builds.",
+				flagType:    boolFlagType{},
+			},
+		},
+	},
+	"cquery": {
+		ancestor: "build",
+		flags: []flag{
+			{
+				longName:    "output",
+				description: "The format in which the cquery results should be printed. Allowed values for cquery are: label, label_kind, starlark.",
+				flagType: enumFlagType{
+					enumType:     "CqueryOutput",
+					defaultValue: "label",
+				},
+			},
+			{
+				longName:    "starlark:expr",
+				description: "A Starlark expression to format each configured target in cquery's --output=starlark mode. The configured target is bound to 'target'. The providers of the configured target can be obtained by calling 'providers(target)'.",
+				flagType: stringFlagType{
+					defaultValue: "str(target.label)",
+				},
+			},
+		},
+		takesArguments: true,
 	},
 	"help": {
 		ancestor: "common",
@@ -266,6 +367,20 @@ var commands = map[string]command{
 	"license": {
 		ancestor: "common",
 	},
+	"query": {
+		ancestor: "common",
+		flags: []flag{
+			{
+				longName:    "output",
+				description: "The format in which the query results should be printed. Allowed values for query are: label, label_kind, build, proto, graph.",
+				flagType: enumFlagType{
+					enumType:     "QueryOutput",
+					defaultValue: "label",
+				},
+			},
+		},
+		takesArguments: true,
+	},
 	"run": {
 		ancestor: "build",
 		flags: []flag{
@@ -277,6 +392,32 @@ var commands = map[string]command{
 		},
 		takesArguments: true,
 	},
+	"test": {
+		ancestor: "build",
+		flags: []flag{
+			{
+				longName:    "runs_per_test",
+				description: "Specifies the number of times each test should be executed. Each run is executed as a separate action, and is reported separately.",
+				flagType: intFlagType{
+					defaultValue: 1,
+				},
+			},
+			{
+				longName:    "test_filter",
+				description: "Specifies a filter to forward to the test framework. Used to limit the tests run. Note that this does not affect which targets are built.",
+				flagType:    stringFlagType{},
+			},
+			{
+				longName:    "test_output",
+				description: "Specifies desired output mode. Valid values are 'summary' to output only test status summary, 'errors' to also print test logs for failed tests, 'all' to print logs for all tests and 'streamed' to output logs for all tests in real time.",
+				flagType: enumFlagType{
+					enumType:     "TestOutput",
+					defaultValue: "summary",
+				},
+			},
+		},
+		takesArguments: true,
+	},
 	"version": {
 		ancestor: "common",
 		flags: []flag{
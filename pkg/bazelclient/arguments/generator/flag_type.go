@@ -207,6 +207,46 @@ func (ft expansionFlagType) emitStartupParser(longName string) {
 	panic("TODO")
 }
 
+type intFlagType struct {
+	defaultValue int
+}
+
+func (ft intFlagType) emitStructField(longName string) {
+	fmt.Printf("%s int\n", toSymbolName(longName, true))
+}
+
+func (ft intFlagType) emitDefaultInitializer(longName string) {
+	fmt.Printf("f.%s = %#v\n", toSymbolName(longName, true), ft.defaultValue)
+}
+
+func (ft intFlagType) emitLongNameParser(flagSetName, longName string) {
+	fmt.Printf("case %#v:\n", "--"+longName)
+	fmt.Printf("  var out *int\n")
+	fmt.Printf("  if flags := cmd.get%sFlags(); flags != nil {\n", toSymbolName(flagSetName, true))
+	fmt.Printf("    out = &flags.%s\n", toSymbolName(longName, true))
+	fmt.Printf("  } else if mustApply {\n")
+	fmt.Printf("    return FlagNotApplicableError{Flag: longOptionName}\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if assignmentIndex < 0 {\n")
+	fmt.Printf("    if len(*currentArgs) == 0 {\n")
+	fmt.Printf("      return FlagMissingValueError{Flag: longOptionName}\n")
+	fmt.Printf("    }\n")
+	fmt.Printf("    optionValue = (*currentArgs)[0]\n")
+	fmt.Printf("    (*currentArgs) = (*currentArgs)[1:]\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if err := parseInt(optionValue, out, longOptionName); err != nil {\n")
+	fmt.Printf("    return err\n")
+	fmt.Printf("  }\n")
+}
+
+func (ft intFlagType) emitShortNameParser(flagSetName, longName, shortName string) {
+	panic("TODO")
+}
+
+func (ft intFlagType) emitStartupParser(longName string) {
+	panic("TODO")
+}
+
 type stringFlagType struct {
 	defaultValue string
 }
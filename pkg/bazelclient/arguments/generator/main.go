@@ -215,10 +215,48 @@ func main() {
 	fmt.Printf("          longOptionName = longOptionName[:assignmentIndex]\n")
 	fmt.Printf("        }\n")
 	fmt.Printf("        switch longOptionName {\n")
+	// Commands may declare flags having the same name, but with a
+	// different type (e.g., --output). Emit a single case for each
+	// of those, dispatching on the flag sets that are available.
+	type flagInSet struct {
+		flagsName string
+		flag      flag
+	}
+	var longNames []string
+	flagsByLongName := map[string][]flagInSet{}
 	for _, flagsName := range slices.Sorted(maps.Keys(commandFlags)) {
 		for _, flag := range commandFlags[flagsName] {
-			flag.flagType.emitLongNameParser(flagsName, flag.longName)
+			if _, ok := flagsByLongName[flag.longName]; !ok {
+				longNames = append(longNames, flag.longName)
+			}
+			flagsByLongName[flag.longName] = append(flagsByLongName[flag.longName], flagInSet{
+				flagsName: flagsName,
+				flag:      flag,
+			})
+		}
+	}
+	for _, longName := range longNames {
+		flags := flagsByLongName[longName]
+		if len(flags) == 1 {
+			flags[0].flag.flagType.emitLongNameParser(flags[0].flagsName, longName)
+			continue
+		}
+		if _, ok := flags[0].flag.flagType.(enumFlagType); !ok {
+			panic("only enum flags may be declared by multiple commands")
 		}
+		fmt.Printf("case %#v:\n", "--"+longName)
+		fmt.Printf("  switch {\n")
+		for i, flag := range flags {
+			if i < len(flags)-1 {
+				fmt.Printf("  case cmd.get%sFlags() != nil:\n", toSymbolName(flag.flagsName, true))
+			} else {
+				fmt.Printf("  default:\n")
+			}
+			fmt.Printf("    switch longOptionName {\n")
+			flag.flag.flagType.emitLongNameParser(flag.flagsName, longName)
+			fmt.Printf("    }\n")
+		}
+		fmt.Printf("  }\n")
 	}
 	fmt.Printf("        case \"--config\":\n")
 	fmt.Printf("          if assignmentIndex < 0 {\n")
@@ -1,5 +1,9 @@
 package arguments
 
+import (
+	"strconv"
+)
+
 type BuildSettingOverride struct {
 	Label string
 	Value string
@@ -72,3 +76,17 @@ func parseBool(hasValue bool, value string, out *bool, flagName string) error {
 	}
 	return nil
 }
+
+func parseInt(value string, out *int, flagName string) error {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return FlagInvalidIntegerValueError{
+			Flag:  flagName,
+			Value: value,
+		}
+	}
+	if out != nil {
+		*out = v
+	}
+	return nil
+}
@@ -499,6 +499,53 @@ func TestParseCommandAndArguments(t *testing.T) {
 		})
 	})
 
+	t.Run("Test", func(t *testing.T) {
+		t.Run("NoFlags", func(t *testing.T) {
+			command, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"test",
+					"//...",
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, arguments.TestFlags{
+				RunsPerTest: 1,
+				TestOutput:  arguments.TestOutput_Summary,
+			}, command.(*arguments.TestCommand).TestFlags)
+		})
+
+		t.Run("RunsPerTest", func(t *testing.T) {
+			command, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"test",
+					"--runs_per_test",
+					"3",
+					"--test_output=errors",
+					"//...",
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, arguments.TestFlags{
+				RunsPerTest: 3,
+				TestOutput:  arguments.TestOutput_Errors,
+			}, command.(*arguments.TestCommand).TestFlags)
+		})
+
+		t.Run("RunsPerTestInvalidValue", func(t *testing.T) {
+			_, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"test",
+					"--runs_per_test=many",
+					"//...",
+				},
+			)
+			require.EqualError(t, err, "flag --runs_per_test only accepts integer values, not \"many\"")
+		})
+	})
+
 	t.Run("Version", func(t *testing.T) {
 		t.Run("NoFlags", func(t *testing.T) {
 			command, err := arguments.ParseCommandAndArguments(
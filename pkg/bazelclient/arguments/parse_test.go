@@ -38,9 +38,11 @@ func TestParse(t *testing.T) {
 				Color:                  arguments.Color_Auto,
 				LockfileMode:           arguments.LockfileMode_Update,
 				RemoteCacheCompression: true,
+				RemoteReferenceFormat:  arguments.ReferenceFormat_Sha256V1,
 			},
 			BuildFlags: arguments.BuildFlags{
-				KeepGoing: true,
+				KeepGoing:             true,
+				RemoteDownloadOutputs: arguments.RemoteDownloadOutputs_Toplevel,
 			},
 			Arguments: []string{"//..."},
 		}, command)
@@ -0,0 +1,92 @@
+package build
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"bonanza.build/pkg/bazelclient/arguments"
+	"bonanza.build/pkg/bazelclient/commands"
+	"bonanza.build/pkg/bazelclient/formatted"
+	"bonanza.build/pkg/bazelclient/logging"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/kballard/go-shellquote"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DoAquery implements the "bazel aquery" command. The query expression
+// is evaluated remotely, after which the actions declared by the
+// resulting configured targets are printed.
+func DoAquery(args *arguments.AqueryCommand, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "aquery", workspacePath)
+
+	evaluation := performEvaluation(logger, &args.CommonFlags, workspacePath, &evaluationRequest{
+		buildSettingOverrides: args.BuildSettingOverrides,
+		platforms:             args.BuildFlags.Platforms,
+		requestedKey: &model_analysis_pb.ActionQueryResult_Key{
+			Expression: getQueryExpression(logger, args.Arguments),
+		},
+	})
+	aqueryResult, ok := evaluation.requestedValue.Message.(*model_analysis_pb.ActionQueryResult_Value)
+	if !ok {
+		logger.Fatal(formatted.Text("Action query result has an unexpected message type"))
+	}
+	if len(aqueryResult.Actions) == 0 {
+		logger.Info(formatted.Text("Empty results"))
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	switch args.AqueryFlags.Output {
+	case arguments.AqueryOutput_Text:
+		for i, action := range aqueryResult.Actions {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			writeAqueryActionAsText(w, action)
+		}
+	case arguments.AqueryOutput_Jsonproto:
+		data, err := protojson.MarshalOptions{Multiline: true}.Marshal(aqueryResult)
+		if err != nil {
+			logger.Fatal(formatted.Textf("Failed to marshal aquery result: %s", err))
+		}
+		w.Write(data)
+		fmt.Fprintln(w)
+	default:
+		panic("unknown aquery output")
+	}
+	if err := w.Flush(); err != nil {
+		logger.Fatal(formatted.Textf("Failed to write aquery results: %s", err))
+	}
+}
+
+// writeAqueryActionAsText writes an action that is part of the results
+// of an action query in a human readable format, similar to the one
+// used by Bazel.
+func writeAqueryActionAsText(w *bufio.Writer, action *model_analysis_pb.ActionQueryResult_Value_Action) {
+	fmt.Fprintf(w, "action '%s'\n", action.Mnemonic)
+	fmt.Fprintf(w, "  Mnemonic: %s\n", action.Mnemonic)
+	fmt.Fprintf(w, "  Target: %s\n", action.Label)
+	fmt.Fprintf(w, "  Configuration: %s\n", action.ConfigurationComponent)
+	fmt.Fprintf(w, "  Inputs: [%s]\n", strings.Join(action.Inputs, ", "))
+	fmt.Fprintf(w, "  Outputs: [%s]\n", strings.Join(action.Outputs, ", "))
+	if len(action.EnvironmentVariables) > 0 {
+		environmentVariables := make([]string, 0, len(action.EnvironmentVariables))
+		for _, environmentVariable := range action.EnvironmentVariables {
+			environmentVariables = append(environmentVariables, environmentVariable.Name+"="+environmentVariable.Value)
+		}
+		fmt.Fprintf(w, "  Environment: [%s]\n", strings.Join(environmentVariables, ", "))
+	}
+	fmt.Fprint(w, "  Command Line: (exec")
+	for i, argument := range action.Arguments {
+		if i > 0 {
+			fmt.Fprint(w, " \\\n   ")
+		}
+		fmt.Fprintf(w, " %s", shellquote.Join(argument))
+	}
+	fmt.Fprintln(w, ")")
+}
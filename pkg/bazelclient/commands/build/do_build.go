@@ -1,16 +1,20 @@
 package build
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"maps"
 	"math"
 	"net/url"
 	"os"
+	"path/filepath"
 	"runtime"
 	"slices"
 	"strings"
@@ -26,6 +30,7 @@ import (
 	model_executewithstorage "bonanza.build/pkg/model/executewithstorage"
 	model_filesystem "bonanza.build/pkg/model/filesystem"
 	model_parser "bonanza.build/pkg/model/parser"
+	model_starlark "bonanza.build/pkg/model/starlark"
 	encryptedaction_pb "bonanza.build/pkg/proto/encryptedaction"
 	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
 	model_core_pb "bonanza.build/pkg/proto/model/core"
@@ -42,11 +47,13 @@ import (
 	"bonanza.build/pkg/storage/object"
 	object_grpc "bonanza.build/pkg/storage/object/grpc"
 	object_namespacemapping "bonanza.build/pkg/storage/object/namespacemapping"
+	object_prefetching "bonanza.build/pkg/storage/object/prefetching"
 
 	"github.com/buildbarn/bb-storage/pkg/eviction"
 	"github.com/buildbarn/bb-storage/pkg/filesystem"
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/google/uuid"
 
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
@@ -54,12 +61,14 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/security/advancedtls"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -158,13 +167,85 @@ func (f *localCapturableFile[TFile]) Discard() {
 	f.file = nil
 }
 
+// DoBuild implements the "bazel build" command.
 func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
 	commands.ValidateInsideWorkspace(logger, "build", workspacePath)
+	performBuild(logger, &args.CommonFlags, &args.BuildFlags, args.Arguments, args.BuildSettingOverrides, workspacePath, &model_analysis_pb.BuildResult_Key{})
+	logger.Info(formatted.Text("Build completed successfully"))
+}
+
+// pinOutcomes assigns a tag to the outcomes of a build. This prevents
+// them from being removed from storage, so that they can be reused by
+// the next build in the same workspace. As the outcomes are uploaded
+// as a DAG whose objects are expected to be present, this also
+// validates that none of the objects have been removed from storage.
+func pinOutcomes(ctx context.Context, dagUploaderClient dag_pb.UploaderClient, outcomesReference object.GlobalReference, outputBase string) error {
+	outputBaseSHA256 := sha256.Sum256([]byte(outputBase))
+	tag, err := anypb.New(&model_evaluation_pb.PreviousOutcomesTag{
+		WorkspaceSha256: outputBaseSHA256[:],
+	})
+	if err != nil {
+		return err
+	}
+	return dag.UploadTaggedDAG(
+		ctx,
+		dagUploaderClient,
+		outcomesReference,
+		tag,
+		dag.ExistingObjectContentsWalker,
+		semaphore.NewWeighted(1),
+		object.Unlimited,
+	)
+}
+
+// completedBuild contains the result of a build that completed
+// successfully, and the facilities that are needed to access its
+// outputs.
+type completedBuild struct {
+	buildResult      model_core.Message[*model_analysis_pb.BuildResult_Value, object.LocalReference]
+	outputDownloader *outputDownloader
+	fileReader       *model_filesystem.FileReader[object.LocalReference]
+	workspacePath    string
+	outputBase       string
+	bazelOutPath     string
+}
+
+// evaluationRequest contains the parameters of an evaluation that
+// needs to be performed remotely, such as the key whose value needs to
+// be computed.
+type evaluationRequest struct {
+	targetPatternArgs     []string
+	buildSettingOverrides []arguments.BuildSettingOverride
+	platforms             string
+	requestedKey          proto.Message
+}
+
+// completedEvaluation contains the value of the key that was requested
+// as part of an evaluation, and the facilities that are needed to read
+// any objects referenced by it.
+type completedEvaluation struct {
+	requestedValue           model_core.Message[proto.Message, object.LocalReference]
+	outcomesReference        *model_core.Decodable[object.LocalReference]
+	parsedObjectPoolIngester *model_parser.ParsedObjectPoolIngester[object.LocalReference]
+	directoryParameters      *model_filesystem.DirectoryCreationParameters
+	fileParameters           *model_filesystem.FileCreationParameters
+	outputBase               string
+}
 
-	remoteCacheClient, err := newGRPCClient(args.CommonFlags.RemoteCache, &args.CommonFlags)
+// performEvaluation uploads the sources of all modules in the
+// workspace and requests the builder to compute the value of a single
+// key. It is used by all commands that need to evaluate keys remotely,
+// such as "build" and "query".
+func performEvaluation(
+	logger logging.Logger,
+	commonFlags *arguments.CommonFlags,
+	workspacePath path.Parser,
+	request *evaluationRequest,
+) *completedEvaluation {
+	remoteCacheClient, err := newGRPCClient(commonFlags.RemoteCache, commonFlags)
 	if err != nil {
-		logger.Fatal(formatted.Textf("Failed to create gRPC client for --remote_cache=%#v: %s", args.CommonFlags.RemoteCache, err))
+		logger.Fatal(formatted.Textf("Failed to create gRPC client for --remote_cache=%#v: %s", commonFlags.RemoteCache, err))
 	}
 
 	// Determine the names and paths of all modules that are present
@@ -201,7 +282,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	}
 
 	// Augment results with modules provided to --override_module.
-	for _, overrideModule := range args.CommonFlags.OverrideModule {
+	for _, overrideModule := range commonFlags.OverrideModule {
 		fields := strings.SplitN(overrideModule, "=", 2)
 		if len(fields) != 2 {
 			logger.Fatal(formatted.Text("Module overrides must use the format ${module_name}=${path}"))
@@ -222,8 +303,14 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	// trees. Parameters include minimum/maximum sizes of the
 	// resulting objects, and whether they are compressed and
 	// encrypted.
-	referenceFormat := util.Must(object.NewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1))
-	encryptionKeyBytes, err := base64.StdEncoding.DecodeString(args.CommonFlags.RemoteEncryptionKey)
+	var referenceFormat object.ReferenceFormat
+	switch commonFlags.RemoteReferenceFormat {
+	case arguments.ReferenceFormat_Sha256V1:
+		referenceFormat = object.SHA256V1ReferenceFormat
+	case arguments.ReferenceFormat_Sha512256V1:
+		referenceFormat = object.SHA512_256V1ReferenceFormat
+	}
+	encryptionKeyBytes, err := base64.StdEncoding.DecodeString(commonFlags.RemoteEncryptionKey)
 	if err != nil {
 		logger.Fatal(formatted.Textf("Failed to base64 decode value of --remote_encryption_key: %s", err))
 	}
@@ -235,7 +322,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		},
 	}}
 	var chunkEncoders []*model_encoding_pb.BinaryEncoder
-	if args.CommonFlags.RemoteCacheCompression {
+	if commonFlags.RemoteCacheCompression {
 		chunkEncoders = append(chunkEncoders, &model_encoding_pb.BinaryEncoder{
 			Encoder: &model_encoding_pb.BinaryEncoder_LzwCompressing{
 				LzwCompressing: &emptypb.Empty{},
@@ -311,7 +398,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		logger.Fatal(formatted.Text(err.Error()))
 	}
 
-	fetcherPKIXPublicKey, err := base64.StdEncoding.DecodeString(args.CommonFlags.RemoteExecutorFetcherPkixPublicKey)
+	fetcherPKIXPublicKey, err := base64.StdEncoding.DecodeString(commonFlags.RemoteExecutorFetcherPkixPublicKey)
 	if err != nil {
 		logger.Fatal(formatted.Textf("Failed to base64 decode --remote_executor_fetcher_pkix_public_key: %s", err))
 	}
@@ -320,8 +407,8 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	// that any relative target patterns are resolved correctly.
 	currentPackage := rootModuleName.ToModuleInstance(nil).GetBareCanonicalRepo().GetRootPackage()
 
-	targetPatterns := make([]string, 0, len(args.Arguments))
-	for _, targetPattern := range args.Arguments {
+	targetPatterns := make([]string, 0, len(request.targetPatternArgs))
+	for _, targetPattern := range request.targetPatternArgs {
 		apparentTargetPattern, err := currentPackage.AppendTargetPattern(targetPattern)
 		if err != nil {
 			logger.Fatal(formatted.Textf("Invalid target pattern %#v: %s", targetPattern, err))
@@ -333,8 +420,8 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	// CLI only supports specifying build setting overrides and a
 	// single list of platforms. However, there is no way to pick
 	// different build setting overrides depending on the platform.
-	commonBuildSettingOverrides := make([]*model_analysis_pb.BuildSpecification_Value_BuildSettingOverride, 0, len(args.BuildSettingOverrides))
-	for _, override := range args.BuildSettingOverrides {
+	commonBuildSettingOverrides := make([]*model_analysis_pb.BuildSpecification_Value_BuildSettingOverride, 0, len(request.buildSettingOverrides))
+	for _, override := range request.buildSettingOverrides {
 		apparentLabel, err := currentPackage.AppendTargetPattern(override.Label)
 		if err != nil {
 			logger.Fatal(formatted.Textf("Invalid build setting override --%s=%#v: %s", override.Label, override.Value, err))
@@ -347,7 +434,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 			},
 		)
 	}
-	targetPlatforms := strings.FieldsFunc(args.BuildFlags.Platforms, func(r rune) bool { return r == ',' })
+	targetPlatforms := strings.FieldsFunc(request.platforms, func(r rune) bool { return r == ',' })
 	if len(targetPlatforms) == 0 {
 		targetPlatforms = []string{"@platforms//host"}
 	}
@@ -372,32 +459,32 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		TargetPatterns:                         targetPatterns,
 		DirectoryCreationParameters:            directoryParametersMessage,
 		FileCreationParameters:                 fileParametersMessage,
-		IgnoreRootModuleDevDependencies:        args.CommonFlags.IgnoreDevDependency,
-		BuiltinsModuleNames:                    args.CommonFlags.BuiltinsModule,
-		RepoPlatform:                           args.CommonFlags.RepoPlatform,
+		IgnoreRootModuleDevDependencies:        commonFlags.IgnoreDevDependency,
+		BuiltinsModuleNames:                    commonFlags.BuiltinsModule,
+		RepoPlatform:                           commonFlags.RepoPlatform,
 		FetchPlatformPkixPublicKey:             fetcherPKIXPublicKey,
 		ActionEncoders:                         defaultEncoders,
 		Configurations:                         configurations,
-		RuleImplementationWrapperIdentifier:    args.CommonFlags.RuleImplementationWrapperIdentifier,
-		SubruleImplementationWrapperIdentifier: args.CommonFlags.SubruleImplementationWrapperIdentifier,
+		RuleImplementationWrapperIdentifier:    commonFlags.RuleImplementationWrapperIdentifier,
+		SubruleImplementationWrapperIdentifier: commonFlags.SubruleImplementationWrapperIdentifier,
 	}
-	switch args.CommonFlags.LockfileMode {
+	switch commonFlags.LockfileMode {
 	case arguments.LockfileMode_Off:
 	case arguments.LockfileMode_Update:
 		buildSpecification.UseLockfile = &model_analysis_pb.BuildSpecification_Value_UseLockfile{}
 	case arguments.LockfileMode_Refresh:
 		buildSpecification.UseLockfile = &model_analysis_pb.BuildSpecification_Value_UseLockfile{
-			Error: true,
+			MaximumCacheDuration: &durationpb.Duration{Seconds: 3600},
 		}
 	case arguments.LockfileMode_Error:
 		buildSpecification.UseLockfile = &model_analysis_pb.BuildSpecification_Value_UseLockfile{
-			MaximumCacheDuration: &durationpb.Duration{Seconds: 3600},
+			Error: true,
 		}
 	default:
 		panic("unknown lockfile mode")
 	}
-	if len(args.CommonFlags.Registry) > 0 {
-		buildSpecification.ModuleRegistryUrls = args.CommonFlags.Registry
+	if len(commonFlags.Registry) > 0 {
+		buildSpecification.ModuleRegistryUrls = commonFlags.Registry
 	} else {
 		buildSpecification.ModuleRegistryUrls = []string{"https://bcr.bazel.build/"}
 	}
@@ -452,27 +539,57 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		logger.Fatal(formatted.Textf("Failed to create action encoder: %s", err))
 	}
 
-	// TODO: Should these be moved into special overrides?
-	/*
-		var invocationID uuid.UUID
-		if v := args.CommonFlags.InvocationId; v == "" {
-			invocationID = util.Must(uuid.NewRandom())
-		} else {
-			invocationID, err = uuid.Parse(v)
-			if err != nil {
-				logger.Fatal(formatted.Textf("Invalid --invocation_id=%#v: %s", v, err))
-			}
+	// Attach request metadata headers to execution requests, so
+	// that the scheduler can identify the invocation to which
+	// actions belong. The headers are also forwarded to the
+	// builder, so that any actions that it dispatches on behalf of
+	// the build carry the same headers.
+	var invocationID uuid.UUID
+	if v := commonFlags.InvocationId; v == "" {
+		invocationID = util.Must(uuid.NewRandom())
+	} else {
+		invocationID, err = uuid.Parse(v)
+		if err != nil {
+			logger.Fatal(formatted.Textf("Invalid --invocation_id=%#v: %s", v, err))
 		}
-		var buildRequestID uuid.UUID
-		if v := args.CommonFlags.BuildRequestId; v == "" {
-			buildRequestID = util.Must(uuid.NewRandom())
-		} else {
-			buildRequestID, err = uuid.Parse(v)
-			if err != nil {
-				logger.Fatal(formatted.Textf("Invalid --build_request_id=%#v: %s", v, err))
-			}
+	}
+	var buildRequestID uuid.UUID
+	if v := commonFlags.BuildRequestId; v == "" {
+		buildRequestID = util.Must(uuid.NewRandom())
+	} else {
+		buildRequestID, err = uuid.Parse(v)
+		if err != nil {
+			logger.Fatal(formatted.Textf("Invalid --build_request_id=%#v: %s", v, err))
 		}
-	*/
+	}
+	executionRequestMetadata := []*model_evaluation_pb.Action_RequestMetadataHeader{
+		{
+			Name:  remoteexecution.ToolInvocationIDMetadataKey,
+			Value: invocationID.String(),
+		},
+		{
+			Name:  remoteexecution.CorrelatedInvocationsIDMetadataKey,
+			Value: buildRequestID.String(),
+		},
+	}
+	for _, header := range commonFlags.RemoteExecHeader {
+		name, value, ok := strings.Cut(header, "=")
+		if !ok || name == "" {
+			logger.Fatal(formatted.Textf("Invalid --remote_exec_header=%#v: Header must be of the form name=value", header))
+		}
+		name = strings.ToLower(name)
+		if err := remoteexecution.ValidateMetadataKey(name); err != nil {
+			logger.Fatal(formatted.Textf("Invalid --remote_exec_header=%#v: %s", header, status.Convert(err).Message()))
+		}
+		executionRequestMetadata = append(executionRequestMetadata, &model_evaluation_pb.Action_RequestMetadataHeader{
+			Name:  name,
+			Value: value,
+		})
+	}
+	executionRequestMetadataPairs := make([]string, 0, 2*len(executionRequestMetadata))
+	for _, header := range executionRequestMetadata {
+		executionRequestMetadataPairs = append(executionRequestMetadataPairs, header.Name, header.Value)
+	}
 
 	overrides, err := model_core.BuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[dag.ObjectContentsWalker]) (model_core.Marshalable, error) {
 		buildSpecificationKey, err := model_core.MarshalAny(
@@ -508,6 +625,39 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		logger.Fatal(formatted.Textf("Failed to create overrides list object: %s", err))
 	}
 
+	// If a previous build was performed in this workspace, provide
+	// a reference to its outcomes. This permits the builder to skip
+	// evaluation of keys whose dependencies did not change. Only do
+	// this if the outcomes are still present in storage in their
+	// entirety.
+	instanceName := object.NewInstanceName(commonFlags.RemoteInstanceName)
+	dagUploaderClient := dag_pb.NewUploaderClient(remoteCacheClient)
+	outputBase, err := commands.GetOutputBase(workspacePath)
+	if err != nil {
+		logger.Fatal(formatted.Textf("Failed to obtain output base: %s", err))
+	}
+	previousOutcomesPath := filepath.Join(outputBase, "previous_outcomes")
+	var previousOutcomesReference *model_core_pb.WeakDecodableReference
+	if previousOutcomesData, err := os.ReadFile(previousOutcomesPath); err == nil {
+		if r, err := model_core.NewDecodableLocalReferenceFromString(referenceFormat, strings.TrimSpace(string(previousOutcomesData))); err != nil {
+			logger.Warning(formatted.Textf("Ignoring invalid outcomes of the previous build in %#v: %s", previousOutcomesPath, err))
+		} else if err := pinOutcomes(ctx, dagUploaderClient, instanceName.WithLocalReference(r.Value), outputBase); err != nil {
+			logger.Warning(formatted.Textf("Ignoring outcomes of the previous build, as they are no longer present in storage: %s", err))
+		} else {
+			previousOutcomesReference = model_core.DecodableLocalReferenceToWeakProto(r)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		logger.Warning(formatted.Textf("Failed to read outcomes of the previous build: %s", err))
+	}
+
+	// The priority is not only used to schedule the build itself.
+	// It is also forwarded to the builder, so that any actions that
+	// it dispatches on behalf of the build use the same priority.
+	if p := commonFlags.RemoteExecutionPriority; p < math.MinInt32 || p > math.MaxInt32 {
+		logger.Fatal(formatted.Textf("Invalid --remote_execution_priority=%d: Value does not fit in a 32-bit integer", p))
+	}
+	priority := int32(commonFlags.RemoteExecutionPriority)
+
 	// Construct an Action message.
 	actionMessage, err := model_core.BuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[dag.ObjectContentsWalker]) (model_core.Marshalable, error) {
 		overridesReference, err := patcher.CaptureAndAddDecodableReference(
@@ -519,20 +669,21 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 			return nil, err
 		}
 
-		buildResultKey, err := model_core.MarshalAny(
-			model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
-				&model_analysis_pb.BuildResult_Key{},
-			),
+		requestedKey, err := model_core.MarshalAny(
+			model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](request.requestedKey),
 		)
 		if err != nil {
 			return nil, err
 		}
 
 		return model_core.NewProtoMarshalable(&model_evaluation_pb.Action{
-			OverridesReference: overridesReference,
+			OverridesReference:        overridesReference,
+			PreviousOutcomesReference: previousOutcomesReference,
+			Priority:                  priority,
+			ExecutionRequestMetadata:  executionRequestMetadata,
 			RequestedKeys: []*model_evaluation_pb.Keys{{
 				Level: &model_evaluation_pb.Keys_Leaf{
-					Leaf: buildResultKey.Merge(patcher),
+					Leaf: requestedKey.Merge(patcher),
 				},
 			}},
 		}), nil
@@ -546,12 +697,11 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	}
 
 	logger.Info(formatted.Text("Uploading module sources"))
-	instanceName := object.NewInstanceName(args.CommonFlags.RemoteInstanceName)
 	actionReference := createdAction.Value.GetLocalReference()
 	actionGlobalReference := instanceName.WithLocalReference(actionReference)
 	if err := dag.UploadDAG(
 		ctx,
-		dag_pb.NewUploaderClient(remoteCacheClient),
+		dagUploaderClient,
 		actionGlobalReference,
 		dag.NewSimpleObjectContentsWalker(
 			createdAction.Value.Contents,
@@ -566,27 +716,27 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		logger.Fatal(formatted.Textf("Failed to upload workspace directory: %s", err))
 	}
 
-	clientPrivateKeyData, err := os.ReadFile(args.CommonFlags.RemoteExecutorClientPrivateKey)
+	clientPrivateKeyData, err := os.ReadFile(commonFlags.RemoteExecutorClientPrivateKey)
 	if err != nil {
-		logger.Fatal(formatted.Textf("Failed to read --remote_executor_client_private_key=%#v: %s", args.CommonFlags.RemoteExecutorClientPrivateKey, err))
+		logger.Fatal(formatted.Textf("Failed to read --remote_executor_client_private_key=%#v: %s", commonFlags.RemoteExecutorClientPrivateKey, err))
 	}
 	clientPrivateKey, err := crypto.ParsePEMWithPKCS8ECDHPrivateKey(clientPrivateKeyData)
 	if err != nil {
-		logger.Fatal(formatted.Textf("Failed to parse --remote_executor_client_private_key=%#v: %s", args.CommonFlags.RemoteExecutorClientPrivateKey, err))
+		logger.Fatal(formatted.Textf("Failed to parse --remote_executor_client_private_key=%#v: %s", commonFlags.RemoteExecutorClientPrivateKey, err))
 	}
 
-	clientCertificateChainData, err := os.ReadFile(args.CommonFlags.RemoteExecutorClientCertificateChain)
+	clientCertificateChainData, err := os.ReadFile(commonFlags.RemoteExecutorClientCertificateChain)
 	if err != nil {
-		logger.Fatal(formatted.Textf("Failed to read --remote_executor_client_certificate_chain=%#v: %s", args.CommonFlags.RemoteExecutorClientCertificateChain, err))
+		logger.Fatal(formatted.Textf("Failed to read --remote_executor_client_certificate_chain=%#v: %s", commonFlags.RemoteExecutorClientCertificateChain, err))
 	}
 	clientCertificateChain, err := remoteexecution.ParseCertificateChain(clientCertificateChainData)
 	if err != nil {
-		logger.Fatal(formatted.Textf("Failed to parse --remote_executor_client_certificate_chain=%#v: %s", args.CommonFlags.RemoteExecutorClientCertificateChain, err))
+		logger.Fatal(formatted.Textf("Failed to parse --remote_executor_client_certificate_chain=%#v: %s", commonFlags.RemoteExecutorClientCertificateChain, err))
 	}
 
-	remoteExecutorClient, err := newGRPCClient(args.CommonFlags.RemoteExecutor, &args.CommonFlags)
+	remoteExecutorClient, err := newGRPCClient(commonFlags.RemoteExecutor, commonFlags)
 	if err != nil {
-		logger.Fatal(formatted.Textf("Failed to create gRPC client for --remote_executor=%#v: %s", args.CommonFlags.RemoteExecutor, err))
+		logger.Fatal(formatted.Textf("Failed to create gRPC client for --remote_executor=%#v: %s", commonFlags.RemoteExecutor, err))
 	}
 	builderClient := model_executewithstorage.NewNamespaceAddingClient(
 		model_executewithstorage.NewProtoClient(
@@ -601,7 +751,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		instanceName,
 	)
 
-	builderPKIXPublicKey, err := base64.StdEncoding.DecodeString(args.CommonFlags.RemoteExecutorBuilderPkixPublicKey)
+	builderPKIXPublicKey, err := base64.StdEncoding.DecodeString(commonFlags.RemoteExecutorBuilderPkixPublicKey)
 	if err != nil {
 		logger.Fatal(formatted.Textf("Failed to base64 decode --remote_executor_builder_pkix_public_key: %s", err))
 	}
@@ -613,7 +763,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	decodableActionReference := model_core.CopyDecodable(createdAction, actionReference)
 	actionReferenceStr := model_core.DecodableLocalReferenceToString(decodableActionReference)
 	actionLink := formatted.Text(actionReferenceStr)
-	browserURL := args.CommonFlags.BrowserUrl
+	browserURL := commonFlags.BrowserUrl
 	actionMessageType := "bonanza.model.evaluation.Action"
 	if browserURL != "" {
 		if actionURL, err := url.JoinPath(
@@ -638,7 +788,16 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		parsedObjectPool,
 		model_parser.NewDownloadingParsedObjectReader(
 			object_namespacemapping.NewNamespaceAddingDownloader(
-				object_grpc.NewGRPCDownloader(object_pb.NewDownloaderClient(remoteCacheClient)),
+				object_prefetching.NewDownloader(
+					object_grpc.NewGRPCBatchDownloader(
+						object_pb.NewDownloaderClient(remoteCacheClient),
+						/* maximumBatchSize = */ 100,
+						/* maximumConcurrentBatches = */ 4,
+					),
+					semaphore.NewWeighted(100),
+					/* maximumDepth = */ 2,
+					/* maximumCount = */ 1000,
+				),
 				instanceName,
 			),
 		),
@@ -672,7 +831,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	)
 	progressLinesWritten := 0
 	for progressReference := range builderClient.RunAction(
-		context.Background(),
+		metadata.AppendToOutgoingContext(context.Background(), executionRequestMetadataPairs...),
 		builderECDHPublicKey,
 		&model_executewithstorage.Action[object.LocalReference]{
 			Reference: model_core.CopyDecodable(
@@ -689,6 +848,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		&encryptedaction_pb.Action_AdditionalData{
 			ExecutionTimeout: &durationpb.Duration{Seconds: 24 * 60 * 60},
 		},
+		priority,
 		&resultReference,
 		&errBuild,
 	) {
@@ -787,12 +947,208 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 			logger.Fatal(formatted.Textf("Invalid evaluations reference: %s", err))
 		}
 		outcomesReference = &r
+
+		// Preserve the outcomes, so that the next build in
+		// this workspace can be performed incrementally.
+		if err := pinOutcomes(ctx, dagUploaderClient, instanceName.WithLocalReference(r.Value), outputBase); err != nil {
+			logger.Warning(formatted.Textf("Failed to assign a tag to the outcomes of the build: %s", err))
+		} else if err := os.MkdirAll(outputBase, 0o777); err != nil {
+			logger.Warning(formatted.Textf("Failed to create output base: %s", err))
+		} else if err := os.WriteFile(previousOutcomesPath, []byte(model_core.DecodableLocalReferenceToString(r)+"\n"), 0o666); err != nil {
+			logger.Warning(formatted.Textf("Failed to write outcomes of the build: %s", err))
+		}
 	}
 
 	if f := result.Message.Failure; f != nil {
 		printStackTrace(namespace, model_core.Nested(result, f.StackTraceKeys), logger, &jsonFormatter, browserURL, outcomesReference)
 		logger.Fatal(formatted.Textf("Failed to perform build: %s", status.FromProto(f.Status)))
 	}
+
+	if len(result.Message.RequestedValues) != 1 {
+		logger.Fatal(formatted.Textf("Build result contains %d values, while 1 value was expected", len(result.Message.RequestedValues)))
+	}
+	requestedValue, err := model_core.UnmarshalAnyNew(model_core.Nested(result, result.Message.RequestedValues[0]))
+	if err != nil {
+		logger.Fatal(formatted.Textf("Invalid build result: %s", err))
+	}
+	return &completedEvaluation{
+		requestedValue:           requestedValue.Decay(),
+		outcomesReference:        outcomesReference,
+		parsedObjectPoolIngester: parsedObjectPoolIngester,
+		directoryParameters:      directoryParameters,
+		fileParameters:           fileParameters,
+		outputBase:               outputBase,
+	}
+}
+
+// performBuild builds the targets matched by the provided target
+// patterns, downloads their outputs, and creates convenience symlinks
+// in the workspace directory. It is used by all commands that need to
+// build targets before acting on them. The provided BuildResult key
+// controls whether tests are run, and whether executables of the
+// targets need to be returned.
+func performBuild(
+	logger logging.Logger,
+	commonFlags *arguments.CommonFlags,
+	buildFlags *arguments.BuildFlags,
+	targetPatternArgs []string,
+	buildSettingOverrides []arguments.BuildSettingOverride,
+	workspacePath path.Parser,
+	buildResultKey *model_analysis_pb.BuildResult_Key,
+) *completedBuild {
+	evaluation := performEvaluation(logger, commonFlags, workspacePath, &evaluationRequest{
+		targetPatternArgs:     targetPatternArgs,
+		buildSettingOverrides: buildSettingOverrides,
+		platforms:             buildFlags.Platforms,
+		requestedKey:          buildResultKey,
+	})
+	buildResultMessage, ok := evaluation.requestedValue.Message.(*model_analysis_pb.BuildResult_Value)
+	if !ok {
+		logger.Fatal(formatted.Text("Build result has an unexpected message type"))
+	}
+	buildResult := model_core.Nested(evaluation.requestedValue, buildResultMessage)
+	ctx := context.Background()
+	parsedObjectPoolIngester := evaluation.parsedObjectPoolIngester
+	directoryParameters := evaluation.directoryParameters
+	fileParameters := evaluation.fileParameters
+	outcomesReference := evaluation.outcomesReference
+	outputBase := evaluation.outputBase
+
+	// Download outputs of the build into the output base.
+	directoryEncoder := directoryParameters.DirectoryAccessParameters.GetEncoder()
+	outputDownloader := outputDownloader{
+		directoryContentsReader: model_parser.LookupParsedObjectReader(
+			parsedObjectPoolIngester,
+			model_parser.NewChainedObjectParser(
+				model_parser.NewEncodedObjectParser[object.LocalReference](directoryEncoder),
+				model_parser.NewProtoObjectParser[object.LocalReference, model_filesystem_pb.DirectoryContents](),
+			),
+		),
+		leavesReader: model_parser.LookupParsedObjectReader(
+			parsedObjectPoolIngester,
+			model_parser.NewChainedObjectParser(
+				model_parser.NewEncodedObjectParser[object.LocalReference](directoryEncoder),
+				model_parser.NewProtoObjectParser[object.LocalReference, model_filesystem_pb.Leaves](),
+			),
+		),
+		execrootPath: filepath.Join(outputBase, "execroot"),
+	}
+	fileReader := model_filesystem.NewFileReader(
+		model_parser.LookupParsedObjectReader(
+			parsedObjectPoolIngester,
+			model_parser.NewChainedObjectParser(
+				model_parser.NewEncodedObjectParser[object.LocalReference](fileParameters.GetFileContentsListEncoder()),
+				model_filesystem.NewFileContentsListObjectParser[object.LocalReference](),
+			),
+		),
+		model_parser.LookupParsedObjectReader(
+			parsedObjectPoolIngester,
+			model_parser.NewChainedObjectParser(
+				model_parser.NewEncodedObjectParser[object.LocalReference](fileParameters.GetChunkEncoder()),
+				model_parser.NewRawObjectParser[object.LocalReference](),
+			),
+		),
+	)
+	outputDownloader.directoryMaterializer = model_filesystem.NewDirectoryMaterializer(
+		outputDownloader.directoryContentsReader,
+		outputDownloader.leavesReader,
+		fileReader,
+	)
+	downloadOutputs := buildFlags.RemoteDownloadOutputs
+	if downloadOutputs == arguments.RemoteDownloadOutputs_All && outcomesReference != nil {
+		logger.Info(formatted.Text("Downloading outputs of all actions"))
+		if err := outputDownloader.materializeAllActionOutputs(
+			ctx,
+			model_parser.LookupParsedObjectReader(
+				parsedObjectPoolIngester,
+				model_parser.NewChainedObjectParser(
+					model_parser.NewEncodedObjectParser[object.LocalReference](model_encoding.NewChainedBinaryEncoder(nil)),
+					model_parser.NewProtoListObjectParser[object.LocalReference, model_evaluation_pb.Evaluation](),
+				),
+			),
+			*outcomesReference,
+		); err != nil {
+			logger.Fatal(formatted.Textf("Failed to download outputs of actions: %s", err))
+		}
+	}
+	if downloadOutputs != arguments.RemoteDownloadOutputs_Minimal {
+		for _, targetCompletion := range buildResult.Message.TargetCompletions {
+			if err := outputDownloader.materializeBazelOut(ctx, model_core.Nested(buildResult, targetCompletion.RootDirectory)); err != nil {
+				logger.Fatal(formatted.Textf("Failed to download outputs of target %s: %s", targetCompletion.Label, err))
+			}
+		}
+	}
+
+	// Create convenience symlinks in the workspace directory. Like
+	// Bazel, let bazel-bin point to the output directory of the
+	// configuration of the first top-level target.
+	workspacePathStr, err := commands.GetWorkspacePathString(workspacePath)
+	if err != nil {
+		logger.Fatal(formatted.Textf("Failed to obtain workspace path: %s", err))
+	}
+	bazelOutPath := filepath.Join(outputDownloader.execrootPath, model_starlark.ComponentStrBazelOut)
+	if err := os.MkdirAll(bazelOutPath, 0o777); err != nil {
+		logger.Fatal(formatted.Textf("Failed to create bazel-out directory: %s", err))
+	}
+	if err := createConvenienceSymlink(workspacePathStr, "bazel-out", bazelOutPath); err != nil {
+		logger.Warning(formatted.Textf("Failed to create bazel-out convenience symlink: %s", err))
+	}
+	var bazelBinConfigurationComponent string
+	if targetCompletions := buildResult.Message.TargetCompletions; len(targetCompletions) > 0 {
+		bazelBinConfigurationComponent = targetCompletions[0].ConfigurationComponent
+		if err := createConvenienceSymlink(
+			workspacePathStr,
+			"bazel-bin",
+			filepath.Join(bazelOutPath, bazelBinConfigurationComponent, model_starlark.ComponentStrBin),
+		); err != nil {
+			logger.Warning(formatted.Textf("Failed to create bazel-bin convenience symlink: %s", err))
+		}
+	}
+
+	// Write back MODULE.bazel.lock if module resolution caused its
+	// contents to change.
+	if moduleLockfile := buildResult.Message.ModuleLockfile; len(moduleLockfile) > 0 {
+		moduleLockfilePath := filepath.Join(workspacePathStr, "MODULE.bazel.lock")
+		if existingModuleLockfile, err := os.ReadFile(moduleLockfilePath); err != nil || !bytes.Equal(existingModuleLockfile, moduleLockfile) {
+			if err := os.WriteFile(moduleLockfilePath, moduleLockfile, 0o666); err != nil {
+				logger.Fatal(formatted.Textf("Failed to write MODULE.bazel.lock: %s", err))
+			}
+		}
+	}
+
+	// Print a summary of the top-level targets that were built.
+	const maximumOutputPathsPerTarget = 10
+	for _, targetCompletion := range buildResult.Message.TargetCompletions {
+		outputPaths, err := outputDownloader.listBazelOutFiles(
+			ctx,
+			model_core.Nested(buildResult, targetCompletion.RootDirectory),
+			maximumOutputPathsPerTarget+1,
+		)
+		if err != nil {
+			logger.Fatal(formatted.Textf("Failed to list outputs of target %s: %s", targetCompletion.Label, err))
+		}
+		if len(outputPaths) == 0 {
+			logger.Info(formatted.Textf("Target %s up-to-date (nothing to build)", targetCompletion.Label))
+			continue
+		}
+		logger.Info(formatted.Textf("Target %s up-to-date:", targetCompletion.Label))
+		for i, outputPath := range outputPaths {
+			if i == maximumOutputPathsPerTarget {
+				fmt.Fprintf(os.Stderr, "  ...\n")
+				break
+			}
+			fmt.Fprintf(os.Stderr, "  %s\n", getConvenienceSymlinkPath(outputPath, bazelBinConfigurationComponent))
+		}
+	}
+
+	return &completedBuild{
+		buildResult:      buildResult,
+		outputDownloader: &outputDownloader,
+		fileReader:       fileReader,
+		workspacePath:    workspacePathStr,
+		outputBase:       outputBase,
+		bazelOutPath:     bazelOutPath,
+	}
 }
 
 func formatKey(namespace object.Namespace, keyAny model_core.Message[*model_core_pb.Any, object.LocalReference], jsonFormatter *messageJSONFormatter, browserURL string, outcomesReference *model_core.Decodable[object.LocalReference], longestType int) formatted.Node {
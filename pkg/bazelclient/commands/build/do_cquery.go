@@ -0,0 +1,59 @@
+package build
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"bonanza.build/pkg/bazelclient/arguments"
+	"bonanza.build/pkg/bazelclient/commands"
+	"bonanza.build/pkg/bazelclient/formatted"
+	"bonanza.build/pkg/bazelclient/logging"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+)
+
+// DoCquery implements the "bazel cquery" command. The query expression
+// is evaluated remotely, after which the resulting targets are
+// configured using the target platforms provided on the command line.
+func DoCquery(args *arguments.CqueryCommand, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "cquery", workspacePath)
+
+	key := &model_analysis_pb.ConfiguredQueryResult_Key{
+		Expression: getQueryExpression(logger, args.Arguments),
+	}
+	if args.CqueryFlags.Output == arguments.CqueryOutput_Starlark {
+		key.StarlarkExpression = args.CqueryFlags.StarlarkExpr
+	}
+	evaluation := performEvaluation(logger, &args.CommonFlags, workspacePath, &evaluationRequest{
+		buildSettingOverrides: args.BuildSettingOverrides,
+		platforms:             args.BuildFlags.Platforms,
+		requestedKey:          key,
+	})
+	cqueryResult, ok := evaluation.requestedValue.Message.(*model_analysis_pb.ConfiguredQueryResult_Value)
+	if !ok {
+		logger.Fatal(formatted.Text("Configured query result has an unexpected message type"))
+	}
+	if len(cqueryResult.Targets) == 0 {
+		logger.Info(formatted.Text("Empty results"))
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	for _, target := range cqueryResult.Targets {
+		switch args.CqueryFlags.Output {
+		case arguments.CqueryOutput_Label:
+			fmt.Fprintf(w, "%s (%s)\n", target.Label, target.ConfigurationComponent)
+		case arguments.CqueryOutput_LabelKind:
+			fmt.Fprintf(w, "%s %s (%s)\n", target.Kind, target.Label, target.ConfigurationComponent)
+		case arguments.CqueryOutput_Starlark:
+			fmt.Fprintln(w, target.StarlarkOutput)
+		default:
+			panic("unknown cquery output")
+		}
+	}
+	if err := w.Flush(); err != nil {
+		logger.Fatal(formatted.Textf("Failed to write cquery results: %s", err))
+	}
+}
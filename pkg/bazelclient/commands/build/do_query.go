@@ -0,0 +1,112 @@
+package build
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"bonanza.build/pkg/bazelclient/arguments"
+	"bonanza.build/pkg/bazelclient/commands"
+	"bonanza.build/pkg/bazelclient/formatted"
+	"bonanza.build/pkg/bazelclient/logging"
+	"bonanza.build/pkg/label"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// DoQuery implements the "bazel query" command. The query expression
+// is evaluated remotely against the unconfigured target graph.
+func DoQuery(args *arguments.QueryCommand, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "query", workspacePath)
+
+	evaluation := performEvaluation(logger, &args.CommonFlags, workspacePath, &evaluationRequest{
+		buildSettingOverrides: args.BuildSettingOverrides,
+		requestedKey: &model_analysis_pb.QueryResult_Key{
+			Expression: getQueryExpression(logger, args.Arguments),
+		},
+	})
+	queryResult, ok := evaluation.requestedValue.Message.(*model_analysis_pb.QueryResult_Value)
+	if !ok {
+		logger.Fatal(formatted.Text("Query result has an unexpected message type"))
+	}
+	if len(queryResult.Targets) == 0 {
+		logger.Info(formatted.Text("Empty results"))
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	switch args.QueryFlags.Output {
+	case arguments.QueryOutput_Label:
+		for _, target := range queryResult.Targets {
+			fmt.Fprintln(w, target.Label)
+		}
+	case arguments.QueryOutput_LabelKind:
+		for _, target := range queryResult.Targets {
+			fmt.Fprintf(w, "%s %s\n", target.Kind, target.Label)
+		}
+	case arguments.QueryOutput_Build:
+		for i, target := range queryResult.Targets {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			writeQueryTargetAsBuild(w, target)
+		}
+	case arguments.QueryOutput_Proto:
+		data, err := proto.Marshal(queryResult)
+		if err != nil {
+			logger.Fatal(formatted.Textf("Failed to marshal query result: %s", err))
+		}
+		w.Write(data)
+	case arguments.QueryOutput_Graph:
+		fmt.Fprintln(w, "digraph mygraph {")
+		fmt.Fprintln(w, "  node [shape=box];")
+		for _, target := range queryResult.Targets {
+			fmt.Fprintf(w, "  %q\n", target.Label)
+			for _, dependency := range target.Dependencies {
+				fmt.Fprintf(w, "  %q -> %q\n", target.Label, dependency)
+			}
+		}
+		fmt.Fprintln(w, "}")
+	default:
+		panic("unknown query output")
+	}
+	if err := w.Flush(); err != nil {
+		logger.Fatal(formatted.Textf("Failed to write query results: %s", err))
+	}
+}
+
+// getQueryExpression returns the query expression that is provided to
+// "bazel query", "bazel cquery" and "bazel aquery". Like Bazel, permit
+// the query expression to be split across multiple arguments.
+func getQueryExpression(logger logging.Logger, arguments []string) string {
+	if len(arguments) == 0 {
+		logger.Fatal(formatted.Text("Missing query expression. Use quotes to provide query expressions containing spaces or special characters"))
+	}
+	return strings.Join(arguments, " ")
+}
+
+// writeQueryTargetAsBuild writes a target that is part of the results
+// of a query in the format of a BUILD file. Only attributes whose
+// values were provided explicitly are written.
+func writeQueryTargetAsBuild(w *bufio.Writer, target *model_analysis_pb.QueryResult_Value_Target) {
+	fmt.Fprintf(w, "# %s\n", target.Label)
+	ruleClass, isRule := strings.CutSuffix(target.Kind, " rule")
+	if !isRule {
+		fmt.Fprintf(w, "# %s\n", target.Kind)
+		return
+	}
+	fmt.Fprintf(w, "%s(\n", ruleClass)
+	if targetLabel, err := label.NewCanonicalLabel(target.Label); err == nil {
+		fmt.Fprintf(w, "  name = %q,\n", targetLabel.GetTargetName().String())
+	}
+	for _, attribute := range target.Attributes {
+		if attribute.Explicit {
+			fmt.Fprintf(w, "  %s = %s,\n", attribute.Name, attribute.Value)
+		}
+	}
+	fmt.Fprintln(w, ")")
+}
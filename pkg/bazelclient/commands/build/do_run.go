@@ -0,0 +1,160 @@
+package build
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"bonanza.build/pkg/bazelclient/arguments"
+	"bonanza.build/pkg/bazelclient/commands"
+	"bonanza.build/pkg/bazelclient/formatted"
+	"bonanza.build/pkg/bazelclient/logging"
+	model_core "bonanza.build/pkg/model/core"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+)
+
+// isRunUnderLabel returns true if the first word of the value of
+// --run_under refers to a target, as opposed to a command that is
+// available on the local system.
+func isRunUnderLabel(s string) bool {
+	return strings.HasPrefix(s, "//") || strings.HasPrefix(s, "@") || strings.HasPrefix(s, ":")
+}
+
+// DoRun implements the "bazel run" command.
+func DoRun(args *arguments.RunCommand, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "run", workspacePath)
+
+	if len(args.Arguments) == 0 {
+		logger.Fatal(formatted.Text("Must specify at least one argument: the target to run"))
+	}
+	targetPattern := args.Arguments[0]
+	targetPatterns := []string{targetPattern}
+	forwardedArguments := args.Arguments[1:]
+
+	// If --run_under refers to a target, build it alongside the
+	// target to run, so that it can be used as a prefix.
+	// TODO: Build the --run_under target for the execution
+	// platform, as opposed to the target platform.
+	runUnder := strings.Fields(args.RunFlags.RunUnder)
+	runUnderIsLabel := len(runUnder) > 0 && isRunUnderLabel(runUnder[0])
+	if runUnderIsLabel {
+		targetPatterns = append(targetPatterns, runUnder[0])
+	}
+
+	build := performBuild(
+		logger,
+		&args.CommonFlags,
+		&args.BuildFlags,
+		targetPatterns,
+		args.BuildSettingOverrides,
+		workspacePath,
+		&model_analysis_pb.BuildResult_Key{
+			IncludeExecutables: true,
+		},
+	)
+	executablesByTargetPattern := map[string][]*model_analysis_pb.BuildResult_Value_Executable{}
+	for _, executable := range build.buildResult.Message.Executables {
+		executablesByTargetPattern[executable.TargetPattern] = append(executablesByTargetPattern[executable.TargetPattern], executable)
+	}
+	targetExecutables := executablesByTargetPattern[targetPattern]
+	if len(targetExecutables) != 1 {
+		logger.Fatal(formatted.Textf("Target pattern %#v must match exactly one target, as only a single target can be run", targetPattern))
+	}
+	executables := []*model_analysis_pb.BuildResult_Value_Executable{targetExecutables[0]}
+	if runUnderIsLabel {
+		runUnderExecutables := executablesByTargetPattern[runUnder[0]]
+		if len(runUnderExecutables) != 1 {
+			logger.Fatal(formatted.Textf("Target pattern %#v provided to --run_under must match exactly one target", runUnder[0]))
+		}
+		executables = append(executables, runUnderExecutables[0])
+	}
+
+	// Download the executables and their runfiles into a scratch
+	// directory, discarding any leftovers of previous invocations.
+	ctx := context.Background()
+	scratchPath := filepath.Join(build.outputBase, "run")
+	if err := os.RemoveAll(scratchPath); err != nil {
+		logger.Fatal(formatted.Textf("Failed to remove scratch directory for running targets: %s", err))
+	}
+	if err := os.MkdirAll(scratchPath, 0o777); err != nil {
+		logger.Fatal(formatted.Textf("Failed to create scratch directory for running targets: %s", err))
+	}
+	scratchDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(scratchPath))
+	if err != nil {
+		logger.Fatal(formatted.Textf("Failed to open scratch directory for running targets: %s", err))
+	}
+	for _, executable := range executables {
+		targetExecutable := model_core.Nested(build.buildResult, executable.Executable)
+		if err := build.outputDownloader.directoryMaterializer.MaterializeDirectory(
+			ctx,
+			model_core.Nested(targetExecutable, targetExecutable.Message.RootDirectory),
+			scratchDirectory,
+		); err != nil {
+			logger.Fatal(formatted.Textf("Failed to download executable of target %s: %s", executable.Label, err))
+		}
+	}
+	scratchDirectory.Close()
+
+	// Construct the command line. If --run_under is provided, its
+	// value is used as a prefix.
+	targetExecutable := targetExecutables[0].Executable
+	targetExecutablePath := filepath.Join(scratchPath, filepath.FromSlash(targetExecutable.ExecutablePath))
+	var argv []string
+	if runUnderIsLabel {
+		runUnderExecutable := executablesByTargetPattern[runUnder[0]][0].Executable
+		argv = append(argv, filepath.Join(scratchPath, filepath.FromSlash(runUnderExecutable.ExecutablePath)))
+		argv = append(argv, runUnder[1:]...)
+	} else {
+		argv = append(argv, runUnder...)
+	}
+	argv = append(argv, targetExecutablePath)
+	argv = append(argv, forwardedArguments...)
+
+	// Like Bazel, run the target from within the main repository's
+	// directory in its runfiles, and provide the paths of the
+	// workspace and the current working directory.
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		logger.Fatal(formatted.Textf("Failed to obtain current working directory: %s", err))
+	}
+	environment := os.Environ()
+	for _, environmentVariable := range targetExecutable.Environment {
+		environment = append(environment, environmentVariable.Name+"="+environmentVariable.Value)
+	}
+	// Variables listed in inherited_environment take precedence
+	// over ones that are set explicitly.
+	for _, name := range targetExecutable.InheritedEnvironment {
+		if value, ok := os.LookupEnv(name); ok {
+			environment = append(environment, name+"="+value)
+		}
+	}
+	environment = append(
+		environment,
+		"BUILD_WORKSPACE_DIRECTORY="+build.workspacePath,
+		"BUILD_WORKING_DIRECTORY="+workingDirectory,
+	)
+
+	logger.Info(formatted.Textf("Running command line: %s", strings.Join(argv, " ")))
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Dir = targetExecutablePath + ".runfiles/_main"
+	cmd.Env = environment
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			if exitCode := exitErr.ExitCode(); exitCode >= 0 {
+				os.Exit(exitCode)
+			}
+		}
+		logger.Fatal(formatted.Textf("Failed to run target %s: %s", targetExecutables[0].Label, err))
+	}
+}
@@ -0,0 +1,230 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"bonanza.build/pkg/bazelclient/arguments"
+	"bonanza.build/pkg/bazelclient/commands"
+	"bonanza.build/pkg/bazelclient/formatted"
+	"bonanza.build/pkg/bazelclient/logging"
+	"bonanza.build/pkg/label"
+	model_core "bonanza.build/pkg/model/core"
+	model_filesystem "bonanza.build/pkg/model/filesystem"
+	model_starlark "bonanza.build/pkg/model/starlark"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_filesystem_pb "bonanza.build/pkg/proto/model/filesystem"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+const (
+	// Exit codes that are also used by Bazel to indicate that one
+	// or more tests failed, or that no tests were found.
+	exitCodeTestsFailed  = 3
+	exitCodeNoTestsFound = 4
+)
+
+// DoTest implements the "bazel test" command.
+func DoTest(args *arguments.TestCommand, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "test", workspacePath)
+
+	runsPerTest := args.TestFlags.RunsPerTest
+	if runsPerTest < 1 {
+		logger.Fatal(formatted.Textf("Invalid --runs_per_test=%d: Value must be at least 1", runsPerTest))
+	}
+	build := performBuild(
+		logger,
+		&args.CommonFlags,
+		&args.BuildFlags,
+		args.Arguments,
+		args.BuildSettingOverrides,
+		workspacePath,
+		&model_analysis_pb.BuildResult_Key{
+			TestParameters: &model_analysis_pb.BuildResult_Key_TestParameters{
+				RunsPerTest: uint32(runsPerTest),
+				TestFilter:  args.TestFlags.TestFilter,
+			},
+		},
+	)
+
+	testResults := build.buildResult.Message.TestResults
+	if len(testResults) == 0 {
+		logger.Error(formatted.Text("No test targets were found, yet testing was requested"))
+		os.Exit(exitCodeNoTestsFound)
+	}
+
+	// Like bazel-bin, let bazel-testlogs point to the test logs
+	// directory of the configuration of the first test.
+	if err := createConvenienceSymlink(
+		build.workspacePath,
+		"bazel-testlogs",
+		filepath.Join(build.bazelOutPath, testResults[0].ConfigurationComponent, model_starlark.ComponentStrTestlogs),
+	); err != nil {
+		logger.Warning(formatted.Textf("Failed to create bazel-testlogs convenience symlink: %s", err))
+	}
+
+	// Download test.xml and undeclared outputs of all tests, and
+	// write the output of each test to test.log.
+	ctx := context.Background()
+	testLogPaths := make([]string, 0, len(testResults))
+	for _, testResult := range testResults {
+		result := model_core.Nested(build.buildResult, testResult.Result)
+		if err := build.outputDownloader.materializeBazelOut(ctx, model_core.Nested(result, result.Message.OutputRoot)); err != nil {
+			logger.Fatal(formatted.Textf("Failed to download outputs of test %s: %s", testResult.Label, err))
+		}
+		testLogPath, err := writeTestLog(ctx, build, testResult, runsPerTest)
+		if err != nil {
+			logger.Fatal(formatted.Textf("Failed to write log of test %s: %s", testResult.Label, err))
+		}
+		testLogPaths = append(testLogPaths, testLogPath)
+	}
+
+	// Print the logs of tests, if requested.
+	for i, testResult := range testResults {
+		passed := testResult.Result.ExitCode == 0
+		switch args.TestFlags.TestOutput {
+		case arguments.TestOutput_Summary:
+			continue
+		case arguments.TestOutput_Errors:
+			if passed {
+				continue
+			}
+		case arguments.TestOutput_All, arguments.TestOutput_Streamed:
+			// TODO: Stream test output while tests are still
+			// running, as opposed to printing it afterwards.
+		default:
+			panic("unknown test output mode")
+		}
+		logger.Info(formatted.Textf("Output of %s:", getTestDisplayName(testResult, runsPerTest)))
+		if err := printTestLog(filepath.Join(build.outputDownloader.execrootPath, testLogPaths[i])); err != nil {
+			logger.Fatal(formatted.Textf("Failed to print log of test %s: %s", testResult.Label, err))
+		}
+	}
+
+	// Print a summary of all tests and their outcomes. For tests
+	// that failed, also print the path of the test log.
+	failedCount := 0
+	for i, testResult := range testResults {
+		displayName := formatted.Textf("%s ", getTestDisplayName(testResult, runsPerTest))
+		if exitCode := testResult.Result.ExitCode; exitCode != 0 {
+			logger.Info(formatted.Join(
+				displayName,
+				formatted.Bold(formatted.Red(formatted.Textf("FAILED (exit code %d)", exitCode))),
+				formatted.Textf(" %s", filepath.Join(build.outputDownloader.execrootPath, testLogPaths[i])),
+			))
+			failedCount++
+		} else {
+			logger.Info(formatted.Join(
+				displayName,
+				formatted.Bold(formatted.Green(formatted.Text("PASSED"))),
+			))
+		}
+	}
+	if failedCount > 0 {
+		logger.Error(formatted.Textf("%d out of %d tests failed", failedCount, len(testResults)))
+		os.Exit(exitCodeTestsFailed)
+	}
+	logger.Info(formatted.Textf("All %d tests passed", len(testResults)))
+}
+
+// getTestDisplayName returns the name under which a test is reported.
+// If tests are run multiple times, the run number is included.
+func getTestDisplayName(testResult *model_analysis_pb.BuildResult_Value_TestResult, runsPerTest int) string {
+	if runsPerTest > 1 {
+		return fmt.Sprintf("%s (run %d of %d)", testResult.Label, testResult.RunNumber, runsPerTest)
+	}
+	return testResult.Label
+}
+
+// getTestLogsPath returns the path of the directory relative to the
+// execution root in which logs of a test are stored. This matches the
+// path of the directory that is created by the test action.
+func getTestLogsPath(testResult *model_analysis_pb.BuildResult_Value_TestResult) (string, error) {
+	testLabel, err := label.NewCanonicalLabel(testResult.Label)
+	if err != nil {
+		return "", util.StatusWrapf(err, "Invalid test label %#v", testResult.Label)
+	}
+	canonicalPackage := testLabel.GetCanonicalPackage()
+	return filepath.Join(
+		model_starlark.ComponentStrBazelOut,
+		testResult.ConfigurationComponent,
+		model_starlark.ComponentStrTestlogs,
+		model_starlark.ComponentStrExternal,
+		canonicalPackage.GetCanonicalRepo().String(),
+		filepath.FromSlash(canonicalPackage.GetPackagePath()),
+		testLabel.GetTargetName().String(),
+	), nil
+}
+
+// writeTestLog writes the standard output and standard error of a
+// test to a file named test.log in the test's logs directory. If
+// tests are run multiple times, the log of each run is written to a
+// separate subdirectory. The path of the log relative to the
+// execution root is returned.
+func writeTestLog(ctx context.Context, build *completedBuild, testResult *model_analysis_pb.BuildResult_Value_TestResult, runsPerTest int) (string, error) {
+	testLogsPath, err := getTestLogsPath(testResult)
+	if err != nil {
+		return "", err
+	}
+	if runsPerTest > 1 {
+		testLogsPath = filepath.Join(testLogsPath, fmt.Sprintf("run_%d_of_%d", testResult.RunNumber, runsPerTest))
+	}
+	testLogPath := filepath.Join(testLogsPath, "test.log")
+
+	absoluteTestLogsPath := filepath.Join(build.outputDownloader.execrootPath, testLogsPath)
+	if err := os.MkdirAll(absoluteTestLogsPath, 0o777); err != nil {
+		return "", util.StatusWrap(err, "Failed to create test logs directory")
+	}
+	f, err := os.Create(filepath.Join(build.outputDownloader.execrootPath, testLogPath))
+	if err != nil {
+		return "", util.StatusWrap(err, "Failed to create test log")
+	}
+
+	result := model_core.Nested(build.buildResult, testResult.Result)
+	for _, output := range []struct {
+		name     string
+		contents *model_filesystem_pb.FileContents
+	}{
+		{"standard output", result.Message.Stdout},
+		{"standard error", result.Message.Stderr},
+	} {
+		if err := copyFileContents(ctx, build.fileReader, model_core.Nested(result, output.contents), f); err != nil {
+			f.Close()
+			return "", util.StatusWrapf(err, "Failed to write %s", output.name)
+		}
+	}
+	if err := f.Close(); err != nil {
+		return "", util.StatusWrap(err, "Failed to close test log")
+	}
+	return testLogPath, nil
+}
+
+func copyFileContents(ctx context.Context, fileReader *model_filesystem.FileReader[object.LocalReference], fileContents model_core.Message[*model_filesystem_pb.FileContents, object.LocalReference], w io.Writer) error {
+	fileContentsEntry, err := model_filesystem.NewFileContentsEntryFromProto(fileContents)
+	if err != nil {
+		return util.StatusWrap(err, "Invalid file contents")
+	}
+	if fileContentsEntry.EndBytes == 0 {
+		return nil
+	}
+	_, err = io.Copy(w, fileReader.FileOpenRead(ctx, fileContentsEntry, 0))
+	return err
+}
+
+// printTestLog writes the contents of a test log to standard error.
+func printTestLog(testLogPath string) error {
+	f, err := os.Open(testLogPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(os.Stderr, f)
+	return err
+}
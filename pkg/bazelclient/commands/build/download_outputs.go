@@ -0,0 +1,194 @@
+package build
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	model_core "bonanza.build/pkg/model/core"
+	"bonanza.build/pkg/model/core/btree"
+	model_filesystem "bonanza.build/pkg/model/filesystem"
+	model_parser "bonanza.build/pkg/model/parser"
+	model_starlark "bonanza.build/pkg/model/starlark"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_core_pb "bonanza.build/pkg/proto/model/core"
+	model_evaluation_pb "bonanza.build/pkg/proto/model/evaluation"
+	model_filesystem_pb "bonanza.build/pkg/proto/model/filesystem"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// outputDownloader is responsible for downloading the outputs of a
+// build into the output base, and for creating convenience symlinks
+// in the workspace directory that point to them.
+type outputDownloader struct {
+	directoryContentsReader model_parser.ParsedObjectReader[model_core.Decodable[object.LocalReference], model_core.Message[*model_filesystem_pb.DirectoryContents, object.LocalReference]]
+	leavesReader            model_parser.ParsedObjectReader[model_core.Decodable[object.LocalReference], model_core.Message[*model_filesystem_pb.Leaves, object.LocalReference]]
+	directoryMaterializer   *model_filesystem.DirectoryMaterializer[object.LocalReference]
+	execrootPath            string
+}
+
+// materializeBazelOut downloads the contents of the "bazel-out"
+// directory contained in a root directory that uses the input root
+// layout. Source files are not downloaded, as these are already
+// present in the workspace.
+func (od *outputDownloader) materializeBazelOut(ctx context.Context, rootDirectory model_core.Message[*model_filesystem_pb.DirectoryContents, object.LocalReference]) error {
+	for _, directoryNode := range rootDirectory.Message.Directories {
+		if directoryNode.Name != model_starlark.ComponentStrBazelOut {
+			continue
+		}
+		bazelOutDirectory, err := model_filesystem.DirectoryGetContents(ctx, od.directoryContentsReader, model_core.Nested(rootDirectory, directoryNode.Directory))
+		if err != nil {
+			return util.StatusWrap(err, "Failed to obtain contents of bazel-out directory")
+		}
+
+		bazelOutPath := filepath.Join(od.execrootPath, model_starlark.ComponentStrBazelOut)
+		if err := os.MkdirAll(bazelOutPath, 0o777); err != nil {
+			return util.StatusWrap(err, "Failed to create bazel-out directory")
+		}
+		out, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(bazelOutPath))
+		if err != nil {
+			return util.StatusWrap(err, "Failed to open bazel-out directory")
+		}
+		defer out.Close()
+		return od.directoryMaterializer.MaterializeDirectory(ctx, bazelOutDirectory, out)
+	}
+	return nil
+}
+
+// materializeAllActionOutputs downloads the outputs of all actions
+// whose results are part of the outcomes of the build.
+func (od *outputDownloader) materializeAllActionOutputs(
+	ctx context.Context,
+	evaluationReader model_parser.ParsedObjectReader[model_core.Decodable[object.LocalReference], model_core.Message[[]*model_evaluation_pb.Evaluation, object.LocalReference]],
+	outcomesReference model_core.Decodable[object.LocalReference],
+) error {
+	outcomes, err := evaluationReader.ReadParsedObject(ctx, outcomesReference)
+	if err != nil {
+		return util.StatusWrap(err, "Failed to read outcomes")
+	}
+
+	var errIter error
+	for evaluation := range btree.AllLeaves(
+		ctx,
+		evaluationReader,
+		outcomes,
+		/* traverser = */ func(evaluation model_core.Message[*model_evaluation_pb.Evaluation, object.LocalReference]) (*model_core_pb.DecodableReference, error) {
+			return evaluation.Message.GetParent().GetReference(), nil
+		},
+		&errIter,
+	) {
+		evaluationLeaf, ok := evaluation.Message.Level.(*model_evaluation_pb.Evaluation_Leaf_)
+		if !ok {
+			return status.Error(codes.InvalidArgument, "Evaluation is not a valid leaf")
+		}
+		value := evaluationLeaf.Leaf.Value
+		if !value.GetValue().MessageIs(&model_analysis_pb.TargetActionResult_Value{}) {
+			continue
+		}
+		topLevelValue, err := model_core.UnmarshalAnyNew(model_core.Nested(evaluation, value))
+		if err != nil {
+			return util.StatusWrap(err, "Invalid target action result")
+		}
+		targetActionResult := topLevelValue.Decay()
+		if err := od.materializeBazelOut(
+			ctx,
+			model_core.Nested(targetActionResult, targetActionResult.Message.(*model_analysis_pb.TargetActionResult_Value).OutputRoot),
+		); err != nil {
+			return err
+		}
+	}
+	return errIter
+}
+
+// listBazelOutFiles returns the paths of all files and symbolic links
+// contained in the "bazel-out" directory of a root directory that
+// uses the input root layout. At most maximumCount paths are returned.
+func (od *outputDownloader) listBazelOutFiles(ctx context.Context, rootDirectory model_core.Message[*model_filesystem_pb.DirectoryContents, object.LocalReference], maximumCount int) ([]string, error) {
+	var paths []string
+	var listDirectory func(directory model_core.Message[*model_filesystem_pb.DirectoryContents, object.LocalReference], directoryPath string) error
+	listDirectory = func(directory model_core.Message[*model_filesystem_pb.DirectoryContents, object.LocalReference], directoryPath string) error {
+		for _, directoryNode := range directory.Message.Directories {
+			if len(paths) >= maximumCount {
+				return nil
+			}
+			childPath := directoryPath + "/" + directoryNode.Name
+			if directoryPath == "" {
+				if directoryNode.Name != model_starlark.ComponentStrBazelOut {
+					continue
+				}
+				childPath = directoryNode.Name
+			}
+			childDirectory, err := model_filesystem.DirectoryGetContents(ctx, od.directoryContentsReader, model_core.Nested(directory, directoryNode.Directory))
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to obtain contents of directory %#v", childPath)
+			}
+			if err := listDirectory(childDirectory, childPath); err != nil {
+				return err
+			}
+		}
+		if directoryPath == "" {
+			return nil
+		}
+
+		leaves, err := model_filesystem.DirectoryGetLeaves(ctx, od.leavesReader, directory)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to obtain leaves of directory %#v", directoryPath)
+		}
+		for _, fileNode := range leaves.Message.Files {
+			paths = append(paths, directoryPath+"/"+fileNode.Name)
+		}
+		for _, symlinkNode := range leaves.Message.Symlinks {
+			paths = append(paths, directoryPath+"/"+symlinkNode.Name)
+		}
+		return nil
+	}
+	if err := listDirectory(rootDirectory, ""); err != nil {
+		return nil, err
+	}
+	if len(paths) > maximumCount {
+		paths = paths[:maximumCount]
+	}
+	return paths, nil
+}
+
+// createConvenienceSymlink creates a symbolic link in the workspace
+// directory that points into the output base. Existing files are only
+// replaced if they are symbolic links, so that we don't accidentally
+// remove files created by the user.
+func createConvenienceSymlink(workspacePathStr, name, target string) error {
+	symlinkPath := filepath.Join(workspacePathStr, name)
+	if fileInfo, err := os.Lstat(symlinkPath); err == nil {
+		if fileInfo.Mode()&fs.ModeSymlink == 0 {
+			return status.Errorf(codes.AlreadyExists, "%#v already exists and is not a symbolic link", symlinkPath)
+		}
+		if existingTarget, err := os.Readlink(symlinkPath); err == nil && existingTarget == target {
+			return nil
+		}
+		if err := os.Remove(symlinkPath); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return os.Symlink(target, symlinkPath)
+}
+
+// getConvenienceSymlinkPath rewrites the path of an output file to go
+// through the bazel-bin convenience symlink, if possible.
+func getConvenienceSymlinkPath(outputPath, bazelBinConfigurationComponent string) string {
+	bazelBinPrefix := model_starlark.ComponentStrBazelOut + "/" + bazelBinConfigurationComponent + "/" + model_starlark.ComponentStrBin + "/"
+	if bazelBinConfigurationComponent != "" && strings.HasPrefix(outputPath, bazelBinPrefix) {
+		return "bazel-bin/" + strings.TrimPrefix(outputPath, bazelBinPrefix)
+	}
+	return outputPath
+}
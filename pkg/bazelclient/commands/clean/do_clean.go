@@ -0,0 +1,78 @@
+package clean
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"bonanza.build/pkg/bazelclient/arguments"
+	"bonanza.build/pkg/bazelclient/commands"
+	"bonanza.build/pkg/bazelclient/formatted"
+	"bonanza.build/pkg/bazelclient/logging"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+)
+
+// DoClean implements the "bazel clean" command.
+//
+// Without --expunge, outputs that were downloaded into the output
+// base are removed, together with the convenience symlinks in the
+// workspace directory. The outcomes of the previous build are
+// retained, meaning that subsequent builds can still be performed
+// incrementally. With --expunge, the entire output base is removed,
+// causing the next build to be evaluated from scratch.
+func DoClean(args *arguments.CleanCommand, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "clean", workspacePath)
+
+	workspacePathStr, err := commands.GetWorkspacePathString(workspacePath)
+	if err != nil {
+		logger.Fatal(formatted.Textf("Failed to obtain workspace path: %s", err))
+	}
+	outputBase, err := commands.GetOutputBase(workspacePath)
+	if err != nil {
+		logger.Fatal(formatted.Textf("Failed to obtain output base: %s", err))
+	}
+
+	// Remove convenience symlinks, such as bazel-bin and
+	// bazel-out. Only remove symbolic links that point into the
+	// output base, so that we don't accidentally remove files
+	// created by the user.
+	entries, err := os.ReadDir(workspacePathStr)
+	if err != nil {
+		logger.Fatal(formatted.Textf("Failed to read workspace directory: %s", err))
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, "bazel-") || entry.Type()&fs.ModeSymlink == 0 {
+			continue
+		}
+		symlinkPath := filepath.Join(workspacePathStr, name)
+		target, err := os.Readlink(symlinkPath)
+		if err != nil {
+			logger.Fatal(formatted.Textf("Failed to read convenience symlink %#v: %s", symlinkPath, err))
+		}
+		if target != outputBase && !strings.HasPrefix(target, outputBase+string(filepath.Separator)) {
+			continue
+		}
+		if err := os.Remove(symlinkPath); err != nil {
+			logger.Fatal(formatted.Textf("Failed to remove convenience symlink %#v: %s", symlinkPath, err))
+		}
+	}
+
+	if args.CleanFlags.Expunge {
+		logger.Info(formatted.Text("Removing the entire output base"))
+		if err := os.RemoveAll(outputBase); err != nil {
+			logger.Fatal(formatted.Textf("Failed to remove output base: %s", err))
+		}
+		return
+	}
+
+	logger.Info(formatted.Text("Removing downloaded outputs"))
+	for _, name := range []string{"execroot", "run"} {
+		if err := os.RemoveAll(filepath.Join(outputBase, name)); err != nil {
+			logger.Fatal(formatted.Textf("Failed to remove %#v directory in output base: %s", name, err))
+		}
+	}
+}
@@ -17,17 +17,18 @@ func DoInfo(args *arguments.InfoCommand, workspacePath path.Parser) {
 	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
 	commands.ValidateInsideWorkspace(logger, "info", workspacePath)
 
-	workspacePathBuilder, scopeWalker := path.EmptyBuilder.Join(path.NewAbsoluteScopeWalker(path.VoidComponentWalker))
-	if err := path.Resolve(workspacePath, scopeWalker); err != nil {
+	workspacePathStr, err := commands.GetWorkspacePathString(workspacePath)
+	if err != nil {
 		logger.Fatal(formatted.Textf("Failed to obtain workspace path: %s", err))
 	}
-	workspacePathStr, err := path.LocalFormat.GetString(workspacePathBuilder)
+	outputBase, err := commands.GetOutputBase(workspacePath)
 	if err != nil {
-		logger.Fatal(formatted.Textf("Failed to obtain workspace path: %s", err))
+		logger.Fatal(formatted.Textf("Failed to obtain output base: %s", err))
 	}
 
 	keys := map[string]string{
-		"workspace": workspacePathStr,
+		"output_base": outputBase,
+		"workspace":   workspacePathStr,
 	}
 
 	var keysToPrint []string
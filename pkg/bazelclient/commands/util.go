@@ -1,6 +1,11 @@
 package commands
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
 	"bonanza.build/pkg/bazelclient/formatted"
 	"bonanza.build/pkg/bazelclient/logging"
 
@@ -12,3 +17,30 @@ func ValidateInsideWorkspace(logger logging.Logger, commandName string, workspac
 		logger.Fatal(formatted.Textf("The %#v command is only supported from within a workspace (below a directory having a MODULE.bazel file)", commandName))
 	}
 }
+
+// GetWorkspacePathString converts the path of the workspace to a
+// string in the format of the local operating system.
+func GetWorkspacePathString(workspacePath path.Parser) (string, error) {
+	workspacePathBuilder, scopeWalker := path.EmptyBuilder.Join(path.NewAbsoluteScopeWalker(path.VoidComponentWalker))
+	if err := path.Resolve(workspacePath, scopeWalker); err != nil {
+		return "", err
+	}
+	return path.LocalFormat.GetString(workspacePathBuilder)
+}
+
+// GetOutputBase returns the path of a directory in which the client
+// may store state that is specific to a workspace, such as the
+// outcomes of the previous build. Similar to Bazel, the name of the
+// directory is derived from the path of the workspace.
+func GetOutputBase(workspacePath path.Parser) (string, error) {
+	workspacePathStr, err := GetWorkspacePathString(workspacePath)
+	if err != nil {
+		return "", err
+	}
+	userCacheDirectory, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	workspacePathHash := sha256.Sum256([]byte(workspacePathStr))
+	return filepath.Join(userCacheDirectory, "bonanza", hex.EncodeToString(workspacePathHash[:16])), nil
+}
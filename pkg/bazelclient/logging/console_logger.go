@@ -64,3 +64,16 @@ func (l *consoleLogger) RemovePreviousLines(linesCount int) {
 	)
 	l.w.Write(b.Bytes())
 }
+
+func (l *consoleLogger) Warning(message formatted.Node) {
+	var b bytes.Buffer
+	l.writeFormatted(
+		formatted.Join(
+			formatted.Magenta(formatted.Text("WARNING: ")),
+			message,
+			formatted.Text("\n"),
+		),
+		&b,
+	)
+	l.w.Write(b.Bytes())
+}
@@ -14,6 +14,7 @@ type Logger interface {
 	Fatal(message formatted.Node)
 	Info(message formatted.Node)
 	RemovePreviousLines(int)
+	Warning(message formatted.Node)
 }
 
 func NewLoggerFromFlags(commonFlags *arguments.CommonFlags) Logger {
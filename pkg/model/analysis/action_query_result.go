@@ -0,0 +1,318 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+
+	"bonanza.build/pkg/label"
+	model_command "bonanza.build/pkg/model/command"
+	model_core "bonanza.build/pkg/model/core"
+	"bonanza.build/pkg/model/core/btree"
+	"bonanza.build/pkg/model/evaluation"
+	model_parser "bonanza.build/pkg/model/parser"
+	model_starlark "bonanza.build/pkg/model/starlark"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_command_pb "bonanza.build/pkg/proto/model/command"
+	model_core_pb "bonanza.build/pkg/proto/model/core"
+	model_starlark_pb "bonanza.build/pkg/proto/model/starlark"
+)
+
+// getActionInputPaths returns the input root relative paths of all
+// input files and tools of an action, sorted alphabetically.
+func (c *baseComputer[TReference, TMetadata]) getActionInputPaths(ctx context.Context, actionDefinition model_core.Message[*model_analysis_pb.TargetActionDefinition, TReference]) ([]string, error) {
+	inputPaths := map[string]struct{}{}
+	var errIter error
+	for element := range btree.AllLeaves(
+		ctx,
+		c.valueReaders.List,
+		model_core.Nested(actionDefinition, actionDefinition.Message.Inputs),
+		func(element model_core.Message[*model_starlark_pb.List_Element, TReference]) (*model_core_pb.DecodableReference, error) {
+			return element.Message.GetParent().GetReference(), nil
+		},
+		&errIter,
+	) {
+		level, ok := element.Message.Level.(*model_starlark_pb.List_Element_Leaf)
+		if !ok {
+			return nil, errors.New("invalid list element level type for inputs")
+		}
+		file, ok := level.Leaf.Kind.(*model_starlark_pb.Value_File)
+		if !ok {
+			return nil, errors.New("input is not a file")
+		}
+		inputPath, err := model_starlark.FileGetInputRootPath(model_core.Nested(element, file.File), nil)
+		if err != nil {
+			return nil, err
+		}
+		inputPaths[inputPath] = struct{}{}
+	}
+	if errIter != nil {
+		return nil, errIter
+	}
+
+	for tool := range btree.AllLeaves(
+		ctx,
+		c.filesToRunProviderReader,
+		model_core.Nested(actionDefinition, actionDefinition.Message.Tools),
+		func(element model_core.Message[*model_analysis_pb.FilesToRunProvider, TReference]) (*model_core_pb.DecodableReference, error) {
+			return element.Message.GetParent().GetReference(), nil
+		},
+		&errIter,
+	) {
+		toolLevel, ok := tool.Message.Level.(*model_analysis_pb.FilesToRunProvider_Leaf_)
+		if !ok {
+			return nil, errors.New("not a valid leaf entry for tool")
+		}
+		toolPath, err := model_starlark.FileGetInputRootPath(model_core.Nested(tool, toolLevel.Leaf.Executable), nil)
+		if err != nil {
+			return nil, err
+		}
+		inputPaths[toolPath] = struct{}{}
+	}
+	if errIter != nil {
+		return nil, errIter
+	}
+	return slices.Sorted(maps.Keys(inputPaths)), nil
+}
+
+// appendPathPatternPaths appends the paths of all files and
+// directories that are matched by a path pattern of a command.
+func appendPathPatternPaths[TReference any](
+	ctx context.Context,
+	reader model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_command_pb.PathPattern_Children, TReference]],
+	pathPattern model_core.Message[*model_command_pb.PathPattern, TReference],
+	currentPath string,
+	paths []string,
+) ([]string, error) {
+	children, err := model_command.PathPatternGetChildren(ctx, reader, pathPattern)
+	if err != nil {
+		return nil, err
+	}
+	if !children.IsSet() {
+		return append(paths, currentPath), nil
+	}
+	for _, child := range children.Message.Children {
+		paths, err = appendPathPatternPaths(
+			ctx,
+			reader,
+			model_core.Nested(children, child.Pattern),
+			path.Join(currentPath, child.Name),
+			paths,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+// getActionQueryResultAction obtains the command of an action declared
+// by a configured target, and converts it to a message that can be
+// displayed by "aquery".
+func (c *baseComputer[TReference, TMetadata]) getActionQueryResultAction(
+	ctx context.Context,
+	e ActionQueryResultEnvironment[TReference, TMetadata],
+	actionReaders *ActionReaders[TReference],
+	targetLabel string,
+	configuration *topLevelConfiguration[TReference],
+	action model_core.Message[*model_analysis_pb.ConfiguredTarget_Value_Action_Leaf, TReference],
+) (*model_analysis_pb.ActionQueryResult_Value_Action, error) {
+	commandValue := e.GetTargetActionCommandValue(
+		model_core.MustBuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) *model_analysis_pb.TargetActionCommand_Key {
+			return &model_analysis_pb.TargetActionCommand_Key{
+				Id: &model_analysis_pb.TargetActionId{
+					Label:                  targetLabel,
+					ConfigurationReference: model_core.Patch(e, configuration.reference).Merge(patcher),
+					ActionId:               action.Message.Id,
+				},
+			}
+		}),
+	)
+	if !commandValue.IsSet() {
+		return nil, evaluation.ErrMissingDependency
+	}
+	command, err := model_parser.Dereference(ctx, actionReaders.CommandCommand, model_core.Nested(commandValue, commandValue.Message.CommandReference))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read command: %w", err)
+	}
+
+	var arguments []string
+	var errIter error
+	for element := range btree.AllLeaves(
+		ctx,
+		actionReaders.CommandArgumentList,
+		model_core.Nested(command, command.Message.Arguments),
+		func(element model_core.Message[*model_command_pb.ArgumentList_Element, TReference]) (*model_core_pb.DecodableReference, error) {
+			return element.Message.GetParent(), nil
+		},
+		&errIter,
+	) {
+		level, ok := element.Message.Level.(*model_command_pb.ArgumentList_Element_Leaf)
+		if !ok {
+			return nil, errors.New("invalid leaf element in arguments")
+		}
+		arguments = append(arguments, level.Leaf)
+	}
+	if errIter != nil {
+		return nil, fmt.Errorf("failed to iterate arguments: %w", errIter)
+	}
+
+	var environmentVariables []*model_command_pb.EnvironmentVariableList_Element_Leaf
+	for entry := range btree.AllLeaves(
+		ctx,
+		actionReaders.CommandEnvironmentVariableList,
+		model_core.Nested(command, command.Message.EnvironmentVariables),
+		func(entry model_core.Message[*model_command_pb.EnvironmentVariableList_Element, TReference]) (*model_core_pb.DecodableReference, error) {
+			return entry.Message.GetParent(), nil
+		},
+		&errIter,
+	) {
+		level, ok := entry.Message.Level.(*model_command_pb.EnvironmentVariableList_Element_Leaf_)
+		if !ok {
+			return nil, errors.New("invalid leaf entry in environment variables")
+		}
+		environmentVariables = append(environmentVariables, level.Leaf)
+	}
+	if errIter != nil {
+		return nil, fmt.Errorf("failed to iterate environment variables: %w", errIter)
+	}
+
+	actionDefinition := model_core.Nested(action, action.Message.Definition)
+	if actionDefinition.Message == nil {
+		return nil, errors.New("action definition missing")
+	}
+	inputs, err := c.getActionInputPaths(ctx, actionDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain inputs: %w", err)
+	}
+
+	outputs, err := appendPathPatternPaths(
+		ctx,
+		actionReaders.CommandPathPatternChildren,
+		model_core.Nested(command, command.Message.OutputPathPattern),
+		"",
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain outputs: %w", err)
+	}
+	slices.Sort(outputs)
+
+	return &model_analysis_pb.ActionQueryResult_Value_Action{
+		Label:                  targetLabel,
+		ConfigurationComponent: configuration.component,
+		Mnemonic:               actionDefinition.Message.Mnemonic,
+		Arguments:              arguments,
+		EnvironmentVariables:   environmentVariables,
+		Inputs:                 inputs,
+		Outputs:                outputs,
+	}, nil
+}
+
+func (c *baseComputer[TReference, TMetadata]) ComputeActionQueryResultValue(ctx context.Context, key *model_analysis_pb.ActionQueryResult_Key, e ActionQueryResultEnvironment[TReference, TMetadata]) (PatchedActionQueryResultValue[TMetadata], error) {
+	buildSpecificationValue := e.GetBuildSpecificationValue(&model_analysis_pb.BuildSpecification_Key{})
+	actionReaders, gotActionReaders := e.GetActionReadersValue(&model_analysis_pb.ActionReaders_Key{})
+	if !buildSpecificationValue.IsSet() || !gotActionReaders {
+		return PatchedActionQueryResultValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+	buildSpecification := buildSpecificationValue.Message
+
+	qe, err := c.newQueryEvaluator(ctx, e)
+	if err != nil {
+		return PatchedActionQueryResultValue[TMetadata]{}, err
+	}
+	result, err := qe.evaluateExpression(key.Expression)
+	if err != nil {
+		return PatchedActionQueryResultValue[TMetadata]{}, err
+	}
+
+	thread := c.newStarlarkThread(ctx, e, buildSpecification.BuiltinsModuleNames)
+	configurations, err := c.getTopLevelConfigurations(ctx, e, thread, qe.rootPackage, buildSpecification)
+	if err != nil {
+		return PatchedActionQueryResultValue[TMetadata]{}, err
+	}
+
+	// Aliases cause the same configured target to be reached
+	// multiple times. Only report its actions once.
+	type configuredTargetKey struct {
+		label                  string
+		configurationComponent string
+	}
+	seenConfiguredTargets := map[configuredTargetKey]struct{}{}
+
+	missingDependencies := false
+	var actions []*model_analysis_pb.ActionQueryResult_Value_Action
+	for _, targetLabelStr := range slices.Sorted(maps.Keys(result)) {
+		targetLabel, err := label.NewCanonicalLabel(targetLabelStr)
+		if err != nil {
+			return PatchedActionQueryResultValue[TMetadata]{}, fmt.Errorf("invalid target label %#v: %w", targetLabelStr, err)
+		}
+
+		for i := range configurations {
+			configuration := &configurations[i]
+			actualLabel, configuredTarget, err := getTopLevelConfiguredTarget(e, targetLabel, configuration)
+			if err != nil {
+				if errors.Is(err, evaluation.ErrMissingDependency) {
+					missingDependencies = true
+					continue
+				}
+				return PatchedActionQueryResultValue[TMetadata]{}, fmt.Errorf("failed to configure target %#v: %w", targetLabelStr, err)
+			}
+			if actualLabel == "" {
+				continue
+			}
+			seenKey := configuredTargetKey{
+				label:                  actualLabel,
+				configurationComponent: configuration.component,
+			}
+			if _, ok := seenConfiguredTargets[seenKey]; ok {
+				continue
+			}
+			seenConfiguredTargets[seenKey] = struct{}{}
+
+			var errIter error
+			for entry := range btree.AllLeaves(
+				ctx,
+				c.configuredTargetActionReader,
+				model_core.Nested(configuredTarget, configuredTarget.Message.Actions),
+				func(entry model_core.Message[*model_analysis_pb.ConfiguredTarget_Value_Action, TReference]) (*model_core_pb.DecodableReference, error) {
+					return entry.Message.GetParent().GetReference(), nil
+				},
+				&errIter,
+			) {
+				level, ok := entry.Message.Level.(*model_analysis_pb.ConfiguredTarget_Value_Action_Leaf_)
+				if !ok {
+					return PatchedActionQueryResultValue[TMetadata]{}, fmt.Errorf("invalid action level type for target %#v", actualLabel)
+				}
+				action, err := c.getActionQueryResultAction(
+					ctx,
+					e,
+					actionReaders,
+					actualLabel,
+					configuration,
+					model_core.Nested(entry, level.Leaf),
+				)
+				if err != nil {
+					if errors.Is(err, evaluation.ErrMissingDependency) {
+						missingDependencies = true
+						continue
+					}
+					return PatchedActionQueryResultValue[TMetadata]{}, fmt.Errorf("failed to obtain action of target %#v: %w", actualLabel, err)
+				}
+				actions = append(actions, action)
+			}
+			if errIter != nil {
+				return PatchedActionQueryResultValue[TMetadata]{}, fmt.Errorf("failed to iterate actions of target %#v: %w", actualLabel, errIter)
+			}
+		}
+	}
+	if missingDependencies || qe.missingDependencies {
+		return PatchedActionQueryResultValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+	return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.ActionQueryResult_Value{
+		Actions: actions,
+	}), nil
+}
@@ -15,9 +15,12 @@ import (
 // references to objects that are encoded using the action encoders
 // that are part of the BuildSpecification.
 type ActionReaders[TReference any] struct {
-	CommandAction              model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_command_pb.Action, TReference]]
-	CommandPathPatternChildren model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_command_pb.PathPattern_Children, TReference]]
-	CommandResult              model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_command_pb.Result, TReference]]
+	CommandAction                  model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_command_pb.Action, TReference]]
+	CommandArgumentList            model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[[]*model_command_pb.ArgumentList_Element, TReference]]
+	CommandCommand                 model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_command_pb.Command, TReference]]
+	CommandEnvironmentVariableList model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[[]*model_command_pb.EnvironmentVariableList_Element, TReference]]
+	CommandPathPatternChildren     model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_command_pb.PathPattern_Children, TReference]]
+	CommandResult                  model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_command_pb.Result, TReference]]
 
 	FetchResult model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_fetch_pb.Result, TReference]]
 }
@@ -36,6 +39,27 @@ func (c *baseComputer[TReference, TMetadata]) ComputeActionReadersValue(ctx cont
 				model_parser.NewProtoObjectParser[TReference, model_command_pb.Action](),
 			),
 		),
+		CommandArgumentList: model_parser.LookupParsedObjectReader(
+			c.parsedObjectPoolIngester,
+			model_parser.NewChainedObjectParser(
+				encodedObjectParser,
+				model_parser.NewProtoListObjectParser[TReference, model_command_pb.ArgumentList_Element](),
+			),
+		),
+		CommandCommand: model_parser.LookupParsedObjectReader(
+			c.parsedObjectPoolIngester,
+			model_parser.NewChainedObjectParser(
+				encodedObjectParser,
+				model_parser.NewProtoObjectParser[TReference, model_command_pb.Command](),
+			),
+		),
+		CommandEnvironmentVariableList: model_parser.LookupParsedObjectReader(
+			c.parsedObjectPoolIngester,
+			model_parser.NewChainedObjectParser(
+				encodedObjectParser,
+				model_parser.NewProtoListObjectParser[TReference, model_command_pb.EnvironmentVariableList_Element](),
+			),
+		),
 		CommandPathPatternChildren: model_parser.LookupParsedObjectReader(
 			c.parsedObjectPoolIngester,
 			model_parser.NewChainedObjectParser(
@@ -78,6 +78,7 @@ func (c *baseComputer[TReference, TMetadata]) ComputeActionResultValue(ctx conte
 			StableFingerprint: commandReferenceSHA256[:],
 			ExecutionTimeout:  executeRequest.Message.ExecutionTimeout,
 		},
+		c.executionPriority,
 		&resultReference,
 		&errExecution,
 	) {
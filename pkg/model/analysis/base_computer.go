@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"bonanza.build/pkg/label"
 	model_core "bonanza.build/pkg/model/core"
@@ -23,6 +24,8 @@ import (
 	"github.com/buildbarn/bb-remote-execution/pkg/filesystem/pool"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
+	"google.golang.org/grpc/metadata"
+
 	"go.starlark.net/starlark"
 )
 
@@ -51,7 +54,7 @@ func NewBaseComputerFactory[TReference object.BasicReference, TMetadata model_co
 	}
 }
 
-func (cf *baseComputerFactory[TReference, TMetadata]) NewComputer(namespace object.Namespace, parsedObjectPoolIngester *model_parser.ParsedObjectPoolIngester[TReference], objectExporter model_core.ObjectExporter[TReference, object.LocalReference]) evaluation.Computer[TReference, TMetadata] {
+func (cf *baseComputerFactory[TReference, TMetadata]) NewComputer(namespace object.Namespace, executionPriority int32, executionRequestMetadata metadata.MD, parsedObjectPoolIngester *model_parser.ParsedObjectPoolIngester[TReference], objectExporter model_core.ObjectExporter[TReference, object.LocalReference]) evaluation.Computer[TReference, TMetadata] {
 	return NewTypedComputer(
 		NewBaseComputer[TReference, TMetadata](
 			parsedObjectPoolIngester,
@@ -59,11 +62,15 @@ func (cf *baseComputerFactory[TReference, TMetadata]) NewComputer(namespace obje
 			cf.filePool,
 			model_executewithstorage.NewObjectExportingClient(
 				model_executewithstorage.NewNamespaceAddingClient(
-					cf.executionClient,
+					remoteexecution.NewRequestMetadataAddingClient(
+						cf.executionClient,
+						executionRequestMetadata,
+					),
 					namespace.InstanceName,
 				),
 				objectExporter,
 			),
+			executionPriority,
 			cf.bzlFileBuiltins,
 			cf.buildFileBuiltins,
 		),
@@ -75,6 +82,7 @@ type baseComputer[TReference object.BasicReference, TMetadata BaseComputerRefere
 	referenceFormat          object.ReferenceFormat
 	filePool                 pool.FilePool
 	executionClient          remoteexecution.Client[*model_executewithstorage.Action[TReference], model_core.Decodable[TReference], model_core.Decodable[TReference]]
+	executionPriority        int32
 	bzlFileBuiltins          starlark.StringDict
 	buildFileBuiltins        starlark.StringDict
 	discardingObjectCapturer model_core.ObjectCapturer[TReference, model_core.NoopReferenceMetadata]
@@ -100,6 +108,7 @@ func NewBaseComputer[TReference object.BasicReference, TMetadata BaseComputerRef
 	referenceFormat object.ReferenceFormat,
 	filePool pool.FilePool,
 	executionClient remoteexecution.Client[*model_executewithstorage.Action[TReference], model_core.Decodable[TReference], model_core.Decodable[TReference]],
+	executionPriority int32,
 	bzlFileBuiltins starlark.StringDict,
 	buildFileBuiltins starlark.StringDict,
 ) Computer[TReference, TMetadata] {
@@ -108,6 +117,7 @@ func NewBaseComputer[TReference object.BasicReference, TMetadata BaseComputerRef
 		referenceFormat:          referenceFormat,
 		filePool:                 filePool,
 		executionClient:          executionClient,
+		executionPriority:        executionPriority,
 		bzlFileBuiltins:          bzlFileBuiltins,
 		buildFileBuiltins:        buildFileBuiltins,
 		discardingObjectCapturer: model_core.NewDiscardingObjectCapturer[TReference](),
@@ -313,9 +323,17 @@ func (c *baseComputer[TReference, TMetadata]) ComputeBuildResultValue(ctx contex
 	rootRepo := rootModule.ToModuleInstance(nil).GetBareCanonicalRepo()
 	rootPackage := rootRepo.GetRootPackage()
 
+	// If MODULE.bazel.lock needs to be updated, provide the new
+	// contents to the client.
+	updatedModuleLockfile := e.GetUpdatedModuleLockfileValue(&model_analysis_pb.UpdatedModuleLockfile_Key{})
+	missingDependencies := !updatedModuleLockfile.IsSet()
+
 	thread := c.newStarlarkThread(ctx, e, buildSpecification.BuiltinsModuleNames)
-	missingDependencies := false
 	labelResolver := newLabelResolver(e)
+	patcher := model_core.NewReferenceMessagePatcher[TMetadata]()
+	var targetCompletions []*model_analysis_pb.BuildResult_Value_TargetCompletion
+	var executables []*model_analysis_pb.BuildResult_Value_Executable
+	var testResults []*model_analysis_pb.BuildResult_Value_TestResult
 	for i, configuration := range buildSpecification.Configurations {
 		targetPlatformConfigurationReference, err := c.createInitialConfiguration(ctx, e, thread, rootPackage, configuration)
 		if err != nil {
@@ -329,6 +347,24 @@ func (c *baseComputer[TReference, TMetadata]) ComputeBuildResultValue(ctx contex
 			e,
 			targetPlatformConfigurationReference,
 		).Decay()
+		configurationComponent, err := model_starlark.ConfigurationReferenceToComponent(clonedConfigurationReference)
+		if err != nil {
+			return PatchedBuildResultValue[TMetadata]{}, fmt.Errorf("failed to obtain pathname component for configuration at index %d: %w", i, err)
+		}
+
+		// If tests need to be run, keep track of all tests that
+		// are matched by the target patterns.
+		var tests *testCollector[TReference, TMetadata]
+		if key.TestParameters != nil {
+			tests = &testCollector[TReference, TMetadata]{
+				computer:               c,
+				context:                ctx,
+				environment:            e,
+				labelResolver:          labelResolver,
+				configurationReference: clonedConfigurationReference,
+				seenLabels:             map[string]struct{}{},
+			}
+		}
 
 		for _, targetPattern := range buildSpecification.TargetPatterns {
 			apparentTargetPattern, err := label.NewApparentTargetPattern(targetPattern)
@@ -372,6 +408,42 @@ func (c *baseComputer[TReference, TMetadata]) ComputeBuildResultValue(ctx contex
 				)
 				if !targetCompletionValue.IsSet() {
 					missingDependencies = true
+					continue
+				}
+				targetCompletions = append(targetCompletions, &model_analysis_pb.BuildResult_Value_TargetCompletion{
+					Label:                  visibleTargetValue.Message.Label,
+					ConfigurationComponent: configurationComponent,
+					RootDirectory: model_core.Patch(
+						e,
+						model_core.Nested(targetCompletionValue, targetCompletionValue.Message.RootDirectory),
+					).Merge(patcher),
+				})
+
+				if key.IncludeExecutables {
+					targetExecutableValue := e.GetTargetExecutableValue(
+						model_core.MustBuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) *model_analysis_pb.TargetExecutable_Key {
+							return &model_analysis_pb.TargetExecutable_Key{
+								Label:                  visibleTargetValue.Message.Label,
+								ConfigurationReference: model_core.Patch(e, clonedConfigurationReference).Merge(patcher),
+							}
+						}),
+					)
+					if !targetExecutableValue.IsSet() {
+						missingDependencies = true
+						continue
+					}
+					executables = append(executables, &model_analysis_pb.BuildResult_Value_Executable{
+						Label:                  visibleTargetValue.Message.Label,
+						ConfigurationComponent: configurationComponent,
+						Executable:             model_core.Patch(e, targetExecutableValue).Merge(patcher),
+						TargetPattern:          targetPattern,
+					})
+				}
+
+				if tests != nil {
+					if err := tests.addTarget(canonicalTargetLabel.GetCanonicalPackage(), canonicalTargetLabel, true); err != nil {
+						return PatchedBuildResultValue[TMetadata]{}, fmt.Errorf("failed to determine whether target %#v is a test: %w", canonicalTargetLabel.String(), err)
+					}
 				}
 			}
 			if iterErr != nil {
@@ -381,12 +453,53 @@ func (c *baseComputer[TReference, TMetadata]) ComputeBuildResultValue(ctx contex
 				missingDependencies = true
 			}
 		}
+
+		if tests != nil {
+			if tests.missingDependencies {
+				missingDependencies = true
+				continue
+			}
+			runsPerTest := max(key.TestParameters.RunsPerTest, 1)
+			slices.Sort(tests.testLabels)
+			for _, testLabel := range tests.testLabels {
+				for runNumber := uint32(1); runNumber <= runsPerTest; runNumber++ {
+					testResultValue := e.GetTestResultValue(
+						model_core.MustBuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) *model_analysis_pb.TestResult_Key {
+							return &model_analysis_pb.TestResult_Key{
+								Label:                  testLabel,
+								ConfigurationReference: model_core.Patch(e, clonedConfigurationReference).Merge(patcher),
+								RunNumber:              runNumber,
+								TestFilter:             key.TestParameters.TestFilter,
+							}
+						}),
+					)
+					if !testResultValue.IsSet() {
+						missingDependencies = true
+						continue
+					}
+					testResults = append(testResults, &model_analysis_pb.BuildResult_Value_TestResult{
+						Label:                  testLabel,
+						ConfigurationComponent: configurationComponent,
+						RunNumber:              runNumber,
+						Result:                 model_core.Patch(e, testResultValue).Merge(patcher),
+					})
+				}
+			}
+		}
 	}
 	if missingDependencies {
 		return PatchedBuildResultValue[TMetadata]{}, evaluation.ErrMissingDependency
 	}
 
-	return PatchedBuildResultValue[TMetadata]{}, errors.New("TODO: report build results in a meaningful way")
+	return model_core.NewPatchedMessage(
+		&model_analysis_pb.BuildResult_Value{
+			TargetCompletions: targetCompletions,
+			TestResults:       testResults,
+			Executables:       executables,
+			ModuleLockfile:    updatedModuleLockfile.Message.Contents,
+		},
+		patcher,
+	), nil
 }
 
 func (c *baseComputer[TReference, TMetadata]) ComputeBuiltinsModuleNamesValue(ctx context.Context, key *model_analysis_pb.BuiltinsModuleNames_Key, e BuiltinsModuleNamesEnvironment[TReference, TMetadata]) (PatchedBuiltinsModuleNamesValue[TMetadata], error) {
@@ -62,6 +62,7 @@ func newBaseComputerTester(ctrl *gomock.Controller) *baseComputerTester {
 			object.SHA256V1ReferenceFormat,
 			filePool,
 			executionClient,
+			/* executionPriority = */ 0,
 			bzlFileBuiltins,
 			buildFileBuiltins,
 		),
@@ -127,7 +128,13 @@ func (bct *baseComputerTester) expectGetFileCreationParametersObjectValue(t *tes
 func (bct *baseComputerTester) expectGetFileReaderValue(t *testing.T, e *MockFileRootEnvironmentForTesting) *gomock.Call {
 	return e.EXPECT().GetFileReaderValue(
 		testutil.EqProto(t, &model_analysis_pb.FileReader_Key{}),
-	).Return(model_filesystem.NewFileReader(
+	).Return(bct.newFileReader(), true)
+}
+
+// newFileReader creates a FileReader that is capable of reading the
+// contents of files that are backed by objects created by tests.
+func (bct *baseComputerTester) newFileReader() *model_filesystem.FileReader[model_core.CreatedObjectTree] {
+	return model_filesystem.NewFileReader(
 		model_parser.LookupParsedObjectReader(
 			bct.parsedObjectPoolIngester,
 			model_filesystem.NewFileContentsListObjectParser[model_core.CreatedObjectTree](),
@@ -136,7 +143,7 @@ func (bct *baseComputerTester) expectGetFileReaderValue(t *testing.T, e *MockFil
 			bct.parsedObjectPoolIngester,
 			model_parser.NewRawObjectParser[model_core.CreatedObjectTree](),
 		),
-	), true)
+	)
 }
 
 // expectGetDirectoryReadersValue can be called by tests to indicate
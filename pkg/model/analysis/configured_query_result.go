@@ -0,0 +1,264 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"bonanza.build/pkg/label"
+	model_core "bonanza.build/pkg/model/core"
+	"bonanza.build/pkg/model/evaluation"
+	model_starlark "bonanza.build/pkg/model/starlark"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_core_pb "bonanza.build/pkg/proto/model/core"
+	"bonanza.build/pkg/storage/object"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// topLevelConfiguration is a configuration in which the targets
+// selected by "cquery" and "aquery" are configured.
+type topLevelConfiguration[TReference any] struct {
+	reference model_core.Message[*model_core_pb.DecodableReference, TReference]
+	component string
+}
+
+type getTopLevelConfigurationsEnvironment[TReference any, TMetadata model_core.ReferenceMetadata] interface {
+	createInitialConfigurationEnvironment[TReference, TMetadata]
+	model_core.ObjectReferencer[TReference, TMetadata]
+}
+
+// getTopLevelConfigurations creates the initial configurations for
+// each of the configurations provided in the build specification.
+func (c *baseComputer[TReference, TMetadata]) getTopLevelConfigurations(
+	ctx context.Context,
+	e getTopLevelConfigurationsEnvironment[TReference, TMetadata],
+	thread *starlark.Thread,
+	rootPackage label.CanonicalPackage,
+	buildSpecification *model_analysis_pb.BuildSpecification_Value,
+) ([]topLevelConfiguration[TReference], error) {
+	missingDependencies := false
+	configurations := make([]topLevelConfiguration[TReference], 0, len(buildSpecification.Configurations))
+	for i, configuration := range buildSpecification.Configurations {
+		configurationReference, err := c.createInitialConfiguration(ctx, e, thread, rootPackage, configuration)
+		if err != nil {
+			if !errors.Is(err, evaluation.ErrMissingDependency) {
+				return nil, fmt.Errorf("failed to create initial configuration for configuration at index %d: %w", i, err)
+			}
+			missingDependencies = true
+			continue
+		}
+		clonedConfigurationReference := model_core.Unpatch(e, configurationReference).Decay()
+		configurationComponent, err := model_starlark.ConfigurationReferenceToComponent(clonedConfigurationReference)
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain pathname component for configuration at index %d: %w", i, err)
+		}
+		configurations = append(configurations, topLevelConfiguration[TReference]{
+			reference: clonedConfigurationReference,
+			component: configurationComponent,
+		})
+	}
+	if missingDependencies {
+		return nil, evaluation.ErrMissingDependency
+	}
+	return configurations, nil
+}
+
+type getTopLevelConfiguredTargetEnvironment[TReference any, TMetadata model_core.ReferenceMetadata] interface {
+	model_core.ObjectCapturer[TReference, TMetadata]
+
+	GetConfiguredTargetValue(model_core.PatchedMessage[*model_analysis_pb.ConfiguredTarget_Key, TMetadata]) model_core.Message[*model_analysis_pb.ConfiguredTarget_Value, TReference]
+	GetVisibleTargetValue(model_core.PatchedMessage[*model_analysis_pb.VisibleTarget_Key, TMetadata]) model_core.Message[*model_analysis_pb.VisibleTarget_Value, TReference]
+}
+
+// getTopLevelConfiguredTarget configures a target that was selected by
+// a query expression in a top-level configuration. Aliases are
+// resolved prior to configuring the target. The label of the actual
+// target is returned, which is empty if an alias did not match any of
+// its conditions.
+func getTopLevelConfiguredTarget[TReference object.BasicReference, TMetadata model_core.ReferenceMetadata](
+	e getTopLevelConfiguredTargetEnvironment[TReference, TMetadata],
+	targetLabel label.CanonicalLabel,
+	configuration *topLevelConfiguration[TReference],
+) (string, model_core.Message[*model_analysis_pb.ConfiguredTarget_Value, TReference], error) {
+	visibleTargetValue := e.GetVisibleTargetValue(
+		model_core.MustBuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) *model_analysis_pb.VisibleTarget_Key {
+			return &model_analysis_pb.VisibleTarget_Key{
+				FromPackage:            targetLabel.GetCanonicalPackage().String(),
+				ToLabel:                targetLabel.String(),
+				PermitAliasNoMatch:     true,
+				ConfigurationReference: model_core.Patch(e, configuration.reference).Merge(patcher),
+			}
+		}),
+	)
+	if !visibleTargetValue.IsSet() {
+		return "", model_core.Message[*model_analysis_pb.ConfiguredTarget_Value, TReference]{}, evaluation.ErrMissingDependency
+	}
+	actualLabel := visibleTargetValue.Message.Label
+	if actualLabel == "" {
+		return "", model_core.Message[*model_analysis_pb.ConfiguredTarget_Value, TReference]{}, nil
+	}
+
+	configuredTarget := e.GetConfiguredTargetValue(
+		model_core.MustBuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) *model_analysis_pb.ConfiguredTarget_Key {
+			return &model_analysis_pb.ConfiguredTarget_Key{
+				Label:                  actualLabel,
+				ConfigurationReference: model_core.Patch(e, configuration.reference).Merge(patcher),
+			}
+		}),
+	)
+	if !configuredTarget.IsSet() {
+		return "", model_core.Message[*model_analysis_pb.ConfiguredTarget_Value, TReference]{}, evaluation.ErrMissingDependency
+	}
+	return actualLabel, configuredTarget, nil
+}
+
+// formatConfiguredTarget evaluates the Starlark expression provided to
+// "cquery --output=starlark" against a configured target.
+func (c *baseComputer[TReference, TMetadata]) formatConfiguredTarget(
+	ctx context.Context,
+	thread *starlark.Thread,
+	starlarkExpression string,
+	targetLabel label.CanonicalLabel,
+	configuredTarget model_core.Message[*model_analysis_pb.ConfiguredTarget_Value, TReference],
+) (string, error) {
+	providerInstances := model_core.Nested(configuredTarget, configuredTarget.Message.ProviderInstances)
+	targetValue := model_starlark.NewTargetReference[TReference, TMetadata](
+		targetLabel.AsResolved(),
+		model_starlark.NewConfiguredTargetReference[TReference, TMetadata](targetLabel, providerInstances),
+	)
+	env := starlark.StringDict{
+		"providers": starlark.NewBuiltin(
+			"providers",
+			func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var target starlark.Value
+				if err := starlark.UnpackArgs(b.Name(), args, kwargs, "target", &target); err != nil {
+					return nil, err
+				}
+				if target != targetValue {
+					return nil, errors.New("providers can only be obtained for the target that is being formatted")
+				}
+				valueDecodingOptions := c.getValueDecodingOptions(ctx, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+					return model_starlark.NewLabel[TReference, TMetadata](resolvedLabel), nil
+				})
+				providers := starlark.NewDict(len(providerInstances.Message))
+				for _, providerInstance := range providerInstances.Message {
+					strukt, err := model_starlark.DecodeStruct[TReference, TMetadata](
+						model_core.Nested(providerInstances, providerInstance),
+						valueDecodingOptions,
+					)
+					if err != nil {
+						return nil, err
+					}
+					if err := providers.SetKey(
+						thread,
+						starlark.String(providerInstance.ProviderInstanceProperties.GetProviderIdentifier()),
+						strukt,
+					); err != nil {
+						return nil, err
+					}
+				}
+				return providers, nil
+			},
+		),
+		"target": targetValue,
+	}
+
+	result, err := starlark.EvalOptions(&syntax.FileOptions{}, thread, "<expr>", starlarkExpression, env)
+	if err != nil {
+		var evalErr *starlark.EvalError
+		if errors.As(err, &evalErr) {
+			return "", errors.New(evalErr.Backtrace())
+		}
+		return "", err
+	}
+	if s, ok := result.(starlark.String); ok {
+		return string(s), nil
+	}
+	return result.String(), nil
+}
+
+func (c *baseComputer[TReference, TMetadata]) ComputeConfiguredQueryResultValue(ctx context.Context, key *model_analysis_pb.ConfiguredQueryResult_Key, e ConfiguredQueryResultEnvironment[TReference, TMetadata]) (PatchedConfiguredQueryResultValue[TMetadata], error) {
+	buildSpecificationValue := e.GetBuildSpecificationValue(&model_analysis_pb.BuildSpecification_Key{})
+	if !buildSpecificationValue.IsSet() {
+		return PatchedConfiguredQueryResultValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+	buildSpecification := buildSpecificationValue.Message
+
+	qe, err := c.newQueryEvaluator(ctx, e)
+	if err != nil {
+		return PatchedConfiguredQueryResultValue[TMetadata]{}, err
+	}
+	result, err := qe.evaluateExpression(key.Expression)
+	if err != nil {
+		return PatchedConfiguredQueryResultValue[TMetadata]{}, err
+	}
+
+	thread := c.newStarlarkThread(ctx, e, buildSpecification.BuiltinsModuleNames)
+	configurations, err := c.getTopLevelConfigurations(ctx, e, thread, qe.rootPackage, buildSpecification)
+	if err != nil {
+		return PatchedConfiguredQueryResultValue[TMetadata]{}, err
+	}
+
+	missingDependencies := false
+	var targets []*model_analysis_pb.ConfiguredQueryResult_Value_Target
+	for _, targetLabelStr := range slices.Sorted(maps.Keys(result)) {
+		target, err := qe.getTarget(targetLabelStr)
+		if err != nil {
+			return PatchedConfiguredQueryResultValue[TMetadata]{}, err
+		}
+		if target == nil {
+			continue
+		}
+		targetLabel, err := label.NewCanonicalLabel(targetLabelStr)
+		if err != nil {
+			return PatchedConfiguredQueryResultValue[TMetadata]{}, fmt.Errorf("invalid target label %#v: %w", targetLabelStr, err)
+		}
+
+		for i := range configurations {
+			configuration := &configurations[i]
+			actualLabelStr, configuredTarget, err := getTopLevelConfiguredTarget(e, targetLabel, configuration)
+			if err != nil {
+				if errors.Is(err, evaluation.ErrMissingDependency) {
+					missingDependencies = true
+					continue
+				}
+				return PatchedConfiguredQueryResultValue[TMetadata]{}, fmt.Errorf("failed to configure target %#v: %w", targetLabelStr, err)
+			}
+			if actualLabelStr == "" {
+				continue
+			}
+
+			resultTarget := &model_analysis_pb.ConfiguredQueryResult_Value_Target{
+				Label:                  targetLabelStr,
+				ConfigurationComponent: configuration.component,
+				Kind:                   target.kind,
+			}
+			if key.StarlarkExpression != "" {
+				actualLabel, err := label.NewCanonicalLabel(actualLabelStr)
+				if err != nil {
+					return PatchedConfiguredQueryResultValue[TMetadata]{}, fmt.Errorf("invalid target label %#v: %w", actualLabelStr, err)
+				}
+				output, err := c.formatConfiguredTarget(ctx, thread, key.StarlarkExpression, actualLabel, configuredTarget)
+				if err != nil {
+					if errors.Is(err, evaluation.ErrMissingDependency) {
+						missingDependencies = true
+						continue
+					}
+					return PatchedConfiguredQueryResultValue[TMetadata]{}, fmt.Errorf("failed to format target %#v: %w", targetLabelStr, err)
+				}
+				resultTarget.StarlarkOutput = output
+			}
+			targets = append(targets, resultTarget)
+		}
+	}
+	if missingDependencies || qe.missingDependencies {
+		return PatchedConfiguredQueryResultValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+	return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.ConfiguredQueryResult_Value{
+		Targets: targets,
+	}), nil
+}
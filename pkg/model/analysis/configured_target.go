@@ -2319,7 +2319,7 @@ func (rca *ruleContextActions[TReference, TMetadata]) doRun(thread *starlark.Thr
 	execGroup := ""
 	var executionRequirements map[string]string
 	var inputs *model_starlark.Depset[TReference, TMetadata]
-	mnemonic := ""
+	mnemonic := "Action"
 	var progressMessage string
 	var resourceSet *model_starlark.NamedFunction[TReference, TMetadata]
 	var toolchain *label.ResolvedLabel
@@ -2706,6 +2706,7 @@ func (rca *ruleContextActions[TReference, TMetadata]) doRun(thread *starlark.Thr
 				func(actionDefinition model_core.PatchedMessage[*model_analysis_pb.TargetActionDefinition, TMetadata]) {
 					actionDefinition.Message.PlatformPkixPublicKey = rc.execGroups[execGroupIndex].platformPkixPublicKey
 					actionDefinition.Message.UseDefaultShellEnv = useDefaultShellEnv
+					actionDefinition.Message.Mnemonic = mnemonic
 				},
 			),
 			// Fields that can be stored externally if needed.
@@ -3192,6 +3193,15 @@ func getProviderFromConfiguredTarget[TReference any, TMetadata model_core.Refere
 		return model_core.Message[*model_starlark_pb.Struct_Fields, TReference]{}, evaluation.ErrMissingDependency
 	}
 
+	if fields, ok := lookupProviderInConfiguredTarget(configuredTargetValue, providerIdentifier); ok {
+		return fields, nil
+	}
+	return model_core.Message[*model_starlark_pb.Struct_Fields, TReference]{}, fmt.Errorf("target did not yield provider %#v", providerIdentifier.String())
+}
+
+// lookupProviderInConfiguredTarget returns the fields of a provider
+// instance that was yielded by a configured target, if present.
+func lookupProviderInConfiguredTarget[TReference any](configuredTargetValue model_core.Message[*model_analysis_pb.ConfiguredTarget_Value, TReference], providerIdentifier label.CanonicalStarlarkIdentifier) (model_core.Message[*model_starlark_pb.Struct_Fields, TReference], bool) {
 	providerIdentifierStr := providerIdentifier.String()
 	providerInstances := configuredTargetValue.Message.ProviderInstances
 	if providerIndex, ok := sort.Find(
@@ -3200,9 +3210,9 @@ func getProviderFromConfiguredTarget[TReference any, TMetadata model_core.Refere
 			return strings.Compare(providerIdentifierStr, providerInstances[i].ProviderInstanceProperties.GetProviderIdentifier())
 		},
 	); ok {
-		return model_core.Nested(configuredTargetValue, providerInstances[providerIndex].Fields), nil
+		return model_core.Nested(configuredTargetValue, providerInstances[providerIndex].Fields), true
 	}
-	return model_core.Message[*model_starlark_pb.Struct_Fields, TReference]{}, fmt.Errorf("target did not yield provider %#v", providerIdentifierStr)
+	return model_core.Message[*model_starlark_pb.Struct_Fields, TReference]{}, false
 }
 
 type getProviderFromVisibleConfiguredTargetEnvironment[TReference any, TMetadata model_core.ReferenceMetadata] interface {
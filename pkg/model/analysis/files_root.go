@@ -10,6 +10,7 @@ import (
 	model_filesystem "bonanza.build/pkg/model/filesystem"
 	model_parser "bonanza.build/pkg/model/parser"
 	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_filesystem_pb "bonanza.build/pkg/proto/model/filesystem"
 	model_starlark_pb "bonanza.build/pkg/proto/model/starlark"
 	"bonanza.build/pkg/storage/object"
 
@@ -100,16 +101,27 @@ func addFileToChangeTrackingDirectory[TReference object.BasicReference, TMetadat
 	return nil
 }
 
-func (c *baseComputer[TReference, TMetadata]) ComputeFilesRootValue(ctx context.Context, key model_core.Message[*model_analysis_pb.FilesRoot_Key, TReference], e FilesRootEnvironment[TReference, TMetadata]) (PatchedFilesRootValue[TMetadata], error) {
+type createRootDirectoryFromFilesEnvironment[TReference any, TMetadata model_core.ReferenceMetadata] interface {
+	addFilesToChangeTrackingDirectoryEnvironment[TReference, TMetadata]
+	model_core.CreatedObjectCapturer[TMetadata]
+
+	GetDirectoryCreationParametersObjectValue(key *model_analysis_pb.DirectoryCreationParametersObject_Key) (*model_filesystem.DirectoryCreationParameters, bool)
+	GetDirectoryReadersValue(key *model_analysis_pb.DirectoryReaders_Key) (*DirectoryReaders[TReference], bool)
+}
+
+// createRootDirectoryFromFiles creates a directory hierarchy that
+// contains all files in a list, placed at the location corresponding
+// to the provided directory layout.
+func createRootDirectoryFromFiles[TReference object.BasicReference, TMetadata model_core.ReferenceMetadata](
+	ctx context.Context,
+	e createRootDirectoryFromFilesEnvironment[TReference, TMetadata],
+	files model_core.Message[[]*model_starlark_pb.List_Element, TReference],
+	directoryLayout model_analysis_pb.DirectoryLayout,
+) (model_core.PatchedMessage[*model_filesystem_pb.DirectoryContents, TMetadata], error) {
 	directoryCreationParameters, gotDirectoryCreationParameters := e.GetDirectoryCreationParametersObjectValue(&model_analysis_pb.DirectoryCreationParametersObject_Key{})
 	directoryReaders, gotDirectoryReaders := e.GetDirectoryReadersValue(&model_analysis_pb.DirectoryReaders_Key{})
 	if !gotDirectoryCreationParameters || !gotDirectoryReaders {
-		return PatchedFilesRootValue[TMetadata]{}, evaluation.ErrMissingDependency
-	}
-
-	files, err := model_parser.Dereference(ctx, c.valueReaders.List, model_core.Nested(key, key.Message.ListReference))
-	if err != nil {
-		return PatchedFilesRootValue[TMetadata]{}, err
+		return model_core.PatchedMessage[*model_filesystem_pb.DirectoryContents, TMetadata]{}, evaluation.ErrMissingDependency
 	}
 
 	var rootDirectory changeTrackingDirectory[TReference, TMetadata]
@@ -122,11 +134,23 @@ func (c *baseComputer[TReference, TMetadata]) ComputeFilesRootValue(ctx context.
 			directoryContentsReader: directoryReaders.DirectoryContents,
 			leavesReader:            directoryReaders.Leaves,
 		},
-		key.Message.DirectoryLayout,
+		directoryLayout,
 	); err != nil {
-		return PatchedFilesRootValue[TMetadata]{}, err
+		return model_core.PatchedMessage[*model_filesystem_pb.DirectoryContents, TMetadata]{}, err
 	}
 
+	return createDirectoryContentsFromChangeTrackingDirectory(ctx, e, &rootDirectory, directoryCreationParameters, directoryReaders)
+}
+
+// createDirectoryContentsFromChangeTrackingDirectory creates a
+// directory Merkle tree from the contents of a changeTrackingDirectory.
+func createDirectoryContentsFromChangeTrackingDirectory[TReference object.BasicReference, TMetadata model_core.ReferenceMetadata](
+	ctx context.Context,
+	e createRootDirectoryFromFilesEnvironment[TReference, TMetadata],
+	rootDirectory *changeTrackingDirectory[TReference, TMetadata],
+	directoryCreationParameters *model_filesystem.DirectoryCreationParameters,
+	directoryReaders *DirectoryReaders[TReference],
+) (model_core.PatchedMessage[*model_filesystem_pb.DirectoryContents, TMetadata], error) {
 	group, groupCtx := errgroup.WithContext(ctx)
 	var createdRootDirectory model_filesystem.CreatedDirectory[TMetadata]
 	group.Go(func() error {
@@ -141,20 +165,32 @@ func (c *baseComputer[TReference, TMetadata]) ComputeFilesRootValue(ctx context.
 					directoryContentsReader: directoryReaders.DirectoryContents,
 					objectCapturer:          e,
 				},
-				directory: &rootDirectory,
+				directory: rootDirectory,
 			},
 			model_filesystem.NewSimpleDirectoryMerkleTreeCapturer[TMetadata](e),
 			&createdRootDirectory,
 		)
 	})
 	if err := group.Wait(); err != nil {
+		return model_core.PatchedMessage[*model_filesystem_pb.DirectoryContents, TMetadata]{}, err
+	}
+	return createdRootDirectory.Message, nil
+}
+
+func (c *baseComputer[TReference, TMetadata]) ComputeFilesRootValue(ctx context.Context, key model_core.Message[*model_analysis_pb.FilesRoot_Key, TReference], e FilesRootEnvironment[TReference, TMetadata]) (PatchedFilesRootValue[TMetadata], error) {
+	files, err := model_parser.Dereference(ctx, c.valueReaders.List, model_core.Nested(key, key.Message.ListReference))
+	if err != nil {
 		return PatchedFilesRootValue[TMetadata]{}, err
 	}
 
+	rootDirectory, err := createRootDirectoryFromFiles(ctx, e, files, key.Message.DirectoryLayout)
+	if err != nil {
+		return PatchedFilesRootValue[TMetadata]{}, err
+	}
 	return model_core.NewPatchedMessage(
 		&model_analysis_pb.FilesRoot_Value{
-			RootDirectory: createdRootDirectory.Message.Message,
+			RootDirectory: rootDirectory.Message,
 		},
-		createdRootDirectory.Message.Patcher,
+		rootDirectory.Patcher,
 	), nil
 }
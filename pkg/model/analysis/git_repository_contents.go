@@ -0,0 +1,211 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"bonanza.build/pkg/crypto"
+	model_core "bonanza.build/pkg/model/core"
+	"bonanza.build/pkg/model/evaluation"
+	model_executewithstorage "bonanza.build/pkg/model/executewithstorage"
+	model_starlark "bonanza.build/pkg/model/starlark"
+	encryptedaction_pb "bonanza.build/pkg/proto/encryptedaction"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_core_pb "bonanza.build/pkg/proto/model/core"
+	model_fetch_pb "bonanza.build/pkg/proto/model/fetch"
+	model_starlark_pb "bonanza.build/pkg/proto/model/starlark"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// gitRepositoryRuleIdentifier is the identifier of the repository rule
+// that is used by git_override(), and may also be called into by
+// module extensions.
+const gitRepositoryRuleIdentifier = "@@bazel_tools+//tools/build_defs/repo:git.bzl%git_repository"
+
+// getNativeGitTarget converts the attributes of a repo that is declared
+// using git_repository() to a GitTarget that can be cloned by the
+// fetcher directly. If the repo uses features that the fetcher does not
+// support (e.g., patches or custom BUILD files), this function returns
+// nil, meaning that the repository rule needs to be invoked instead.
+func (c *baseComputer[TReference, TMetadata]) getNativeGitTarget(ctx context.Context, repo model_core.Message[*model_starlark_pb.Repo_Definition, TReference]) (*model_fetch_pb.GitTarget, error) {
+	var errIter error
+	attrValues := maps.Collect(
+		model_starlark.AllStructFields(
+			ctx,
+			c.valueReaders.List,
+			model_core.Nested(repo, repo.Message.AttrValues),
+			&errIter,
+		),
+	)
+	if errIter != nil {
+		return nil, errIter
+	}
+
+	var target model_fetch_pb.GitTarget
+	for name, value := range attrValues {
+		switch name {
+		case "remote", "commit", "tag", "strip_prefix":
+			str, ok := value.Message.Kind.(*model_starlark_pb.Value_Str)
+			if !ok {
+				return nil, fmt.Errorf("attribute %#v is not a string", name)
+			}
+			switch name {
+			case "remote":
+				target.Remote = str.Str
+			case "commit":
+				target.Commit = str.Str
+			case "tag":
+				target.Tag = str.Str
+			case "strip_prefix":
+				target.StripPrefix = str.Str
+			}
+		case "init_submodules", "recursive_init_submodules":
+			b, ok := value.Message.Kind.(*model_starlark_pb.Value_Bool)
+			if !ok {
+				return nil, fmt.Errorf("attribute %#v is not a bool", name)
+			}
+			if b.Bool {
+				target.InitSubmodules = true
+			}
+		case "patches", "patch_args", "patch_cmds", "patch_cmds_win":
+			// Empty lists of patches can be ignored.
+			list, ok := value.Message.Kind.(*model_starlark_pb.Value_List)
+			if !ok || len(list.List.Elements) > 0 {
+				return nil, nil
+			}
+		case "shallow_since", "verbose":
+			// Only affect how the repository is cloned, not
+			// the resulting contents.
+		default:
+			return nil, nil
+		}
+	}
+	if target.Remote == "" || (target.Commit == "") == (target.Tag == "") {
+		// Let the repository rule report the error, or
+		// handle the case where a branch is cloned.
+		return nil, nil
+	}
+	return &target, nil
+}
+
+// fetchRepoFromGit obtains the contents of a repo by letting the
+// fetcher clone the git repository.
+func (c *baseComputer[TReference, TMetadata]) fetchRepoFromGit(target *model_fetch_pb.GitTarget, e RepoEnvironment[TReference, TMetadata]) (PatchedRepoValue[TMetadata], error) {
+	gitRepositoryContentsValue := e.GetGitRepositoryContentsValue(&model_analysis_pb.GitRepositoryContents_Key{
+		Target: target,
+	})
+	if !gitRepositoryContentsValue.IsSet() {
+		return PatchedRepoValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+	rootDirectoryReference := model_core.Patch(e, model_core.Nested(gitRepositoryContentsValue, gitRepositoryContentsValue.Message.RootDirectoryReference))
+	if l := rootDirectoryReference.Message.GetMaximumSymlinkEscapementLevels(); l == nil || l.Value != 0 {
+		return PatchedRepoValue[TMetadata]{}, fmt.Errorf("git repository %#v contains one or more symbolic links that potentially escape the repository's root directory", target.Remote)
+	}
+	return model_core.NewPatchedMessage(
+		&model_analysis_pb.Repo_Value{
+			RootDirectoryReference: rootDirectoryReference.Message,
+		},
+		rootDirectoryReference.Patcher,
+	), nil
+}
+
+func (c *baseComputer[TReference, TMetadata]) ComputeGitRepositoryContentsValue(ctx context.Context, key *model_analysis_pb.GitRepositoryContents_Key, e GitRepositoryContentsEnvironment[TReference, TMetadata]) (PatchedGitRepositoryContentsValue[TMetadata], error) {
+	actionEncodersValue := e.GetActionEncodersValue(&model_analysis_pb.ActionEncoders_Key{})
+	actionEncoder, gotActionEncoder := e.GetActionEncoderObjectValue(&model_analysis_pb.ActionEncoderObject_Key{})
+	actionReaders, gotActionReaders := e.GetActionReadersValue(&model_analysis_pb.ActionReaders_Key{})
+	directoryCreationParametersValue := e.GetDirectoryCreationParametersValue(&model_analysis_pb.DirectoryCreationParameters_Key{})
+	fileCreationParametersValue := e.GetFileCreationParametersValue(&model_analysis_pb.FileCreationParameters_Key{})
+	registeredFetchPlatformValue := e.GetRegisteredFetchPlatformValue(&model_analysis_pb.RegisteredFetchPlatform_Key{})
+	if !actionEncodersValue.IsSet() ||
+		!gotActionEncoder ||
+		!gotActionReaders ||
+		!directoryCreationParametersValue.IsSet() ||
+		!fileCreationParametersValue.IsSet() ||
+		!registeredFetchPlatformValue.IsSet() {
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+
+	fetchPlatformECDHPublicKey, err := crypto.ParsePKIXECDHPublicKey(registeredFetchPlatformValue.Message.FetchPlatformPkixPublicKey)
+	if err != nil {
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, fmt.Errorf("invalid fetch platform PKIX public key: %w", err)
+	}
+
+	if key.Target == nil {
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, errors.New("no target provided")
+	}
+
+	referenceFormat := c.referenceFormat
+	createdAction, err := model_core.MarshalAndEncode(
+		model_core.NewSimplePatchedMessage[TMetadata](
+			model_core.NewProtoMarshalable(&model_fetch_pb.Action{
+				FileCreationParameters:      fileCreationParametersValue.Message.FileCreationParameters,
+				GitTarget:                   key.Target,
+				DirectoryCreationParameters: directoryCreationParametersValue.Message.DirectoryCreationParameters,
+			}),
+		),
+		referenceFormat,
+		actionEncoder,
+	)
+	if err != nil {
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, err
+	}
+	capturedAction, err := createdAction.Value.Capture(ctx, e)
+	if err != nil {
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, err
+	}
+
+	var resultReference model_core.Decodable[TReference]
+	var errExecution error
+	for range c.executionClient.RunAction(
+		ctx,
+		fetchPlatformECDHPublicKey,
+		&model_executewithstorage.Action[TReference]{
+			Reference: model_core.CopyDecodable(
+				createdAction,
+				e.ReferenceObject(capturedAction),
+			),
+			Encoders: actionEncodersValue.Message.ActionEncoders,
+			Format: &model_core_pb.ObjectFormat{
+				Format: &model_core_pb.ObjectFormat_ProtoTypeName{
+					ProtoTypeName: "bonanza.model.fetch.Action",
+				},
+			},
+		},
+		&encryptedaction_pb.Action_AdditionalData{
+			ExecutionTimeout: &durationpb.Duration{Seconds: 3600},
+		},
+		c.executionPriority,
+		&resultReference,
+		&errExecution,
+	) {
+		// TODO: Capture and propagate execution events?
+	}
+	if errExecution != nil {
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, errExecution
+	}
+
+	result, err := actionReaders.FetchResult.ReadParsedObject(ctx, resultReference)
+	if err != nil {
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, fmt.Errorf("failed to read completion event: %w", err)
+	}
+
+	switch outcome := result.Message.Outcome.(type) {
+	case *model_fetch_pb.Result_GitSuccess_:
+		rootDirectoryReference := model_core.Patch(e, model_core.Nested(result, outcome.GitSuccess.RootDirectoryReference))
+		return model_core.NewPatchedMessage(
+			&model_analysis_pb.GitRepositoryContents_Value{
+				RootDirectoryReference: rootDirectoryReference.Message,
+				Commit:                 outcome.GitSuccess.Commit,
+			},
+			rootDirectoryReference.Patcher,
+		), nil
+	case *model_fetch_pb.Result_Failure:
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, fmt.Errorf("failed to clone git repository: %w", status.ErrorProto(outcome.Failure))
+	default:
+		return PatchedGitRepositoryContentsValue[TMetadata]{}, errors.New("unknown fetch result type")
+	}
+}
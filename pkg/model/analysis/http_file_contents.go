@@ -81,6 +81,7 @@ func (c *baseComputer[TReference, TMetadata]) ComputeHttpFileContentsValue(ctx c
 		&encryptedaction_pb.Action_AdditionalData{
 			ExecutionTimeout: &durationpb.Duration{Seconds: 3600},
 		},
+		c.executionPriority,
 		&resultReference,
 		&errExecution,
 	) {
@@ -0,0 +1,358 @@
+package analysis
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"bonanza.build/pkg/label"
+	model_core "bonanza.build/pkg/model/core"
+	"bonanza.build/pkg/model/evaluation"
+	model_filesystem "bonanza.build/pkg/model/filesystem"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_fetch_pb "bonanza.build/pkg/proto/model/fetch"
+	"bonanza.build/pkg/storage/object"
+)
+
+const moduleLockfileFilename = "MODULE.bazel.lock"
+
+// moduleLockfileVersion is the version of the lockfile format that is
+// used when creating a new lockfile. The version of existing lockfiles
+// is left intact, as fields other than "registryFileHashes" are never
+// modified.
+const moduleLockfileVersion = 18
+
+// registryFileNotFound is the value that the lockfile stores in
+// "registryFileHashes" for files that are not present in a registry.
+const registryFileNotFound = "not found"
+
+// moduleLockfileJSON corresponds to the parts of the format of
+// MODULE.bazel.lock files that are interpreted.
+//
+// Entries in "moduleExtensions" are not interpreted, as the digests
+// and inputs that Bazel records in them cannot be reproduced. They are
+// preserved as is when the lockfile is updated.
+type moduleLockfileJSON struct {
+	RegistryFileHashes map[string]string `json:"registryFileHashes"`
+}
+
+// moduleLockfileField is a top-level field of a MODULE.bazel.lock
+// file, containing its value in undecoded form.
+type moduleLockfileField struct {
+	name  string
+	value json.RawMessage
+}
+
+// parseModuleLockfileFields splits the contents of a MODULE.bazel.lock
+// file into its top-level fields, retaining the order in which they
+// are stored. This allows updated lockfiles to be written in a way
+// that matches the original as closely as possible.
+func parseModuleLockfileFields(data []byte) ([]moduleLockfileField, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil {
+		return nil, err
+	} else if token != json.Delim('{') {
+		return nil, errors.New("lockfile does not contain a JSON object")
+	}
+	var fields []moduleLockfileField
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, ok := token.(string)
+		if !ok {
+			return nil, errors.New("lockfile contains a field with a non-string name")
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("invalid value for field %#v: %w", name, err)
+		}
+		fields = append(fields, moduleLockfileField{name: name, value: value})
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// marshalModuleLockfileFields converts the top-level fields of a
+// MODULE.bazel.lock file back to JSON, using the same indentation as
+// Bazel.
+func marshalModuleLockfileFields(fields []moduleLockfileField) ([]byte, error) {
+	var compact bytes.Buffer
+	compact.WriteByte('{')
+	for i, field := range fields {
+		if i > 0 {
+			compact.WriteByte(',')
+		}
+		name, err := json.Marshal(field.name)
+		if err != nil {
+			return nil, err
+		}
+		compact.Write(name)
+		compact.WriteByte(':')
+		if err := json.Compact(&compact, field.value); err != nil {
+			return nil, fmt.Errorf("invalid value for field %#v: %w", field.name, err)
+		}
+	}
+	compact.WriteByte('}')
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, compact.Bytes(), "", "  "); err != nil {
+		return nil, err
+	}
+	indented.WriteByte('\n')
+	return indented.Bytes(), nil
+}
+
+type readModuleLockfileEnvironment[TReference object.BasicReference] interface {
+	GetFilePropertiesValue(key *model_analysis_pb.FileProperties_Key) model_core.Message[*model_analysis_pb.FileProperties_Value, TReference]
+	GetFileReaderValue(key *model_analysis_pb.FileReader_Key) (*model_filesystem.FileReader[TReference], bool)
+	GetRootModuleValue(key *model_analysis_pb.RootModule_Key) model_core.Message[*model_analysis_pb.RootModule_Value, TReference]
+}
+
+// readModuleLockfile reads the contents of the MODULE.bazel.lock file
+// that is stored in the root module. If no such file exists, nil is
+// returned.
+func readModuleLockfile[TReference object.BasicReference](ctx context.Context, e readModuleLockfileEnvironment[TReference]) ([]byte, error) {
+	rootModuleValue := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
+	fileReader, gotFileReader := e.GetFileReaderValue(&model_analysis_pb.FileReader_Key{})
+	if !rootModuleValue.IsSet() || !gotFileReader {
+		return nil, evaluation.ErrMissingDependency
+	}
+	rootModuleName, err := label.NewModule(rootModuleValue.Message.RootModuleName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root module name %#v: %w", rootModuleValue.Message.RootModuleName, err)
+	}
+
+	lockfileProperties := e.GetFilePropertiesValue(&model_analysis_pb.FileProperties_Key{
+		CanonicalRepo: rootModuleName.ToModuleInstance(nil).String(),
+		Path:          moduleLockfileFilename,
+	})
+	if !lockfileProperties.IsSet() {
+		return nil, evaluation.ErrMissingDependency
+	}
+	if lockfileProperties.Message.Exists == nil {
+		return nil, nil
+	}
+
+	lockfileContentsEntry, err := model_filesystem.NewFileContentsEntryFromProto(
+		model_core.Nested(lockfileProperties, lockfileProperties.Message.Exists.Contents),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file contents entry for file %#v: %w", moduleLockfileFilename, err)
+	}
+	return fileReader.FileReadAll(ctx, lockfileContentsEntry, 16<<20)
+}
+
+func (c *baseComputer[TReference, TMetadata]) ComputeModuleLockfileValue(ctx context.Context, key *model_analysis_pb.ModuleLockfile_Key, e ModuleLockfileEnvironment[TReference, TMetadata]) (PatchedModuleLockfileValue[TMetadata], error) {
+	buildSpecification := e.GetBuildSpecificationValue(&model_analysis_pb.BuildSpecification_Key{})
+	if !buildSpecification.IsSet() {
+		return PatchedModuleLockfileValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+	useLockfile := buildSpecification.Message.UseLockfile
+	if useLockfile == nil {
+		return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.ModuleLockfile_Value{}), nil
+	}
+
+	data, err := readModuleLockfile(ctx, e)
+	if err != nil {
+		return PatchedModuleLockfileValue[TMetadata]{}, err
+	}
+	var lockfile moduleLockfileJSON
+	if data != nil {
+		if err := json.Unmarshal(data, &lockfile); err != nil {
+			return PatchedModuleLockfileValue[TMetadata]{}, fmt.Errorf("invalid JSON contents for %#v: %w", moduleLockfileFilename, err)
+		}
+	}
+
+	registryFileHashes := make([]*model_analysis_pb.RegistryFileHash, 0, len(lockfile.RegistryFileHashes))
+	for _, fileURL := range slices.Sorted(maps.Keys(lockfile.RegistryFileHashes)) {
+		registryFileHash := &model_analysis_pb.RegistryFileHash{Url: fileURL}
+		if hash := lockfile.RegistryFileHashes[fileURL]; hash != registryFileNotFound {
+			registryFileHash.Sha256, err = hex.DecodeString(hash)
+			if err != nil {
+				return PatchedModuleLockfileValue[TMetadata]{}, fmt.Errorf("invalid hash %#v for registry file %#v: %w", hash, fileURL, err)
+			}
+		}
+		registryFileHashes = append(registryFileHashes, registryFileHash)
+	}
+
+	return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.ModuleLockfile_Value{
+		UseLockfile:        useLockfile,
+		RegistryFileHashes: registryFileHashes,
+	}), nil
+}
+
+type fetchRegistryFileEnvironment[TReference any] interface {
+	GetHttpFileContentsValue(key *model_analysis_pb.HttpFileContents_Key) model_core.Message[*model_analysis_pb.HttpFileContents_Value, TReference]
+}
+
+// fetchRegistryFile downloads a file from a module registry. If the
+// lockfile contains a hash of the file, it is used to validate the
+// contents of the file. Files that the lockfile records as being
+// absent are not downloaded, unless mutable information needs to be
+// refreshed.
+func fetchRegistryFile[TReference any](e fetchRegistryFileEnvironment[TReference], lockfile *model_analysis_pb.ModuleLockfile_Value, fileURL string) (model_core.Message[*model_analysis_pb.HttpFileContents_Value, TReference], error) {
+	target := &model_fetch_pb.Target{
+		Urls: []string{fileURL},
+	}
+	if useLockfile := lockfile.UseLockfile; useLockfile != nil {
+		registryFileHashes := lockfile.RegistryFileHashes
+		if i, ok := slices.BinarySearchFunc(
+			registryFileHashes,
+			fileURL,
+			func(registryFileHash *model_analysis_pb.RegistryFileHash, fileURL string) int {
+				return strings.Compare(registryFileHash.Url, fileURL)
+			},
+		); ok {
+			if hash := registryFileHashes[i].Sha256; len(hash) > 0 {
+				target.Integrity = &model_fetch_pb.SubresourceIntegrity{
+					HashAlgorithm: model_fetch_pb.SubresourceIntegrity_SHA256,
+					Hash:          hash,
+				}
+			} else if useLockfile.MaximumCacheDuration == nil {
+				// The lockfile does not record when the
+				// absence of the file was observed.
+				// Only check again if refreshing is
+				// permitted.
+				return model_core.NewSimpleMessage[TReference](&model_analysis_pb.HttpFileContents_Value{}), nil
+			}
+		} else if useLockfile.Error {
+			return model_core.Message[*model_analysis_pb.HttpFileContents_Value, TReference]{}, fmt.Errorf("lockfile is out of date, as it does not contain a hash for registry file %#v", fileURL)
+		}
+	}
+
+	httpFileContents := e.GetHttpFileContentsValue(&model_analysis_pb.HttpFileContents_Key{
+		FetchOptions: &model_analysis_pb.HttpFetchOptions{
+			Target: target,
+		},
+	})
+	if !httpFileContents.IsSet() {
+		return model_core.Message[*model_analysis_pb.HttpFileContents_Value, TReference]{}, evaluation.ErrMissingDependency
+	}
+	return httpFileContents, nil
+}
+
+// formatRegistryFileHash converts the hash of a registry file to the
+// format used by "registryFileHashes" in the lockfile.
+func formatRegistryFileHash(hash []byte) string {
+	if len(hash) == 0 {
+		return registryFileNotFound
+	}
+	return hex.EncodeToString(hash)
+}
+
+func (c *baseComputer[TReference, TMetadata]) ComputeUpdatedModuleLockfileValue(ctx context.Context, key *model_analysis_pb.UpdatedModuleLockfile_Key, e UpdatedModuleLockfileEnvironment[TReference, TMetadata]) (PatchedUpdatedModuleLockfileValue[TMetadata], error) {
+	moduleLockfileValue := e.GetModuleLockfileValue(&model_analysis_pb.ModuleLockfile_Key{})
+	if !moduleLockfileValue.IsSet() {
+		return PatchedUpdatedModuleLockfileValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+	moduleLockfile := moduleLockfileValue.Message
+	if useLockfile := moduleLockfile.UseLockfile; useLockfile == nil || useLockfile.Error {
+		// The lockfile is not in use, or may not be modified.
+		return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.UpdatedModuleLockfile_Value{}), nil
+	}
+
+	roughBuildListValue := e.GetModuleRoughBuildListValue(&model_analysis_pb.ModuleRoughBuildList_Key{})
+	finalBuildListValue := e.GetModuleFinalBuildListValue(&model_analysis_pb.ModuleFinalBuildList_Key{})
+	fileReader, gotFileReader := e.GetFileReaderValue(&model_analysis_pb.FileReader_Key{})
+	if !roughBuildListValue.IsSet() || !finalBuildListValue.IsSet() || !gotFileReader {
+		return PatchedUpdatedModuleLockfileValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+
+	// Hashes of MODULE.bazel files have already been computed as
+	// part of computing the build list. Hashes of source.json files
+	// of modules that are part of the final build list need to be
+	// computed separately.
+	registryFileHashes := map[string]string{}
+	for _, registryFileHash := range roughBuildListValue.Message.RegistryFileHashes {
+		registryFileHashes[registryFileHash.Url] = formatRegistryFileHash(registryFileHash.Sha256)
+	}
+	missingDependencies := false
+	for _, module := range finalBuildListValue.Message.BuildList {
+		sourceJSONURL, err := getSourceJSONURL(module)
+		if err != nil {
+			return PatchedUpdatedModuleLockfileValue[TMetadata]{}, fmt.Errorf("failed to construct URL for module %s with version %s in registry %#v: %w", module.Name, module.Version, module.RegistryUrl, err)
+		}
+		sourceJSONContentsValue, err := fetchRegistryFile(e, moduleLockfile, sourceJSONURL)
+		if err != nil {
+			if errors.Is(err, evaluation.ErrMissingDependency) {
+				missingDependencies = true
+				continue
+			}
+			return PatchedUpdatedModuleLockfileValue[TMetadata]{}, err
+		}
+		if sourceJSONContentsValue.Message.Exists == nil {
+			registryFileHashes[sourceJSONURL] = registryFileNotFound
+			continue
+		}
+		sourceJSONContentsEntry, err := model_filesystem.NewFileContentsEntryFromProto(
+			model_core.Nested(sourceJSONContentsValue, sourceJSONContentsValue.Message.Exists.Contents),
+		)
+		if err != nil {
+			return PatchedUpdatedModuleLockfileValue[TMetadata]{}, fmt.Errorf("invalid file contents: %w", err)
+		}
+		sourceJSONData, err := fileReader.FileReadAll(ctx, sourceJSONContentsEntry, 1<<20)
+		if err != nil {
+			return PatchedUpdatedModuleLockfileValue[TMetadata]{}, err
+		}
+		hash := sha256.Sum256(sourceJSONData)
+		registryFileHashes[sourceJSONURL] = formatRegistryFileHash(hash[:])
+	}
+	if missingDependencies {
+		return PatchedUpdatedModuleLockfileValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+
+	registryFileHashesData, err := json.Marshal(registryFileHashes)
+	if err != nil {
+		return PatchedUpdatedModuleLockfileValue[TMetadata]{}, err
+	}
+
+	// Preserve all other fields of the existing lockfile, so that it
+	// can be shared with Bazel. Fields such as "moduleExtensions"
+	// contain information that only Bazel is capable of computing.
+	existingData, err := readModuleLockfile(ctx, e)
+	if err != nil {
+		return PatchedUpdatedModuleLockfileValue[TMetadata]{}, err
+	}
+	var lockfileFields []moduleLockfileField
+	if existingData == nil {
+		lockfileFields = []moduleLockfileField{
+			{name: "lockFileVersion", value: json.RawMessage(fmt.Sprintf("%d", moduleLockfileVersion))},
+			{name: "registryFileHashes", value: registryFileHashesData},
+			{name: "selectedYankedVersions", value: json.RawMessage("{}")},
+			{name: "moduleExtensions", value: json.RawMessage("{}")},
+		}
+	} else {
+		lockfileFields, err = parseModuleLockfileFields(existingData)
+		if err != nil {
+			return PatchedUpdatedModuleLockfileValue[TMetadata]{}, fmt.Errorf("invalid JSON contents for %#v: %w", moduleLockfileFilename, err)
+		}
+		if i := slices.IndexFunc(lockfileFields, func(field moduleLockfileField) bool {
+			return field.name == "registryFileHashes"
+		}); i >= 0 {
+			lockfileFields[i].value = registryFileHashesData
+		} else {
+			lockfileFields = append(lockfileFields, moduleLockfileField{name: "registryFileHashes", value: registryFileHashesData})
+		}
+	}
+
+	contents, err := marshalModuleLockfileFields(lockfileFields)
+	if err != nil {
+		return PatchedUpdatedModuleLockfileValue[TMetadata]{}, err
+	}
+	return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.UpdatedModuleLockfile_Value{
+		Contents: contents,
+	}), nil
+}
+
+type UpdatedModuleLockfileEnvironmentForTesting UpdatedModuleLockfileEnvironment[model_core.CreatedObjectTree, model_core.CreatedObjectTree]
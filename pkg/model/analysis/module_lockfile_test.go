@@ -0,0 +1,193 @@
+package analysis_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	model_core "bonanza.build/pkg/model/core"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_filesystem_pb "bonanza.build/pkg/proto/model/filesystem"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+// bazelModuleLockfile is an example MODULE.bazel.lock file, as written
+// by Bazel. It contains fields that bonanza_bazel does not interpret,
+// and should therefore be preserved when the lockfile is updated.
+const bazelModuleLockfile = `{
+  "lockFileVersion": 18,
+  "registryFileHashes": {
+    "https://bcr.bazel.build/modules/platforms/0.0.10/MODULE.bazel": "8cb8efaf200bdeb2150d93e162c40f388529a25852b332cec879373771e48ed5",
+    "https://bcr.bazel.build/modules/rules_go/0.50.1/MODULE.bazel": "b91a308dc5782bb0a8021ad4330c81fea5bda77f96b9e4c117b9b9c8f6665ee0"
+  },
+  "selectedYankedVersions": {},
+  "moduleExtensions": {
+    "@@rules_go+//go:extensions.bzl%go_sdk": {
+      "general": {
+        "bzlTransitiveDigest": "vfnWbCBpgFJsHljsA7cBRhCqD0Bf2Hz2/9hnGsu4Jzs\u003d",
+        "usagesDigest": "MQtmUkMxEGTgsKP2J1r4jFOiRbcmwTAaJdCFdYsw2Sg\u003d",
+        "recordedFileInputs": {},
+        "recordedDirentsInputs": {},
+        "envVariables": {},
+        "generatedRepoSpecs": {
+          "go_default_sdk": {
+            "repoRuleId": "@@rules_go+//go/private:sdk.bzl%go_download_sdk_rule",
+            "attributes": {
+              "goos": "",
+              "goarch": "",
+              "sdks": {},
+              "experiments": [],
+              "patches": [],
+              "patch_strip": 0,
+              "urls": [
+                "https://dl.google.com/go/{}"
+              ],
+              "version": "1.23.1",
+              "strip_prefix": "go"
+            }
+          }
+        },
+        "recordedRepoMappingEntries": [
+          [
+            "rules_go+",
+            "bazel_tools",
+            "bazel_tools"
+          ]
+        ]
+      }
+    }
+  }
+}
+`
+
+func TestUpdatedModuleLockfile(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(t.Context(), t)
+	bct := newBaseComputerTester(ctrl)
+
+	registryFileHash := func(url, hash string) *model_analysis_pb.RegistryFileHash {
+		return &model_analysis_pb.RegistryFileHash{
+			Url:    url,
+			Sha256: util.Must(hex.DecodeString(hash)),
+		}
+	}
+
+	// expectGetModuleLockfile sets expectations on the
+	// environment that are needed to compute the updated lockfile,
+	// given the existing lockfile and the hashes of MODULE.bazel
+	// files that were obtained while computing the build list.
+	expectGetModuleLockfile := func(t *testing.T, e *MockUpdatedModuleLockfileEnvironmentForTesting, existingContents string, registryFileHashes []*model_analysis_pb.RegistryFileHash) {
+		e.EXPECT().GetModuleLockfileValue(
+			testutil.EqProto(t, &model_analysis_pb.ModuleLockfile_Key{}),
+		).Return(model_core.NewSimpleMessage[model_core.CreatedObjectTree](&model_analysis_pb.ModuleLockfile_Value{
+			UseLockfile: &model_analysis_pb.BuildSpecification_Value_UseLockfile{},
+		}))
+		e.EXPECT().GetModuleRoughBuildListValue(
+			testutil.EqProto(t, &model_analysis_pb.ModuleRoughBuildList_Key{}),
+		).Return(model_core.NewSimpleMessage[model_core.CreatedObjectTree](&model_analysis_pb.ModuleRoughBuildList_Value{
+			RegistryFileHashes: registryFileHashes,
+		}))
+		e.EXPECT().GetModuleFinalBuildListValue(
+			testutil.EqProto(t, &model_analysis_pb.ModuleFinalBuildList_Key{}),
+		).Return(model_core.NewSimpleMessage[model_core.CreatedObjectTree](&model_analysis_pb.ModuleFinalBuildList_Value{}))
+		e.EXPECT().GetFileReaderValue(
+			testutil.EqProto(t, &model_analysis_pb.FileReader_Key{}),
+		).Return(bct.newFileReader(), true).AnyTimes()
+		e.EXPECT().GetRootModuleValue(
+			testutil.EqProto(t, &model_analysis_pb.RootModule_Key{}),
+		).Return(model_core.NewSimpleMessage[model_core.CreatedObjectTree](&model_analysis_pb.RootModule_Value{
+			RootModuleName: "mymodule",
+		}))
+		e.EXPECT().GetFilePropertiesValue(
+			testutil.EqProto(t, &model_analysis_pb.FileProperties_Key{
+				CanonicalRepo: "mymodule+",
+				Path:          "MODULE.bazel.lock",
+			}),
+		).Return(newMessage(func(patcher *model_core.ReferenceMessagePatcher[model_core.CreatedObjectTree]) *model_analysis_pb.FileProperties_Value {
+			if existingContents == "" {
+				return &model_analysis_pb.FileProperties_Value{}
+			}
+			return &model_analysis_pb.FileProperties_Value{
+				Exists: &model_filesystem_pb.FileProperties{
+					Contents: &model_filesystem_pb.FileContents{
+						Level: &model_filesystem_pb.FileContents_ChunkReference{
+							ChunkReference: attachObject(patcher, newObject(func(patcher *model_core.ReferenceMessagePatcher[model_core.CreatedObjectTree]) model_core.Marshalable {
+								return model_core.NewRawMarshalable([]byte(existingContents))
+							})),
+						},
+						TotalSizeBytes: uint64(len(existingContents)),
+					},
+				},
+			}
+		}))
+	}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		// If the hashes of registry files did not change, the
+		// lockfile written by Bazel should be reproduced
+		// exactly. In particular, the entries of module
+		// extensions and the version of the lockfile should be
+		// left intact.
+		e := NewMockUpdatedModuleLockfileEnvironmentForTesting(ctrl)
+		expectGetModuleLockfile(t, e, bazelModuleLockfile, []*model_analysis_pb.RegistryFileHash{
+			registryFileHash("https://bcr.bazel.build/modules/platforms/0.0.10/MODULE.bazel", "8cb8efaf200bdeb2150d93e162c40f388529a25852b332cec879373771e48ed5"),
+			registryFileHash("https://bcr.bazel.build/modules/rules_go/0.50.1/MODULE.bazel", "b91a308dc5782bb0a8021ad4330c81fea5bda77f96b9e4c117b9b9c8f6665ee0"),
+		})
+
+		updatedModuleLockfile, err := bct.computer.ComputeUpdatedModuleLockfileValue(ctx, &model_analysis_pb.UpdatedModuleLockfile_Key{}, e)
+		require.NoError(t, err)
+		require.Equal(t, bazelModuleLockfile, string(updatedModuleLockfile.Message.Contents))
+	})
+
+	t.Run("RegistryFileHashesChanged", func(t *testing.T) {
+		// Only "registryFileHashes" should be replaced. All other
+		// fields should remain untouched.
+		e := NewMockUpdatedModuleLockfileEnvironmentForTesting(ctrl)
+		expectGetModuleLockfile(t, e, bazelModuleLockfile, []*model_analysis_pb.RegistryFileHash{
+			registryFileHash("https://bcr.bazel.build/modules/platforms/0.0.11/MODULE.bazel", "be4d1a4bb3a25a33dd3a0c8f2f5e5ae0e3d8f8e2e7cc3ac4a0b4c8a6a2b1c8d9"),
+			{Url: "https://bcr.bazel.build/modules/rules_go/0.50.1/source.json"},
+		})
+
+		updatedModuleLockfile, err := bct.computer.ComputeUpdatedModuleLockfileValue(ctx, &model_analysis_pb.UpdatedModuleLockfile_Key{}, e)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			strings.Replace(
+				bazelModuleLockfile,
+				`    "https://bcr.bazel.build/modules/platforms/0.0.10/MODULE.bazel": "8cb8efaf200bdeb2150d93e162c40f388529a25852b332cec879373771e48ed5",
+    "https://bcr.bazel.build/modules/rules_go/0.50.1/MODULE.bazel": "b91a308dc5782bb0a8021ad4330c81fea5bda77f96b9e4c117b9b9c8f6665ee0"
+`,
+				`    "https://bcr.bazel.build/modules/platforms/0.0.11/MODULE.bazel": "be4d1a4bb3a25a33dd3a0c8f2f5e5ae0e3d8f8e2e7cc3ac4a0b4c8a6a2b1c8d9",
+    "https://bcr.bazel.build/modules/rules_go/0.50.1/source.json": "not found"
+`,
+				1,
+			),
+			string(updatedModuleLockfile.Message.Contents),
+		)
+	})
+
+	t.Run("NoExistingLockfile", func(t *testing.T) {
+		// If no lockfile exists, a new one should be created
+		// that contains all fields that Bazel requires.
+		e := NewMockUpdatedModuleLockfileEnvironmentForTesting(ctrl)
+		expectGetModuleLockfile(t, e, "", []*model_analysis_pb.RegistryFileHash{
+			registryFileHash("https://bcr.bazel.build/modules/platforms/0.0.10/MODULE.bazel", "8cb8efaf200bdeb2150d93e162c40f388529a25852b332cec879373771e48ed5"),
+		})
+
+		updatedModuleLockfile, err := bct.computer.ComputeUpdatedModuleLockfileValue(ctx, &model_analysis_pb.UpdatedModuleLockfile_Key{}, e)
+		require.NoError(t, err)
+		require.Equal(t, `{
+  "lockFileVersion": 18,
+  "registryFileHashes": {
+    "https://bcr.bazel.build/modules/platforms/0.0.10/MODULE.bazel": "8cb8efaf200bdeb2150d93e162c40f388529a25852b332cec879373771e48ed5"
+  },
+  "selectedYankedVersions": {},
+  "moduleExtensions": {}
+}
+`, string(updatedModuleLockfile.Message.Contents))
+	})
+}
@@ -2,10 +2,13 @@ package analysis
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"sort"
+	"strings"
 
 	"bonanza.build/pkg/ds"
 	"bonanza.build/pkg/label"
@@ -13,7 +16,6 @@ import (
 	"bonanza.build/pkg/model/evaluation"
 	model_filesystem "bonanza.build/pkg/model/filesystem"
 	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
-	model_fetch_pb "bonanza.build/pkg/proto/model/fetch"
 	model_filesystem_pb "bonanza.build/pkg/proto/model/filesystem"
 	pg_starlark "bonanza.build/pkg/starlark"
 
@@ -139,8 +141,9 @@ func (c *baseComputer[TReference, TMetadata]) ComputeModuleRoughBuildListValue(c
 	rootModuleValue := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
 	modulesWithOverridesValue := e.GetModulesWithOverridesValue(&model_analysis_pb.ModulesWithOverrides_Key{})
 	registryURLsValue := e.GetModuleRegistryUrlsValue(&model_analysis_pb.ModuleRegistryUrls_Key{})
+	moduleLockfileValue := e.GetModuleLockfileValue(&model_analysis_pb.ModuleLockfile_Key{})
 	fileReader, gotFileReader := e.GetFileReaderValue(&model_analysis_pb.FileReader_Key{})
-	if !rootModuleValue.IsSet() || !modulesWithOverridesValue.IsSet() || !registryURLsValue.IsSet() || !gotFileReader {
+	if !rootModuleValue.IsSet() || !modulesWithOverridesValue.IsSet() || !registryURLsValue.IsSet() || !moduleLockfileValue.IsSet() || !gotFileReader {
 		return PatchedModuleRoughBuildListValue[TMetadata]{}, evaluation.ErrMissingDependency
 	}
 
@@ -168,6 +171,7 @@ func (c *baseComputer[TReference, TMetadata]) ComputeModuleRoughBuildListValue(c
 	missingDependencies := false
 	registryURLs := registryURLsValue.Message.RegistryUrls
 	var buildList roughBuildList
+	var registryFileHashes []*model_analysis_pb.RegistryFileHash
 
 ProcessModule:
 	for len(modulesToCheck) > 0 {
@@ -175,6 +179,7 @@ ProcessModule:
 		modulesToCheck = modulesToCheck[1:]
 		var moduleFileContents model_core.Message[*model_filesystem_pb.FileContents, TReference]
 		var buildListEntry *model_analysis_pb.BuildListModule
+		var moduleFileURL string
 		if versions, ok := modulesWithOverrides[module.name]; ok {
 			// An override for the module exists. This means
 			// that we can access its sources directly and
@@ -200,24 +205,27 @@ ProcessModule:
 			// yet, as there is no guarantee that this is
 			// the definitive version to load.
 			for _, registryURL := range registryURLs {
-				moduleFileURL, err := getModuleDotBazelURL(registryURL, module.name, module.version)
+				var err error
+				moduleFileURL, err = getModuleDotBazelURL(registryURL, module.name, module.version)
 				if err != nil {
 					return PatchedModuleRoughBuildListValue[TMetadata]{}, fmt.Errorf("failed to construct URL for module %s with version %s in registry %#v: %w", module.name, module.version, registryURL, err)
 				}
-				httpFileContents := e.GetHttpFileContentsValue(
-					&model_analysis_pb.HttpFileContents_Key{
-						FetchOptions: &model_analysis_pb.HttpFetchOptions{
-							Target: &model_fetch_pb.Target{
-								Urls: []string{moduleFileURL},
-							},
-						},
-					},
-				)
-				if !httpFileContents.IsSet() {
-					missingDependencies = true
-					continue ProcessModule
+				httpFileContents, err := fetchRegistryFile(e, moduleLockfileValue.Message, moduleFileURL)
+				if err != nil {
+					if errors.Is(err, evaluation.ErrMissingDependency) {
+						missingDependencies = true
+						continue ProcessModule
+					}
+					return PatchedModuleRoughBuildListValue[TMetadata]{}, err
 				}
-				if httpFileContents.Message.Exists != nil {
+				if httpFileContents.Message.Exists == nil {
+					// Record the absence of the file, so
+					// that the lockfile can prevent
+					// subsequent lookups.
+					registryFileHashes = append(registryFileHashes, &model_analysis_pb.RegistryFileHash{
+						Url: moduleFileURL,
+					})
+				} else {
 					moduleFileContents = model_core.Nested(httpFileContents, httpFileContents.Message.Exists.Contents)
 					buildListEntry = &model_analysis_pb.BuildListModule{
 						Name:        module.name.String(),
@@ -266,6 +274,12 @@ ProcessModule:
 		if buildListEntry != nil {
 			buildListEntry.CompatibilityLevel = int32(handler.compatibilityLevel)
 			buildList.Slice = append(buildList.Slice, buildListEntry)
+
+			moduleFileHash := sha256.Sum256(moduleFileData)
+			registryFileHashes = append(registryFileHashes, &model_analysis_pb.RegistryFileHash{
+				Url:    moduleFileURL,
+				Sha256: moduleFileHash[:],
+			})
 		}
 
 		for dependencyName, dependencyVersion := range handler.dependencies {
@@ -299,7 +313,11 @@ ProcessModule:
 	}
 
 	sort.Sort(buildList)
+	slices.SortFunc(registryFileHashes, func(a, b *model_analysis_pb.RegistryFileHash) int {
+		return strings.Compare(a.Url, b.Url)
+	})
 	return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.ModuleRoughBuildList_Value{
-		BuildList: buildList.Slice,
+		BuildList:          buildList.Slice,
+		RegistryFileHashes: registryFileHashes,
 	}), nil
 }
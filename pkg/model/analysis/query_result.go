@@ -0,0 +1,733 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"bonanza.build/pkg/label"
+	model_core "bonanza.build/pkg/model/core"
+	"bonanza.build/pkg/model/evaluation"
+	model_starlark "bonanza.build/pkg/model/starlark"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_starlark_pb "bonanza.build/pkg/proto/model/starlark"
+	"bonanza.build/pkg/query"
+	"bonanza.build/pkg/storage/object"
+
+	"go.starlark.net/starlark"
+)
+
+// queryTargetSet is a set of targets, keyed by canonical label, that is
+// yielded by evaluating a query expression.
+type queryTargetSet map[string]struct{}
+
+// queryTarget contains the properties of a target that are needed to
+// evaluate query expressions and to display query results.
+type queryTarget struct {
+	kind         string
+	attributes   []*model_analysis_pb.QueryResult_Value_Attribute
+	dependencies []string
+}
+
+// queryEvaluatorEnvironment contains the functions that a
+// queryEvaluator needs to obtain the properties of targets. It is
+// shared by the functions that compute the results of "query",
+// "cquery" and "aquery".
+type queryEvaluatorEnvironment[TReference any] interface {
+	labelResolverEnvironment[TReference]
+	expandCanonicalTargetPatternEnvironment[TReference]
+
+	GetCompiledBzlFileGlobalValue(*model_analysis_pb.CompiledBzlFileGlobal_Key) model_core.Message[*model_analysis_pb.CompiledBzlFileGlobal_Value, TReference]
+	GetTargetValue(*model_analysis_pb.Target_Key) model_core.Message[*model_analysis_pb.Target_Value, TReference]
+}
+
+// queryEvaluator is capable of evaluating expressions written in the
+// Bazel query language against the unconfigured target graph.
+//
+// If properties of targets cannot be obtained due to missing
+// dependencies, evaluation continues with partial results. This
+// permits as many dependencies as possible to be requested in a single
+// pass. The final results are only valid if no dependencies were
+// missing.
+type queryEvaluator[TReference object.BasicReference, TMetadata BaseComputerReferenceMetadata] struct {
+	computer            *baseComputer[TReference, TMetadata]
+	context             context.Context
+	environment         queryEvaluatorEnvironment[TReference]
+	labelResolver       label.Resolver
+	rootPackage         label.CanonicalPackage
+	targets             map[string]*queryTarget
+	missingDependencies bool
+}
+
+// getTarget returns the properties of a target. If the properties
+// cannot be obtained due to missing dependencies, nil is returned.
+func (qe *queryEvaluator[TReference, TMetadata]) getTarget(targetLabel string) (*queryTarget, error) {
+	if target, ok := qe.targets[targetLabel]; ok {
+		return target, nil
+	}
+
+	e := qe.environment
+	targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+		Label: targetLabel,
+	})
+	if !targetValue.IsSet() {
+		qe.missingDependencies = true
+		return nil, nil
+	}
+	canonicalLabel, err := label.NewCanonicalLabel(targetLabel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target label %#v: %w", targetLabel, err)
+	}
+
+	dependencies := map[string]struct{}{}
+	var target *queryTarget
+	switch targetKind := targetValue.Message.Definition.GetKind().(type) {
+	case *model_starlark_pb.Target_Definition_Alias:
+		if err := qe.addSelectGroupDependencies(model_core.Nested(targetValue, targetKind.Alias.Actual), dependencies); err != nil {
+			return nil, fmt.Errorf("invalid actual target of alias %#v: %w", targetLabel, err)
+		}
+		actual, err := qe.formatSelectGroups(model_core.Nested(targetValue, []*model_starlark_pb.Select_Group{targetKind.Alias.Actual}))
+		if err != nil {
+			return nil, fmt.Errorf("invalid actual target of alias %#v: %w", targetLabel, err)
+		}
+		target = &queryTarget{
+			kind: "alias rule",
+			attributes: []*model_analysis_pb.QueryResult_Value_Attribute{{
+				Name:     "actual",
+				Value:    actual,
+				Explicit: true,
+			}},
+		}
+	case *model_starlark_pb.Target_Definition_LabelSetting:
+		kind := "label_setting rule"
+		if targetKind.LabelSetting.Flag {
+			kind = "label_flag rule"
+		}
+		target = &queryTarget{kind: kind}
+		if buildSettingDefault := targetKind.LabelSetting.BuildSettingDefault; buildSettingDefault != "" {
+			dependencies[buildSettingDefault] = struct{}{}
+			target.attributes = []*model_analysis_pb.QueryResult_Value_Attribute{{
+				Name:     "build_setting_default",
+				Value:    starlark.String(buildSettingDefault).String(),
+				Explicit: true,
+			}}
+		}
+	case *model_starlark_pb.Target_Definition_PackageGroup:
+		target = &queryTarget{kind: "package_group rule"}
+	case *model_starlark_pb.Target_Definition_PredeclaredOutputFileTarget:
+		ownerTargetName, err := label.NewTargetName(targetKind.PredeclaredOutputFileTarget.OwnerTargetName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid owner target name %#v: %w", targetKind.PredeclaredOutputFileTarget.OwnerTargetName, err)
+		}
+		dependencies[canonicalLabel.GetCanonicalPackage().AppendTargetName(ownerTargetName).String()] = struct{}{}
+		target = &queryTarget{kind: "generated file"}
+	case *model_starlark_pb.Target_Definition_RuleTarget:
+		target, err = qe.getRuleTarget(model_core.Nested(targetValue, targetKind.RuleTarget), dependencies)
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain properties of rule target %#v: %w", targetLabel, err)
+		}
+		if target == nil {
+			return nil, nil
+		}
+	case *model_starlark_pb.Target_Definition_SourceFileTarget:
+		target = &queryTarget{kind: "source file"}
+	default:
+		return nil, fmt.Errorf("target %#v has an unknown kind", targetLabel)
+	}
+
+	delete(dependencies, targetLabel)
+	target.dependencies = slices.Sorted(maps.Keys(dependencies))
+	qe.targets[targetLabel] = target
+	return target, nil
+}
+
+// getRuleTarget returns the properties of a rule target. Labels
+// contained in the values of the rule target's attrs are added to the
+// set of dependencies, regardless of whether they are part of a
+// select() expression. This matches the behavior of Bazel.
+func (qe *queryEvaluator[TReference, TMetadata]) getRuleTarget(ruleTarget model_core.Message[*model_starlark_pb.RuleTarget, TReference], dependencies map[string]struct{}) (*queryTarget, error) {
+	ruleIdentifierStr := ruleTarget.Message.RuleIdentifier
+	ruleIdentifier, err := label.NewCanonicalStarlarkIdentifier(ruleIdentifierStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule identifier %#v: %w", ruleIdentifierStr, err)
+	}
+	ruleValue := qe.environment.GetCompiledBzlFileGlobalValue(&model_analysis_pb.CompiledBzlFileGlobal_Key{
+		Identifier: ruleIdentifierStr,
+	})
+	if !ruleValue.IsSet() {
+		qe.missingDependencies = true
+		return nil, nil
+	}
+	rule, ok := ruleValue.Message.Global.GetKind().(*model_starlark_pb.Value_Rule)
+	if !ok {
+		return nil, fmt.Errorf("%#v is not a rule", ruleIdentifierStr)
+	}
+	ruleDefinition, ok := rule.Rule.Kind.(*model_starlark_pb.Rule_Definition_)
+	if !ok {
+		return nil, fmt.Errorf("%#v is not a rule definition", ruleIdentifierStr)
+	}
+
+	attributes := []*model_analysis_pb.QueryResult_Value_Attribute{{
+		Name:     "tags",
+		Value:    formatStringList(ruleTarget.Message.Tags),
+		Explicit: len(ruleTarget.Message.Tags) > 0,
+	}}
+	publicAttrValues := ruleTarget.Message.PublicAttrValues
+	for _, namedAttr := range ruleDefinition.Definition.Attrs {
+		var valueParts model_core.Message[[]*model_starlark_pb.Select_Group, TReference]
+		isPublic := !strings.HasPrefix(namedAttr.Name, "_")
+		if isPublic {
+			if len(publicAttrValues) == 0 {
+				return nil, errors.New("rule target has fewer public attr values than the rule definition has public attrs")
+			}
+			valueParts = model_core.Nested(ruleTarget, publicAttrValues[0].ValueParts)
+			publicAttrValues = publicAttrValues[1:]
+
+			// If the value is None, fall back to the default
+			// value from the rule definition.
+			if len(valueParts.Message) == 1 && len(valueParts.Message[0].Conditions) == 0 {
+				if noMatch, ok := valueParts.Message[0].NoMatch.(*model_starlark_pb.Select_Group_NoMatchValue); ok {
+					if _, ok := noMatch.NoMatchValue.Kind.(*model_starlark_pb.Value_None); ok {
+						valueParts.Message = nil
+					}
+				}
+			}
+		}
+
+		explicit := len(valueParts.Message) > 0
+		if !explicit {
+			defaultValue := namedAttr.Attr.GetDefault()
+			if defaultValue == nil {
+				continue
+			}
+			valueParts = model_core.Nested(ruleValue, []*model_starlark_pb.Select_Group{{
+				NoMatch: &model_starlark_pb.Select_Group_NoMatchValue{
+					NoMatchValue: defaultValue,
+				},
+			}})
+		}
+
+		switch namedAttr.Attr.GetType().(type) {
+		case *model_starlark_pb.Attr_Output, *model_starlark_pb.Attr_OutputList:
+			// Output files depend on the rule target, not
+			// the other way around.
+		default:
+			for _, selectGroup := range valueParts.Message {
+				if err := qe.addSelectGroupDependencies(model_core.Nested(valueParts, selectGroup), dependencies); err != nil {
+					return nil, fmt.Errorf("invalid value for attr %#v: %w", namedAttr.Name, err)
+				}
+			}
+		}
+
+		if isPublic {
+			value, err := qe.formatSelectGroups(valueParts)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for attr %#v: %w", namedAttr.Name, err)
+			}
+			attributes = append(attributes, &model_analysis_pb.QueryResult_Value_Attribute{
+				Name:     namedAttr.Name,
+				Value:    value,
+				Explicit: explicit,
+			})
+		}
+	}
+	if l := len(publicAttrValues); l != 0 {
+		return nil, fmt.Errorf("rule target has %d more public attr values than the rule definition has public attrs", l)
+	}
+
+	slices.SortFunc(attributes, func(a, b *model_analysis_pb.QueryResult_Value_Attribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return &queryTarget{
+		kind:       ruleIdentifier.GetStarlarkIdentifier().String() + " rule",
+		attributes: attributes,
+	}, nil
+}
+
+// decodeValue decodes a Starlark value that is part of a target's
+// definition. Labels are converted to strings, so that their
+// representation matches the one used in BUILD files. If a set of
+// dependencies is provided, all labels contained in the value are
+// added to it.
+func (qe *queryEvaluator[TReference, TMetadata]) decodeValue(encodedValue model_core.Message[*model_starlark_pb.Value, TReference], dependencies map[string]struct{}) (starlark.Value, error) {
+	return model_starlark.DecodeValue[TReference, TMetadata](
+		encodedValue,
+		/* currentIdentifier = */ nil,
+		qe.computer.getValueDecodingOptions(qe.context, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+			if dependencies != nil {
+				canonicalLabel, err := resolvedLabel.AsCanonical()
+				if err != nil {
+					return nil, err
+				}
+				dependencies[canonicalLabel.String()] = struct{}{}
+			}
+			return starlark.String(resolvedLabel.String()), nil
+		}),
+	)
+}
+
+// addSelectGroupDependencies adds the labels of all conditions and
+// values contained in a select() expression to a set of dependencies.
+func (qe *queryEvaluator[TReference, TMetadata]) addSelectGroupDependencies(selectGroup model_core.Message[*model_starlark_pb.Select_Group, TReference], dependencies map[string]struct{}) error {
+	for _, condition := range selectGroup.Message.Conditions {
+		dependencies[condition.ConditionIdentifier] = struct{}{}
+		if _, err := qe.decodeValue(model_core.Nested(selectGroup, condition.Value), dependencies); err != nil {
+			return err
+		}
+	}
+	if noMatch, ok := selectGroup.Message.NoMatch.(*model_starlark_pb.Select_Group_NoMatchValue); ok {
+		if _, err := qe.decodeValue(model_core.Nested(selectGroup, noMatch.NoMatchValue), dependencies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatSelectGroups converts the value of an attr to its Starlark
+// representation. Each select group is either written as a plain
+// value, or as a select() expression. Groups are concatenated using
+// the "+" operator.
+func (qe *queryEvaluator[TReference, TMetadata]) formatSelectGroups(selectGroups model_core.Message[[]*model_starlark_pb.Select_Group, TReference]) (string, error) {
+	parts := make([]string, 0, len(selectGroups.Message))
+	for _, selectGroup := range selectGroups.Message {
+		noMatchValue, hasNoMatchValue := selectGroup.NoMatch.(*model_starlark_pb.Select_Group_NoMatchValue)
+		if len(selectGroup.Conditions) == 0 && hasNoMatchValue {
+			value, err := qe.decodeValue(model_core.Nested(selectGroups, noMatchValue.NoMatchValue), nil)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, value.String())
+			continue
+		}
+
+		var sb strings.Builder
+		sb.WriteString("select({")
+		for i, condition := range selectGroup.Conditions {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			value, err := qe.decodeValue(model_core.Nested(selectGroups, condition.Value), nil)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&sb, "%s: %s", starlark.String(condition.ConditionIdentifier).String(), value.String())
+		}
+		if hasNoMatchValue {
+			if len(selectGroup.Conditions) > 0 {
+				sb.WriteString(", ")
+			}
+			value, err := qe.decodeValue(model_core.Nested(selectGroups, noMatchValue.NoMatchValue), nil)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&sb, "\"//conditions:default\": %s", value.String())
+		}
+		sb.WriteString("}")
+		if noMatchError, ok := selectGroup.NoMatch.(*model_starlark_pb.Select_Group_NoMatchError); ok {
+			fmt.Fprintf(&sb, ", no_match_error = %s", starlark.String(noMatchError.NoMatchError).String())
+		}
+		sb.WriteString(")")
+		parts = append(parts, sb.String())
+	}
+	return strings.Join(parts, " + "), nil
+}
+
+func formatStringList(values []string) string {
+	elements := make([]starlark.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, starlark.String(value))
+	}
+	return starlark.NewList(elements).String()
+}
+
+// forEachDependency calls a function for each direct dependency of a
+// target. If the properties of the target cannot be obtained due to
+// missing dependencies, the function is not called.
+func (qe *queryEvaluator[TReference, TMetadata]) forEachDependency(targetLabel string, yield func(string)) error {
+	target, err := qe.getTarget(targetLabel)
+	if err != nil {
+		return err
+	}
+	if target != nil {
+		for _, dependency := range target.dependencies {
+			yield(dependency)
+		}
+	}
+	return nil
+}
+
+// getTransitiveDependencies returns the set of targets that can be
+// reached from a set of targets by following at most maximumDepth
+// dependency edges. A negative maximum depth indicates that the full
+// transitive closure needs to be computed.
+func (qe *queryEvaluator[TReference, TMetadata]) getTransitiveDependencies(targets queryTargetSet, maximumDepth int) (queryTargetSet, error) {
+	result := maps.Clone(targets)
+	frontier := slices.Sorted(maps.Keys(targets))
+	for depth := 0; len(frontier) > 0 && depth != maximumDepth; depth++ {
+		var nextFrontier []string
+		for _, targetLabel := range frontier {
+			if err := qe.forEachDependency(targetLabel, func(dependency string) {
+				if _, ok := result[dependency]; !ok {
+					result[dependency] = struct{}{}
+					nextFrontier = append(nextFrontier, dependency)
+				}
+			}); err != nil {
+				return nil, err
+			}
+		}
+		frontier = nextFrontier
+	}
+	return result, nil
+}
+
+// getReverseDependencies computes the reverse dependency edges of all
+// targets contained in a set of targets, only considering edges
+// between targets in the set.
+func (qe *queryEvaluator[TReference, TMetadata]) getReverseDependencies(targets queryTargetSet) (map[string][]string, error) {
+	reverseDependencies := map[string][]string{}
+	for _, targetLabel := range slices.Sorted(maps.Keys(targets)) {
+		if err := qe.forEachDependency(targetLabel, func(dependency string) {
+			if _, ok := targets[dependency]; ok {
+				reverseDependencies[dependency] = append(reverseDependencies[dependency], targetLabel)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return reverseDependencies, nil
+}
+
+// getReachableThroughReverseDependencies returns the set of targets
+// that can be reached from a set of targets by following at most
+// maximumDepth reverse dependency edges.
+func getReachableThroughReverseDependencies(reverseDependencies map[string][]string, targets queryTargetSet, maximumDepth int) queryTargetSet {
+	result := maps.Clone(targets)
+	frontier := slices.Sorted(maps.Keys(targets))
+	for depth := 0; len(frontier) > 0 && depth != maximumDepth; depth++ {
+		var nextFrontier []string
+		for _, targetLabel := range frontier {
+			for _, reverseDependency := range reverseDependencies[targetLabel] {
+				if _, ok := result[reverseDependency]; !ok {
+					result[reverseDependency] = struct{}{}
+					nextFrontier = append(nextFrontier, reverseDependency)
+				}
+			}
+		}
+		frontier = nextFrontier
+	}
+	return result
+}
+
+// intersectTargetSets returns the targets that are present in both
+// sets.
+func intersectTargetSets(a, b queryTargetSet) queryTargetSet {
+	result := queryTargetSet{}
+	for targetLabel := range a {
+		if _, ok := b[targetLabel]; ok {
+			result[targetLabel] = struct{}{}
+		}
+	}
+	return result
+}
+
+// expandTargetPattern returns the set of targets matched by a target
+// pattern. Unlike "bazel build", manual targets are included.
+func (qe *queryEvaluator[TReference, TMetadata]) expandTargetPattern(targetPattern string) (queryTargetSet, error) {
+	apparentTargetPattern, err := qe.rootPackage.AppendTargetPattern(targetPattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target pattern %#v: %w", targetPattern, err)
+	}
+	canonicalTargetPattern, err := label.Canonicalize(qe.labelResolver, qe.rootPackage.GetCanonicalRepo(), apparentTargetPattern)
+	if err != nil {
+		if errors.Is(err, evaluation.ErrMissingDependency) {
+			qe.missingDependencies = true
+			return queryTargetSet{}, nil
+		}
+		return nil, fmt.Errorf("failed to resolve target pattern %#v: %w", targetPattern, err)
+	}
+
+	result := queryTargetSet{}
+	var errIter error
+	for targetLabel := range qe.computer.expandCanonicalTargetPattern(
+		qe.context,
+		qe.environment,
+		canonicalTargetPattern,
+		/* includeManualTargets = */ true,
+		&errIter,
+	) {
+		result[targetLabel.String()] = struct{}{}
+	}
+	if errIter != nil {
+		if errors.Is(errIter, evaluation.ErrMissingDependency) {
+			qe.missingDependencies = true
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to expand target pattern %#v: %w", targetPattern, errIter)
+	}
+	return result, nil
+}
+
+// filterTargets returns the targets in a set for which a regular
+// expression matches a string property of the target. Like Bazel, the
+// regular expression is not anchored.
+func (qe *queryEvaluator[TReference, TMetadata]) filterTargets(targets queryTargetSet, pattern string, getProperty func(*queryTarget) (string, bool)) (queryTargetSet, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression %#v: %w", pattern, err)
+	}
+	result := queryTargetSet{}
+	for _, targetLabel := range slices.Sorted(maps.Keys(targets)) {
+		target, err := qe.getTarget(targetLabel)
+		if err != nil {
+			return nil, err
+		}
+		if target != nil {
+			if property, ok := getProperty(target); ok && re.MatchString(property) {
+				result[targetLabel] = struct{}{}
+			}
+		}
+	}
+	return result, nil
+}
+
+// evaluate a query expression, returning the set of targets to which
+// it evaluates.
+func (qe *queryEvaluator[TReference, TMetadata]) evaluate(expression query.Expression, variables map[string]queryTargetSet) (queryTargetSet, error) {
+	switch e := expression.(type) {
+	case query.TargetPatternExpression:
+		return qe.expandTargetPattern(e.Pattern)
+	case query.VariableExpression:
+		value, ok := variables[e.Name]
+		if !ok {
+			return nil, fmt.Errorf("variable %#v is not defined", e.Name)
+		}
+		return value, nil
+	case query.LetExpression:
+		value, err := qe.evaluate(e.Value, variables)
+		if err != nil {
+			return nil, err
+		}
+		innerVariables := maps.Clone(variables)
+		innerVariables[e.Name] = value
+		return qe.evaluate(e.Body, innerVariables)
+	case query.SetExpression:
+		result := queryTargetSet{}
+		for _, pattern := range e.Patterns {
+			targets, err := qe.expandTargetPattern(pattern)
+			if err != nil {
+				return nil, err
+			}
+			maps.Copy(result, targets)
+		}
+		return result, nil
+	case query.BinaryOperatorExpression:
+		left, err := qe.evaluate(e.Left, variables)
+		if err != nil {
+			return nil, err
+		}
+		right, err := qe.evaluate(e.Right, variables)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Operator {
+		case query.BinaryOperatorIntersect:
+			return intersectTargetSets(left, right), nil
+		case query.BinaryOperatorUnion:
+			result := maps.Clone(left)
+			maps.Copy(result, right)
+			return result, nil
+		case query.BinaryOperatorExcept:
+			result := maps.Clone(left)
+			for targetLabel := range right {
+				delete(result, targetLabel)
+			}
+			return result, nil
+		default:
+			panic("unknown binary operator")
+		}
+	case query.FunctionExpression:
+		return qe.evaluateFunction(e, variables)
+	default:
+		panic("unknown expression type")
+	}
+}
+
+func (qe *queryEvaluator[TReference, TMetadata]) evaluateFunction(e query.FunctionExpression, variables map[string]queryTargetSet) (queryTargetSet, error) {
+	// Evaluate all arguments that are expressions up front.
+	arguments := make([]queryTargetSet, len(e.Arguments))
+	for i, argument := range e.Arguments {
+		if argument.Expression != nil {
+			value, err := qe.evaluate(argument.Expression, variables)
+			if err != nil {
+				return nil, err
+			}
+			arguments[i] = value
+		}
+	}
+
+	maximumDepth := -1
+	switch e.Name {
+	case "allpaths":
+		// Targets that are reachable from the first set, and
+		// from which the second set can be reached.
+		reachable, err := qe.getTransitiveDependencies(arguments[0], -1)
+		if err != nil {
+			return nil, err
+		}
+		reverseDependencies, err := qe.getReverseDependencies(reachable)
+		if err != nil {
+			return nil, err
+		}
+		return getReachableThroughReverseDependencies(reverseDependencies, intersectTargetSets(arguments[1], reachable), -1), nil
+	case "attr":
+		attrName := e.Arguments[0].Word
+		return qe.filterTargets(arguments[2], e.Arguments[1].Word, func(target *queryTarget) (string, bool) {
+			for _, attribute := range target.attributes {
+				if attribute.Name == attrName {
+					return attribute.Value, true
+				}
+			}
+			return "", false
+		})
+	case "deps":
+		if len(e.Arguments) > 1 {
+			maximumDepth = e.Arguments[1].Integer
+		}
+		return qe.getTransitiveDependencies(arguments[0], maximumDepth)
+	case "kind":
+		return qe.filterTargets(arguments[1], e.Arguments[0].Word, func(target *queryTarget) (string, bool) {
+			return target.kind, true
+		})
+	case "rdeps":
+		if len(e.Arguments) > 2 {
+			maximumDepth = e.Arguments[2].Integer
+		}
+		universe, err := qe.getTransitiveDependencies(arguments[0], -1)
+		if err != nil {
+			return nil, err
+		}
+		reverseDependencies, err := qe.getReverseDependencies(universe)
+		if err != nil {
+			return nil, err
+		}
+		return getReachableThroughReverseDependencies(reverseDependencies, intersectTargetSets(arguments[1], universe), maximumDepth), nil
+	case "somepath":
+		return qe.getSomePath(arguments[0], arguments[1])
+	default:
+		return nil, fmt.Errorf("function %#v is not supported", e.Name)
+	}
+}
+
+// getSomePath returns the targets along a single path from a target
+// in the first set to a target in the second set. If no such path
+// exists, the empty set is returned.
+func (qe *queryEvaluator[TReference, TMetadata]) getSomePath(from, to queryTargetSet) (queryTargetSet, error) {
+	// Perform a breadth-first search, so that the shortest path is
+	// returned.
+	predecessors := map[string]string{}
+	frontier := slices.Sorted(maps.Keys(from))
+	for _, targetLabel := range frontier {
+		predecessors[targetLabel] = ""
+	}
+	for len(frontier) > 0 {
+		var nextFrontier []string
+		for _, targetLabel := range frontier {
+			if _, ok := to[targetLabel]; ok {
+				result := queryTargetSet{}
+				for ; targetLabel != ""; targetLabel = predecessors[targetLabel] {
+					result[targetLabel] = struct{}{}
+				}
+				return result, nil
+			}
+			if err := qe.forEachDependency(targetLabel, func(dependency string) {
+				if _, ok := predecessors[dependency]; !ok {
+					predecessors[dependency] = targetLabel
+					nextFrontier = append(nextFrontier, dependency)
+				}
+			}); err != nil {
+				return nil, err
+			}
+		}
+		frontier = nextFrontier
+	}
+	return queryTargetSet{}, nil
+}
+
+// newQueryEvaluator creates a queryEvaluator that resolves target
+// patterns relative to the root package of the root module.
+func (c *baseComputer[TReference, TMetadata]) newQueryEvaluator(ctx context.Context, e queryEvaluatorEnvironment[TReference]) (*queryEvaluator[TReference, TMetadata], error) {
+	rootModuleValue := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
+	if !rootModuleValue.IsSet() {
+		return nil, evaluation.ErrMissingDependency
+	}
+	rootModuleName := rootModuleValue.Message.RootModuleName
+	rootModule, err := label.NewModule(rootModuleName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root module name %#v: %w", rootModuleName, err)
+	}
+
+	return &queryEvaluator[TReference, TMetadata]{
+		computer:      c,
+		context:       ctx,
+		environment:   e,
+		labelResolver: newLabelResolver(e),
+		rootPackage:   rootModule.ToModuleInstance(nil).GetBareCanonicalRepo().GetRootPackage(),
+		targets:       map[string]*queryTarget{},
+	}, nil
+}
+
+// evaluateExpression parses a query expression and evaluates it,
+// returning the set of targets that it yields.
+func (qe *queryEvaluator[TReference, TMetadata]) evaluateExpression(expressionStr string) (queryTargetSet, error) {
+	expression, err := query.Parse(expressionStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query expression: %w", err)
+	}
+	return qe.evaluate(expression, map[string]queryTargetSet{})
+}
+
+func (c *baseComputer[TReference, TMetadata]) ComputeQueryResultValue(ctx context.Context, key *model_analysis_pb.QueryResult_Key, e QueryResultEnvironment[TReference, TMetadata]) (PatchedQueryResultValue[TMetadata], error) {
+	qe, err := c.newQueryEvaluator(ctx, e)
+	if err != nil {
+		return PatchedQueryResultValue[TMetadata]{}, err
+	}
+	result, err := qe.evaluateExpression(key.Expression)
+	if err != nil {
+		return PatchedQueryResultValue[TMetadata]{}, err
+	}
+
+	// Obtain the properties of all targets in the result, so that
+	// they can be displayed.
+	targets := make([]*model_analysis_pb.QueryResult_Value_Target, 0, len(result))
+	for _, targetLabel := range slices.Sorted(maps.Keys(result)) {
+		target, err := qe.getTarget(targetLabel)
+		if err != nil {
+			return PatchedQueryResultValue[TMetadata]{}, err
+		}
+		if target == nil {
+			continue
+		}
+		var dependencies []string
+		for _, dependency := range target.dependencies {
+			if _, ok := result[dependency]; ok {
+				dependencies = append(dependencies, dependency)
+			}
+		}
+		targets = append(targets, &model_analysis_pb.QueryResult_Value_Target{
+			Label:        targetLabel,
+			Kind:         target.kind,
+			Attributes:   target.attributes,
+			Dependencies: dependencies,
+		})
+	}
+	if qe.missingDependencies {
+		return PatchedQueryResultValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+	return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.QueryResult_Value{
+		Targets: targets,
+	}), nil
+}
@@ -884,6 +884,10 @@ func parseSubresourceIntegrityOrSHA256(integrity, sha256 string) (*model_fetch_p
 	return nil, nil
 }
 
+func getSourceJSONURL(module *model_analysis_pb.BuildListModule) (string, error) {
+	return url.JoinPath(module.RegistryUrl, "modules", module.Name, module.Version, "source.json")
+}
+
 func (c *baseComputer[TReference, TMetadata]) fetchModuleFromRegistry(
 	ctx context.Context,
 	module *model_analysis_pb.BuildListModule,
@@ -897,28 +901,19 @@ func (c *baseComputer[TReference, TMetadata]) fetchModuleFromRegistry(
 		return PatchedRepoValue[TMetadata]{}, evaluation.ErrMissingDependency
 	}
 
-	sourceJSONURL, err := url.JoinPath(
-		module.RegistryUrl,
-		"modules",
-		module.Name,
-		module.Version,
-		"source.json",
-	)
+	moduleLockfileValue := e.GetModuleLockfileValue(&model_analysis_pb.ModuleLockfile_Key{})
+	if !moduleLockfileValue.IsSet() {
+		return PatchedRepoValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+
+	sourceJSONURL, err := getSourceJSONURL(module)
 	if err != nil {
 		return PatchedRepoValue[TMetadata]{}, fmt.Errorf("failed to construct URL for module %s with version %s in registry %#v: %w", module.Name, module.Version, module.RegistryUrl, err)
 	}
 
-	sourceJSONContentsValue := e.GetHttpFileContentsValue(
-		&model_analysis_pb.HttpFileContents_Key{
-			FetchOptions: &model_analysis_pb.HttpFetchOptions{
-				Target: &model_fetch_pb.Target{
-					Urls: []string{sourceJSONURL},
-				},
-			},
-		},
-	)
-	if !sourceJSONContentsValue.IsSet() {
-		return PatchedRepoValue[TMetadata]{}, evaluation.ErrMissingDependency
+	sourceJSONContentsValue, err := fetchRegistryFile(e, moduleLockfileValue.Message, sourceJSONURL)
+	if err != nil {
+		return PatchedRepoValue[TMetadata]{}, err
 	}
 	if sourceJSONContentsValue.Message.Exists == nil {
 		return PatchedRepoValue[TMetadata]{}, fmt.Errorf("file at URL %#v does not exist", sourceJSONURL)
@@ -3462,6 +3457,18 @@ func (rc *repositoryContext[TReference, TMetadata]) doTemplate(thread *starlark.
 }
 
 func (c *baseComputer[TReference, TMetadata]) fetchRepo(ctx context.Context, canonicalRepo label.CanonicalRepo, apparentRepo label.ApparentRepo, repo model_core.Message[*model_starlark_pb.Repo_Definition, TReference], e RepoEnvironment[TReference, TMetadata]) (PatchedRepoValue[TMetadata], error) {
+	// Git repositories can be cloned by the fetcher directly,
+	// without needing to run the repository rule.
+	if repo.Message.RepositoryRuleIdentifier == gitRepositoryRuleIdentifier {
+		gitTarget, err := c.getNativeGitTarget(ctx, repo)
+		if err != nil {
+			return PatchedRepoValue[TMetadata]{}, err
+		}
+		if gitTarget != nil {
+			return c.fetchRepoFromGit(gitTarget, e)
+		}
+	}
+
 	// Obtain the definition of the repository rule used by the repo.
 	rootModuleValue := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
 	allBuiltinsModulesNames := e.GetBuiltinsModuleNamesValue(&model_analysis_pb.BuiltinsModuleNames_Key{})
@@ -13,6 +13,8 @@ import (
 	model_starlark "bonanza.build/pkg/model/starlark"
 	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
 	model_core_pb "bonanza.build/pkg/proto/model/core"
+	model_starlark_pb "bonanza.build/pkg/proto/model/starlark"
+	"bonanza.build/pkg/storage/object"
 
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bb-storage/pkg/util"
@@ -111,46 +113,16 @@ func (c *baseComputer[TReference, TMetadata]) ComputeTargetActionInputRootValue(
 		}
 		toolLeaf := toolLevel.Leaf
 
-		// Add the tool's executable to the input root.
-		executable := model_core.Nested(tool, toolLeaf.Executable)
-		if err := addFileToChangeTrackingDirectory(
-			e,
-			executable,
-			&rootDirectory,
-			loadOptions,
-			model_analysis_pb.DirectoryLayout_INPUT_ROOT,
-		); err != nil {
-			return PatchedTargetActionInputRootValue[TMetadata]{}, fmt.Errorf("failed to add tool executable to input root: %w", err)
-		}
-
-		// Create the tool's runfiles directory.
-		executablePath, err := model_starlark.FileGetInputRootPath(executable, nil)
-		if err != nil {
-			return PatchedTargetActionInputRootValue[TMetadata]{}, fmt.Errorf("failed to get path of tool executable: %w", err)
-		}
-		runfilesDirectoryResolver := changeTrackingDirectoryNewDirectoryResolver[TReference, TMetadata]{
-			loadOptions: loadOptions,
-			stack:       util.NewNonEmptyStack(&rootDirectory),
-		}
-		if err := path.Resolve(path.UNIXFormat.NewParser(executablePath+".runfiles"), &runfilesDirectoryResolver); err != nil {
-			return PatchedTargetActionInputRootValue[TMetadata]{}, fmt.Errorf("failed to create runfiles directory of tool with path %#v: %w", executablePath, err)
-		}
-		runfilesDirectory := runfilesDirectoryResolver.stack.Peek()
-		if err := addFilesToChangeTrackingDirectory(
+		if _, err := addExecutableAndRunfilesToChangeTrackingDirectory(
 			e,
+			model_core.Nested(tool, toolLeaf.Executable),
 			model_core.Nested(tool, toolLeaf.RunfilesFiles),
-			runfilesDirectory,
+			&rootDirectory,
 			loadOptions,
-			model_analysis_pb.DirectoryLayout_RUNFILES,
 		); err != nil {
-			return PatchedTargetActionInputRootValue[TMetadata]{}, fmt.Errorf("failed to add runfiles files of tool with path %#v to input root: %w", executablePath, err)
+			return PatchedTargetActionInputRootValue[TMetadata]{}, fmt.Errorf("failed to add tool to input root: %w", err)
 		}
 
-		// Create a ctx.workspace_name == "_main" directory.
-		// This is needed to make path lookups of the form
-		// "${RUNFILES_DIR}/_main/../${path}" work.
-		runfilesDirectory.getOrCreateDirectory(componentMainWorkspaceName)
-
 		if len(toolLeaf.RunfilesSymlinks) > 0 {
 			return PatchedTargetActionInputRootValue[TMetadata]{}, errors.New("TODO: add runfiles symlinks to the input root")
 		}
@@ -205,3 +177,58 @@ func (c *baseComputer[TReference, TMetadata]) ComputeTargetActionInputRootValue(
 		}, nil
 	})
 }
+
+// addExecutableAndRunfilesToChangeTrackingDirectory adds an executable
+// to a directory that uses the input root layout. Files that are part
+// of the executable's runfiles are placed in a directory named
+// "${executable}.runfiles" next to it. The path of the executable
+// relative to the root directory is returned.
+func addExecutableAndRunfilesToChangeTrackingDirectory[TReference object.BasicReference, TMetadata model_core.ReferenceMetadata](
+	e addFilesToChangeTrackingDirectoryEnvironment[TReference, TMetadata],
+	executable model_core.Message[*model_starlark_pb.File, TReference],
+	runfilesFiles model_core.Message[[]*model_starlark_pb.List_Element, TReference],
+	rootDirectory *changeTrackingDirectory[TReference, TMetadata],
+	loadOptions *changeTrackingDirectoryLoadOptions[TReference],
+) (string, error) {
+	// Add the executable to the input root.
+	if err := addFileToChangeTrackingDirectory(
+		e,
+		executable,
+		rootDirectory,
+		loadOptions,
+		model_analysis_pb.DirectoryLayout_INPUT_ROOT,
+	); err != nil {
+		return "", fmt.Errorf("failed to add executable: %w", err)
+	}
+
+	// Create the executable's runfiles directory.
+	executablePath, err := model_starlark.FileGetInputRootPath(executable, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get path of executable: %w", err)
+	}
+	runfilesDirectoryResolver := changeTrackingDirectoryNewDirectoryResolver[TReference, TMetadata]{
+		loadOptions: loadOptions,
+		stack:       util.NewNonEmptyStack(rootDirectory),
+	}
+	if err := path.Resolve(path.UNIXFormat.NewParser(executablePath+".runfiles"), &runfilesDirectoryResolver); err != nil {
+		return "", fmt.Errorf("failed to create runfiles directory of executable with path %#v: %w", executablePath, err)
+	}
+	runfilesDirectory := runfilesDirectoryResolver.stack.Peek()
+	if err := addFilesToChangeTrackingDirectory(
+		e,
+		runfilesFiles,
+		runfilesDirectory,
+		loadOptions,
+		model_analysis_pb.DirectoryLayout_RUNFILES,
+	); err != nil {
+		return "", fmt.Errorf("failed to add runfiles files of executable with path %#v: %w", executablePath, err)
+	}
+
+	// Create a ctx.workspace_name == "_main" directory.
+	// This is needed to make path lookups of the form
+	// "${RUNFILES_DIR}/_main/../${path}" work.
+	if _, err := runfilesDirectory.getOrCreateDirectory(componentMainWorkspaceName); err != nil {
+		return "", fmt.Errorf("failed to create main workspace directory in runfiles directory of executable with path %#v: %w", executablePath, err)
+	}
+	return executablePath, nil
+}
@@ -5,11 +5,9 @@ import (
 	"errors"
 
 	model_core "bonanza.build/pkg/model/core"
-	"bonanza.build/pkg/model/evaluation"
 	model_starlark "bonanza.build/pkg/model/starlark"
 	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
 	model_starlark_pb "bonanza.build/pkg/proto/model/starlark"
-	"bonanza.build/pkg/storage/object"
 )
 
 func (c *baseComputer[TReference, TMetadata]) ComputeTargetCompletionValue(ctx context.Context, key model_core.Message[*model_analysis_pb.TargetCompletion_Key, TReference], e TargetCompletionEnvironment[TReference, TMetadata]) (PatchedTargetCompletionValue[TMetadata], error) {
@@ -33,38 +31,22 @@ func (c *baseComputer[TReference, TMetadata]) ComputeTargetCompletionValue(ctx c
 		return PatchedTargetCompletionValue[TMetadata]{}, errors.New("\"files\" field of DefaultInfo provider is not a depset")
 	}
 
-	var errIter error
-	missingDependencies := false
-	for element := range model_starlark.AllListLeafElementsSkippingDuplicateParents(
+	// Place all files in a single directory hierarchy, so that
+	// clients can download them without needing to be aware of
+	// the structure of depsets.
+	rootDirectory, err := createRootDirectoryFromFiles(
 		ctx,
-		c.valueReaders.List,
+		e,
 		model_core.Nested(files, filesDepset.Depset.Elements),
-		map[model_core.Decodable[object.LocalReference]]struct{}{},
-		&errIter,
-	) {
-		elementFile, ok := element.Message.Kind.(*model_starlark_pb.Value_File)
-		if !ok {
-			return PatchedTargetCompletionValue[TMetadata]{}, errors.New("\"files\" field of DefaultInfo provider contains an element that is not a File")
-		}
-
-		patchedFile := model_core.Patch(e, model_core.Nested(element, elementFile.File))
-		targetOutput := e.GetFileRootValue(
-			model_core.NewPatchedMessage(
-				&model_analysis_pb.FileRoot_Key{
-					File:            patchedFile.Message,
-					DirectoryLayout: model_analysis_pb.DirectoryLayout_INPUT_ROOT,
-				},
-				patchedFile.Patcher,
-			),
-		)
-		if !targetOutput.IsSet() {
-			missingDependencies = true
-			continue
-		}
-	}
-	if missingDependencies {
-		return PatchedTargetCompletionValue[TMetadata]{}, evaluation.ErrMissingDependency
+		model_analysis_pb.DirectoryLayout_INPUT_ROOT,
+	)
+	if err != nil {
+		return PatchedTargetCompletionValue[TMetadata]{}, err
 	}
-
-	return model_core.NewSimplePatchedMessage[TMetadata](&model_analysis_pb.TargetCompletion_Value{}), nil
+	return model_core.NewPatchedMessage(
+		&model_analysis_pb.TargetCompletion_Value{
+			RootDirectory: rootDirectory.Message,
+		},
+		rootDirectory.Patcher,
+	), nil
 }
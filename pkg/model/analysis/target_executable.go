@@ -0,0 +1,164 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"bonanza.build/pkg/label"
+	model_core "bonanza.build/pkg/model/core"
+	"bonanza.build/pkg/model/evaluation"
+	model_starlark "bonanza.build/pkg/model/starlark"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_starlark_pb "bonanza.build/pkg/proto/model/starlark"
+	"bonanza.build/pkg/starlark/unpack"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"go.starlark.net/starlark"
+)
+
+var runEnvironmentInfoProviderIdentifier = util.Must(label.NewCanonicalStarlarkIdentifier("@@builtins_core+//:exports.bzl%RunEnvironmentInfo"))
+
+// getExecutableFromDefaultInfo extracts the executable of a target and
+// the files that are part of its runfiles from the FilesToRunProvider
+// that is part of the target's DefaultInfo provider.
+func (c *baseComputer[TReference, TMetadata]) getExecutableFromDefaultInfo(ctx context.Context, defaultInfo model_core.Message[*model_starlark_pb.Struct_Fields, TReference]) (model_core.Message[*model_starlark_pb.File, TReference], model_core.Message[[]*model_starlark_pb.List_Element, TReference], error) {
+	filesToRun, err := model_starlark.GetStructFieldValue(ctx, c.valueReaders.List, defaultInfo, "files_to_run")
+	if err != nil {
+		return model_core.Message[*model_starlark_pb.File, TReference]{}, model_core.Message[[]*model_starlark_pb.List_Element, TReference]{}, err
+	}
+	filesToRunStruct, ok := filesToRun.Message.Kind.(*model_starlark_pb.Value_Struct)
+	if !ok {
+		return model_core.Message[*model_starlark_pb.File, TReference]{}, model_core.Message[[]*model_starlark_pb.List_Element, TReference]{}, errors.New("\"files_to_run\" field of DefaultInfo provider is not a struct")
+	}
+	filesToRunFields := model_core.Nested(filesToRun, filesToRunStruct.Struct.Fields)
+
+	executable, err := model_starlark.GetStructFieldValue(ctx, c.valueReaders.List, filesToRunFields, "executable")
+	if err != nil {
+		return model_core.Message[*model_starlark_pb.File, TReference]{}, model_core.Message[[]*model_starlark_pb.List_Element, TReference]{}, err
+	}
+	executableFile, ok := executable.Message.Kind.(*model_starlark_pb.Value_File)
+	if !ok {
+		return model_core.Message[*model_starlark_pb.File, TReference]{}, model_core.Message[[]*model_starlark_pb.List_Element, TReference]{}, errors.New("target does not have an executable")
+	}
+
+	runfilesFiles, err := model_starlark.GetStructFieldValue(ctx, c.valueReaders.List, filesToRunFields, "_runfiles_files")
+	if err != nil {
+		return model_core.Message[*model_starlark_pb.File, TReference]{}, model_core.Message[[]*model_starlark_pb.List_Element, TReference]{}, err
+	}
+	runfilesFilesDepset, ok := runfilesFiles.Message.Kind.(*model_starlark_pb.Value_Depset)
+	if !ok {
+		return model_core.Message[*model_starlark_pb.File, TReference]{}, model_core.Message[[]*model_starlark_pb.List_Element, TReference]{}, errors.New("runfiles files of FilesToRunProvider are not a depset")
+	}
+	for _, fieldName := range []string{"_runfiles_symlinks", "_runfiles_root_symlinks"} {
+		runfilesSymlinks, err := model_starlark.GetStructFieldValue(ctx, c.valueReaders.List, filesToRunFields, fieldName)
+		if err != nil {
+			return model_core.Message[*model_starlark_pb.File, TReference]{}, model_core.Message[[]*model_starlark_pb.List_Element, TReference]{}, err
+		}
+		if depset, ok := runfilesSymlinks.Message.Kind.(*model_starlark_pb.Value_Depset); ok && len(depset.Depset.Elements) > 0 {
+			return model_core.Message[*model_starlark_pb.File, TReference]{}, model_core.Message[[]*model_starlark_pb.List_Element, TReference]{}, errors.New("TODO: add runfiles symlinks to the runfiles directory")
+		}
+	}
+	return model_core.Nested(executable, executableFile.File), model_core.Nested(runfilesFiles, runfilesFilesDepset.Depset.Elements), nil
+}
+
+func (c *baseComputer[TReference, TMetadata]) ComputeTargetExecutableValue(ctx context.Context, key model_core.Message[*model_analysis_pb.TargetExecutable_Key, TReference], e TargetExecutableEnvironment[TReference, TMetadata]) (PatchedTargetExecutableValue[TMetadata], error) {
+	patchedConfigurationReference := model_core.Patch(e, model_core.Nested(key, key.Message.ConfigurationReference))
+	configuredTarget := e.GetConfiguredTargetValue(
+		model_core.NewPatchedMessage(
+			&model_analysis_pb.ConfiguredTarget_Key{
+				Label:                  key.Message.Label,
+				ConfigurationReference: patchedConfigurationReference.Message,
+			},
+			patchedConfigurationReference.Patcher,
+		),
+	)
+	directoryCreationParameters, gotDirectoryCreationParameters := e.GetDirectoryCreationParametersObjectValue(&model_analysis_pb.DirectoryCreationParametersObject_Key{})
+	directoryReaders, gotDirectoryReaders := e.GetDirectoryReadersValue(&model_analysis_pb.DirectoryReaders_Key{})
+	if !configuredTarget.IsSet() || !gotDirectoryCreationParameters || !gotDirectoryReaders {
+		return PatchedTargetExecutableValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+
+	defaultInfo, ok := lookupProviderInConfiguredTarget(configuredTarget, defaultInfoProviderIdentifier)
+	if !ok {
+		return PatchedTargetExecutableValue[TMetadata]{}, errors.New("target did not yield DefaultInfo")
+	}
+	executable, runfilesFiles, err := c.getExecutableFromDefaultInfo(ctx, defaultInfo)
+	if err != nil {
+		return PatchedTargetExecutableValue[TMetadata]{}, err
+	}
+
+	// Obtain environment variables from RunEnvironmentInfo, if
+	// the target provides it.
+	var environment []*model_analysis_pb.TargetExecutable_Value_EnvironmentVariable
+	var inheritedEnvironment []string
+	if runEnvironmentInfo, ok := lookupProviderInConfiguredTarget(configuredTarget, runEnvironmentInfoProviderIdentifier); ok {
+		thread := &starlark.Thread{}
+		valueDecodingOptions := c.getValueDecodingOptions(ctx, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+			return model_starlark.NewLabel[TReference, TMetadata](resolvedLabel), nil
+		})
+
+		encodedEnvironment, err := model_starlark.GetStructFieldValue(ctx, c.valueReaders.List, runEnvironmentInfo, "environment")
+		if err != nil {
+			return PatchedTargetExecutableValue[TMetadata]{}, err
+		}
+		decodedEnvironment, err := model_starlark.DecodeValue[TReference, TMetadata](encodedEnvironment, nil, valueDecodingOptions)
+		if err != nil {
+			return PatchedTargetExecutableValue[TMetadata]{}, err
+		}
+		var environmentDict map[string]string
+		if err := unpack.Dict(unpack.String, unpack.String).UnpackInto(thread, decodedEnvironment, &environmentDict); err != nil {
+			return PatchedTargetExecutableValue[TMetadata]{}, fmt.Errorf("invalid environment of RunEnvironmentInfo: %w", err)
+		}
+		for _, name := range slices.Sorted(maps.Keys(environmentDict)) {
+			environment = append(environment, &model_analysis_pb.TargetExecutable_Value_EnvironmentVariable{
+				Name:  name,
+				Value: environmentDict[name],
+			})
+		}
+
+		encodedInheritedEnvironment, err := model_starlark.GetStructFieldValue(ctx, c.valueReaders.List, runEnvironmentInfo, "inherited_environment")
+		if err != nil {
+			return PatchedTargetExecutableValue[TMetadata]{}, err
+		}
+		decodedInheritedEnvironment, err := model_starlark.DecodeValue[TReference, TMetadata](encodedInheritedEnvironment, nil, valueDecodingOptions)
+		if err != nil {
+			return PatchedTargetExecutableValue[TMetadata]{}, err
+		}
+		if err := unpack.List(unpack.String).UnpackInto(thread, decodedInheritedEnvironment, &inheritedEnvironment); err != nil {
+			return PatchedTargetExecutableValue[TMetadata]{}, fmt.Errorf("invalid inherited environment of RunEnvironmentInfo: %w", err)
+		}
+	}
+
+	var rootDirectory changeTrackingDirectory[TReference, TMetadata]
+	executablePath, err := addExecutableAndRunfilesToChangeTrackingDirectory(
+		e,
+		executable,
+		runfilesFiles,
+		&rootDirectory,
+		&changeTrackingDirectoryLoadOptions[TReference]{
+			context:                 ctx,
+			directoryContentsReader: directoryReaders.DirectoryContents,
+			leavesReader:            directoryReaders.Leaves,
+		},
+	)
+	if err != nil {
+		return PatchedTargetExecutableValue[TMetadata]{}, err
+	}
+	createdRootDirectory, err := createDirectoryContentsFromChangeTrackingDirectory(ctx, e, &rootDirectory, directoryCreationParameters, directoryReaders)
+	if err != nil {
+		return PatchedTargetExecutableValue[TMetadata]{}, err
+	}
+	return model_core.NewPatchedMessage(
+		&model_analysis_pb.TargetExecutable_Value{
+			RootDirectory:        createdRootDirectory.Message,
+			ExecutablePath:       executablePath,
+			Environment:          environment,
+			InheritedEnvironment: inheritedEnvironment,
+		},
+		createdRootDirectory.Patcher,
+	), nil
+}
@@ -0,0 +1,461 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	go_path "path"
+	"strconv"
+	"strings"
+
+	"bonanza.build/pkg/label"
+	model_core "bonanza.build/pkg/model/core"
+	"bonanza.build/pkg/model/evaluation"
+	model_parser "bonanza.build/pkg/model/parser"
+	model_starlark "bonanza.build/pkg/model/starlark"
+	model_analysis_pb "bonanza.build/pkg/proto/model/analysis"
+	model_command_pb "bonanza.build/pkg/proto/model/command"
+	model_core_pb "bonanza.build/pkg/proto/model/core"
+	model_starlark_pb "bonanza.build/pkg/proto/model/starlark"
+	"bonanza.build/pkg/starlark/unpack"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"go.starlark.net/starlark"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var testSuiteRuleIdentifier = util.Must(label.NewCanonicalStarlarkIdentifier("@@builtins_core+//:exports.bzl%test_suite"))
+
+const (
+	// Name of the directory at the root of the input root that is
+	// provided to tests through TEST_TMPDIR.
+	testTmpdirName = "_tmp"
+	// Name of the directory inside the test's log directory that
+	// is provided to tests through TEST_UNDECLARED_OUTPUTS_DIR.
+	testUndeclaredOutputsDirectoryName = "test.outputs"
+)
+
+func (c *baseComputer[TReference, TMetadata]) ComputeTestResultValue(ctx context.Context, key model_core.Message[*model_analysis_pb.TestResult_Key, TReference], e TestResultEnvironment[TReference, TMetadata]) (PatchedTestResultValue[TMetadata], error) {
+	targetLabel, err := label.NewCanonicalLabel(key.Message.Label)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("invalid target label: %w", err)
+	}
+	configurationReference := model_core.Nested(key, key.Message.ConfigurationReference)
+	defaultInfo, err := getProviderFromConfiguredTarget(
+		e,
+		targetLabel.String(),
+		model_core.Patch(e, configurationReference),
+		defaultInfoProviderIdentifier,
+	)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, err
+	}
+
+	actionEncoder, gotActionEncoder := e.GetActionEncoderObjectValue(&model_analysis_pb.ActionEncoderObject_Key{})
+	directoryCreationParameters, gotDirectoryCreationParameters := e.GetDirectoryCreationParametersObjectValue(&model_analysis_pb.DirectoryCreationParametersObject_Key{})
+	directoryCreationParametersMessage := e.GetDirectoryCreationParametersValue(&model_analysis_pb.DirectoryCreationParameters_Key{})
+	directoryReaders, gotDirectoryReaders := e.GetDirectoryReadersValue(&model_analysis_pb.DirectoryReaders_Key{})
+	fileCreationParametersMessage := e.GetFileCreationParametersValue(&model_analysis_pb.FileCreationParameters_Key{})
+
+	// TODO: Respect exec_compatible_with of the test target and
+	// the "test" exec group when selecting a platform.
+	patchedConfigurationReference := model_core.Patch(e, configurationReference)
+	resolvedToolchains := e.GetResolvedToolchainsValue(
+		model_core.NewPatchedMessage(
+			&model_analysis_pb.ResolvedToolchains_Key{
+				ConfigurationReference: patchedConfigurationReference.Message,
+			},
+			patchedConfigurationReference.Patcher,
+		),
+	)
+	if !gotActionEncoder ||
+		!gotDirectoryCreationParameters ||
+		!directoryCreationParametersMessage.IsSet() ||
+		!gotDirectoryReaders ||
+		!fileCreationParametersMessage.IsSet() ||
+		!resolvedToolchains.IsSet() {
+		return PatchedTestResultValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+
+	executable, runfilesFiles, err := c.getExecutableFromDefaultInfo(ctx, defaultInfo)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, err
+	}
+
+	// Construct the input root, containing the test executable,
+	// its runfiles directory, and empty directories in which the
+	// test may write its logs and temporary files.
+	var rootDirectory changeTrackingDirectory[TReference, TMetadata]
+	loadOptions := &changeTrackingDirectoryLoadOptions[TReference]{
+		context:                 ctx,
+		directoryContentsReader: directoryReaders.DirectoryContents,
+		leavesReader:            directoryReaders.Leaves,
+	}
+	executablePath, err := addExecutableAndRunfilesToChangeTrackingDirectory(
+		e,
+		executable,
+		runfilesFiles,
+		&rootDirectory,
+		loadOptions,
+	)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to add test executable to input root: %w", err)
+	}
+
+	configurationComponent, err := model_starlark.ConfigurationReferenceToComponent(configurationReference)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, err
+	}
+	canonicalPackage := targetLabel.GetCanonicalPackage()
+	testLogsPath := go_path.Join(
+		model_starlark.ComponentStrBazelOut,
+		configurationComponent,
+		model_starlark.ComponentStrTestlogs,
+		model_starlark.ComponentStrExternal,
+		canonicalPackage.GetCanonicalRepo().String(),
+		canonicalPackage.GetPackagePath(),
+		targetLabel.GetTargetName().String(),
+	)
+	testLogsDirectoryResolver := changeTrackingDirectoryNewDirectoryResolver[TReference, TMetadata]{
+		loadOptions: loadOptions,
+		stack:       util.NewNonEmptyStack(&rootDirectory),
+	}
+	if err := path.Resolve(path.UNIXFormat.NewParser(testLogsPath), &testLogsDirectoryResolver); err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to create test logs directory: %w", err)
+	}
+	if _, err := testLogsDirectoryResolver.stack.Peek().getOrCreateDirectory(path.MustNewComponent(testUndeclaredOutputsDirectoryName)); err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to create undeclared outputs directory: %w", err)
+	}
+	if _, err := rootDirectory.getOrCreateDirectory(path.MustNewComponent(testTmpdirName)); err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+
+	inputRootReference, err := c.createMerkleTreeFromChangeTrackingDirectory(
+		ctx,
+		e,
+		&rootDirectory,
+		directoryCreationParameters,
+		directoryReaders,
+		/* fileCreationParameters = */ nil,
+		/* patchedFiles = */ nil,
+	)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to create Merkle tree of input root: %w", err)
+	}
+
+	// Like Bazel, run the test from within the runfiles directory
+	// of the main repo. As the absolute path of the input root is
+	// not known up front, paths in environment variables are
+	// relative to the working directory.
+	workingDirectoryPath := executablePath + ".runfiles/" + componentMainWorkspaceName.String()
+	inputRootPrefix := strings.Repeat("../", strings.Count(workingDirectoryPath, "/")+1)
+	environment := map[string]string{
+		"PATH":                        "/bin:/usr/bin:/usr/local/bin",
+		"RUNFILES_DIR":                "..",
+		"TEST_BINARY":                 inputRootPrefix + executablePath,
+		"TEST_RUN_NUMBER":             strconv.FormatUint(uint64(key.Message.RunNumber), 10),
+		"TEST_SRCDIR":                 "..",
+		"TEST_TARGET":                 targetLabel.String(),
+		"TEST_TMPDIR":                 inputRootPrefix + testTmpdirName,
+		"TEST_UNDECLARED_OUTPUTS_DIR": inputRootPrefix + testLogsPath + "/" + testUndeclaredOutputsDirectoryName,
+		"TEST_WORKSPACE":              componentMainWorkspaceName.String(),
+		"XML_OUTPUT_FILE":             inputRootPrefix + testLogsPath + "/test.xml",
+	}
+	if testFilter := key.Message.TestFilter; testFilter != "" {
+		environment["TESTBRIDGE_TEST_ONLY"] = testFilter
+	}
+	referenceFormat := c.referenceFormat
+	environmentVariableList, _, err := convertDictToEnvironmentVariableList(
+		ctx,
+		environment,
+		actionEncoder,
+		referenceFormat,
+		e,
+	)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, err
+	}
+
+	// Capture the contents of the test's log directory, so that
+	// test.xml and any undeclared outputs can be reported.
+	outputPathPattern := &model_command_pb.PathPattern{}
+	testLogsPathComponents := strings.Split(testLogsPath, "/")
+	for i := len(testLogsPathComponents) - 1; i >= 0; i-- {
+		outputPathPattern = &model_command_pb.PathPattern{
+			Children: &model_command_pb.PathPattern_ChildrenInline{
+				ChildrenInline: &model_command_pb.PathPattern_Children{
+					Children: []*model_command_pb.PathPattern_Child{{
+						Name:    testLogsPathComponents[i],
+						Pattern: outputPathPattern,
+					}},
+				},
+			},
+		}
+	}
+
+	// TODO: This should use inlinedtree.Build().
+	createdCommand, err := model_core.MarshalAndEncode(
+		model_core.NewPatchedMessage(
+			model_core.NewProtoMarshalable(&model_command_pb.Command{
+				Arguments: []*model_command_pb.ArgumentList_Element{{
+					Level: &model_command_pb.ArgumentList_Element_Leaf{
+						Leaf: inputRootPrefix + executablePath,
+					},
+				}},
+				EnvironmentVariables:        environmentVariableList.Message,
+				DirectoryCreationParameters: directoryCreationParametersMessage.Message.DirectoryCreationParameters,
+				FileCreationParameters:      fileCreationParametersMessage.Message.FileCreationParameters,
+				OutputPathPattern:           outputPathPattern,
+				WorkingDirectory:            workingDirectoryPath,
+			}),
+			environmentVariableList.Patcher,
+		),
+		referenceFormat,
+		actionEncoder,
+	)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to create command: %w", err)
+	}
+
+	action, err := model_core.BuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) (model_core.Marshalable, error) {
+		patcher.Merge(inputRootReference.Patcher)
+		commandReference, err := patcher.CaptureAndAddDecodableReference(ctx, createdCommand, e)
+		if err != nil {
+			return nil, err
+		}
+		return model_core.NewProtoMarshalable(&model_command_pb.Action{
+			CommandReference:   commandReference,
+			InputRootReference: inputRootReference.Message,
+		}), nil
+	})
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to create action: %w", err)
+	}
+	createdAction, err := model_core.MarshalAndEncode(action, referenceFormat, actionEncoder)
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to encode action: %w", err)
+	}
+
+	// Tests exiting with a non-zero exit code are not considered
+	// to be evaluation errors. Use ActionResult as opposed to
+	// SuccessfulActionResult, so that failures can be reported.
+	actionResultKey, err := model_core.BuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) (*model_analysis_pb.ActionResult_Key, error) {
+		actionReference, err := patcher.CaptureAndAddDecodableReference(ctx, createdAction, e)
+		if err != nil {
+			return nil, err
+		}
+		return &model_analysis_pb.ActionResult_Key{
+			ExecuteRequest: &model_analysis_pb.ExecuteRequest{
+				PlatformPkixPublicKey: resolvedToolchains.Message.PlatformPkixPublicKey,
+				ActionReference:       actionReference,
+				// TODO: Derive the execution timeout from
+				// the size and timeout attributes of the
+				// test. For now, use the timeout of
+				// "moderate" tests.
+				ExecutionTimeout: &durationpb.Duration{Seconds: 300},
+			},
+		}, nil
+	})
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to create action result key: %w", err)
+	}
+	actionResult := e.GetActionResultValue(actionResultKey)
+	if !actionResult.IsSet() {
+		return PatchedTestResultValue[TMetadata]{}, evaluation.ErrMissingDependency
+	}
+
+	outputs, err := model_parser.MaybeDereference(ctx, directoryReaders.CommandOutputs, model_core.Nested(actionResult, actionResult.Message.OutputsReference))
+	if err != nil {
+		return PatchedTestResultValue[TMetadata]{}, fmt.Errorf("failed to obtain outputs from action result: %w", err)
+	}
+	return model_core.MustBuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) *model_analysis_pb.TestResult_Value {
+		return &model_analysis_pb.TestResult_Value{
+			ExitCode:   actionResult.Message.ExitCode,
+			Stdout:     model_core.Patch(e, model_core.Nested(outputs, outputs.Message.Stdout)).Merge(patcher),
+			Stderr:     model_core.Patch(e, model_core.Nested(outputs, outputs.Message.Stderr)).Merge(patcher),
+			OutputRoot: model_core.Patch(e, model_core.Nested(outputs, outputs.Message.OutputRoot)).Merge(patcher),
+		}
+	}), nil
+}
+
+// testCollector is used by ComputeBuildResultValue() to determine
+// which of the targets matched by the target patterns in the build
+// specification are tests. Targets that are not tests are ignored,
+// while test_suite() targets are expanded to the tests they contain.
+type testCollector[TReference object.BasicReference, TMetadata BaseComputerReferenceMetadata] struct {
+	computer               *baseComputer[TReference, TMetadata]
+	context                context.Context
+	environment            BuildResultEnvironment[TReference, TMetadata]
+	labelResolver          label.Resolver
+	configurationReference model_core.Message[*model_core_pb.DecodableReference, TReference]
+
+	seenLabels          map[string]struct{}
+	testLabels          []string
+	missingDependencies bool
+}
+
+// addTarget adds a target to the set of tests if it is a test. If the
+// target is a test_suite() and expandTestSuites is set, the tests
+// contained in the test suite are added instead.
+func (tc *testCollector[TReference, TMetadata]) addTarget(fromPackage label.CanonicalPackage, targetLabel label.CanonicalLabel, expandTestSuites bool) error {
+	e := tc.environment
+	visibleTarget := e.GetVisibleTargetValue(
+		model_core.MustBuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[TMetadata]) *model_analysis_pb.VisibleTarget_Key {
+			return &model_analysis_pb.VisibleTarget_Key{
+				FromPackage:            fromPackage.String(),
+				ToLabel:                targetLabel.String(),
+				ConfigurationReference: model_core.Patch(e, tc.configurationReference).Merge(patcher),
+			}
+		}),
+	)
+	if !visibleTarget.IsSet() {
+		tc.missingDependencies = true
+		return nil
+	}
+	visibleTargetLabelStr := visibleTarget.Message.Label
+	if _, ok := tc.seenLabels[visibleTargetLabelStr]; ok {
+		return nil
+	}
+	tc.seenLabels[visibleTargetLabelStr] = struct{}{}
+
+	target := e.GetTargetValue(&model_analysis_pb.Target_Key{
+		Label: visibleTargetLabelStr,
+	})
+	if !target.IsSet() {
+		tc.missingDependencies = true
+		return nil
+	}
+	ruleTarget, ok := target.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_RuleTarget)
+	if !ok {
+		// Only rule targets can be tests.
+		return nil
+	}
+
+	ruleIdentifier := ruleTarget.RuleTarget.RuleIdentifier
+	if ruleIdentifier == testSuiteRuleIdentifier.String() {
+		if expandTestSuites {
+			visibleTargetLabel, err := label.NewCanonicalLabel(visibleTargetLabelStr)
+			if err != nil {
+				return fmt.Errorf("invalid target label %#v: %w", visibleTargetLabelStr, err)
+			}
+			if err := tc.addTestSuite(visibleTargetLabel, model_core.Nested(target, ruleTarget.RuleTarget)); err != nil {
+				return fmt.Errorf("failed to expand test suite %#v: %w", visibleTargetLabelStr, err)
+			}
+		}
+		return nil
+	}
+
+	ruleValue := e.GetCompiledBzlFileGlobalValue(&model_analysis_pb.CompiledBzlFileGlobal_Key{
+		Identifier: ruleIdentifier,
+	})
+	if !ruleValue.IsSet() {
+		tc.missingDependencies = true
+		return nil
+	}
+	rule, ok := ruleValue.Message.Global.GetKind().(*model_starlark_pb.Value_Rule)
+	if !ok {
+		return fmt.Errorf("%#v is not a rule", ruleIdentifier)
+	}
+	ruleDefinition, ok := rule.Rule.Kind.(*model_starlark_pb.Rule_Definition_)
+	if !ok {
+		return fmt.Errorf("%#v is not a rule definition", ruleIdentifier)
+	}
+	if ruleDefinition.Definition.Test {
+		tc.testLabels = append(tc.testLabels, visibleTargetLabelStr)
+	}
+	return nil
+}
+
+// addTestSuite adds all tests contained in a test_suite() target. If
+// the "tests" attribute of the test suite is empty, all non-manual
+// tests in the same package are added.
+func (tc *testCollector[TReference, TMetadata]) addTestSuite(testSuiteLabel label.CanonicalLabel, ruleTarget model_core.Message[*model_starlark_pb.RuleTarget, TReference]) error {
+	// test_suite() only has a single public attribute, "tests".
+	publicAttrValues := ruleTarget.Message.PublicAttrValues
+	if len(publicAttrValues) != 1 {
+		return fmt.Errorf("expected test suite to have 1 public attr value, while %d were provided", len(publicAttrValues))
+	}
+	thread := &starlark.Thread{}
+	var tests []string
+	for _, selectGroup := range publicAttrValues[0].ValueParts {
+		if len(selectGroup.Conditions) > 0 {
+			return errors.New("TODO: support select() in the \"tests\" attribute of test suites")
+		}
+		noMatch, ok := selectGroup.NoMatch.(*model_starlark_pb.Select_Group_NoMatchValue)
+		if !ok {
+			return errors.New("\"tests\" attribute does not have a value")
+		}
+		decodedPart, err := model_starlark.DecodeValue[TReference, TMetadata](
+			model_core.Nested(ruleTarget, noMatch.NoMatchValue),
+			/* currentIdentifier = */ nil,
+			tc.computer.getValueDecodingOptions(tc.context, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+				return model_starlark.NewLabel[TReference, TMetadata](resolvedLabel), nil
+			}),
+		)
+		if err != nil {
+			return err
+		}
+		if decodedPart == starlark.None {
+			continue
+		}
+		var testsPart []string
+		if err := unpack.List(unpack.String).UnpackInto(thread, decodedPart, &testsPart); err != nil {
+			return fmt.Errorf("invalid value for \"tests\" attribute: %w", err)
+		}
+		tests = append(tests, testsPart...)
+	}
+
+	testSuitePackage := testSuiteLabel.GetCanonicalPackage()
+	if len(tests) > 0 {
+		for _, test := range tests {
+			apparentTestLabel, err := testSuitePackage.AppendLabel(test)
+			if err != nil {
+				return fmt.Errorf("invalid test label %#v: %w", test, err)
+			}
+			testLabel, err := label.Canonicalize(tc.labelResolver, testSuitePackage.GetCanonicalRepo(), apparentTestLabel)
+			if err != nil {
+				if errors.Is(err, evaluation.ErrMissingDependency) {
+					tc.missingDependencies = true
+					continue
+				}
+				return fmt.Errorf("failed to resolve test label %#v: %w", test, err)
+			}
+			if err := tc.addTarget(testSuitePackage, testLabel, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	apparentTargetPattern, err := testSuitePackage.AppendTargetPattern(":all")
+	if err != nil {
+		return err
+	}
+	targetPattern, err := label.Canonicalize(tc.labelResolver, testSuitePackage.GetCanonicalRepo(), apparentTargetPattern)
+	if err != nil {
+		return err
+	}
+	var errIter error
+	for targetLabel := range tc.computer.expandCanonicalTargetPattern(
+		tc.context,
+		tc.environment,
+		targetPattern,
+		/* includeManualTargets = */ false,
+		&errIter,
+	) {
+		if err := tc.addTarget(testSuitePackage, targetLabel, false); err != nil {
+			return err
+		}
+	}
+	if errIter != nil {
+		if errors.Is(errIter, evaluation.ErrMissingDependency) {
+			tc.missingDependencies = true
+			return nil
+		}
+		return errIter
+	}
+	return nil
+}
@@ -1,9 +1,11 @@
 package evaluation
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"errors"
+	"log"
 	"time"
 
 	model_core "bonanza.build/pkg/model/core"
@@ -16,6 +18,7 @@ import (
 	model_evaluation_pb "bonanza.build/pkg/proto/model/evaluation"
 	remoteworker_pb "bonanza.build/pkg/proto/remoteworker"
 	dag_pb "bonanza.build/pkg/proto/storage/dag"
+	"bonanza.build/pkg/remoteexecution"
 	"bonanza.build/pkg/remoteworker"
 	"bonanza.build/pkg/storage/object"
 	object_namespacemapping "bonanza.build/pkg/storage/object/namespacemapping"
@@ -26,6 +29,7 @@ import (
 
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
@@ -33,6 +37,8 @@ import (
 type ComputerFactory[TReference any, TMetadata model_core.ReferenceMetadata] interface {
 	NewComputer(
 		namespace object.Namespace,
+		executionPriority int32,
+		executionRequestMetadata metadata.MD,
 		parsedObjectPoolIngester *model_parser.ParsedObjectPoolIngester[TReference],
 		objectExporter model_core.ObjectExporter[TReference, object.LocalReference],
 	) Computer[TReference, TMetadata]
@@ -46,6 +52,8 @@ type executor struct {
 	dagUploaderClient             dag_pb.UploaderClient
 	objectContentsWalkerSemaphore *semaphore.Weighted
 	clock                         clock.Clock
+
+	additionalForwardedMetadataKeys map[string]struct{}
 }
 
 func NewExecutor(
@@ -56,6 +64,7 @@ func NewExecutor(
 	dagUploaderClient dag_pb.UploaderClient,
 	objectContentsWalkerSemaphore *semaphore.Weighted,
 	clock clock.Clock,
+	additionalForwardedMetadataKeys map[string]struct{},
 ) remoteworker.Executor[*model_executewithstorage.Action[object.GlobalReference], model_core.Decodable[object.LocalReference], model_core.Decodable[object.LocalReference]] {
 	return &executor{
 		objectDownloader:              objectDownloader,
@@ -65,6 +74,8 @@ func NewExecutor(
 		dagUploaderClient:             dagUploaderClient,
 		objectContentsWalkerSemaphore: objectContentsWalkerSemaphore,
 		clock:                         clock,
+
+		additionalForwardedMetadataKeys: additionalForwardedMetadataKeys,
 	}
 }
 
@@ -132,11 +143,32 @@ func (e *executor) Execute(ctx context.Context, action *model_executewithstorage
 			return &result
 		}
 
+		// Forward any request metadata headers provided by the
+		// client to the scheduler, so that actions dispatched as
+		// part of this evaluation are associated with the same
+		// invocation. Only headers whose names start with
+		// "bonanza-" or that are permitted by configuration are
+		// accepted, as the client should not be able to use
+		// headers such as "authorization" to influence requests
+		// issued by the builder.
+		executionRequestMetadata := metadata.MD{}
+		for _, header := range actionMessage.Message.ExecutionRequestMetadata {
+			if err := remoteexecution.ValidateForwardedMetadataKey(header.Name, e.additionalForwardedMetadataKeys); err != nil {
+				result.Failure = &model_evaluation_pb.Result_Failure{
+					Status: status.Convert(util.StatusWrapf(err, "Invalid execution request metadata header %#v", header.Name)).Proto(),
+				}
+				return &result
+			}
+			executionRequestMetadata.Append(header.Name, header.Value)
+		}
+
 		queues := e.queuesFactory.NewQueues()
 		recursiveComputer := NewRecursiveComputer(
 			NewLeakCheckingComputer(
 				e.computerFactory.NewComputer(
 					action.Reference.Value.GetNamespace(),
+					actionMessage.Message.Priority,
+					executionRequestMetadata,
 					parsedObjectPoolIngester,
 					objectExporter,
 				),
@@ -146,6 +178,29 @@ func (e *executor) Execute(ctx context.Context, action *model_executewithstorage
 			e.clock,
 		)
 
+		// If the client provided the outcomes of a previous
+		// evaluation, let the computer look up evaluations in
+		// them. This permits reusing values of keys whose
+		// dependencies did not change. The outcomes may have
+		// been removed from storage in the meantime, in which
+		// case we evaluate all keys from scratch.
+		// TODO: Use a proper configuration.
+		evaluationTreeEncoder := model_encoding.NewChainedBinaryEncoder(nil)
+		if previousOutcomesReference := actionMessage.Message.PreviousOutcomesReference; previousOutcomesReference != nil {
+			if previousEvaluationLookup, err := newOutcomesPreviousEvaluationLookup(
+				ctx,
+				parsedObjectPoolIngester,
+				evaluationTreeEncoder,
+				objectExporter,
+				referenceFormat,
+				previousOutcomesReference,
+			); err == nil {
+				recursiveComputer.SetPreviousEvaluationLookup(previousEvaluationLookup)
+			} else {
+				log.Printf("Evaluating action %s from scratch, as the outcomes of the previous evaluation could not be loaded: %s", actionGlobalReference, err)
+			}
+		}
+
 		// Set keys for which we have overrides in place.
 		evaluationReader := model_parser.LookupParsedObjectReader(
 			parsedObjectPoolIngester,
@@ -266,7 +321,7 @@ func (e *executor) Execute(ctx context.Context, action *model_executewithstorage
 		}
 
 		// Perform the build.
-		var value model_core.Message[proto.Message, buffered.Reference]
+		requestedValues := make([]model_core.Message[proto.Message, buffered.Reference], len(requestedKeyStates))
 		errCompute := program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
 			// Launch a goroutine for reporting progress.
 			dependenciesGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
@@ -319,21 +374,23 @@ func (e *executor) Execute(ctx context.Context, action *model_executewithstorage
 			// Launch goroutines for waiting for build completion.
 			for i, requestedKeyState := range requestedKeyStates {
 				siblingsGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
-					if _, err := recursiveComputer.WaitForMessageValue(ctx, requestedKeyState); err != nil {
+					value, err := recursiveComputer.WaitForMessageValue(ctx, requestedKeyState)
+					if err != nil {
 						return NestedError[buffered.Reference]{
 							Key: requestedKeys[i],
 							Err: err,
 						}
 					}
+					requestedValues[i] = value
 					return nil
 				})
 			}
 			return nil
 		})
 
-		// Store all evaluation results to permit debugging of the build.
-		// TODO: Use a proper configuration.
-		evaluationTreeEncoder := model_encoding.NewChainedBinaryEncoder(nil)
+		// Store all evaluation results to permit debugging of the
+		// build, and to allow subsequent builds to be performed
+		// incrementally.
 		outcomesTreeBuilder := btree.NewUniformBuilder(
 			btree.NewProllyChunkerFactory[buffered.ReferenceMetadata](
 				/* minimumSizeBytes = */ 1<<16,
@@ -465,6 +522,8 @@ func (e *executor) Execute(ctx context.Context, action *model_executewithstorage
 								Key:          key.Message,
 								Value:        value.Message,
 								Dependencies: dependencies.Message,
+								NativeValue:  evaluation.NativeValue,
+								Overridden:   evaluation.Overridden,
 							},
 						},
 					},
@@ -536,8 +595,18 @@ func (e *executor) Execute(ctx context.Context, action *model_executewithstorage
 			return &result
 		}
 
-		result.Failure = &model_evaluation_pb.Result_Failure{
-			Status: status.Newf(codes.Internal, "TODO: %s", value).Proto(),
+		for _, requestedValue := range requestedValues {
+			marshaledValue, err := model_core.MarshalAny(
+				model_core.Patch(objectManager, requestedValue),
+			)
+			if err != nil {
+				result.Failure = &model_evaluation_pb.Result_Failure{
+					Status: status.Convert(err).Proto(),
+				}
+				return &result
+			}
+			result.RequestedValues = append(result.RequestedValues, marshaledValue.Message)
+			resultPatcher.Merge(marshaledValue.Patcher)
 		}
 		return &result
 	})
@@ -569,3 +638,140 @@ func (e *executor) Execute(ctx context.Context, action *model_executewithstorage
 	}
 	return model_core.CopyDecodable(createdResult, resultReference), 0, resultCode, nil
 }
+
+// outcomesPreviousEvaluationLookup is an implementation of
+// PreviousEvaluationLookup that looks up evaluations in the outcomes
+// of a previous evaluation, as returned through
+// Result.outcomes_reference. As evaluations in the outcomes are sorted
+// by the SHA-256 hash of their keys, lookups only need to load the
+// objects on the path from the root to the leaf of the B-tree.
+type outcomesPreviousEvaluationLookup struct {
+	evaluationReader model_parser.ParsedObjectReader[model_core.Decodable[buffered.Reference], model_core.Message[[]*model_evaluation_pb.Evaluation, buffered.Reference]]
+	keysReader       model_parser.ParsedObjectReader[model_core.Decodable[buffered.Reference], model_core.Message[[]*model_evaluation_pb.Keys, buffered.Reference]]
+	outcomes         model_core.Message[[]*model_evaluation_pb.Evaluation, buffered.Reference]
+}
+
+func newOutcomesPreviousEvaluationLookup(
+	ctx context.Context,
+	parsedObjectPoolIngester *model_parser.ParsedObjectPoolIngester[buffered.Reference],
+	evaluationTreeEncoder model_encoding.BinaryEncoder,
+	objectExporter model_core.ObjectExporter[buffered.Reference, object.LocalReference],
+	referenceFormat object.ReferenceFormat,
+	previousOutcomesReference *model_core_pb.WeakDecodableReference,
+) (PreviousEvaluationLookup[buffered.Reference], error) {
+	outcomesReference, err := model_core.NewDecodableLocalReferenceFromWeakProto(referenceFormat, previousOutcomesReference)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Invalid previous outcomes reference")
+	}
+	evaluationReader := model_parser.LookupParsedObjectReader(
+		parsedObjectPoolIngester,
+		model_parser.NewChainedObjectParser(
+			model_parser.NewEncodedObjectParser[buffered.Reference](evaluationTreeEncoder),
+			model_parser.NewProtoListObjectParser[buffered.Reference, model_evaluation_pb.Evaluation](),
+		),
+	)
+	outcomes, err := evaluationReader.ReadParsedObject(
+		ctx,
+		model_core.CopyDecodable(
+			outcomesReference,
+			objectExporter.ImportReference(outcomesReference.Value),
+		),
+	)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to read previous outcomes")
+	}
+	return &outcomesPreviousEvaluationLookup{
+		evaluationReader: evaluationReader,
+		keysReader: model_parser.LookupParsedObjectReader(
+			parsedObjectPoolIngester,
+			model_parser.NewChainedObjectParser(
+				model_parser.NewEncodedObjectParser[buffered.Reference](evaluationTreeEncoder),
+				model_parser.NewProtoListObjectParser[buffered.Reference, model_evaluation_pb.Keys](),
+			),
+		),
+		outcomes: outcomes,
+	}, nil
+}
+
+func (l *outcomesPreviousEvaluationLookup) LookupPreviousEvaluation(ctx context.Context, keyHash [sha256.Size]byte) (*Evaluation[buffered.Reference], error) {
+	evaluation, err := btree.Find(
+		ctx,
+		l.evaluationReader,
+		l.outcomes,
+		func(entry model_core.Message[*model_evaluation_pb.Evaluation, buffered.Reference]) (int, *model_core_pb.DecodableReference) {
+			switch level := entry.Message.Level.(type) {
+			case *model_evaluation_pb.Evaluation_Leaf_:
+				flattenedKey, err := model_core.FlattenAny(model_core.Nested(entry, level.Leaf.Key))
+				if err != nil {
+					return -1, nil
+				}
+				marshaledKey, err := model_core.MarshalTopLevelMessage(flattenedKey)
+				if err != nil {
+					return -1, nil
+				}
+				marshaledKeySHA256 := sha256.Sum256(marshaledKey)
+				return bytes.Compare(keyHash[:], marshaledKeySHA256[:]), nil
+			case *model_evaluation_pb.Evaluation_Parent_:
+				return bytes.Compare(keyHash[:], level.Parent.FirstKeySha256), level.Parent.Reference
+			default:
+				return 0, nil
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !evaluation.IsSet() {
+		return nil, nil
+	}
+
+	evaluationLeaf, ok := evaluation.Message.Level.(*model_evaluation_pb.Evaluation_Leaf_)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Previous evaluation is not a valid leaf")
+	}
+	key, err := model_core.UnmarshalAnyNew(model_core.Nested(evaluation, evaluationLeaf.Leaf.Key))
+	if err != nil {
+		return nil, util.StatusWrap(err, "Invalid key of previous evaluation")
+	}
+	var value model_core.Message[proto.Message, buffered.Reference]
+	if evaluationLeaf.Leaf.Value != nil {
+		topLevelValue, err := model_core.UnmarshalAnyNew(model_core.Nested(evaluation, evaluationLeaf.Leaf.Value))
+		if err != nil {
+			return nil, util.StatusWrap(err, "Invalid value of previous evaluation")
+		}
+		value = topLevelValue.Decay()
+	}
+
+	var dependencies []model_core.TopLevelMessage[proto.Message, buffered.Reference]
+	var errIterDependencies error
+	for dependencyNode := range btree.AllLeaves(
+		ctx,
+		l.keysReader,
+		model_core.Nested(evaluation, evaluationLeaf.Leaf.Dependencies),
+		/* traverser = */ func(keys model_core.Message[*model_evaluation_pb.Keys, buffered.Reference]) (*model_core_pb.DecodableReference, error) {
+			return keys.Message.GetParent().GetReference(), nil
+		},
+		&errIterDependencies,
+	) {
+		dependencyLeaf, ok := dependencyNode.Message.Level.(*model_evaluation_pb.Keys_Leaf)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "Dependency of previous evaluation is not a valid leaf")
+		}
+		dependency, err := model_core.UnmarshalAnyNew(model_core.Nested(dependencyNode, dependencyLeaf.Leaf))
+		if err != nil {
+			return nil, util.StatusWrap(err, "Invalid dependency of previous evaluation")
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	if errIterDependencies != nil {
+		return nil, util.StatusWrap(errIterDependencies, "Failed to iterate dependencies of previous evaluation")
+	}
+
+	return &Evaluation[buffered.Reference]{
+		Key:          key,
+		Value:        value,
+		NativeValue:  evaluationLeaf.Leaf.NativeValue,
+		Overridden:   evaluationLeaf.Leaf.Overridden,
+		Dependencies: dependencies,
+	}, nil
+}
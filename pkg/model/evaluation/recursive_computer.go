@@ -84,6 +84,23 @@ type RecursiveComputer[TReference object.BasicReference, TMetadata model_core.Re
 	totalQueuedKeysCount uint64
 
 	completedKeys keyStateList[TReference, TMetadata]
+
+	// If set, used to obtain values and dependencies of keys that
+	// were obtained during a previous evaluation, which may be
+	// reused if none of the dependencies changed.
+	previousEvaluationLookup PreviousEvaluationLookup[TReference]
+}
+
+// PreviousEvaluationLookup is used by RecursiveComputer to obtain the
+// value and dependencies of a key that were obtained during a previous
+// evaluation. Lookups are performed on demand, so that only the parts
+// of the outcomes of the previous evaluation that are relevant to the
+// current evaluation need to be loaded.
+type PreviousEvaluationLookup[TReference any] interface {
+	// LookupPreviousEvaluation returns the previous evaluation of
+	// the key having a given hash. If the key was not part of the
+	// previous evaluation, nil is returned.
+	LookupPreviousEvaluation(ctx context.Context, keyHash [sha256.Size]byte) (*Evaluation[TReference], error)
 }
 
 // NewRecursiveComputer creates a new RecursiveComputer that is in the
@@ -148,21 +165,45 @@ func (rc *RecursiveComputer[TReference, TMetadata]) ProcessNextQueuedKey(ctx con
 	if ks.restarts == 0 {
 		ks.firstEvaluationStart = ks.currentEvaluationStart
 	}
+	previous := ks.previous
+	previousDependencies := ks.previousDependencies
 	rc.lock.Unlock()
 
 	e := recursivelyComputingEnvironment[TReference, TMetadata]{
-		computer:     rc,
-		keyState:     ks,
-		dependencies: map[*KeyState[TReference, TMetadata]]struct{}{},
+		computer:        rc,
+		keyState:        ks,
+		dependenciesSet: map[*KeyState[TReference, TMetadata]]struct{}{},
+	}
+
+	// If the key was evaluated previously, check whether any of
+	// its dependencies changed. If not, we may be able to reuse the
+	// previous value without invoking the computer.
+	var err error
+	if previousDependencies == previousDependenciesUnknown {
+		previous, err = rc.lookupPreviousEvaluation(ctx, ks.keyHash)
+		previousDependencies = previous.getInitialDependenciesState()
 	}
-	err := ks.value.compute(ctx, &e)
-	dependencies := slices.Collect(maps.Keys(e.dependencies))
+	if err == nil && previousDependencies == previousDependenciesUnvalidated {
+		previousDependencies, err = e.validatePreviousDependencies(ctx, previous)
+	}
+	unchanged := false
+	if err == nil {
+		if previousDependencies == previousDependenciesUnchanged && ks.value.reusePreviousEvaluation(previous) {
+			unchanged = true
+		} else if err = ks.value.compute(ctx, &e); err == nil {
+			unchanged = ks.value.isUnchanged(previous, previousDependencies == previousDependenciesUnchanged)
+		}
+	}
+	dependencies := e.dependencies
 
 	rc.lock.Lock()
 	rc.evaluatingKeys.remove(ks)
+	ks.previous = previous
+	ks.previousDependencies = previousDependencies
 	if ks.err == (errKeyNotEvaluated{}) {
 		if err == nil {
 			ks.err = nil
+			ks.unchanged = unchanged
 			for _, ksBlocked := range ks.blocking {
 				if ksBlocked.blockedCount > 0 {
 					ksBlocked.blockedCount--
@@ -184,15 +225,23 @@ func (rc *RecursiveComputer[TReference, TMetadata]) ProcessNextQueuedKey(ctx con
 			restartImmediately := true
 			for _, ksDep := range dependencies {
 				if err := ksDep.err; err != nil && err != (errKeyNotEvaluated{}) {
-					rc.failKeyState(ks, NestedError[TReference]{
-						Key: ksDep.key,
-						Err: err,
-					})
-					restartImmediately = false
+					if previousDependencies == previousDependenciesUnvalidated {
+						// A dependency of the previous
+						// evaluation failed, meaning
+						// its value cannot be reused.
+						// Evaluate the key from scratch.
+						ks.previousDependencies = previousDependenciesChanged
+					} else {
+						rc.failKeyState(ks, NestedError[TReference]{
+							Key: ksDep.key,
+							Err: err,
+						})
+						restartImmediately = false
+					}
 					break
 				}
 			}
-			if restartImmediately {
+			if restartImmediately && ks.previousDependencies == previousDependencies {
 				for _, ksDep := range dependencies {
 					if ksDep.err == (errKeyNotEvaluated{}) {
 						if len(ksDep.blocking) == 0 {
@@ -236,10 +285,18 @@ func (rc *RecursiveComputer[TReference, TMetadata]) propagateFailure(ks *KeyStat
 		if ksBlocked.blockedCount > 0 {
 			rc.blockedKeys.remove(ksBlocked)
 			ksBlocked.blockedCount = 0
-			rc.failKeyState(ksBlocked, NestedError[TReference]{
-				Key: ks.key,
-				Err: err,
-			})
+			if ksBlocked.previousDependencies == previousDependenciesUnvalidated {
+				// The key was only blocked on this key to
+				// validate its previous value. Instead of
+				// failing, evaluate the key from scratch.
+				ksBlocked.previousDependencies = previousDependenciesChanged
+				rc.enqueue(ksBlocked)
+			} else {
+				rc.failKeyState(ksBlocked, NestedError[TReference]{
+					Key: ks.key,
+					Err: err,
+				})
+			}
 		}
 	}
 }
@@ -269,6 +326,9 @@ func (rc *RecursiveComputer[TReference, TMetadata]) getOrCreateKeyStateLocked(ke
 			value:   initialValueState,
 			err:     errKeyNotEvaluated{},
 		}
+		if rc.previousEvaluationLookup != nil {
+			ks.previousDependencies = previousDependenciesUnknown
+		}
 		rc.keys[keyHash] = ks
 		rc.enqueue(ks)
 	}
@@ -295,18 +355,67 @@ func (rc *RecursiveComputer[TReference, TMetadata]) InjectKeyState(key model_cor
 
 	rc.lock.Lock()
 	if _, ok := rc.keys[keyHash]; !ok {
-		rc.keys[keyHash] = &KeyState[TReference, TMetadata]{
+		ks := &KeyState[TReference, TMetadata]{
 			key:     key,
 			keyHash: keyHash,
 			value: &messageValueState[TReference, TMetadata]{
 				value: value,
 			},
+			overridden: true,
+		}
+		if rc.previousEvaluationLookup != nil {
+			ks.previousDependencies = previousDependenciesUnknown
 		}
+		rc.keys[keyHash] = ks
 	}
 	rc.lock.Unlock()
 	return nil
 }
 
+// SetPreviousEvaluationLookup provides a lookup function for values and
+// dependencies of keys that were obtained during a previous evaluation.
+// If a key needs to be evaluated and none of its dependencies yield a
+// different value, the previous value is reused instead of invoking
+// the computer.
+//
+// This method needs to be called before any keys are requested or
+// injected through InjectKeyState().
+func (rc *RecursiveComputer[TReference, TMetadata]) SetPreviousEvaluationLookup(lookup PreviousEvaluationLookup[TReference]) {
+	rc.lock.Lock()
+	rc.previousEvaluationLookup = lookup
+	rc.lock.Unlock()
+}
+
+// lookupPreviousEvaluation obtains the value and dependencies of a key
+// that were obtained during a previous evaluation. This method returns
+// nil if no previous evaluation of the key exists.
+func (rc *RecursiveComputer[TReference, TMetadata]) lookupPreviousEvaluation(ctx context.Context, keyHash [sha256.Size]byte) (*previousEvaluation[TReference], error) {
+	evaluation, err := rc.previousEvaluationLookup.LookupPreviousEvaluation(ctx, keyHash)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to look up previous evaluation")
+	}
+	if evaluation == nil {
+		return nil, nil
+	}
+	dependencies := make([]previousDependency[TReference], 0, len(evaluation.Dependencies))
+	for _, dependency := range evaluation.Dependencies {
+		dependencyHash, err := getKeyHash(dependency)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Invalid dependency of previous evaluation")
+		}
+		dependencies = append(dependencies, previousDependency[TReference]{
+			key:     dependency,
+			keyHash: dependencyHash,
+		})
+	}
+	return &previousEvaluation[TReference]{
+		value:        evaluation.Value,
+		nativeValue:  evaluation.NativeValue,
+		overridden:   evaluation.Overridden,
+		dependencies: dependencies,
+	}, nil
+}
+
 func (rc *RecursiveComputer[TReference, TMetadata]) WaitForMessageValue(ctx context.Context, ks *KeyState[TReference, TMetadata]) (model_core.Message[proto.Message, TReference], error) {
 	rc.lock.Lock()
 	if ks.err == (errKeyNotEvaluated{}) {
@@ -376,6 +485,8 @@ func (rc *RecursiveComputer[TReference, TMetadata]) enqueue(ks *KeyState[TRefere
 type Evaluation[TReference any] struct {
 	Key          model_core.TopLevelMessage[proto.Message, TReference]
 	Value        model_core.Message[proto.Message, TReference]
+	NativeValue  bool
+	Overridden   bool
 	Dependencies []model_core.TopLevelMessage[proto.Message, TReference]
 }
 
@@ -392,14 +503,11 @@ func (rc *RecursiveComputer[TReference, TMetadata]) GetAllEvaluations() iter.Seq
 		) {
 			ks := rc.keys[key]
 
+			// Dependencies are reported in the order in
+			// which they were requested, so that subsequent
+			// evaluations can validate them in that order.
 			value := ks.value.getMessageValue()
 			dependencies := make([]model_core.TopLevelMessage[proto.Message, TReference], 0, len(ks.dependencies))
-			slices.SortFunc(
-				ks.dependencies,
-				func(a, b *KeyState[TReference, TMetadata]) int {
-					return bytes.Compare(a.keyHash[:], b.keyHash[:])
-				},
-			)
 			for _, ksDep := range ks.dependencies {
 				dependencies = append(dependencies, ksDep.key)
 			}
@@ -411,9 +519,12 @@ func (rc *RecursiveComputer[TReference, TMetadata]) GetAllEvaluations() iter.Seq
 				continue
 			}
 
+			_, nativeValue := ks.value.(*nativeValueState[TReference, TMetadata])
 			if !yield(Evaluation[TReference]{
 				Key:          ks.key,
 				Value:        value,
+				NativeValue:  nativeValue,
+				Overridden:   ks.overridden,
 				Dependencies: dependencies,
 			}) {
 				break
@@ -427,7 +538,119 @@ type recursivelyComputingEnvironment[TReference object.BasicReference, TMetadata
 	computer *RecursiveComputer[TReference, TMetadata]
 	keyState *KeyState[TReference, TMetadata]
 
-	dependencies map[*KeyState[TReference, TMetadata]]struct{}
+	// Keys on which the current key depends, in the order in which
+	// they were requested for the first time.
+	dependencies    []*KeyState[TReference, TMetadata]
+	dependenciesSet map[*KeyState[TReference, TMetadata]]struct{}
+}
+
+// addDependencyLocked records that the current key depends on another
+// key, if it was not recorded previously.
+func (e *recursivelyComputingEnvironment[TReference, TMetadata]) addDependencyLocked(ks *KeyState[TReference, TMetadata]) {
+	if _, ok := e.dependenciesSet[ks]; !ok {
+		e.dependenciesSet[ks] = struct{}{}
+		e.dependencies = append(e.dependencies, ks)
+	}
+}
+
+// clearDependencies discards all dependencies that were recorded
+// while validating the dependencies of a previous evaluation, so that
+// the key can be evaluated from scratch.
+func (e *recursivelyComputingEnvironment[TReference, TMetadata]) clearDependencies() {
+	e.dependencies = nil
+	clear(e.dependenciesSet)
+}
+
+// validatePreviousDependencies checks whether the dependencies of a
+// key during a previous evaluation still yield the same values. If so,
+// the key's value is also unchanged.
+//
+// Dependencies are validated in the order in which they were requested
+// during the previous evaluation. Validation stops at the first
+// dependency that changed or that has not been evaluated yet. Computers
+// may decide which keys to request based on the values of keys that
+// were requested earlier. Stopping at the first difference ensures that
+// no keys are evaluated that the computer would not request when
+// provided with the current values.
+func (e *recursivelyComputingEnvironment[TReference, TMetadata]) validatePreviousDependencies(ctx context.Context, previous *previousEvaluation[TReference]) (previousDependenciesState, error) {
+	rc := e.computer
+	for _, dependency := range previous.dependencies {
+		rc.lock.Lock()
+		ksDep, ok := rc.keys[dependency.keyHash]
+		needsLookup := !ok || (ksDep.err == nil && ksDep.previousDependencies == previousDependenciesUnknown)
+		rc.lock.Unlock()
+
+		if needsLookup {
+			// Either the dependency has not been requested
+			// as part of the current evaluation, or it is
+			// overridden and we don't know yet whether its
+			// value differs from the previous evaluation.
+			dependencyPrevious, err := rc.lookupPreviousEvaluation(ctx, dependency.keyHash)
+			if err != nil {
+				e.clearDependencies()
+				return previousDependenciesUnvalidated, err
+			}
+
+			rc.lock.Lock()
+			if ksDep, ok = rc.keys[dependency.keyHash]; ok {
+				if ksDep.err == nil && ksDep.previousDependencies == previousDependenciesUnknown {
+					ksDep.previous = dependencyPrevious
+					ksDep.previousDependencies = previousDependenciesChanged
+					ksDep.unchanged = ksDep.value.isUnchanged(dependencyPrevious, false)
+				}
+			} else {
+				var initialValueState valueState[TReference, TMetadata]
+				if dependencyPrevious == nil {
+					// Keys that have no dependencies
+					// and don't yield a message value
+					// are omitted from the outcomes.
+					// Their native values are a pure
+					// function of the key, so they
+					// cannot change. Overridden keys
+					// are always present in rc.keys.
+					rc.lock.Unlock()
+					continue
+				} else if dependencyPrevious.value.IsSet() {
+					initialValueState = &messageValueState[TReference, TMetadata]{}
+				} else if dependencyPrevious.nativeValue {
+					initialValueState = &nativeValueState[TReference, TMetadata]{}
+				} else {
+					// Evaluation of the dependency
+					// failed previously.
+					rc.lock.Unlock()
+					e.clearDependencies()
+					return previousDependenciesChanged, nil
+				}
+				ksDep = rc.getOrCreateKeyStateLocked(dependency.key, dependency.keyHash, initialValueState)
+				ksDep.previous = dependencyPrevious
+				ksDep.previousDependencies = dependencyPrevious.getInitialDependenciesState()
+			}
+		} else {
+			rc.lock.Lock()
+		}
+
+		switch ksDep.err {
+		case nil:
+			if !ksDep.unchanged {
+				rc.lock.Unlock()
+				e.clearDependencies()
+				return previousDependenciesChanged, nil
+			}
+		case errKeyNotEvaluated{}:
+			// Validation can only continue once the
+			// dependency has been evaluated.
+			e.addDependencyLocked(ksDep)
+			rc.lock.Unlock()
+			return previousDependenciesUnvalidated, ErrMissingDependency
+		default:
+			rc.lock.Unlock()
+			e.clearDependencies()
+			return previousDependenciesChanged, nil
+		}
+		e.addDependencyLocked(ksDep)
+		rc.lock.Unlock()
+	}
+	return previousDependenciesUnchanged, nil
 }
 
 func (e *recursivelyComputingEnvironment[TReference, TMetadata]) CaptureCreatedObject(ctx context.Context, createdObject model_core.CreatedObject[TMetadata]) (TMetadata, error) {
@@ -454,7 +677,7 @@ func (e *recursivelyComputingEnvironment[TReference, TMetadata]) getValueState(p
 	defer rc.lock.Unlock()
 
 	ks := rc.getOrCreateKeyStateLocked(key, keyHash, initialValueState)
-	e.dependencies[ks] = struct{}{}
+	e.addDependencyLocked(ks)
 	if ks.err != nil {
 		return nil
 	}
@@ -557,6 +780,19 @@ type KeyState[TReference object.BasicReference, TMetadata model_core.ReferenceMe
 	// last evaluation of this key.
 	dependencies []*KeyState[TReference, TMetadata]
 
+	// If set, the value and dependencies of this key that were
+	// obtained during a previous evaluation.
+	previous             *previousEvaluation[TReference]
+	previousDependencies previousDependenciesState
+
+	// Set if the value of this key was provided through
+	// InjectKeyState(), as opposed to being computed.
+	overridden bool
+
+	// Set if evaluation of this key completed, and yielded a value
+	// that is identical to the one of the previous evaluation.
+	unchanged bool
+
 	completionWait chan struct{}
 	value          valueState[TReference, TMetadata]
 	err            error
@@ -569,6 +805,10 @@ type KeyState[TReference object.BasicReference, TMetadata model_core.ReferenceMe
 type valueState[TReference object.BasicReference, TMetadata model_core.ReferenceMetadata] interface {
 	compute(ctx context.Context, e *recursivelyComputingEnvironment[TReference, TMetadata]) error
 	getMessageValue() model_core.Message[proto.Message, TReference]
+
+	// Methods for performing incremental evaluation.
+	reusePreviousEvaluation(previous *previousEvaluation[TReference]) bool
+	isUnchanged(previous *previousEvaluation[TReference], dependenciesUnchanged bool) bool
 }
 
 type messageValueState[TReference object.BasicReference, TMetadata model_core.ReferenceMetadata] struct {
@@ -588,6 +828,18 @@ func (vs *messageValueState[TReference, TMetadata]) getMessageValue() model_core
 	return vs.value
 }
 
+func (vs *messageValueState[TReference, TMetadata]) reusePreviousEvaluation(previous *previousEvaluation[TReference]) bool {
+	if !previous.value.IsSet() {
+		return false
+	}
+	vs.value = previous.value
+	return true
+}
+
+func (vs *messageValueState[TReference, TMetadata]) isUnchanged(previous *previousEvaluation[TReference], dependenciesUnchanged bool) bool {
+	return previous != nil && previous.value.IsSet() && model_core.MessagesEqual(vs.value, previous.value)
+}
+
 type nativeValueState[TReference object.BasicReference, TMetadata model_core.ReferenceMetadata] struct {
 	value any
 }
@@ -605,6 +857,64 @@ func (nativeValueState[TReference, TMetadata]) getMessageValue() model_core.Mess
 	return model_core.Message[proto.Message, TReference]{}
 }
 
+func (nativeValueState[TReference, TMetadata]) reusePreviousEvaluation(previous *previousEvaluation[TReference]) bool {
+	// Native values are not preserved across evaluations, meaning
+	// they always need to be recomputed.
+	return false
+}
+
+func (nativeValueState[TReference, TMetadata]) isUnchanged(previous *previousEvaluation[TReference], dependenciesUnchanged bool) bool {
+	// Native values cannot be compared. Assume that they are
+	// unchanged if all of their dependencies are unchanged.
+	return dependenciesUnchanged
+}
+
+// previousEvaluation contains the value and dependencies of a key that
+// were obtained during a previous evaluation.
+type previousEvaluation[TReference any] struct {
+	value        model_core.Message[proto.Message, TReference]
+	nativeValue  bool
+	overridden   bool
+	dependencies []previousDependency[TReference]
+}
+
+// getInitialDependenciesState returns whether the dependencies of a
+// previous evaluation of a key need to be validated before its value
+// can be reused.
+func (pe *previousEvaluation[TReference]) getInitialDependenciesState() previousDependenciesState {
+	if pe == nil || pe.overridden {
+		// The key was not evaluated previously, or its value
+		// was provided through an override. In the latter case
+		// there is no computed value that can be reused.
+		return previousDependenciesChanged
+	}
+	return previousDependenciesUnvalidated
+}
+
+type previousDependency[TReference any] struct {
+	key     model_core.TopLevelMessage[proto.Message, TReference]
+	keyHash [sha256.Size]byte
+}
+
+// previousDependenciesState indicates whether the dependencies of a
+// key during a previous evaluation have been validated, and if so
+// whether any of them changed.
+type previousDependenciesState int
+
+const (
+	// The key was not evaluated previously, or the dependencies
+	// of the previous evaluation have changed.
+	previousDependenciesChanged previousDependenciesState = iota
+	// The dependencies of the previous evaluation still need to
+	// be validated.
+	previousDependenciesUnvalidated
+	// None of the dependencies of the previous evaluation have
+	// changed.
+	previousDependenciesUnchanged
+	// The previous evaluation of the key has not been looked up.
+	previousDependenciesUnknown
+)
+
 // errKeyNotEvaluated is a placeholder value that is assigned to
 // KeyState.err to indicate that evaluation of a given key has not yet
 // been completed.
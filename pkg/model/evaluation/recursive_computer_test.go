@@ -2,6 +2,7 @@ package evaluation_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"testing"
 
@@ -238,4 +239,282 @@ func TestRecursiveComputer(t *testing.T) {
 			}),
 		)
 	})
+
+	t.Run("Incremental", func(t *testing.T) {
+		// Keys 1 and 2 are computed as follows, where the value
+		// of key 0 is provided through an override:
+		//
+		//     value(1) = value(0) % 2
+		//     value(2) = value(1)
+		//
+		// Outcomes of a previous evaluation are provided where
+		// value(0) = 5. This means that the values of keys 1
+		// and 2 should only be recomputed if needed.
+		newKey := func(k uint32) model_core.TopLevelMessage[proto.Message, object.LocalReference] {
+			return model_core.NewSimpleTopLevelMessage[object.LocalReference, proto.Message](
+				&wrapperspb.UInt32Value{Value: k},
+			)
+		}
+		newValue := func(v uint64) model_core.Message[proto.Message, object.LocalReference] {
+			return model_core.NewSimpleMessage[object.LocalReference, proto.Message](
+				&wrapperspb.UInt64Value{Value: v},
+			)
+		}
+		computeValue := func(ctx context.Context, key model_core.Message[proto.Message, object.LocalReference], e model_evaluation.Environment[object.LocalReference, model_core.ReferenceMetadata]) (model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata], error) {
+			k := key.Message.(*wrapperspb.UInt32Value)
+			v := e.GetMessageValue(model_core.NewSimplePatchedMessage[model_core.ReferenceMetadata, proto.Message](&wrapperspb.UInt32Value{
+				Value: k.Value - 1,
+			}))
+			if !v.IsSet() {
+				return model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata]{}, model_evaluation.ErrMissingDependency
+			}
+			value := v.Message.(*wrapperspb.UInt64Value).Value
+			if k.Value == 1 {
+				value %= 2
+			}
+			return model_core.NewSimplePatchedMessage[model_core.ReferenceMetadata, proto.Message](
+				&wrapperspb.UInt64Value{Value: value},
+			), nil
+		}
+		evaluate := func(t *testing.T, computer model_evaluation.ComputerForTesting, overrideValue *uint64) uint64 {
+			objectManager := NewMockObjectManagerForTesting(ctrl)
+			queuesFactory := model_evaluation.NewSimpleRecursiveComputerQueuesFactory[object.LocalReference, model_core.ReferenceMetadata](1)
+			queues := queuesFactory.NewQueues()
+			recursiveComputer := model_evaluation.NewRecursiveComputer(computer, queues, objectManager, clock.SystemClock)
+
+			previousEvaluations := previousEvaluationsForTesting{}
+			for _, previousEvaluation := range []model_evaluation.Evaluation[object.LocalReference]{
+				{Key: newKey(0), Value: newValue(5), Overridden: true},
+				{Key: newKey(1), Value: newValue(1), Dependencies: []model_core.TopLevelMessage[proto.Message, object.LocalReference]{newKey(0)}},
+				{Key: newKey(2), Value: newValue(1), Dependencies: []model_core.TopLevelMessage[proto.Message, object.LocalReference]{newKey(1)}},
+			} {
+				previousEvaluations.add(t, previousEvaluation)
+			}
+			recursiveComputer.SetPreviousEvaluationLookup(previousEvaluations)
+			if overrideValue != nil {
+				require.NoError(t, recursiveComputer.InjectKeyState(newKey(0), newValue(*overrideValue)))
+			}
+			keyState, err := recursiveComputer.GetOrCreateKeyState(newKey(2))
+			require.NoError(t, err)
+
+			var value model_core.Message[proto.Message, object.LocalReference]
+			require.NoError(
+				t,
+				program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+					queues.ProcessAllQueuedKeys(dependenciesGroup, recursiveComputer)
+
+					var err error
+					value, err = recursiveComputer.WaitForMessageValue(ctx, keyState)
+					return err
+				}),
+			)
+			return value.Message.(*wrapperspb.UInt64Value).Value
+		}
+
+		t.Run("Unchanged", func(t *testing.T) {
+			// If the override has the same value as
+			// before, none of the keys need to be
+			// recomputed.
+			computer := NewMockComputerForTesting(ctrl)
+			require.Equal(t, uint64(1), evaluate(t, computer, proto.Uint64(5)))
+		})
+
+		t.Run("ChangePruning", func(t *testing.T) {
+			// Changing the override from 5 to 7 requires
+			// that key 1 is recomputed. As it yields the
+			// same value, key 2 does not need to be
+			// recomputed.
+			computer := NewMockComputerForTesting(ctrl)
+			computer.EXPECT().ComputeMessageValue(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message, object.LocalReference], e model_evaluation.Environment[object.LocalReference, model_core.ReferenceMetadata]) (model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata], error) {
+					require.Equal(t, uint32(1), key.Message.(*wrapperspb.UInt32Value).Value)
+					return computeValue(ctx, key, e)
+				})
+			require.Equal(t, uint64(1), evaluate(t, computer, proto.Uint64(7)))
+		})
+
+		t.Run("Changed", func(t *testing.T) {
+			// Changing the override from 5 to 6 causes key
+			// 1 to yield a different value, meaning key 2
+			// needs to be recomputed as well.
+			computer := NewMockComputerForTesting(ctrl)
+			computer.EXPECT().ComputeMessageValue(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(computeValue).
+				Times(2)
+			require.Equal(t, uint64(0), evaluate(t, computer, proto.Uint64(6)))
+		})
+
+		t.Run("OverrideRemoved", func(t *testing.T) {
+			// If key 0 is no longer overridden, its value
+			// must be computed, even though it has no
+			// dependencies. As it yields a different value,
+			// keys 1 and 2 need to be recomputed as well.
+			computer := NewMockComputerForTesting(ctrl)
+			computer.EXPECT().ComputeMessageValue(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message, object.LocalReference], e model_evaluation.Environment[object.LocalReference, model_core.ReferenceMetadata]) (model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata], error) {
+					if key.Message.(*wrapperspb.UInt32Value).Value == 0 {
+						return model_core.NewSimplePatchedMessage[model_core.ReferenceMetadata, proto.Message](
+							&wrapperspb.UInt64Value{Value: 6},
+						), nil
+					}
+					return computeValue(ctx, key, e)
+				}).
+				Times(3)
+			require.Equal(t, uint64(0), evaluate(t, computer, nil))
+		})
+	})
+
+	t.Run("IncrementalMultipleDependencies", func(t *testing.T) {
+		// Key 20 depends on two keys, where the second key that
+		// is requested depends on the value of the first:
+		//
+		//     value(1)  = value(0) % 2
+		//     value(20) = value(1) == 1 ? value(21) : value(22)
+		//     value(21) = value(0) + 100
+		//     value(22) = value(0) + 200
+		//
+		// The value of key 0 is provided through an override.
+		// Outcomes of a previous evaluation are provided where
+		// value(0) = 5.
+		newKey := func(k uint32) model_core.TopLevelMessage[proto.Message, object.LocalReference] {
+			return model_core.NewSimpleTopLevelMessage[object.LocalReference, proto.Message](
+				&wrapperspb.UInt32Value{Value: k},
+			)
+		}
+		newValue := func(v uint64) model_core.Message[proto.Message, object.LocalReference] {
+			return model_core.NewSimpleMessage[object.LocalReference, proto.Message](
+				&wrapperspb.UInt64Value{Value: v},
+			)
+		}
+		computeValue := func(ctx context.Context, key model_core.Message[proto.Message, object.LocalReference], e model_evaluation.Environment[object.LocalReference, model_core.ReferenceMetadata]) (model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata], error) {
+			getValue := func(k uint32) (uint64, bool) {
+				v := e.GetMessageValue(model_core.NewSimplePatchedMessage[model_core.ReferenceMetadata, proto.Message](&wrapperspb.UInt32Value{
+					Value: k,
+				}))
+				if !v.IsSet() {
+					return 0, false
+				}
+				return v.Message.(*wrapperspb.UInt64Value).Value, true
+			}
+
+			var value uint64
+			switch k := key.Message.(*wrapperspb.UInt32Value).Value; k {
+			case 1:
+				v, ok := getValue(0)
+				if !ok {
+					return model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata]{}, model_evaluation.ErrMissingDependency
+				}
+				value = v % 2
+			case 20:
+				v, ok := getValue(1)
+				if !ok {
+					return model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata]{}, model_evaluation.ErrMissingDependency
+				}
+				if v == 1 {
+					value, ok = getValue(21)
+				} else {
+					value, ok = getValue(22)
+				}
+				if !ok {
+					return model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata]{}, model_evaluation.ErrMissingDependency
+				}
+			case 21, 22:
+				v, ok := getValue(0)
+				if !ok {
+					return model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata]{}, model_evaluation.ErrMissingDependency
+				}
+				value = v + uint64(k-20)*100
+			default:
+				t.Fatalf("Unexpected key %d", k)
+			}
+			return model_core.NewSimplePatchedMessage[model_core.ReferenceMetadata, proto.Message](
+				&wrapperspb.UInt64Value{Value: value},
+			), nil
+		}
+		evaluate := func(t *testing.T, computer model_evaluation.ComputerForTesting, overrideValue uint64) uint64 {
+			objectManager := NewMockObjectManagerForTesting(ctrl)
+			queuesFactory := model_evaluation.NewSimpleRecursiveComputerQueuesFactory[object.LocalReference, model_core.ReferenceMetadata](1)
+			queues := queuesFactory.NewQueues()
+			recursiveComputer := model_evaluation.NewRecursiveComputer(computer, queues, objectManager, clock.SystemClock)
+
+			previousEvaluations := previousEvaluationsForTesting{}
+			for _, previousEvaluation := range []model_evaluation.Evaluation[object.LocalReference]{
+				{Key: newKey(0), Value: newValue(5), Overridden: true},
+				{Key: newKey(1), Value: newValue(1), Dependencies: []model_core.TopLevelMessage[proto.Message, object.LocalReference]{newKey(0)}},
+				{Key: newKey(20), Value: newValue(105), Dependencies: []model_core.TopLevelMessage[proto.Message, object.LocalReference]{newKey(1), newKey(21)}},
+				{Key: newKey(21), Value: newValue(105), Dependencies: []model_core.TopLevelMessage[proto.Message, object.LocalReference]{newKey(0)}},
+			} {
+				previousEvaluations.add(t, previousEvaluation)
+			}
+			recursiveComputer.SetPreviousEvaluationLookup(previousEvaluations)
+			require.NoError(t, recursiveComputer.InjectKeyState(newKey(0), newValue(overrideValue)))
+			keyState, err := recursiveComputer.GetOrCreateKeyState(newKey(20))
+			require.NoError(t, err)
+
+			var value model_core.Message[proto.Message, object.LocalReference]
+			require.NoError(
+				t,
+				program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+					queues.ProcessAllQueuedKeys(dependenciesGroup, recursiveComputer)
+
+					var err error
+					value, err = recursiveComputer.WaitForMessageValue(ctx, keyState)
+					return err
+				}),
+			)
+			return value.Message.(*wrapperspb.UInt64Value).Value
+		}
+
+		t.Run("Unchanged", func(t *testing.T) {
+			computer := NewMockComputerForTesting(ctrl)
+			require.Equal(t, uint64(105), evaluate(t, computer, 5))
+		})
+
+		t.Run("SecondDependencyChanged", func(t *testing.T) {
+			// Changing the override from 5 to 7 causes key
+			// 1 to be recomputed, yielding the same value.
+			// Key 21 yields a different value, meaning key
+			// 20 needs to be recomputed as well.
+			computer := NewMockComputerForTesting(ctrl)
+			computer.EXPECT().ComputeMessageValue(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(computeValue).
+				Times(3)
+			require.Equal(t, uint64(107), evaluate(t, computer, 7))
+		})
+
+		t.Run("FirstDependencyChanged", func(t *testing.T) {
+			// Changing the override from 5 to 6 causes key
+			// 1 to yield a different value. Key 20 should
+			// then be recomputed, causing it to depend on
+			// key 22 instead of key 21. As key 21 is no
+			// longer needed, it should not be evaluated
+			// while validating the dependencies of key 20.
+			var computedKeys []uint32
+			computer := NewMockComputerForTesting(ctrl)
+			computer.EXPECT().ComputeMessageValue(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message, object.LocalReference], e model_evaluation.Environment[object.LocalReference, model_core.ReferenceMetadata]) (model_core.PatchedMessage[proto.Message, model_core.ReferenceMetadata], error) {
+					computedKeys = append(computedKeys, key.Message.(*wrapperspb.UInt32Value).Value)
+					return computeValue(ctx, key, e)
+				}).
+				AnyTimes()
+			require.Equal(t, uint64(206), evaluate(t, computer, 6))
+			require.NotContains(t, computedKeys, uint32(21))
+		})
+	})
+}
+
+// previousEvaluationsForTesting is a simple implementation of
+// PreviousEvaluationLookup that is backed by a map.
+type previousEvaluationsForTesting map[[sha256.Size]byte]*model_evaluation.Evaluation[object.LocalReference]
+
+func (pe previousEvaluationsForTesting) add(t *testing.T, evaluation model_evaluation.Evaluation[object.LocalReference]) {
+	anyKey, err := model_core.MarshalTopLevelAny(evaluation.Key)
+	require.NoError(t, err)
+	marshaledKey, err := model_core.MarshalTopLevelMessage(anyKey)
+	require.NoError(t, err)
+	pe[sha256.Sum256(marshaledKey)] = &evaluation
+}
+
+func (pe previousEvaluationsForTesting) LookupPreviousEvaluation(ctx context.Context, keyHash [sha256.Size]byte) (*model_evaluation.Evaluation[object.LocalReference], error) {
+	return pe[keyHash], nil
 }
@@ -37,6 +37,7 @@ func (c *namespaceAddingClient[TNamespace, TReference]) RunAction(
 	platformECDHPublicKey *ecdh.PublicKey,
 	action *Action[object.LocalReference],
 	actionAdditionalData *encryptedaction_pb.Action_AdditionalData,
+	priority int32,
 	resultReferenceOut *model_core.Decodable[object.LocalReference],
 	errOut *error,
 ) iter.Seq[model_core.Decodable[object.LocalReference]] {
@@ -54,6 +55,7 @@ func (c *namespaceAddingClient[TNamespace, TReference]) RunAction(
 			Format:   action.Format,
 		},
 		actionAdditionalData,
+		priority,
 		resultReferenceOut,
 		errOut,
 	)
@@ -39,6 +39,7 @@ func (c *objectExportingClient[TInternal, TExternal]) RunAction(
 	platformECDHPublicKey *ecdh.PublicKey,
 	action *Action[TInternal],
 	actionAdditionalData *encryptedaction_pb.Action_AdditionalData,
+	priority int32,
 	resultReferenceOut *model_core.Decodable[TInternal],
 	errOut *error,
 ) iter.Seq[model_core.Decodable[TInternal]] {
@@ -63,6 +64,7 @@ func (c *objectExportingClient[TInternal, TExternal]) RunAction(
 			Format:   action.Format,
 		},
 		actionAdditionalData,
+		priority,
 		&baseResultReference,
 		&baseErr,
 	)
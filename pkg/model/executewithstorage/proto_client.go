@@ -56,6 +56,7 @@ func (c *protoClient) RunAction(
 	platformECDHPublicKey *ecdh.PublicKey,
 	action *Action[object.GlobalReference],
 	actionAdditionalData *encryptedaction_pb.Action_AdditionalData,
+	priority int32,
 	resultReferenceOut *model_core.Decodable[object.LocalReference],
 	errOut *error,
 ) iter.Seq[model_core.Decodable[object.LocalReference]] {
@@ -73,6 +74,7 @@ func (c *protoClient) RunAction(
 			ActionFormat:    action.Format,
 		},
 		actionAdditionalData,
+		priority,
 		&resultReferenceMessage,
 		&errBase,
 	)
@@ -0,0 +1,162 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"strings"
+
+	model_fetch_pb "bonanza.build/pkg/proto/model/fetch"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GitCloner is capable of cloning a single commit of a git repository
+// into a local directory. It invokes the git command line tool,
+// fetching no more history than needed to check out the commit.
+type GitCloner struct {
+	executablePath       string
+	workingDirectoryPath string
+}
+
+// NewGitCloner creates a GitCloner that invokes the git executable at
+// the provided path. Repositories are cloned into uniquely named
+// subdirectories of the provided working directory.
+func NewGitCloner(executablePath, workingDirectoryPath string) *GitCloner {
+	return &GitCloner{
+		executablePath:       executablePath,
+		workingDirectoryPath: workingDirectoryPath,
+	}
+}
+
+// Clone a git repository at the commit or tag specified in the target.
+// Upon success, the path of the directory containing the working tree
+// and the hash of the commit that was checked out are returned. The
+// caller is responsible for removing the directory.
+func (gc *GitCloner) Clone(ctx context.Context, target *model_fetch_pb.GitTarget) (string, string, error) {
+	remote := target.Remote
+	if remote == "" {
+		return "", "", status.Error(codes.InvalidArgument, "No remote provided")
+	}
+	if strings.HasPrefix(remote, "-") {
+		return "", "", status.Errorf(codes.InvalidArgument, "Invalid remote %#v", remote)
+	}
+
+	var ref string
+	switch {
+	case target.Commit != "" && target.Tag != "":
+		return "", "", status.Error(codes.InvalidArgument, "Commit and tag are mutually exclusive")
+	case target.Commit != "":
+		if !isFullCommitHash(target.Commit) {
+			return "", "", status.Errorf(codes.InvalidArgument, "Commit %#v is not a full commit hash", target.Commit)
+		}
+		ref = target.Commit
+	case target.Tag != "":
+		if strings.HasPrefix(target.Tag, "-") || strings.Contains(target.Tag, ":") {
+			return "", "", status.Errorf(codes.InvalidArgument, "Invalid tag %#v", target.Tag)
+		}
+		ref = "refs/tags/" + target.Tag
+	default:
+		return "", "", status.Error(codes.InvalidArgument, "No commit or tag provided")
+	}
+
+	directoryPath, err := os.MkdirTemp(gc.workingDirectoryPath, "git")
+	if err != nil {
+		return "", "", util.StatusWrapWithCode(err, codes.Internal, "Failed to create working directory")
+	}
+	commit, err := gc.cloneIntoDirectory(ctx, target, ref, directoryPath)
+	if err != nil {
+		os.RemoveAll(directoryPath)
+		return "", "", err
+	}
+	return directoryPath, commit, nil
+}
+
+func (gc *GitCloner) cloneIntoDirectory(ctx context.Context, target *model_fetch_pb.GitTarget, ref, directoryPath string) (string, error) {
+	// Submodules referring to local paths are only permitted if the
+	// repository itself is local. Otherwise a remote repository
+	// could be used to read arbitrary repositories stored on the
+	// system running the fetcher.
+	var configArguments []string
+	if strings.HasPrefix(target.Remote, "file://") {
+		configArguments = []string{"-c", "protocol.file.allow=always"}
+	}
+
+	if _, err := gc.run(ctx, directoryPath, "init", "--quiet"); err != nil {
+		return "", err
+	}
+	if _, err := gc.run(ctx, directoryPath, "remote", "add", "origin", target.Remote); err != nil {
+		return "", err
+	}
+
+	// Attempt a shallow fetch of the requested commit or tag. Not
+	// all servers permit fetching commits by hash, in which case we
+	// fall back to fetching all branches and tags.
+	checkoutRef := "FETCH_HEAD"
+	if _, err := gc.run(ctx, directoryPath, "fetch", "--quiet", "--depth=1", "--no-tags", "origin", ref); err != nil {
+		if target.Commit == "" {
+			return "", err
+		}
+		if _, err := gc.run(ctx, directoryPath, "fetch", "--quiet", "origin", "+refs/heads/*:refs/remotes/origin/*", "+refs/tags/*:refs/tags/*"); err != nil {
+			return "", err
+		}
+		checkoutRef = target.Commit
+	}
+	if _, err := gc.run(ctx, directoryPath, "-c", "advice.detachedHead=false", "checkout", "--quiet", "--detach", checkoutRef); err != nil {
+		return "", err
+	}
+
+	output, err := gc.run(ctx, directoryPath, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	commit := strings.TrimSpace(output)
+	if target.Commit != "" && commit != target.Commit {
+		return "", status.Errorf(codes.InvalidArgument, "Checked out commit %s, while %s was expected", commit, target.Commit)
+	}
+
+	if target.InitSubmodules {
+		submoduleArguments := append(configArguments, "submodule", "update", "--quiet", "--init", "--recursive")
+		if _, err := gc.run(ctx, directoryPath, append(submoduleArguments, "--depth=1")...); err != nil {
+			// Shallow fetching fails if the commit of the
+			// submodule is not at the tip of any of its
+			// branches. Retry without limiting the depth.
+			if _, err := gc.run(ctx, directoryPath, submoduleArguments...); err != nil {
+				return "", err
+			}
+		}
+	}
+	return commit, nil
+}
+
+// run the git command line tool within a given directory, returning
+// its standard output.
+func (gc *GitCloner) run(ctx context.Context, directoryPath string, arguments ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, gc.executablePath, arguments...)
+	cmd.Dir = directoryPath
+	// Never prompt for credentials, as there is no terminal to read
+	// them from.
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", status.Errorf(codes.Internal, "Failed to run \"git %s\": %s: %s", strings.Join(arguments, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// isFullCommitHash returns true if a string is a full SHA-1 or SHA-256
+// commit hash in lowercase hexadecimal form.
+func isFullCommitHash(commit string) bool {
+	if len(commit) != 40 && len(commit) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(commit)
+	return err == nil && strings.ToLower(commit) == commit
+}
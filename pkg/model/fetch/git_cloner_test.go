@@ -0,0 +1,167 @@
+package fetch_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	model_fetch "bonanza.build/pkg/model/fetch"
+	model_fetch_pb "bonanza.build/pkg/proto/model/fetch"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// runGit runs the git command line tool in a given directory, returning
+// its output with trailing whitespace removed.
+func runGit(t *testing.T, directoryPath string, arguments ...string) string {
+	cmd := exec.Command("git", append([]string{"-c", "protocol.file.allow=always"}, arguments...)...)
+	cmd.Dir = directoryPath
+	cmd.Env = append(
+		os.Environ(),
+		"GIT_AUTHOR_NAME=Author",
+		"GIT_AUTHOR_EMAIL=author@example.com",
+		"GIT_COMMITTER_NAME=Committer",
+		"GIT_COMMITTER_EMAIL=committer@example.com",
+	)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+	return strings.TrimSpace(string(output))
+}
+
+// createBareRepository creates a bare git repository containing a
+// single commit, consisting of the provided files.
+func createBareRepository(t *testing.T, files map[string]string) (string, string) {
+	workingTreePath := t.TempDir()
+	runGit(t, workingTreePath, "init", "--quiet")
+	for name, contents := range files {
+		filePath := filepath.Join(workingTreePath, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o777))
+		require.NoError(t, os.WriteFile(filePath, []byte(contents), 0o666))
+	}
+	runGit(t, workingTreePath, "add", ".")
+	runGit(t, workingTreePath, "commit", "--quiet", "-m", "Initial commit")
+	runGit(t, workingTreePath, "tag", "v1.0")
+
+	barePath := filepath.Join(t.TempDir(), "repository.git")
+	runGit(t, workingTreePath, "clone", "--quiet", "--bare", workingTreePath, barePath)
+	return barePath, runGit(t, workingTreePath, "rev-parse", "HEAD")
+}
+
+func TestGitCloner(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	ctx := context.Background()
+	workingDirectoryPath := t.TempDir()
+	gitCloner := model_fetch.NewGitCloner("git", workingDirectoryPath)
+
+	barePath, commit := createBareRepository(t, map[string]string{
+		"README.md":       "Hello world\n",
+		"src/lib/main.go": "package main\n",
+	})
+	remote := "file://" + barePath
+
+	t.Run("NoCommitOrTag", func(t *testing.T) {
+		_, _, err := gitCloner.Clone(ctx, &model_fetch_pb.GitTarget{
+			Remote: remote,
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "No commit or tag provided"), err)
+	})
+
+	t.Run("AbbreviatedCommit", func(t *testing.T) {
+		_, _, err := gitCloner.Clone(ctx, &model_fetch_pb.GitTarget{
+			Remote: remote,
+			Commit: commit[:12],
+		})
+		testutil.RequireEqualStatus(t, status.Errorf(codes.InvalidArgument, "Commit %#v is not a full commit hash", commit[:12]), err)
+	})
+
+	t.Run("NonexistentCommit", func(t *testing.T) {
+		_, _, err := gitCloner.Clone(ctx, &model_fetch_pb.GitTarget{
+			Remote: remote,
+			Commit: strings.Repeat("0", len(commit)),
+		})
+		require.Equal(t, codes.Internal, status.Code(err))
+
+		// Working directories of failed clones should be
+		// removed.
+		entries, err := os.ReadDir(workingDirectoryPath)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("Commit", func(t *testing.T) {
+		directoryPath, actualCommit, err := gitCloner.Clone(ctx, &model_fetch_pb.GitTarget{
+			Remote: remote,
+			Commit: commit,
+		})
+		require.NoError(t, err)
+		defer os.RemoveAll(directoryPath)
+		require.Equal(t, commit, actualCommit)
+
+		contents, err := os.ReadFile(filepath.Join(directoryPath, "src/lib/main.go"))
+		require.NoError(t, err)
+		require.Equal(t, "package main\n", string(contents))
+	})
+
+	t.Run("Tag", func(t *testing.T) {
+		directoryPath, actualCommit, err := gitCloner.Clone(ctx, &model_fetch_pb.GitTarget{
+			Remote: remote,
+			Tag:    "v1.0",
+		})
+		require.NoError(t, err)
+		defer os.RemoveAll(directoryPath)
+		require.Equal(t, commit, actualCommit)
+
+		contents, err := os.ReadFile(filepath.Join(directoryPath, "README.md"))
+		require.NoError(t, err)
+		require.Equal(t, "Hello world\n", string(contents))
+	})
+
+	t.Run("Submodules", func(t *testing.T) {
+		// Create a repository that has the repository created
+		// above as a submodule.
+		workingTreePath := t.TempDir()
+		runGit(t, workingTreePath, "init", "--quiet")
+		runGit(t, workingTreePath, "submodule", "add", "--quiet", remote, "third_party/library")
+		runGit(t, workingTreePath, "commit", "--quiet", "-m", "Add submodule")
+		parentCommit := runGit(t, workingTreePath, "rev-parse", "HEAD")
+		parentBarePath := filepath.Join(t.TempDir(), "parent.git")
+		runGit(t, workingTreePath, "clone", "--quiet", "--bare", workingTreePath, parentBarePath)
+
+		t.Run("Disabled", func(t *testing.T) {
+			directoryPath, _, err := gitCloner.Clone(ctx, &model_fetch_pb.GitTarget{
+				Remote: "file://" + parentBarePath,
+				Commit: parentCommit,
+			})
+			require.NoError(t, err)
+			defer os.RemoveAll(directoryPath)
+
+			entries, err := os.ReadDir(filepath.Join(directoryPath, "third_party/library"))
+			require.NoError(t, err)
+			require.Empty(t, entries)
+		})
+
+		t.Run("Enabled", func(t *testing.T) {
+			directoryPath, _, err := gitCloner.Clone(ctx, &model_fetch_pb.GitTarget{
+				Remote:         "file://" + parentBarePath,
+				Commit:         parentCommit,
+				InitSubmodules: true,
+			})
+			require.NoError(t, err)
+			defer os.RemoveAll(directoryPath)
+
+			contents, err := os.ReadFile(filepath.Join(directoryPath, "third_party/library/src/lib/main.go"))
+			require.NoError(t, err)
+			require.Equal(t, "package main\n", string(contents))
+		})
+	})
+}
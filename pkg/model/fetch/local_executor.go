@@ -12,6 +12,8 @@ import (
 	"io/fs"
 	"math"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	model_core "bonanza.build/pkg/model/core"
@@ -21,6 +23,7 @@ import (
 	model_parser "bonanza.build/pkg/model/parser"
 	model_core_pb "bonanza.build/pkg/proto/model/core"
 	model_fetch_pb "bonanza.build/pkg/proto/model/fetch"
+	model_filesystem_pb "bonanza.build/pkg/proto/model/filesystem"
 	remoteworker_pb "bonanza.build/pkg/proto/remoteworker"
 	dag_pb "bonanza.build/pkg/proto/storage/dag"
 	"bonanza.build/pkg/remoteworker"
@@ -33,6 +36,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -47,6 +51,7 @@ type localExecutor struct {
 	httpClient                    *http.Client
 	filePool                      pool.FilePool
 	cacheDirectory                filesystem.Directory
+	gitCloner                     *GitCloner
 }
 
 func NewLocalExecutor(
@@ -57,6 +62,7 @@ func NewLocalExecutor(
 	httpClient *http.Client,
 	filePool pool.FilePool,
 	cacheDirectory filesystem.Directory,
+	gitCloner *GitCloner,
 ) remoteworker.Executor[*model_executewithstorage.Action[object.GlobalReference], model_core.Decodable[object.LocalReference], model_core.Decodable[object.LocalReference]] {
 	return &localExecutor{
 		objectDownloader:              objectDownloader,
@@ -66,6 +72,7 @@ func NewLocalExecutor(
 		httpClient:                    httpClient,
 		filePool:                      filePool,
 		cacheDirectory:                cacheDirectory,
+		gitCloner:                     gitCloner,
 	}
 }
 
@@ -99,6 +106,15 @@ func (e *localExecutor) Execute(ctx context.Context, action *model_executewithst
 		),
 	)
 
+	// Working trees of git repositories can only be removed after
+	// uploading completes, as files are read from them lazily.
+	var gitWorkingTreePath string
+	defer func() {
+		if gitWorkingTreePath != "" {
+			os.RemoveAll(gitWorkingTreePath)
+		}
+	}()
+
 	var virtualExecutionDuration time.Duration
 	result := model_core.MustBuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[dag.ObjectContentsWalker]) *model_fetch_pb.Result {
 		var result model_fetch_pb.Result
@@ -125,6 +141,53 @@ func (e *localExecutor) Execute(ctx context.Context, action *model_executewithst
 			return &result
 		}
 
+		if gitTarget := action.Message.GitTarget; gitTarget != nil {
+			if e.gitCloner == nil {
+				result.Outcome = &model_fetch_pb.Result_Failure{
+					Failure: status.New(codes.Unimplemented, "This worker has not been configured to clone git repositories").Proto(),
+				}
+				return &result
+			}
+			directoryCreationParameters, err := model_filesystem.NewDirectoryCreationParametersFromProto(action.Message.DirectoryCreationParameters, referenceFormat)
+			if err != nil {
+				result.Outcome = &model_fetch_pb.Result_Failure{
+					Failure: status.Convert(util.StatusWrap(err, "Invalid directory creation parameters")).Proto(),
+				}
+				return &result
+			}
+
+			workingTreePath, commit, err := e.gitCloner.Clone(ctx, gitTarget)
+			if err != nil {
+				result.Outcome = &model_fetch_pb.Result_Failure{
+					Failure: status.Convert(util.StatusWrapf(err, "Failed to clone git repository %#v", gitTarget.Remote)).Proto(),
+				}
+				return &result
+			}
+			gitWorkingTreePath = workingTreePath
+
+			rootDirectoryReference, err := e.captureGitWorkingTree(
+				ctx,
+				workingTreePath,
+				gitTarget.StripPrefix,
+				referenceFormat,
+				fileCreationParameters,
+				directoryCreationParameters,
+			)
+			if err != nil {
+				result.Outcome = &model_fetch_pb.Result_Failure{
+					Failure: status.Convert(util.StatusWrap(err, "Failed to capture working tree of git repository")).Proto(),
+				}
+				return &result
+			}
+			result.Outcome = &model_fetch_pb.Result_GitSuccess_{
+				GitSuccess: &model_fetch_pb.Result_GitSuccess{
+					RootDirectoryReference: rootDirectoryReference.Merge(patcher),
+					Commit:                 commit,
+				},
+			}
+			return &result
+		}
+
 		target := action.Message.Target
 		if target == nil {
 			result.Outcome = &model_fetch_pb.Result_Failure{
@@ -317,3 +380,135 @@ func (e *localExecutor) Execute(ctx context.Context, action *model_executewithst
 	}
 	return model_core.CopyDecodable(createdResult, resultReference), virtualExecutionDuration, resultCode, nil
 }
+
+// captureGitWorkingTree computes a Merkle tree of the working tree of
+// a git repository that was cloned by GitCloner. Files are not loaded
+// into memory. Instead, they are reread from the working tree while
+// the results are uploaded.
+func (e *localExecutor) captureGitWorkingTree(
+	ctx context.Context,
+	workingTreePath string,
+	stripPrefix string,
+	referenceFormat object.ReferenceFormat,
+	fileCreationParameters *model_filesystem.FileCreationParameters,
+	directoryCreationParameters *model_filesystem.DirectoryCreationParameters,
+) (model_core.PatchedMessage[*model_filesystem_pb.DirectoryReference, dag.ObjectContentsWalker], error) {
+	rootDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(workingTreePath))
+	if err != nil {
+		return model_core.PatchedMessage[*model_filesystem_pb.DirectoryReference, dag.ObjectContentsWalker]{}, util.StatusWrapWithCode(err, codes.Internal, "Failed to open working tree")
+	}
+	if stripPrefix = strings.TrimSuffix(stripPrefix, "/"); stripPrefix != "" {
+		for _, component := range strings.Split(stripPrefix, "/") {
+			name, ok := path.NewComponent(component)
+			if !ok || name == gitDirectoryName {
+				rootDirectory.Close()
+				return model_core.PatchedMessage[*model_filesystem_pb.DirectoryReference, dag.ObjectContentsWalker]{}, status.Errorf(codes.InvalidArgument, "Invalid strip prefix %#v", stripPrefix)
+			}
+			childDirectory, err := rootDirectory.EnterDirectory(name)
+			rootDirectory.Close()
+			if err != nil {
+				return model_core.PatchedMessage[*model_filesystem_pb.DirectoryReference, dag.ObjectContentsWalker]{}, util.StatusWrapf(err, "Failed to open strip prefix directory %#v", stripPrefix)
+			}
+			rootDirectory = childDirectory
+		}
+	}
+	defer rootDirectory.Close()
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	var createdRootDirectory model_filesystem.CreatedDirectory[dag.ObjectContentsWalker]
+	group.Go(func() error {
+		return model_filesystem.CreateDirectoryMerkleTree(
+			groupCtx,
+			e.objectContentsWalkerSemaphore,
+			group,
+			directoryCreationParameters,
+			&gitWorkingTreeCapturableDirectory{
+				DirectoryCloser:        rootDirectory,
+				fileCreationParameters: fileCreationParameters,
+			},
+			model_filesystem.NewSimpleDirectoryMerkleTreeCapturer(model_core.WalkableCreatedObjectCapturer),
+			&createdRootDirectory,
+		)
+	})
+	if err := group.Wait(); err != nil {
+		return model_core.PatchedMessage[*model_filesystem_pb.DirectoryReference, dag.ObjectContentsWalker]{}, err
+	}
+
+	createdRootDirectoryObject, err := model_core.MarshalAndEncode(
+		model_core.ProtoToMarshalable(createdRootDirectory.Message),
+		referenceFormat,
+		directoryCreationParameters.GetEncoder(),
+	)
+	if err != nil {
+		return model_core.PatchedMessage[*model_filesystem_pb.DirectoryReference, dag.ObjectContentsWalker]{}, util.StatusWrap(err, "Failed to marshal root directory")
+	}
+	return model_core.BuildPatchedMessage(func(patcher *model_core.ReferenceMessagePatcher[dag.ObjectContentsWalker]) (*model_filesystem_pb.DirectoryReference, error) {
+		rootDirectoryReference, err := patcher.CaptureAndAddDecodableReference(ctx, createdRootDirectoryObject, model_core.WalkableCreatedObjectCapturer)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to capture root directory")
+		}
+		return createdRootDirectory.ToDirectoryReference(rootDirectoryReference), nil
+	})
+}
+
+var gitDirectoryName = path.MustNewComponent(".git")
+
+// gitWorkingTreeCapturableDirectory is an implementation of
+// CapturableDirectory that is backed by the working tree of a git
+// repository. It hides the ".git" directories (or files, in the case of
+// submodules) that git uses to store its metadata.
+type gitWorkingTreeCapturableDirectory struct {
+	filesystem.DirectoryCloser
+	fileCreationParameters *model_filesystem.FileCreationParameters
+}
+
+func (d *gitWorkingTreeCapturableDirectory) ReadDir() ([]filesystem.FileInfo, error) {
+	entries, err := d.DirectoryCloser.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	filteredEntries := entries[:0]
+	for _, entry := range entries {
+		if entry.Name() != gitDirectoryName {
+			filteredEntries = append(filteredEntries, entry)
+		}
+	}
+	return filteredEntries, nil
+}
+
+func (d *gitWorkingTreeCapturableDirectory) EnterCapturableDirectory(name path.Component) (*model_filesystem.CreatedDirectory[dag.ObjectContentsWalker], model_filesystem.CapturableDirectory[dag.ObjectContentsWalker, dag.ObjectContentsWalker], error) {
+	childDirectory, err := d.DirectoryCloser.EnterDirectory(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, &gitWorkingTreeCapturableDirectory{
+		DirectoryCloser:        childDirectory,
+		fileCreationParameters: d.fileCreationParameters,
+	}, nil
+}
+
+func (d *gitWorkingTreeCapturableDirectory) OpenForFileMerkleTreeCreation(name path.Component) (model_filesystem.CapturableFile[dag.ObjectContentsWalker], error) {
+	f, err := d.DirectoryCloser.OpenRead(name)
+	if err != nil {
+		return nil, err
+	}
+	return &gitWorkingTreeCapturableFile{
+		file:                   f,
+		fileCreationParameters: d.fileCreationParameters,
+	}, nil
+}
+
+type gitWorkingTreeCapturableFile struct {
+	file                   filesystem.FileReader
+	fileCreationParameters *model_filesystem.FileCreationParameters
+}
+
+func (f *gitWorkingTreeCapturableFile) CreateFileMerkleTree(ctx context.Context) (model_core.PatchedMessage[*model_filesystem_pb.FileContents, dag.ObjectContentsWalker], error) {
+	// CreateChunkDiscardingFileMerkleTree() takes ownership of the
+	// file, closing it once all chunks have been uploaded.
+	return model_filesystem.CreateChunkDiscardingFileMerkleTree(ctx, f.fileCreationParameters, f.file)
+}
+
+func (f *gitWorkingTreeCapturableFile) Discard() {
+	f.file.Close()
+}
@@ -0,0 +1,167 @@
+package filesystem
+
+import (
+	"context"
+	"io"
+	"os"
+
+	model_core "bonanza.build/pkg/model/core"
+	model_parser "bonanza.build/pkg/model/parser"
+	model_filesystem_pb "bonanza.build/pkg/proto/model/filesystem"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DirectoryMaterializer can be used to write the contents of a
+// directory Merkle tree to a directory on a local file system. This
+// can, for example, be used by clients to download outputs of a build.
+type DirectoryMaterializer[TReference object.BasicReference] struct {
+	directoryContentsReader model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_filesystem_pb.DirectoryContents, TReference]]
+	leavesReader            model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_filesystem_pb.Leaves, TReference]]
+	fileReader              *FileReader[TReference]
+}
+
+// NewDirectoryMaterializer creates a DirectoryMaterializer that reads
+// directories, leaves and files using the provided readers.
+func NewDirectoryMaterializer[TReference object.BasicReference](
+	directoryContentsReader model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_filesystem_pb.DirectoryContents, TReference]],
+	leavesReader model_parser.ParsedObjectReader[model_core.Decodable[TReference], model_core.Message[*model_filesystem_pb.Leaves, TReference]],
+	fileReader *FileReader[TReference],
+) *DirectoryMaterializer[TReference] {
+	return &DirectoryMaterializer[TReference]{
+		directoryContentsReader: directoryContentsReader,
+		leavesReader:            leavesReader,
+		fileReader:              fileReader,
+	}
+}
+
+// MaterializeDirectory writes the contents of a directory into a
+// local directory. Files, directories and symbolic links that already
+// exist in the local directory and conflict with the directory's
+// contents are replaced. Other files are left alone, meaning that
+// repeated calls can be used to merge multiple directories.
+func (dm *DirectoryMaterializer[TReference]) MaterializeDirectory(ctx context.Context, directory model_core.Message[*model_filesystem_pb.DirectoryContents, TReference], out filesystem.Directory) error {
+	for _, directoryNode := range directory.Message.Directories {
+		name, ok := path.NewComponent(directoryNode.Name)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "Invalid name for directory %#v", directoryNode.Name)
+		}
+		childDirectory, err := DirectoryGetContents(ctx, dm.directoryContentsReader, model_core.Nested(directory, directoryNode.Directory))
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to obtain contents of directory %#v", name.String())
+		}
+
+		// Only create the directory if it doesn't exist yet.
+		// This permits merging multiple directories.
+		if fileInfo, err := out.Lstat(name); err == nil {
+			if fileInfo.Type() != filesystem.FileTypeDirectory {
+				if err := out.Remove(name); err != nil {
+					return util.StatusWrapf(err, "Failed to remove existing file %#v", name.String())
+				}
+				if err := out.Mkdir(name, 0o777); err != nil {
+					return util.StatusWrapf(err, "Failed to create directory %#v", name.String())
+				}
+			}
+		} else if os.IsNotExist(err) {
+			if err := out.Mkdir(name, 0o777); err != nil {
+				return util.StatusWrapf(err, "Failed to create directory %#v", name.String())
+			}
+		} else {
+			return util.StatusWrapf(err, "Failed to obtain properties of %#v", name.String())
+		}
+
+		childOut, err := out.EnterDirectory(name)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to enter directory %#v", name.String())
+		}
+		err = dm.MaterializeDirectory(ctx, childDirectory, childOut)
+		childOut.Close()
+		if err != nil {
+			return util.StatusWrapf(err, "Directory %#v", name.String())
+		}
+	}
+
+	leaves, err := DirectoryGetLeaves(ctx, dm.leavesReader, directory)
+	if err != nil {
+		return err
+	}
+	for _, fileNode := range leaves.Message.Files {
+		name, ok := path.NewComponent(fileNode.Name)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "Invalid name for file %#v", fileNode.Name)
+		}
+		if err := dm.materializeFile(ctx, model_core.Nested(leaves, fileNode.Properties), out, name); err != nil {
+			return util.StatusWrapf(err, "File %#v", name.String())
+		}
+	}
+	for _, symlinkNode := range leaves.Message.Symlinks {
+		name, ok := path.NewComponent(symlinkNode.Name)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "Invalid name for symbolic link %#v", symlinkNode.Name)
+		}
+		if err := removeIfExists(out, name); err != nil {
+			return util.StatusWrapf(err, "Failed to remove existing file %#v", name.String())
+		}
+		if err := out.Symlink(path.UNIXFormat.NewParser(symlinkNode.Target), name); err != nil {
+			return util.StatusWrapf(err, "Failed to create symbolic link %#v", name.String())
+		}
+	}
+	return nil
+}
+
+func (dm *DirectoryMaterializer[TReference]) materializeFile(ctx context.Context, fileProperties model_core.Message[*model_filesystem_pb.FileProperties, TReference], out filesystem.Directory, name path.Component) error {
+	if fileProperties.Message == nil {
+		return status.Error(codes.InvalidArgument, "File properties are missing")
+	}
+	fileContents, err := NewFileContentsEntryFromProto(model_core.Nested(fileProperties, fileProperties.Message.Contents))
+	if err != nil {
+		return util.StatusWrap(err, "Invalid file contents")
+	}
+
+	if err := removeIfExists(out, name); err != nil {
+		return util.StatusWrap(err, "Failed to remove existing file")
+	}
+	perm := os.FileMode(0o666)
+	if fileProperties.Message.IsExecutable {
+		perm = 0o777
+	}
+	w, err := out.OpenWrite(name, filesystem.CreateExcl(perm))
+	if err != nil {
+		return util.StatusWrap(err, "Failed to create file")
+	}
+	if fileContents.EndBytes > 0 {
+		if _, err := io.Copy(
+			io.NewOffsetWriter(w, 0),
+			dm.fileReader.FileOpenRead(ctx, fileContents, 0),
+		); err != nil {
+			w.Close()
+			return util.StatusWrap(err, "Failed to write file contents")
+		}
+	}
+	if err := w.Close(); err != nil {
+		return util.StatusWrap(err, "Failed to close file")
+	}
+	return nil
+}
+
+// removeIfExists removes a file, directory or symbolic link from a
+// directory, if present.
+func removeIfExists(d filesystem.Directory, name path.Component) error {
+	fileInfo, err := d.Lstat(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if fileInfo.Type() == filesystem.FileTypeDirectory {
+		return d.RemoveAll(name)
+	}
+	return d.Remove(name)
+}
@@ -0,0 +1,98 @@
+package filesystem_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	model_core "bonanza.build/pkg/model/core"
+	model_filesystem "bonanza.build/pkg/model/filesystem"
+	model_filesystem_pb "bonanza.build/pkg/proto/model/filesystem"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryMaterializer(t *testing.T) {
+	ctx := context.Background()
+
+	// As the directory that is materialized only contains inline
+	// leaves and empty files, no objects need to be read.
+	directoryMaterializer := model_filesystem.NewDirectoryMaterializer[object.LocalReference](nil, nil, nil)
+
+	t.Run("Merge", func(t *testing.T) {
+		outPath := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(outPath, "dir"), 0o777))
+		require.NoError(t, os.WriteFile(filepath.Join(outPath, "dir", "file"), []byte("Old contents"), 0o666))
+		require.NoError(t, os.WriteFile(filepath.Join(outPath, "dir", "unrelated"), []byte("Hello"), 0o666))
+		require.NoError(t, os.Mkdir(filepath.Join(outPath, "symlink"), 0o777))
+
+		out, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(outPath))
+		require.NoError(t, err)
+		defer out.Close()
+
+		require.NoError(t, directoryMaterializer.MaterializeDirectory(
+			ctx,
+			model_core.NewSimpleMessage[object.LocalReference](&model_filesystem_pb.DirectoryContents{
+				Directories: []*model_filesystem_pb.DirectoryNode{{
+					Name: "dir",
+					Directory: &model_filesystem_pb.Directory{
+						Contents: &model_filesystem_pb.Directory_ContentsInline{
+							ContentsInline: &model_filesystem_pb.DirectoryContents{
+								Leaves: &model_filesystem_pb.DirectoryContents_LeavesInline{
+									LeavesInline: &model_filesystem_pb.Leaves{
+										Files: []*model_filesystem_pb.FileNode{{
+											Name: "file",
+											Properties: &model_filesystem_pb.FileProperties{
+												IsExecutable: true,
+											},
+										}},
+									},
+								},
+							},
+						},
+					},
+				}},
+				Leaves: &model_filesystem_pb.DirectoryContents_LeavesInline{
+					LeavesInline: &model_filesystem_pb.Leaves{
+						Symlinks: []*model_filesystem_pb.SymlinkNode{{
+							Name:   "symlink",
+							Target: "dir/file",
+						}},
+					},
+				},
+			}),
+			out,
+		))
+
+		// Existing files should have been replaced, while
+		// unrelated files should have been left alone.
+		fileInfo, err := os.Stat(filepath.Join(outPath, "dir", "file"))
+		require.NoError(t, err)
+		require.Equal(t, int64(0), fileInfo.Size())
+		require.NotZero(t, fileInfo.Mode()&0o100)
+
+		contents, err := os.ReadFile(filepath.Join(outPath, "dir", "unrelated"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), contents)
+
+		target, err := os.Readlink(filepath.Join(outPath, "symlink"))
+		require.NoError(t, err)
+		require.Equal(t, "dir/file", target)
+	})
+
+	t.Run("MissingLeaves", func(t *testing.T) {
+		out, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(t.TempDir()))
+		require.NoError(t, err)
+		defer out.Close()
+
+		require.Error(t, directoryMaterializer.MaterializeDirectory(
+			ctx,
+			model_core.NewSimpleMessage[object.LocalReference](&model_filesystem_pb.DirectoryContents{}),
+			out,
+		))
+	})
+}
@@ -23,6 +23,7 @@ const (
 	ComponentStrBazelOut = "bazel-out"
 	ComponentStrBin      = "bin"
 	ComponentStrExternal = "external"
+	ComponentStrTestlogs = "testlogs"
 )
 
 // Typed instances of the names specified above.
@@ -30,6 +31,7 @@ var (
 	ComponentBazelOut = bb_path.MustNewComponent(ComponentStrBazelOut)
 	ComponentBin      = bb_path.MustNewComponent(ComponentStrBin)
 	ComponentExternal = bb_path.MustNewComponent(ComponentStrExternal)
+	ComponentTestlogs = bb_path.MustNewComponent(ComponentStrTestlogs)
 )
 
 type File[TReference object.BasicReference, TMetadata model_core.ReferenceMetadata] struct {
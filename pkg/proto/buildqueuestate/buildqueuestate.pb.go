@@ -1674,6 +1674,58 @@ func (*BackgroundLearning) Descriptor() ([]byte, []int) {
 	return file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_rawDescGZIP(), []int{26}
 }
 
+type RequestMetadataHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMetadataHeader) Reset() {
+	*x = RequestMetadataHeader{}
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMetadataHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMetadataHeader) ProtoMessage() {}
+
+func (x *RequestMetadataHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMetadataHeader.ProtoReflect.Descriptor instead.
+func (*RequestMetadataHeader) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RequestMetadataHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RequestMetadataHeader) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type ListOperationsRequest_StartAfter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OperationName string                 `protobuf:"bytes,1,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"`
@@ -1683,7 +1735,7 @@ type ListOperationsRequest_StartAfter struct {
 
 func (x *ListOperationsRequest_StartAfter) Reset() {
 	*x = ListOperationsRequest_StartAfter{}
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[29]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1695,7 +1747,7 @@ func (x *ListOperationsRequest_StartAfter) String() string {
 func (*ListOperationsRequest_StartAfter) ProtoMessage() {}
 
 func (x *ListOperationsRequest_StartAfter) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[29]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,7 +1783,7 @@ type KillOperationsRequest_Filter struct {
 
 func (x *KillOperationsRequest_Filter) Reset() {
 	*x = KillOperationsRequest_Filter{}
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[30]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1743,7 +1795,7 @@ func (x *KillOperationsRequest_Filter) String() string {
 func (*KillOperationsRequest_Filter) ProtoMessage() {}
 
 func (x *KillOperationsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[30]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1812,7 +1864,7 @@ type ListQueuedOperationsRequest_StartAfter struct {
 
 func (x *ListQueuedOperationsRequest_StartAfter) Reset() {
 	*x = ListQueuedOperationsRequest_StartAfter{}
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[31]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1824,7 +1876,7 @@ func (x *ListQueuedOperationsRequest_StartAfter) String() string {
 func (*ListQueuedOperationsRequest_StartAfter) ProtoMessage() {}
 
 func (x *ListQueuedOperationsRequest_StartAfter) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[31]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,7 +1927,7 @@ type ListWorkersRequest_Filter struct {
 
 func (x *ListWorkersRequest_Filter) Reset() {
 	*x = ListWorkersRequest_Filter{}
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[32]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1887,7 +1939,7 @@ func (x *ListWorkersRequest_Filter) String() string {
 func (*ListWorkersRequest_Filter) ProtoMessage() {}
 
 func (x *ListWorkersRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[32]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,7 +2020,7 @@ type ListWorkersRequest_StartAfter struct {
 
 func (x *ListWorkersRequest_StartAfter) Reset() {
 	*x = ListWorkersRequest_StartAfter{}
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[33]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1980,7 +2032,7 @@ func (x *ListWorkersRequest_StartAfter) String() string {
 func (*ListWorkersRequest_StartAfter) ProtoMessage() {}
 
 func (x *ListWorkersRequest_StartAfter) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[33]
+	mi := &file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2159,7 +2211,10 @@ const file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_rawDesc
 	"\x14WorkerIdPatternEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x14\n" +
-	"\x12BackgroundLearning2\x9e\t\n" +
+	"\x12BackgroundLearning\"C\n" +
+	"\x15RequestMetadataHeader\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values2\x9e\t\n" +
 	"\x0fBuildQueueState\x12k\n" +
 	"\fGetOperation\x12,.bonanza.buildqueuestate.GetOperationRequest\x1a-.bonanza.buildqueuestate.GetOperationResponse\x12q\n" +
 	"\x0eListOperations\x12..bonanza.buildqueuestate.ListOperationsRequest\x1a/.bonanza.buildqueuestate.ListOperationsResponse\x12X\n" +
@@ -2187,7 +2242,7 @@ func file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_rawDescG
 }
 
 var file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_goTypes = []any{
 	(ListOperationsRequest_ExecutionStage)(0),      // 0: bonanza.buildqueuestate.ListOperationsRequest.ExecutionStage
 	(ListInvocationChildrenRequest_Filter)(0),      // 1: bonanza.buildqueuestate.ListInvocationChildrenRequest.Filter
@@ -2218,80 +2273,81 @@ var file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_goTypes =
 	(*ListDrainsResponse)(nil),                     // 26: bonanza.buildqueuestate.ListDrainsResponse
 	(*AddOrRemoveDrainRequest)(nil),                // 27: bonanza.buildqueuestate.AddOrRemoveDrainRequest
 	(*BackgroundLearning)(nil),                     // 28: bonanza.buildqueuestate.BackgroundLearning
-	nil,                                            // 29: bonanza.buildqueuestate.WorkerState.IdEntry
-	nil,                                            // 30: bonanza.buildqueuestate.DrainState.WorkerIdPatternEntry
-	(*ListOperationsRequest_StartAfter)(nil),       // 31: bonanza.buildqueuestate.ListOperationsRequest.StartAfter
-	(*KillOperationsRequest_Filter)(nil),           // 32: bonanza.buildqueuestate.KillOperationsRequest.Filter
-	(*ListQueuedOperationsRequest_StartAfter)(nil), // 33: bonanza.buildqueuestate.ListQueuedOperationsRequest.StartAfter
-	(*ListWorkersRequest_Filter)(nil),              // 34: bonanza.buildqueuestate.ListWorkersRequest.Filter
-	(*ListWorkersRequest_StartAfter)(nil),          // 35: bonanza.buildqueuestate.ListWorkersRequest.StartAfter
-	nil,                                            // 36: bonanza.buildqueuestate.ListWorkersRequest.StartAfter.WorkerIdEntry
-	nil,                                            // 37: bonanza.buildqueuestate.TerminateWorkersRequest.WorkerIdPatternEntry
-	nil,                                            // 38: bonanza.buildqueuestate.AddOrRemoveDrainRequest.WorkerIdPatternEntry
-	(*anypb.Any)(nil),                              // 39: google.protobuf.Any
-	(*durationpb.Duration)(nil),                    // 40: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),                  // 41: google.protobuf.Timestamp
-	(*encryptedaction.Action)(nil),                 // 42: bonanza.encryptedaction.Action
-	(*emptypb.Empty)(nil),                          // 43: google.protobuf.Empty
-	(*status.Status)(nil),                          // 44: google.rpc.Status
+	(*RequestMetadataHeader)(nil),                  // 29: bonanza.buildqueuestate.RequestMetadataHeader
+	nil,                                            // 30: bonanza.buildqueuestate.WorkerState.IdEntry
+	nil,                                            // 31: bonanza.buildqueuestate.DrainState.WorkerIdPatternEntry
+	(*ListOperationsRequest_StartAfter)(nil),       // 32: bonanza.buildqueuestate.ListOperationsRequest.StartAfter
+	(*KillOperationsRequest_Filter)(nil),           // 33: bonanza.buildqueuestate.KillOperationsRequest.Filter
+	(*ListQueuedOperationsRequest_StartAfter)(nil), // 34: bonanza.buildqueuestate.ListQueuedOperationsRequest.StartAfter
+	(*ListWorkersRequest_Filter)(nil),              // 35: bonanza.buildqueuestate.ListWorkersRequest.Filter
+	(*ListWorkersRequest_StartAfter)(nil),          // 36: bonanza.buildqueuestate.ListWorkersRequest.StartAfter
+	nil,                                            // 37: bonanza.buildqueuestate.ListWorkersRequest.StartAfter.WorkerIdEntry
+	nil,                                            // 38: bonanza.buildqueuestate.TerminateWorkersRequest.WorkerIdPatternEntry
+	nil,                                            // 39: bonanza.buildqueuestate.AddOrRemoveDrainRequest.WorkerIdPatternEntry
+	(*anypb.Any)(nil),                              // 40: google.protobuf.Any
+	(*durationpb.Duration)(nil),                    // 41: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                  // 42: google.protobuf.Timestamp
+	(*encryptedaction.Action)(nil),                 // 43: bonanza.encryptedaction.Action
+	(*emptypb.Empty)(nil),                          // 44: google.protobuf.Empty
+	(*status.Status)(nil),                          // 45: google.rpc.Status
 }
 var file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_depIdxs = []int32{
 	3,  // 0: bonanza.buildqueuestate.InvocationName.size_class_queue_name:type_name -> bonanza.buildqueuestate.SizeClassQueueName
-	39, // 1: bonanza.buildqueuestate.InvocationName.ids:type_name -> google.protobuf.Any
+	40, // 1: bonanza.buildqueuestate.InvocationName.ids:type_name -> google.protobuf.Any
 	4,  // 2: bonanza.buildqueuestate.OperationState.invocation_name:type_name -> bonanza.buildqueuestate.InvocationName
-	40, // 3: bonanza.buildqueuestate.OperationState.expected_duration:type_name -> google.protobuf.Duration
-	41, // 4: bonanza.buildqueuestate.OperationState.queued_timestamp:type_name -> google.protobuf.Timestamp
-	42, // 5: bonanza.buildqueuestate.OperationState.action:type_name -> bonanza.encryptedaction.Action
-	41, // 6: bonanza.buildqueuestate.OperationState.timeout:type_name -> google.protobuf.Timestamp
-	43, // 7: bonanza.buildqueuestate.OperationState.queued:type_name -> google.protobuf.Empty
-	43, // 8: bonanza.buildqueuestate.OperationState.executing:type_name -> google.protobuf.Empty
-	43, // 9: bonanza.buildqueuestate.OperationState.completed:type_name -> google.protobuf.Empty
-	41, // 10: bonanza.buildqueuestate.SizeClassQueueState.timeout:type_name -> google.protobuf.Timestamp
+	41, // 3: bonanza.buildqueuestate.OperationState.expected_duration:type_name -> google.protobuf.Duration
+	42, // 4: bonanza.buildqueuestate.OperationState.queued_timestamp:type_name -> google.protobuf.Timestamp
+	43, // 5: bonanza.buildqueuestate.OperationState.action:type_name -> bonanza.encryptedaction.Action
+	42, // 6: bonanza.buildqueuestate.OperationState.timeout:type_name -> google.protobuf.Timestamp
+	44, // 7: bonanza.buildqueuestate.OperationState.queued:type_name -> google.protobuf.Empty
+	44, // 8: bonanza.buildqueuestate.OperationState.executing:type_name -> google.protobuf.Empty
+	44, // 9: bonanza.buildqueuestate.OperationState.completed:type_name -> google.protobuf.Empty
+	42, // 10: bonanza.buildqueuestate.SizeClassQueueState.timeout:type_name -> google.protobuf.Timestamp
 	8,  // 11: bonanza.buildqueuestate.SizeClassQueueState.root_invocation:type_name -> bonanza.buildqueuestate.InvocationState
 	6,  // 12: bonanza.buildqueuestate.PlatformQueueState.size_class_queues:type_name -> bonanza.buildqueuestate.SizeClassQueueState
-	39, // 13: bonanza.buildqueuestate.InvocationChildState.id:type_name -> google.protobuf.Any
+	40, // 13: bonanza.buildqueuestate.InvocationChildState.id:type_name -> google.protobuf.Any
 	8,  // 14: bonanza.buildqueuestate.InvocationChildState.state:type_name -> bonanza.buildqueuestate.InvocationState
-	29, // 15: bonanza.buildqueuestate.WorkerState.id:type_name -> bonanza.buildqueuestate.WorkerState.IdEntry
-	41, // 16: bonanza.buildqueuestate.WorkerState.timeout:type_name -> google.protobuf.Timestamp
+	30, // 15: bonanza.buildqueuestate.WorkerState.id:type_name -> bonanza.buildqueuestate.WorkerState.IdEntry
+	42, // 16: bonanza.buildqueuestate.WorkerState.timeout:type_name -> google.protobuf.Timestamp
 	5,  // 17: bonanza.buildqueuestate.WorkerState.current_operation:type_name -> bonanza.buildqueuestate.OperationState
-	30, // 18: bonanza.buildqueuestate.DrainState.worker_id_pattern:type_name -> bonanza.buildqueuestate.DrainState.WorkerIdPatternEntry
-	41, // 19: bonanza.buildqueuestate.DrainState.created_timestamp:type_name -> google.protobuf.Timestamp
+	31, // 18: bonanza.buildqueuestate.DrainState.worker_id_pattern:type_name -> bonanza.buildqueuestate.DrainState.WorkerIdPatternEntry
+	42, // 19: bonanza.buildqueuestate.DrainState.created_timestamp:type_name -> google.protobuf.Timestamp
 	5,  // 20: bonanza.buildqueuestate.GetOperationResponse.operation:type_name -> bonanza.buildqueuestate.OperationState
-	31, // 21: bonanza.buildqueuestate.ListOperationsRequest.start_after:type_name -> bonanza.buildqueuestate.ListOperationsRequest.StartAfter
-	39, // 22: bonanza.buildqueuestate.ListOperationsRequest.filter_invocation_id:type_name -> google.protobuf.Any
+	32, // 21: bonanza.buildqueuestate.ListOperationsRequest.start_after:type_name -> bonanza.buildqueuestate.ListOperationsRequest.StartAfter
+	40, // 22: bonanza.buildqueuestate.ListOperationsRequest.filter_invocation_id:type_name -> google.protobuf.Any
 	0,  // 23: bonanza.buildqueuestate.ListOperationsRequest.filter_stage:type_name -> bonanza.buildqueuestate.ListOperationsRequest.ExecutionStage
 	5,  // 24: bonanza.buildqueuestate.ListOperationsResponse.operations:type_name -> bonanza.buildqueuestate.OperationState
 	2,  // 25: bonanza.buildqueuestate.ListOperationsResponse.pagination_info:type_name -> bonanza.buildqueuestate.PaginationInfo
-	32, // 26: bonanza.buildqueuestate.KillOperationsRequest.filter:type_name -> bonanza.buildqueuestate.KillOperationsRequest.Filter
-	44, // 27: bonanza.buildqueuestate.KillOperationsRequest.status:type_name -> google.rpc.Status
+	33, // 26: bonanza.buildqueuestate.KillOperationsRequest.filter:type_name -> bonanza.buildqueuestate.KillOperationsRequest.Filter
+	45, // 27: bonanza.buildqueuestate.KillOperationsRequest.status:type_name -> google.rpc.Status
 	7,  // 28: bonanza.buildqueuestate.ListPlatformQueuesResponse.platform_queues:type_name -> bonanza.buildqueuestate.PlatformQueueState
 	4,  // 29: bonanza.buildqueuestate.ListInvocationChildrenRequest.invocation_name:type_name -> bonanza.buildqueuestate.InvocationName
 	1,  // 30: bonanza.buildqueuestate.ListInvocationChildrenRequest.filter:type_name -> bonanza.buildqueuestate.ListInvocationChildrenRequest.Filter
 	9,  // 31: bonanza.buildqueuestate.ListInvocationChildrenResponse.children:type_name -> bonanza.buildqueuestate.InvocationChildState
 	4,  // 32: bonanza.buildqueuestate.ListQueuedOperationsRequest.invocation_name:type_name -> bonanza.buildqueuestate.InvocationName
-	33, // 33: bonanza.buildqueuestate.ListQueuedOperationsRequest.start_after:type_name -> bonanza.buildqueuestate.ListQueuedOperationsRequest.StartAfter
+	34, // 33: bonanza.buildqueuestate.ListQueuedOperationsRequest.start_after:type_name -> bonanza.buildqueuestate.ListQueuedOperationsRequest.StartAfter
 	5,  // 34: bonanza.buildqueuestate.ListQueuedOperationsResponse.queued_operations:type_name -> bonanza.buildqueuestate.OperationState
 	2,  // 35: bonanza.buildqueuestate.ListQueuedOperationsResponse.pagination_info:type_name -> bonanza.buildqueuestate.PaginationInfo
-	34, // 36: bonanza.buildqueuestate.ListWorkersRequest.filter:type_name -> bonanza.buildqueuestate.ListWorkersRequest.Filter
-	35, // 37: bonanza.buildqueuestate.ListWorkersRequest.start_after:type_name -> bonanza.buildqueuestate.ListWorkersRequest.StartAfter
+	35, // 36: bonanza.buildqueuestate.ListWorkersRequest.filter:type_name -> bonanza.buildqueuestate.ListWorkersRequest.Filter
+	36, // 37: bonanza.buildqueuestate.ListWorkersRequest.start_after:type_name -> bonanza.buildqueuestate.ListWorkersRequest.StartAfter
 	10, // 38: bonanza.buildqueuestate.ListWorkersResponse.workers:type_name -> bonanza.buildqueuestate.WorkerState
 	2,  // 39: bonanza.buildqueuestate.ListWorkersResponse.pagination_info:type_name -> bonanza.buildqueuestate.PaginationInfo
-	37, // 40: bonanza.buildqueuestate.TerminateWorkersRequest.worker_id_pattern:type_name -> bonanza.buildqueuestate.TerminateWorkersRequest.WorkerIdPatternEntry
+	38, // 40: bonanza.buildqueuestate.TerminateWorkersRequest.worker_id_pattern:type_name -> bonanza.buildqueuestate.TerminateWorkersRequest.WorkerIdPatternEntry
 	3,  // 41: bonanza.buildqueuestate.ListDrainsRequest.size_class_queue_name:type_name -> bonanza.buildqueuestate.SizeClassQueueName
 	11, // 42: bonanza.buildqueuestate.ListDrainsResponse.drains:type_name -> bonanza.buildqueuestate.DrainState
 	3,  // 43: bonanza.buildqueuestate.AddOrRemoveDrainRequest.size_class_queue_name:type_name -> bonanza.buildqueuestate.SizeClassQueueName
-	38, // 44: bonanza.buildqueuestate.AddOrRemoveDrainRequest.worker_id_pattern:type_name -> bonanza.buildqueuestate.AddOrRemoveDrainRequest.WorkerIdPatternEntry
+	39, // 44: bonanza.buildqueuestate.AddOrRemoveDrainRequest.worker_id_pattern:type_name -> bonanza.buildqueuestate.AddOrRemoveDrainRequest.WorkerIdPatternEntry
 	3,  // 45: bonanza.buildqueuestate.KillOperationsRequest.Filter.size_class_queue_without_workers:type_name -> bonanza.buildqueuestate.SizeClassQueueName
-	40, // 46: bonanza.buildqueuestate.ListQueuedOperationsRequest.StartAfter.expected_duration:type_name -> google.protobuf.Duration
-	41, // 47: bonanza.buildqueuestate.ListQueuedOperationsRequest.StartAfter.queued_timestamp:type_name -> google.protobuf.Timestamp
+	41, // 46: bonanza.buildqueuestate.ListQueuedOperationsRequest.StartAfter.expected_duration:type_name -> google.protobuf.Duration
+	42, // 47: bonanza.buildqueuestate.ListQueuedOperationsRequest.StartAfter.queued_timestamp:type_name -> google.protobuf.Timestamp
 	3,  // 48: bonanza.buildqueuestate.ListWorkersRequest.Filter.all:type_name -> bonanza.buildqueuestate.SizeClassQueueName
 	4,  // 49: bonanza.buildqueuestate.ListWorkersRequest.Filter.executing:type_name -> bonanza.buildqueuestate.InvocationName
 	4,  // 50: bonanza.buildqueuestate.ListWorkersRequest.Filter.idle_synchronizing:type_name -> bonanza.buildqueuestate.InvocationName
-	36, // 51: bonanza.buildqueuestate.ListWorkersRequest.StartAfter.worker_id:type_name -> bonanza.buildqueuestate.ListWorkersRequest.StartAfter.WorkerIdEntry
+	37, // 51: bonanza.buildqueuestate.ListWorkersRequest.StartAfter.worker_id:type_name -> bonanza.buildqueuestate.ListWorkersRequest.StartAfter.WorkerIdEntry
 	12, // 52: bonanza.buildqueuestate.BuildQueueState.GetOperation:input_type -> bonanza.buildqueuestate.GetOperationRequest
 	14, // 53: bonanza.buildqueuestate.BuildQueueState.ListOperations:input_type -> bonanza.buildqueuestate.ListOperationsRequest
 	16, // 54: bonanza.buildqueuestate.BuildQueueState.KillOperations:input_type -> bonanza.buildqueuestate.KillOperationsRequest
-	43, // 55: bonanza.buildqueuestate.BuildQueueState.ListPlatformQueues:input_type -> google.protobuf.Empty
+	44, // 55: bonanza.buildqueuestate.BuildQueueState.ListPlatformQueues:input_type -> google.protobuf.Empty
 	18, // 56: bonanza.buildqueuestate.BuildQueueState.ListInvocationChildren:input_type -> bonanza.buildqueuestate.ListInvocationChildrenRequest
 	20, // 57: bonanza.buildqueuestate.BuildQueueState.ListQueuedOperations:input_type -> bonanza.buildqueuestate.ListQueuedOperationsRequest
 	22, // 58: bonanza.buildqueuestate.BuildQueueState.ListWorkers:input_type -> bonanza.buildqueuestate.ListWorkersRequest
@@ -2301,15 +2357,15 @@ var file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_depIdxs =
 	27, // 62: bonanza.buildqueuestate.BuildQueueState.RemoveDrain:input_type -> bonanza.buildqueuestate.AddOrRemoveDrainRequest
 	13, // 63: bonanza.buildqueuestate.BuildQueueState.GetOperation:output_type -> bonanza.buildqueuestate.GetOperationResponse
 	15, // 64: bonanza.buildqueuestate.BuildQueueState.ListOperations:output_type -> bonanza.buildqueuestate.ListOperationsResponse
-	43, // 65: bonanza.buildqueuestate.BuildQueueState.KillOperations:output_type -> google.protobuf.Empty
+	44, // 65: bonanza.buildqueuestate.BuildQueueState.KillOperations:output_type -> google.protobuf.Empty
 	17, // 66: bonanza.buildqueuestate.BuildQueueState.ListPlatformQueues:output_type -> bonanza.buildqueuestate.ListPlatformQueuesResponse
 	19, // 67: bonanza.buildqueuestate.BuildQueueState.ListInvocationChildren:output_type -> bonanza.buildqueuestate.ListInvocationChildrenResponse
 	21, // 68: bonanza.buildqueuestate.BuildQueueState.ListQueuedOperations:output_type -> bonanza.buildqueuestate.ListQueuedOperationsResponse
 	23, // 69: bonanza.buildqueuestate.BuildQueueState.ListWorkers:output_type -> bonanza.buildqueuestate.ListWorkersResponse
-	43, // 70: bonanza.buildqueuestate.BuildQueueState.TerminateWorkers:output_type -> google.protobuf.Empty
+	44, // 70: bonanza.buildqueuestate.BuildQueueState.TerminateWorkers:output_type -> google.protobuf.Empty
 	26, // 71: bonanza.buildqueuestate.BuildQueueState.ListDrains:output_type -> bonanza.buildqueuestate.ListDrainsResponse
-	43, // 72: bonanza.buildqueuestate.BuildQueueState.AddDrain:output_type -> google.protobuf.Empty
-	43, // 73: bonanza.buildqueuestate.BuildQueueState.RemoveDrain:output_type -> google.protobuf.Empty
+	44, // 72: bonanza.buildqueuestate.BuildQueueState.AddDrain:output_type -> google.protobuf.Empty
+	44, // 73: bonanza.buildqueuestate.BuildQueueState.RemoveDrain:output_type -> google.protobuf.Empty
 	63, // [63:74] is the sub-list for method output_type
 	52, // [52:63] is the sub-list for method input_type
 	52, // [52:52] is the sub-list for extension type_name
@@ -2327,11 +2383,11 @@ func file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_init() {
 		(*OperationState_Executing)(nil),
 		(*OperationState_Completed)(nil),
 	}
-	file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[30].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[31].OneofWrappers = []any{
 		(*KillOperationsRequest_Filter_OperationName)(nil),
 		(*KillOperationsRequest_Filter_SizeClassQueueWithoutWorkers)(nil),
 	}
-	file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[32].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_msgTypes[33].OneofWrappers = []any{
 		(*ListWorkersRequest_Filter_All)(nil),
 		(*ListWorkersRequest_Filter_Executing)(nil),
 		(*ListWorkersRequest_Filter_IdleSynchronizing)(nil),
@@ -2342,7 +2398,7 @@ func file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_rawDesc), len(file_bonanza_build_pkg_proto_buildqueuestate_buildqueuestate_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   37,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8,6 +8,7 @@ package bonanza_builder
 
 import (
 	parser "bonanza.build/pkg/proto/configuration/model/parser"
+	grpc1 "bonanza.build/pkg/proto/configuration/storage/object/grpc"
 	local "bonanza.build/pkg/proto/configuration/storage/object/local"
 	filesystem "github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem"
 	global "github.com/buildbarn/bb-storage/pkg/proto/configuration/global"
@@ -28,23 +29,26 @@ const (
 )
 
 type ApplicationConfiguration struct {
-	state                           protoimpl.MessageState                       `protogen:"open.v1"`
-	Global                          *global.Configuration                        `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
-	StorageGrpcClient               *grpc.ClientConfiguration                    `protobuf:"bytes,3,opt,name=storage_grpc_client,json=storageGrpcClient,proto3" json:"storage_grpc_client,omitempty"`
-	FilePool                        *filesystem.FilePoolConfiguration            `protobuf:"bytes,5,opt,name=file_pool,json=filePool,proto3" json:"file_pool,omitempty"`
-	ExecutionGrpcClient             *grpc.ClientConfiguration                    `protobuf:"bytes,7,opt,name=execution_grpc_client,json=executionGrpcClient,proto3" json:"execution_grpc_client,omitempty"`
-	ExecutionClientPrivateKey       string                                       `protobuf:"bytes,8,opt,name=execution_client_private_key,json=executionClientPrivateKey,proto3" json:"execution_client_private_key,omitempty"`
-	ExecutionClientCertificateChain string                                       `protobuf:"bytes,9,opt,name=execution_client_certificate_chain,json=executionClientCertificateChain,proto3" json:"execution_client_certificate_chain,omitempty"`
-	RemoteWorkerGrpcClient          *grpc.ClientConfiguration                    `protobuf:"bytes,10,opt,name=remote_worker_grpc_client,json=remoteWorkerGrpcClient,proto3" json:"remote_worker_grpc_client,omitempty"`
-	PlatformPrivateKeys             []string                                     `protobuf:"bytes,11,rep,name=platform_private_keys,json=platformPrivateKeys,proto3" json:"platform_private_keys,omitempty"`
-	ClientCertificateVerifier       *x509.ClientCertificateVerifierConfiguration `protobuf:"bytes,12,opt,name=client_certificate_verifier,json=clientCertificateVerifier,proto3" json:"client_certificate_verifier,omitempty"`
-	WorkerId                        map[string]string                            `protobuf:"bytes,13,rep,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	LocalObjectStore                *local.StoreConfiguration                    `protobuf:"bytes,17,opt,name=local_object_store,json=localObjectStore,proto3" json:"local_object_store,omitempty"`
-	ParsedObjectPool                *parser.ParsedObjectPool                     `protobuf:"bytes,14,opt,name=parsed_object_pool,json=parsedObjectPool,proto3" json:"parsed_object_pool,omitempty"`
-	LocalEvaluationConcurrency      uint32                                       `protobuf:"varint,15,opt,name=local_evaluation_concurrency,json=localEvaluationConcurrency,proto3" json:"local_evaluation_concurrency,omitempty"`
-	RemoteEvaluationConcurrency     uint32                                       `protobuf:"varint,16,opt,name=remote_evaluation_concurrency,json=remoteEvaluationConcurrency,proto3" json:"remote_evaluation_concurrency,omitempty"`
-	unknownFields                   protoimpl.UnknownFields
-	sizeCache                       protoimpl.SizeCache
+	state                                     protoimpl.MessageState                       `protogen:"open.v1"`
+	Global                                    *global.Configuration                        `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
+	StorageGrpcClient                         *grpc.ClientConfiguration                    `protobuf:"bytes,3,opt,name=storage_grpc_client,json=storageGrpcClient,proto3" json:"storage_grpc_client,omitempty"`
+	StorageBatching                           *grpc1.BatchingConfiguration                 `protobuf:"bytes,18,opt,name=storage_batching,json=storageBatching,proto3" json:"storage_batching,omitempty"`
+	FilePool                                  *filesystem.FilePoolConfiguration            `protobuf:"bytes,5,opt,name=file_pool,json=filePool,proto3" json:"file_pool,omitempty"`
+	ExecutionGrpcClient                       *grpc.ClientConfiguration                    `protobuf:"bytes,7,opt,name=execution_grpc_client,json=executionGrpcClient,proto3" json:"execution_grpc_client,omitempty"`
+	ExecutionClientPrivateKey                 string                                       `protobuf:"bytes,8,opt,name=execution_client_private_key,json=executionClientPrivateKey,proto3" json:"execution_client_private_key,omitempty"`
+	ExecutionClientCertificateChain           string                                       `protobuf:"bytes,9,opt,name=execution_client_certificate_chain,json=executionClientCertificateChain,proto3" json:"execution_client_certificate_chain,omitempty"`
+	RemoteWorkerGrpcClient                    *grpc.ClientConfiguration                    `protobuf:"bytes,10,opt,name=remote_worker_grpc_client,json=remoteWorkerGrpcClient,proto3" json:"remote_worker_grpc_client,omitempty"`
+	PlatformPrivateKeys                       []string                                     `protobuf:"bytes,11,rep,name=platform_private_keys,json=platformPrivateKeys,proto3" json:"platform_private_keys,omitempty"`
+	ClientCertificateVerifier                 *x509.ClientCertificateVerifierConfiguration `protobuf:"bytes,12,opt,name=client_certificate_verifier,json=clientCertificateVerifier,proto3" json:"client_certificate_verifier,omitempty"`
+	WorkerId                                  map[string]string                            `protobuf:"bytes,13,rep,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	LocalObjectStore                          *local.StoreConfiguration                    `protobuf:"bytes,17,opt,name=local_object_store,json=localObjectStore,proto3" json:"local_object_store,omitempty"`
+	LocalObjectStorePrefetchConcurrency       int64                                        `protobuf:"varint,19,opt,name=local_object_store_prefetch_concurrency,json=localObjectStorePrefetchConcurrency,proto3" json:"local_object_store_prefetch_concurrency,omitempty"`
+	ParsedObjectPool                          *parser.ParsedObjectPool                     `protobuf:"bytes,14,opt,name=parsed_object_pool,json=parsedObjectPool,proto3" json:"parsed_object_pool,omitempty"`
+	LocalEvaluationConcurrency                uint32                                       `protobuf:"varint,15,opt,name=local_evaluation_concurrency,json=localEvaluationConcurrency,proto3" json:"local_evaluation_concurrency,omitempty"`
+	RemoteEvaluationConcurrency               uint32                                       `protobuf:"varint,16,opt,name=remote_evaluation_concurrency,json=remoteEvaluationConcurrency,proto3" json:"remote_evaluation_concurrency,omitempty"`
+	AdditionalForwardedRequestMetadataHeaders []string                                     `protobuf:"bytes,20,rep,name=additional_forwarded_request_metadata_headers,json=additionalForwardedRequestMetadataHeaders,proto3" json:"additional_forwarded_request_metadata_headers,omitempty"`
+	unknownFields                             protoimpl.UnknownFields
+	sizeCache                                 protoimpl.SizeCache
 }
 
 func (x *ApplicationConfiguration) Reset() {
@@ -91,6 +95,13 @@ func (x *ApplicationConfiguration) GetStorageGrpcClient() *grpc.ClientConfigurat
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetStorageBatching() *grpc1.BatchingConfiguration {
+	if x != nil {
+		return x.StorageBatching
+	}
+	return nil
+}
+
 func (x *ApplicationConfiguration) GetFilePool() *filesystem.FilePoolConfiguration {
 	if x != nil {
 		return x.FilePool
@@ -154,6 +165,13 @@ func (x *ApplicationConfiguration) GetLocalObjectStore() *local.StoreConfigurati
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetLocalObjectStorePrefetchConcurrency() int64 {
+	if x != nil {
+		return x.LocalObjectStorePrefetchConcurrency
+	}
+	return 0
+}
+
 func (x *ApplicationConfiguration) GetParsedObjectPool() *parser.ParsedObjectPool {
 	if x != nil {
 		return x.ParsedObjectPool
@@ -175,15 +193,22 @@ func (x *ApplicationConfiguration) GetRemoteEvaluationConcurrency() uint32 {
 	return 0
 }
 
+func (x *ApplicationConfiguration) GetAdditionalForwardedRequestMetadataHeaders() []string {
+	if x != nil {
+		return x.AdditionalForwardedRequestMetadataHeaders
+	}
+	return nil
+}
+
 var File_bonanza_build_pkg_proto_configuration_bonanza_builder_bonanza_builder_proto protoreflect.FileDescriptor
 
 const file_bonanza_build_pkg_proto_configuration_bonanza_builder_bonanza_builder_proto_rawDesc = "" +
 	"\n" +
-	"Kbonanza.build/pkg/proto/configuration/bonanza_builder/bonanza_builder.proto\x12%bonanza.configuration.bonanza_builder\x1a?bonanza.build/pkg/proto/configuration/model/parser/parser.proto\x1aFbonanza.build/pkg/proto/configuration/storage/object/local/local.proto\x1a\\github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/filesystem.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/x509/x509.proto\"\xbb\n" +
-	"\n" +
+	"Kbonanza.build/pkg/proto/configuration/bonanza_builder/bonanza_builder.proto\x12%bonanza.configuration.bonanza_builder\x1a?bonanza.build/pkg/proto/configuration/model/parser/parser.proto\x1aDbonanza.build/pkg/proto/configuration/storage/object/grpc/grpc.proto\x1aFbonanza.build/pkg/proto/configuration/storage/object/local/local.proto\x1a\\github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/filesystem.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/x509/x509.proto\"\xe0\f\n" +
 	"\x18ApplicationConfiguration\x12E\n" +
 	"\x06global\x18\x01 \x01(\v2-.buildbarn.configuration.global.ConfigurationR\x06global\x12a\n" +
-	"\x13storage_grpc_client\x18\x03 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x11storageGrpcClient\x12V\n" +
+	"\x13storage_grpc_client\x18\x03 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x11storageGrpcClient\x12k\n" +
+	"\x10storage_batching\x18\x12 \x01(\v2@.bonanza.configuration.storage.object.grpc.BatchingConfigurationR\x0fstorageBatching\x12V\n" +
 	"\tfile_pool\x18\x05 \x01(\v29.buildbarn.configuration.filesystem.FilePoolConfigurationR\bfilePool\x12e\n" +
 	"\x15execution_grpc_client\x18\a \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x13executionGrpcClient\x12?\n" +
 	"\x1cexecution_client_private_key\x18\b \x01(\tR\x19executionClientPrivateKey\x12K\n" +
@@ -193,10 +218,12 @@ const file_bonanza_build_pkg_proto_configuration_bonanza_builder_bonanza_builder
 	"\x15platform_private_keys\x18\v \x03(\tR\x13platformPrivateKeys\x12\x84\x01\n" +
 	"\x1bclient_certificate_verifier\x18\f \x01(\v2D.buildbarn.configuration.x509.ClientCertificateVerifierConfigurationR\x19clientCertificateVerifier\x12j\n" +
 	"\tworker_id\x18\r \x03(\v2M.bonanza.configuration.bonanza_builder.ApplicationConfiguration.WorkerIdEntryR\bworkerId\x12l\n" +
-	"\x12local_object_store\x18\x11 \x01(\v2>.bonanza.configuration.storage.object.local.StoreConfigurationR\x10localObjectStore\x12b\n" +
+	"\x12local_object_store\x18\x11 \x01(\v2>.bonanza.configuration.storage.object.local.StoreConfigurationR\x10localObjectStore\x12T\n" +
+	"'local_object_store_prefetch_concurrency\x18\x13 \x01(\x03R#localObjectStorePrefetchConcurrency\x12b\n" +
 	"\x12parsed_object_pool\x18\x0e \x01(\v24.bonanza.configuration.model.parser.ParsedObjectPoolR\x10parsedObjectPool\x12@\n" +
 	"\x1clocal_evaluation_concurrency\x18\x0f \x01(\rR\x1alocalEvaluationConcurrency\x12B\n" +
-	"\x1dremote_evaluation_concurrency\x18\x10 \x01(\rR\x1bremoteEvaluationConcurrency\x1a;\n" +
+	"\x1dremote_evaluation_concurrency\x18\x10 \x01(\rR\x1bremoteEvaluationConcurrency\x12`\n" +
+	"-additional_forwarded_request_metadata_headers\x18\x14 \x03(\tR)additionalForwardedRequestMetadataHeaders\x1a;\n" +
 	"\rWorkerIdEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B7Z5bonanza.build/pkg/proto/configuration/bonanza_builderb\x06proto3"
@@ -215,30 +242,32 @@ func file_bonanza_build_pkg_proto_configuration_bonanza_builder_bonanza_builder_
 
 var file_bonanza_build_pkg_proto_configuration_bonanza_builder_bonanza_builder_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_bonanza_build_pkg_proto_configuration_bonanza_builder_bonanza_builder_proto_goTypes = []any{
-	(*ApplicationConfiguration)(nil),         // 0: bonanza.configuration.bonanza_builder.ApplicationConfiguration
-	nil,                                      // 1: bonanza.configuration.bonanza_builder.ApplicationConfiguration.WorkerIdEntry
-	(*global.Configuration)(nil),             // 2: buildbarn.configuration.global.Configuration
-	(*grpc.ClientConfiguration)(nil),         // 3: buildbarn.configuration.grpc.ClientConfiguration
-	(*filesystem.FilePoolConfiguration)(nil), // 4: buildbarn.configuration.filesystem.FilePoolConfiguration
-	(*x509.ClientCertificateVerifierConfiguration)(nil), // 5: buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
-	(*local.StoreConfiguration)(nil),                    // 6: bonanza.configuration.storage.object.local.StoreConfiguration
-	(*parser.ParsedObjectPool)(nil),                     // 7: bonanza.configuration.model.parser.ParsedObjectPool
+	(*ApplicationConfiguration)(nil),                    // 0: bonanza.configuration.bonanza_builder.ApplicationConfiguration
+	nil,                                                 // 1: bonanza.configuration.bonanza_builder.ApplicationConfiguration.WorkerIdEntry
+	(*global.Configuration)(nil),                        // 2: buildbarn.configuration.global.Configuration
+	(*grpc.ClientConfiguration)(nil),                    // 3: buildbarn.configuration.grpc.ClientConfiguration
+	(*grpc1.BatchingConfiguration)(nil),                 // 4: bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	(*filesystem.FilePoolConfiguration)(nil),            // 5: buildbarn.configuration.filesystem.FilePoolConfiguration
+	(*x509.ClientCertificateVerifierConfiguration)(nil), // 6: buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
+	(*local.StoreConfiguration)(nil),                    // 7: bonanza.configuration.storage.object.local.StoreConfiguration
+	(*parser.ParsedObjectPool)(nil),                     // 8: bonanza.configuration.model.parser.ParsedObjectPool
 }
 var file_bonanza_build_pkg_proto_configuration_bonanza_builder_bonanza_builder_proto_depIdxs = []int32{
-	2, // 0: bonanza.configuration.bonanza_builder.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
-	3, // 1: bonanza.configuration.bonanza_builder.ApplicationConfiguration.storage_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	4, // 2: bonanza.configuration.bonanza_builder.ApplicationConfiguration.file_pool:type_name -> buildbarn.configuration.filesystem.FilePoolConfiguration
-	3, // 3: bonanza.configuration.bonanza_builder.ApplicationConfiguration.execution_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	3, // 4: bonanza.configuration.bonanza_builder.ApplicationConfiguration.remote_worker_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	5, // 5: bonanza.configuration.bonanza_builder.ApplicationConfiguration.client_certificate_verifier:type_name -> buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
-	1, // 6: bonanza.configuration.bonanza_builder.ApplicationConfiguration.worker_id:type_name -> bonanza.configuration.bonanza_builder.ApplicationConfiguration.WorkerIdEntry
-	6, // 7: bonanza.configuration.bonanza_builder.ApplicationConfiguration.local_object_store:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration
-	7, // 8: bonanza.configuration.bonanza_builder.ApplicationConfiguration.parsed_object_pool:type_name -> bonanza.configuration.model.parser.ParsedObjectPool
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	2,  // 0: bonanza.configuration.bonanza_builder.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
+	3,  // 1: bonanza.configuration.bonanza_builder.ApplicationConfiguration.storage_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	4,  // 2: bonanza.configuration.bonanza_builder.ApplicationConfiguration.storage_batching:type_name -> bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	5,  // 3: bonanza.configuration.bonanza_builder.ApplicationConfiguration.file_pool:type_name -> buildbarn.configuration.filesystem.FilePoolConfiguration
+	3,  // 4: bonanza.configuration.bonanza_builder.ApplicationConfiguration.execution_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	3,  // 5: bonanza.configuration.bonanza_builder.ApplicationConfiguration.remote_worker_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	6,  // 6: bonanza.configuration.bonanza_builder.ApplicationConfiguration.client_certificate_verifier:type_name -> buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
+	1,  // 7: bonanza.configuration.bonanza_builder.ApplicationConfiguration.worker_id:type_name -> bonanza.configuration.bonanza_builder.ApplicationConfiguration.WorkerIdEntry
+	7,  // 8: bonanza.configuration.bonanza_builder.ApplicationConfiguration.local_object_store:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration
+	8,  // 9: bonanza.configuration.bonanza_builder.ApplicationConfiguration.parsed_object_pool:type_name -> bonanza.configuration.model.parser.ParsedObjectPool
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_configuration_bonanza_builder_bonanza_builder_proto_init() }
@@ -8,6 +8,7 @@ package bonanza_fetcher
 
 import (
 	parser "bonanza.build/pkg/proto/configuration/model/parser"
+	grpc1 "bonanza.build/pkg/proto/configuration/storage/object/grpc"
 	local "bonanza.build/pkg/proto/configuration/storage/object/local"
 	filesystem "github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem"
 	global "github.com/buildbarn/bb-storage/pkg/proto/configuration/global"
@@ -29,21 +30,24 @@ const (
 )
 
 type ApplicationConfiguration struct {
-	state                     protoimpl.MessageState                       `protogen:"open.v1"`
-	Global                    *global.Configuration                        `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
-	StorageGrpcClient         *grpc.ClientConfiguration                    `protobuf:"bytes,2,opt,name=storage_grpc_client,json=storageGrpcClient,proto3" json:"storage_grpc_client,omitempty"`
-	HttpClient                *client.Configuration                        `protobuf:"bytes,3,opt,name=http_client,json=httpClient,proto3" json:"http_client,omitempty"`
-	FilePool                  *filesystem.FilePoolConfiguration            `protobuf:"bytes,4,opt,name=file_pool,json=filePool,proto3" json:"file_pool,omitempty"`
-	CacheDirectoryPath        string                                       `protobuf:"bytes,5,opt,name=cache_directory_path,json=cacheDirectoryPath,proto3" json:"cache_directory_path,omitempty"`
-	RemoteWorkerGrpcClient    *grpc.ClientConfiguration                    `protobuf:"bytes,6,opt,name=remote_worker_grpc_client,json=remoteWorkerGrpcClient,proto3" json:"remote_worker_grpc_client,omitempty"`
-	PlatformPrivateKeys       []string                                     `protobuf:"bytes,7,rep,name=platform_private_keys,json=platformPrivateKeys,proto3" json:"platform_private_keys,omitempty"`
-	ClientCertificateVerifier *x509.ClientCertificateVerifierConfiguration `protobuf:"bytes,8,opt,name=client_certificate_verifier,json=clientCertificateVerifier,proto3" json:"client_certificate_verifier,omitempty"`
-	WorkerId                  map[string]string                            `protobuf:"bytes,9,rep,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Concurrency               uint64                                       `protobuf:"varint,10,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	LocalObjectStore          *local.StoreConfiguration                    `protobuf:"bytes,12,opt,name=local_object_store,json=localObjectStore,proto3" json:"local_object_store,omitempty"`
-	ParsedObjectPool          *parser.ParsedObjectPool                     `protobuf:"bytes,11,opt,name=parsed_object_pool,json=parsedObjectPool,proto3" json:"parsed_object_pool,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	state                               protoimpl.MessageState                       `protogen:"open.v1"`
+	Global                              *global.Configuration                        `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
+	StorageGrpcClient                   *grpc.ClientConfiguration                    `protobuf:"bytes,2,opt,name=storage_grpc_client,json=storageGrpcClient,proto3" json:"storage_grpc_client,omitempty"`
+	StorageBatching                     *grpc1.BatchingConfiguration                 `protobuf:"bytes,15,opt,name=storage_batching,json=storageBatching,proto3" json:"storage_batching,omitempty"`
+	HttpClient                          *client.Configuration                        `protobuf:"bytes,3,opt,name=http_client,json=httpClient,proto3" json:"http_client,omitempty"`
+	FilePool                            *filesystem.FilePoolConfiguration            `protobuf:"bytes,4,opt,name=file_pool,json=filePool,proto3" json:"file_pool,omitempty"`
+	CacheDirectoryPath                  string                                       `protobuf:"bytes,5,opt,name=cache_directory_path,json=cacheDirectoryPath,proto3" json:"cache_directory_path,omitempty"`
+	RemoteWorkerGrpcClient              *grpc.ClientConfiguration                    `protobuf:"bytes,6,opt,name=remote_worker_grpc_client,json=remoteWorkerGrpcClient,proto3" json:"remote_worker_grpc_client,omitempty"`
+	PlatformPrivateKeys                 []string                                     `protobuf:"bytes,7,rep,name=platform_private_keys,json=platformPrivateKeys,proto3" json:"platform_private_keys,omitempty"`
+	ClientCertificateVerifier           *x509.ClientCertificateVerifierConfiguration `protobuf:"bytes,8,opt,name=client_certificate_verifier,json=clientCertificateVerifier,proto3" json:"client_certificate_verifier,omitempty"`
+	WorkerId                            map[string]string                            `protobuf:"bytes,9,rep,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Concurrency                         uint64                                       `protobuf:"varint,10,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	LocalObjectStore                    *local.StoreConfiguration                    `protobuf:"bytes,12,opt,name=local_object_store,json=localObjectStore,proto3" json:"local_object_store,omitempty"`
+	LocalObjectStorePrefetchConcurrency int64                                        `protobuf:"varint,14,opt,name=local_object_store_prefetch_concurrency,json=localObjectStorePrefetchConcurrency,proto3" json:"local_object_store_prefetch_concurrency,omitempty"`
+	ParsedObjectPool                    *parser.ParsedObjectPool                     `protobuf:"bytes,11,opt,name=parsed_object_pool,json=parsedObjectPool,proto3" json:"parsed_object_pool,omitempty"`
+	Git                                 *GitConfiguration                            `protobuf:"bytes,13,opt,name=git,proto3" json:"git,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
 }
 
 func (x *ApplicationConfiguration) Reset() {
@@ -90,6 +94,13 @@ func (x *ApplicationConfiguration) GetStorageGrpcClient() *grpc.ClientConfigurat
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetStorageBatching() *grpc1.BatchingConfiguration {
+	if x != nil {
+		return x.StorageBatching
+	}
+	return nil
+}
+
 func (x *ApplicationConfiguration) GetHttpClient() *client.Configuration {
 	if x != nil {
 		return x.HttpClient
@@ -153,6 +164,13 @@ func (x *ApplicationConfiguration) GetLocalObjectStore() *local.StoreConfigurati
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetLocalObjectStorePrefetchConcurrency() int64 {
+	if x != nil {
+		return x.LocalObjectStorePrefetchConcurrency
+	}
+	return 0
+}
+
 func (x *ApplicationConfiguration) GetParsedObjectPool() *parser.ParsedObjectPool {
 	if x != nil {
 		return x.ParsedObjectPool
@@ -160,14 +178,75 @@ func (x *ApplicationConfiguration) GetParsedObjectPool() *parser.ParsedObjectPoo
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetGit() *GitConfiguration {
+	if x != nil {
+		return x.Git
+	}
+	return nil
+}
+
+type GitConfiguration struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ExecutablePath       string                 `protobuf:"bytes,1,opt,name=executable_path,json=executablePath,proto3" json:"executable_path,omitempty"`
+	WorkingDirectoryPath string                 `protobuf:"bytes,2,opt,name=working_directory_path,json=workingDirectoryPath,proto3" json:"working_directory_path,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GitConfiguration) Reset() {
+	*x = GitConfiguration{}
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitConfiguration) ProtoMessage() {}
+
+func (x *GitConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitConfiguration.ProtoReflect.Descriptor instead.
+func (*GitConfiguration) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GitConfiguration) GetExecutablePath() string {
+	if x != nil {
+		return x.ExecutablePath
+	}
+	return ""
+}
+
+func (x *GitConfiguration) GetWorkingDirectoryPath() string {
+	if x != nil {
+		return x.WorkingDirectoryPath
+	}
+	return ""
+}
+
 var File_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto protoreflect.FileDescriptor
 
 const file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_rawDesc = "" +
 	"\n" +
-	"Kbonanza.build/pkg/proto/configuration/bonanza_fetcher/bonanza_fetcher.proto\x12%bonanza.configuration.bonanza_fetcher\x1a?bonanza.build/pkg/proto/configuration/model/parser/parser.proto\x1aFbonanza.build/pkg/proto/configuration/storage/object/local/local.proto\x1a\\github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/filesystem.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1aPgithub.com/buildbarn/bb-storage/pkg/proto/configuration/http/client/client.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/x509/x509.proto\"\xe9\b\n" +
+	"Kbonanza.build/pkg/proto/configuration/bonanza_fetcher/bonanza_fetcher.proto\x12%bonanza.configuration.bonanza_fetcher\x1a?bonanza.build/pkg/proto/configuration/model/parser/parser.proto\x1aDbonanza.build/pkg/proto/configuration/storage/object/grpc/grpc.proto\x1aFbonanza.build/pkg/proto/configuration/storage/object/local/local.proto\x1a\\github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/filesystem.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1aPgithub.com/buildbarn/bb-storage/pkg/proto/configuration/http/client/client.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/x509/x509.proto\"\xf7\n" +
+	"\n" +
 	"\x18ApplicationConfiguration\x12E\n" +
 	"\x06global\x18\x01 \x01(\v2-.buildbarn.configuration.global.ConfigurationR\x06global\x12a\n" +
-	"\x13storage_grpc_client\x18\x02 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x11storageGrpcClient\x12S\n" +
+	"\x13storage_grpc_client\x18\x02 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x11storageGrpcClient\x12k\n" +
+	"\x10storage_batching\x18\x0f \x01(\v2@.bonanza.configuration.storage.object.grpc.BatchingConfigurationR\x0fstorageBatching\x12S\n" +
 	"\vhttp_client\x18\x03 \x01(\v22.buildbarn.configuration.http.client.ConfigurationR\n" +
 	"httpClient\x12V\n" +
 	"\tfile_pool\x18\x04 \x01(\v29.buildbarn.configuration.filesystem.FilePoolConfigurationR\bfilePool\x120\n" +
@@ -178,11 +257,16 @@ const file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher
 	"\tworker_id\x18\t \x03(\v2M.bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.WorkerIdEntryR\bworkerId\x12 \n" +
 	"\vconcurrency\x18\n" +
 	" \x01(\x04R\vconcurrency\x12l\n" +
-	"\x12local_object_store\x18\f \x01(\v2>.bonanza.configuration.storage.object.local.StoreConfigurationR\x10localObjectStore\x12b\n" +
-	"\x12parsed_object_pool\x18\v \x01(\v24.bonanza.configuration.model.parser.ParsedObjectPoolR\x10parsedObjectPool\x1a;\n" +
+	"\x12local_object_store\x18\f \x01(\v2>.bonanza.configuration.storage.object.local.StoreConfigurationR\x10localObjectStore\x12T\n" +
+	"'local_object_store_prefetch_concurrency\x18\x0e \x01(\x03R#localObjectStorePrefetchConcurrency\x12b\n" +
+	"\x12parsed_object_pool\x18\v \x01(\v24.bonanza.configuration.model.parser.ParsedObjectPoolR\x10parsedObjectPool\x12I\n" +
+	"\x03git\x18\r \x01(\v27.bonanza.configuration.bonanza_fetcher.GitConfigurationR\x03git\x1a;\n" +
 	"\rWorkerIdEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B7Z5bonanza.build/pkg/proto/configuration/bonanza_fetcherb\x06proto3"
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"q\n" +
+	"\x10GitConfiguration\x12'\n" +
+	"\x0fexecutable_path\x18\x01 \x01(\tR\x0eexecutablePath\x124\n" +
+	"\x16working_directory_path\x18\x02 \x01(\tR\x14workingDirectoryPathB7Z5bonanza.build/pkg/proto/configuration/bonanza_fetcherb\x06proto3"
 
 var (
 	file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_rawDescOnce sync.Once
@@ -196,33 +280,37 @@ func file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_
 	return file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_rawDescData
 }
 
-var file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_goTypes = []any{
 	(*ApplicationConfiguration)(nil),                    // 0: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration
-	nil,                                                 // 1: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.WorkerIdEntry
-	(*global.Configuration)(nil),                        // 2: buildbarn.configuration.global.Configuration
-	(*grpc.ClientConfiguration)(nil),                    // 3: buildbarn.configuration.grpc.ClientConfiguration
-	(*client.Configuration)(nil),                        // 4: buildbarn.configuration.http.client.Configuration
-	(*filesystem.FilePoolConfiguration)(nil),            // 5: buildbarn.configuration.filesystem.FilePoolConfiguration
-	(*x509.ClientCertificateVerifierConfiguration)(nil), // 6: buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
-	(*local.StoreConfiguration)(nil),                    // 7: bonanza.configuration.storage.object.local.StoreConfiguration
-	(*parser.ParsedObjectPool)(nil),                     // 8: bonanza.configuration.model.parser.ParsedObjectPool
+	(*GitConfiguration)(nil),                            // 1: bonanza.configuration.bonanza_fetcher.GitConfiguration
+	nil,                                                 // 2: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.WorkerIdEntry
+	(*global.Configuration)(nil),                        // 3: buildbarn.configuration.global.Configuration
+	(*grpc.ClientConfiguration)(nil),                    // 4: buildbarn.configuration.grpc.ClientConfiguration
+	(*grpc1.BatchingConfiguration)(nil),                 // 5: bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	(*client.Configuration)(nil),                        // 6: buildbarn.configuration.http.client.Configuration
+	(*filesystem.FilePoolConfiguration)(nil),            // 7: buildbarn.configuration.filesystem.FilePoolConfiguration
+	(*x509.ClientCertificateVerifierConfiguration)(nil), // 8: buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
+	(*local.StoreConfiguration)(nil),                    // 9: bonanza.configuration.storage.object.local.StoreConfiguration
+	(*parser.ParsedObjectPool)(nil),                     // 10: bonanza.configuration.model.parser.ParsedObjectPool
 }
 var file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_depIdxs = []int32{
-	2, // 0: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
-	3, // 1: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.storage_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	4, // 2: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.http_client:type_name -> buildbarn.configuration.http.client.Configuration
-	5, // 3: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.file_pool:type_name -> buildbarn.configuration.filesystem.FilePoolConfiguration
-	3, // 4: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.remote_worker_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	6, // 5: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.client_certificate_verifier:type_name -> buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
-	1, // 6: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.worker_id:type_name -> bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.WorkerIdEntry
-	7, // 7: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.local_object_store:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration
-	8, // 8: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.parsed_object_pool:type_name -> bonanza.configuration.model.parser.ParsedObjectPool
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	3,  // 0: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
+	4,  // 1: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.storage_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	5,  // 2: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.storage_batching:type_name -> bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	6,  // 3: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.http_client:type_name -> buildbarn.configuration.http.client.Configuration
+	7,  // 4: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.file_pool:type_name -> buildbarn.configuration.filesystem.FilePoolConfiguration
+	4,  // 5: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.remote_worker_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	8,  // 6: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.client_certificate_verifier:type_name -> buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
+	2,  // 7: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.worker_id:type_name -> bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.WorkerIdEntry
+	9,  // 8: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.local_object_store:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration
+	10, // 9: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.parsed_object_pool:type_name -> bonanza.configuration.model.parser.ParsedObjectPool
+	1,  // 10: bonanza.configuration.bonanza_fetcher.ApplicationConfiguration.git:type_name -> bonanza.configuration.bonanza_fetcher.GitConfiguration
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_init() }
@@ -236,7 +324,7 @@ func file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_rawDesc), len(file_bonanza_build_pkg_proto_configuration_bonanza_fetcher_bonanza_fetcher_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -8,6 +8,9 @@ package bonanza_scheduler
 
 import (
 	scheduler "bonanza.build/pkg/proto/configuration/scheduler"
+	grpc1 "bonanza.build/pkg/proto/configuration/storage/object/grpc"
+	object "bonanza.build/pkg/proto/storage/object"
+	auth "github.com/buildbarn/bb-storage/pkg/proto/configuration/auth"
 	global "github.com/buildbarn/bb-storage/pkg/proto/configuration/global"
 	grpc "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -26,14 +29,19 @@ const (
 )
 
 type ApplicationConfiguration struct {
-	state                             protoimpl.MessageState                   `protogen:"open.v1"`
-	Global                            *global.Configuration                    `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
-	ClientGrpcServers                 []*grpc.ServerConfiguration              `protobuf:"bytes,3,rep,name=client_grpc_servers,json=clientGrpcServers,proto3" json:"client_grpc_servers,omitempty"`
-	WorkerGrpcServers                 []*grpc.ServerConfiguration              `protobuf:"bytes,4,rep,name=worker_grpc_servers,json=workerGrpcServers,proto3" json:"worker_grpc_servers,omitempty"`
-	BuildQueueStateGrpcServers        []*grpc.ServerConfiguration              `protobuf:"bytes,5,rep,name=build_queue_state_grpc_servers,json=buildQueueStateGrpcServers,proto3" json:"build_queue_state_grpc_servers,omitempty"`
-	PredeclaredPlatformQueues         []*PredeclaredPlatformQueueConfiguration `protobuf:"bytes,6,rep,name=predeclared_platform_queues,json=predeclaredPlatformQueues,proto3" json:"predeclared_platform_queues,omitempty"`
-	ActionRouter                      *scheduler.ActionRouterConfiguration     `protobuf:"bytes,7,opt,name=action_router,json=actionRouter,proto3" json:"action_router,omitempty"`
-	PlatformQueueWithNoWorkersTimeout *durationpb.Duration                     `protobuf:"bytes,8,opt,name=platform_queue_with_no_workers_timeout,json=platformQueueWithNoWorkersTimeout,proto3" json:"platform_queue_with_no_workers_timeout,omitempty"`
+	state                             protoimpl.MessageState                    `protogen:"open.v1"`
+	Global                            *global.Configuration                     `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
+	ClientGrpcServers                 []*grpc.ServerConfiguration               `protobuf:"bytes,3,rep,name=client_grpc_servers,json=clientGrpcServers,proto3" json:"client_grpc_servers,omitempty"`
+	WorkerGrpcServers                 []*grpc.ServerConfiguration               `protobuf:"bytes,4,rep,name=worker_grpc_servers,json=workerGrpcServers,proto3" json:"worker_grpc_servers,omitempty"`
+	BuildQueueStateGrpcServers        []*grpc.ServerConfiguration               `protobuf:"bytes,5,rep,name=build_queue_state_grpc_servers,json=buildQueueStateGrpcServers,proto3" json:"build_queue_state_grpc_servers,omitempty"`
+	PredeclaredPlatformQueues         []*PredeclaredPlatformQueueConfiguration  `protobuf:"bytes,6,rep,name=predeclared_platform_queues,json=predeclaredPlatformQueues,proto3" json:"predeclared_platform_queues,omitempty"`
+	ActionRouter                      *scheduler.ActionRouterConfiguration      `protobuf:"bytes,7,opt,name=action_router,json=actionRouter,proto3" json:"action_router,omitempty"`
+	PlatformQueueWithNoWorkersTimeout *durationpb.Duration                      `protobuf:"bytes,8,opt,name=platform_queue_with_no_workers_timeout,json=platformQueueWithNoWorkersTimeout,proto3" json:"platform_queue_with_no_workers_timeout,omitempty"`
+	PreviousExecutionStatsStore       *PreviousExecutionStatsStoreConfiguration `protobuf:"bytes,9,opt,name=previous_execution_stats_store,json=previousExecutionStatsStore,proto3" json:"previous_execution_stats_store,omitempty"`
+	ExecuteAuthorizer                 *auth.AuthorizerConfiguration             `protobuf:"bytes,10,opt,name=execute_authorizer,json=executeAuthorizer,proto3" json:"execute_authorizer,omitempty"`
+	ModifyDrainsAuthorizer            *auth.AuthorizerConfiguration             `protobuf:"bytes,11,opt,name=modify_drains_authorizer,json=modifyDrainsAuthorizer,proto3" json:"modify_drains_authorizer,omitempty"`
+	KillOperationsAuthorizer          *auth.AuthorizerConfiguration             `protobuf:"bytes,12,opt,name=kill_operations_authorizer,json=killOperationsAuthorizer,proto3" json:"kill_operations_authorizer,omitempty"`
+	Journal                           *JournalConfiguration                     `protobuf:"bytes,13,opt,name=journal,proto3" json:"journal,omitempty"`
 	unknownFields                     protoimpl.UnknownFields
 	sizeCache                         protoimpl.SizeCache
 }
@@ -117,6 +125,153 @@ func (x *ApplicationConfiguration) GetPlatformQueueWithNoWorkersTimeout() *durat
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetPreviousExecutionStatsStore() *PreviousExecutionStatsStoreConfiguration {
+	if x != nil {
+		return x.PreviousExecutionStatsStore
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetExecuteAuthorizer() *auth.AuthorizerConfiguration {
+	if x != nil {
+		return x.ExecuteAuthorizer
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetModifyDrainsAuthorizer() *auth.AuthorizerConfiguration {
+	if x != nil {
+		return x.ModifyDrainsAuthorizer
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetKillOperationsAuthorizer() *auth.AuthorizerConfiguration {
+	if x != nil {
+		return x.KillOperationsAuthorizer
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetJournal() *JournalConfiguration {
+	if x != nil {
+		return x.Journal
+	}
+	return nil
+}
+
+type JournalConfiguration struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DirectoryPath    string                 `protobuf:"bytes,1,opt,name=directory_path,json=directoryPath,proto3" json:"directory_path,omitempty"`
+	SnapshotInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JournalConfiguration) Reset() {
+	*x = JournalConfiguration{}
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalConfiguration) ProtoMessage() {}
+
+func (x *JournalConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalConfiguration.ProtoReflect.Descriptor instead.
+func (*JournalConfiguration) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JournalConfiguration) GetDirectoryPath() string {
+	if x != nil {
+		return x.DirectoryPath
+	}
+	return ""
+}
+
+func (x *JournalConfiguration) GetSnapshotInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SnapshotInterval
+	}
+	return nil
+}
+
+type PreviousExecutionStatsStoreConfiguration struct {
+	state             protoimpl.MessageState       `protogen:"open.v1"`
+	StorageGrpcClient *grpc.ClientConfiguration    `protobuf:"bytes,1,opt,name=storage_grpc_client,json=storageGrpcClient,proto3" json:"storage_grpc_client,omitempty"`
+	StorageBatching   *grpc1.BatchingConfiguration `protobuf:"bytes,3,opt,name=storage_batching,json=storageBatching,proto3" json:"storage_batching,omitempty"`
+	Namespace         *object.Namespace            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PreviousExecutionStatsStoreConfiguration) Reset() {
+	*x = PreviousExecutionStatsStoreConfiguration{}
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviousExecutionStatsStoreConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviousExecutionStatsStoreConfiguration) ProtoMessage() {}
+
+func (x *PreviousExecutionStatsStoreConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviousExecutionStatsStoreConfiguration.ProtoReflect.Descriptor instead.
+func (*PreviousExecutionStatsStoreConfiguration) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PreviousExecutionStatsStoreConfiguration) GetStorageGrpcClient() *grpc.ClientConfiguration {
+	if x != nil {
+		return x.StorageGrpcClient
+	}
+	return nil
+}
+
+func (x *PreviousExecutionStatsStoreConfiguration) GetStorageBatching() *grpc1.BatchingConfiguration {
+	if x != nil {
+		return x.StorageBatching
+	}
+	return nil
+}
+
+func (x *PreviousExecutionStatsStoreConfiguration) GetNamespace() *object.Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
 type PredeclaredPlatformQueueConfiguration struct {
 	state                                     protoimpl.MessageState `protogen:"open.v1"`
 	PkixPublicKeys                            [][]byte               `protobuf:"bytes,1,rep,name=pkix_public_keys,json=pkixPublicKeys,proto3" json:"pkix_public_keys,omitempty"`
@@ -130,7 +285,7 @@ type PredeclaredPlatformQueueConfiguration struct {
 
 func (x *PredeclaredPlatformQueueConfiguration) Reset() {
 	*x = PredeclaredPlatformQueueConfiguration{}
-	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes[1]
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -142,7 +297,7 @@ func (x *PredeclaredPlatformQueueConfiguration) String() string {
 func (*PredeclaredPlatformQueueConfiguration) ProtoMessage() {}
 
 func (x *PredeclaredPlatformQueueConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes[1]
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +310,7 @@ func (x *PredeclaredPlatformQueueConfiguration) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use PredeclaredPlatformQueueConfiguration.ProtoReflect.Descriptor instead.
 func (*PredeclaredPlatformQueueConfiguration) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_rawDescGZIP(), []int{1}
+	return file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PredeclaredPlatformQueueConfiguration) GetPkixPublicKeys() [][]byte {
@@ -197,7 +352,8 @@ var File_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_schedul
 
 const file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_rawDesc = "" +
 	"\n" +
-	"Obonanza.build/pkg/proto/configuration/bonanza_scheduler/bonanza_scheduler.proto\x12'bonanza.configuration.bonanza_scheduler\x1a?bonanza.build/pkg/proto/configuration/scheduler/scheduler.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1a\x1egoogle/protobuf/duration.proto\"\xfe\x05\n" +
+	"Obonanza.build/pkg/proto/configuration/bonanza_scheduler/bonanza_scheduler.proto\x12'bonanza.configuration.bonanza_scheduler\x1a?bonanza.build/pkg/proto/configuration/scheduler/scheduler.proto\x1aDbonanza.build/pkg/proto/configuration/storage/object/grpc/grpc.proto\x1a3bonanza.build/pkg/proto/storage/object/object.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/auth/auth.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1a\x1egoogle/protobuf/duration.proto\"\xbc\n" +
+	"\n" +
 	"\x18ApplicationConfiguration\x12E\n" +
 	"\x06global\x18\x01 \x01(\v2-.buildbarn.configuration.global.ConfigurationR\x06global\x12a\n" +
 	"\x13client_grpc_servers\x18\x03 \x03(\v21.buildbarn.configuration.grpc.ServerConfigurationR\x11clientGrpcServers\x12a\n" +
@@ -205,7 +361,20 @@ const file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_sched
 	"\x1ebuild_queue_state_grpc_servers\x18\x05 \x03(\v21.buildbarn.configuration.grpc.ServerConfigurationR\x1abuildQueueStateGrpcServers\x12\x8e\x01\n" +
 	"\x1bpredeclared_platform_queues\x18\x06 \x03(\v2N.bonanza.configuration.bonanza_scheduler.PredeclaredPlatformQueueConfigurationR\x19predeclaredPlatformQueues\x12_\n" +
 	"\raction_router\x18\a \x01(\v2:.bonanza.configuration.scheduler.ActionRouterConfigurationR\factionRouter\x12l\n" +
-	"&platform_queue_with_no_workers_timeout\x18\b \x01(\v2\x19.google.protobuf.DurationR!platformQueueWithNoWorkersTimeout\"\x95\x03\n" +
+	"&platform_queue_with_no_workers_timeout\x18\b \x01(\v2\x19.google.protobuf.DurationR!platformQueueWithNoWorkersTimeout\x12\x96\x01\n" +
+	"\x1eprevious_execution_stats_store\x18\t \x01(\v2Q.bonanza.configuration.bonanza_scheduler.PreviousExecutionStatsStoreConfigurationR\x1bpreviousExecutionStatsStore\x12d\n" +
+	"\x12execute_authorizer\x18\n" +
+	" \x01(\v25.buildbarn.configuration.auth.AuthorizerConfigurationR\x11executeAuthorizer\x12o\n" +
+	"\x18modify_drains_authorizer\x18\v \x01(\v25.buildbarn.configuration.auth.AuthorizerConfigurationR\x16modifyDrainsAuthorizer\x12s\n" +
+	"\x1akill_operations_authorizer\x18\f \x01(\v25.buildbarn.configuration.auth.AuthorizerConfigurationR\x18killOperationsAuthorizer\x12W\n" +
+	"\ajournal\x18\r \x01(\v2=.bonanza.configuration.bonanza_scheduler.JournalConfigurationR\ajournal\"\x85\x01\n" +
+	"\x14JournalConfiguration\x12%\n" +
+	"\x0edirectory_path\x18\x01 \x01(\tR\rdirectoryPath\x12F\n" +
+	"\x11snapshot_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x10snapshotInterval\"\xbb\x02\n" +
+	"(PreviousExecutionStatsStoreConfiguration\x12a\n" +
+	"\x13storage_grpc_client\x18\x01 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x11storageGrpcClient\x12k\n" +
+	"\x10storage_batching\x18\x03 \x01(\v2@.bonanza.configuration.storage.object.grpc.BatchingConfigurationR\x0fstorageBatching\x12?\n" +
+	"\tnamespace\x18\x02 \x01(\v2!.bonanza.storage.object.NamespaceR\tnamespace\"\x95\x03\n" +
 	"%PredeclaredPlatformQueueConfiguration\x12(\n" +
 	"\x10pkix_public_keys\x18\x01 \x03(\fR\x0epkixPublicKeys\x12!\n" +
 	"\fsize_classes\x18\x02 \x03(\rR\vsizeClasses\x12h\n" +
@@ -225,29 +394,44 @@ func file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_schedu
 	return file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_rawDescData
 }
 
-var file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_goTypes = []any{
-	(*ApplicationConfiguration)(nil),              // 0: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration
-	(*PredeclaredPlatformQueueConfiguration)(nil), // 1: bonanza.configuration.bonanza_scheduler.PredeclaredPlatformQueueConfiguration
-	(*global.Configuration)(nil),                  // 2: buildbarn.configuration.global.Configuration
-	(*grpc.ServerConfiguration)(nil),              // 3: buildbarn.configuration.grpc.ServerConfiguration
-	(*scheduler.ActionRouterConfiguration)(nil),   // 4: bonanza.configuration.scheduler.ActionRouterConfiguration
-	(*durationpb.Duration)(nil),                   // 5: google.protobuf.Duration
+	(*ApplicationConfiguration)(nil),                 // 0: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration
+	(*JournalConfiguration)(nil),                     // 1: bonanza.configuration.bonanza_scheduler.JournalConfiguration
+	(*PreviousExecutionStatsStoreConfiguration)(nil), // 2: bonanza.configuration.bonanza_scheduler.PreviousExecutionStatsStoreConfiguration
+	(*PredeclaredPlatformQueueConfiguration)(nil),    // 3: bonanza.configuration.bonanza_scheduler.PredeclaredPlatformQueueConfiguration
+	(*global.Configuration)(nil),                     // 4: buildbarn.configuration.global.Configuration
+	(*grpc.ServerConfiguration)(nil),                 // 5: buildbarn.configuration.grpc.ServerConfiguration
+	(*scheduler.ActionRouterConfiguration)(nil),      // 6: bonanza.configuration.scheduler.ActionRouterConfiguration
+	(*durationpb.Duration)(nil),                      // 7: google.protobuf.Duration
+	(*auth.AuthorizerConfiguration)(nil),             // 8: buildbarn.configuration.auth.AuthorizerConfiguration
+	(*grpc.ClientConfiguration)(nil),                 // 9: buildbarn.configuration.grpc.ClientConfiguration
+	(*grpc1.BatchingConfiguration)(nil),              // 10: bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	(*object.Namespace)(nil),                         // 11: bonanza.storage.object.Namespace
 }
 var file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_depIdxs = []int32{
-	2, // 0: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
-	3, // 1: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.client_grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
-	3, // 2: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.worker_grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
-	3, // 3: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.build_queue_state_grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
-	1, // 4: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.predeclared_platform_queues:type_name -> bonanza.configuration.bonanza_scheduler.PredeclaredPlatformQueueConfiguration
-	4, // 5: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.action_router:type_name -> bonanza.configuration.scheduler.ActionRouterConfiguration
-	5, // 6: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.platform_queue_with_no_workers_timeout:type_name -> google.protobuf.Duration
-	5, // 7: bonanza.configuration.bonanza_scheduler.PredeclaredPlatformQueueConfiguration.worker_invocation_stickiness_limits:type_name -> google.protobuf.Duration
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	4,  // 0: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
+	5,  // 1: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.client_grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
+	5,  // 2: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.worker_grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
+	5,  // 3: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.build_queue_state_grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
+	3,  // 4: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.predeclared_platform_queues:type_name -> bonanza.configuration.bonanza_scheduler.PredeclaredPlatformQueueConfiguration
+	6,  // 5: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.action_router:type_name -> bonanza.configuration.scheduler.ActionRouterConfiguration
+	7,  // 6: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.platform_queue_with_no_workers_timeout:type_name -> google.protobuf.Duration
+	2,  // 7: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.previous_execution_stats_store:type_name -> bonanza.configuration.bonanza_scheduler.PreviousExecutionStatsStoreConfiguration
+	8,  // 8: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.execute_authorizer:type_name -> buildbarn.configuration.auth.AuthorizerConfiguration
+	8,  // 9: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.modify_drains_authorizer:type_name -> buildbarn.configuration.auth.AuthorizerConfiguration
+	8,  // 10: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.kill_operations_authorizer:type_name -> buildbarn.configuration.auth.AuthorizerConfiguration
+	1,  // 11: bonanza.configuration.bonanza_scheduler.ApplicationConfiguration.journal:type_name -> bonanza.configuration.bonanza_scheduler.JournalConfiguration
+	7,  // 12: bonanza.configuration.bonanza_scheduler.JournalConfiguration.snapshot_interval:type_name -> google.protobuf.Duration
+	9,  // 13: bonanza.configuration.bonanza_scheduler.PreviousExecutionStatsStoreConfiguration.storage_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	10, // 14: bonanza.configuration.bonanza_scheduler.PreviousExecutionStatsStoreConfiguration.storage_batching:type_name -> bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	11, // 15: bonanza.configuration.bonanza_scheduler.PreviousExecutionStatsStoreConfiguration.namespace:type_name -> bonanza.storage.object.Namespace
+	7,  // 16: bonanza.configuration.bonanza_scheduler.PredeclaredPlatformQueueConfiguration.worker_invocation_stickiness_limits:type_name -> google.protobuf.Duration
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() {
@@ -263,7 +447,7 @@ func file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_schedu
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_rawDesc), len(file_bonanza_build_pkg_proto_configuration_bonanza_scheduler_bonanza_scheduler_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
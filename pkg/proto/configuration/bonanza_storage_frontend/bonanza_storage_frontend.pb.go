@@ -7,11 +7,14 @@
 package bonanza_storage_frontend
 
 import (
+	grpc1 "bonanza.build/pkg/proto/configuration/storage/object/grpc"
 	object "bonanza.build/pkg/proto/storage/object"
+	auth "github.com/buildbarn/bb-storage/pkg/proto/configuration/auth"
 	global "github.com/buildbarn/bb-storage/pkg/proto/configuration/global"
 	grpc "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,14 +28,19 @@ const (
 )
 
 type ApplicationConfiguration struct {
-	state                          protoimpl.MessageState                     `protogen:"open.v1"`
-	Global                         *global.Configuration                      `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
-	GrpcServers                    []*grpc.ServerConfiguration                `protobuf:"bytes,2,rep,name=grpc_servers,json=grpcServers,proto3" json:"grpc_servers,omitempty"`
-	ObjectStoreConcurrency         int64                                      `protobuf:"varint,3,opt,name=object_store_concurrency,json=objectStoreConcurrency,proto3" json:"object_store_concurrency,omitempty"`
-	MaximumUnfinalizedDagsCount    uint32                                     `protobuf:"varint,4,opt,name=maximum_unfinalized_dags_count,json=maximumUnfinalizedDagsCount,proto3" json:"maximum_unfinalized_dags_count,omitempty"`
-	MaximumUnfinalizedParentsLimit *object.Limit                              `protobuf:"bytes,5,opt,name=maximum_unfinalized_parents_limit,json=maximumUnfinalizedParentsLimit,proto3" json:"maximum_unfinalized_parents_limit,omitempty"`
-	ShardsReplicaA                 map[string]*ApplicationConfiguration_Shard `protobuf:"bytes,6,rep,name=shards_replica_a,json=shardsReplicaA,proto3" json:"shards_replica_a,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ShardsReplicaB                 map[string]*ApplicationConfiguration_Shard `protobuf:"bytes,7,rep,name=shards_replica_b,json=shardsReplicaB,proto3" json:"shards_replica_b,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state                          protoimpl.MessageState                  `protogen:"open.v1"`
+	Global                         *global.Configuration                   `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
+	GrpcServers                    []*grpc.ServerConfiguration             `protobuf:"bytes,2,rep,name=grpc_servers,json=grpcServers,proto3" json:"grpc_servers,omitempty"`
+	ObjectStoreConcurrency         int64                                   `protobuf:"varint,3,opt,name=object_store_concurrency,json=objectStoreConcurrency,proto3" json:"object_store_concurrency,omitempty"`
+	MaximumUnfinalizedDagsCount    uint32                                  `protobuf:"varint,4,opt,name=maximum_unfinalized_dags_count,json=maximumUnfinalizedDagsCount,proto3" json:"maximum_unfinalized_dags_count,omitempty"`
+	MaximumUnfinalizedParentsLimit *object.Limit                           `protobuf:"bytes,5,opt,name=maximum_unfinalized_parents_limit,json=maximumUnfinalizedParentsLimit,proto3" json:"maximum_unfinalized_parents_limit,omitempty"`
+	ShardBatching                  *grpc1.BatchingConfiguration            `protobuf:"bytes,8,opt,name=shard_batching,json=shardBatching,proto3" json:"shard_batching,omitempty"`
+	Replicas                       []*ApplicationConfiguration_Replica     `protobuf:"bytes,9,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	ReadQuorum                     uint32                                  `protobuf:"varint,10,opt,name=read_quorum,json=readQuorum,proto3" json:"read_quorum,omitempty"`
+	WriteQuorum                    uint32                                  `protobuf:"varint,11,opt,name=write_quorum,json=writeQuorum,proto3" json:"write_quorum,omitempty"`
+	ReplicaRepair                  *ApplicationConfiguration_ReplicaRepair `protobuf:"bytes,12,opt,name=replica_repair,json=replicaRepair,proto3" json:"replica_repair,omitempty"`
+	ReadAuthorizer                 *auth.AuthorizerConfiguration           `protobuf:"bytes,13,opt,name=read_authorizer,json=readAuthorizer,proto3" json:"read_authorizer,omitempty"`
+	WriteAuthorizer                *auth.AuthorizerConfiguration           `protobuf:"bytes,14,opt,name=write_authorizer,json=writeAuthorizer,proto3" json:"write_authorizer,omitempty"`
 	unknownFields                  protoimpl.UnknownFields
 	sizeCache                      protoimpl.SizeCache
 }
@@ -102,16 +110,51 @@ func (x *ApplicationConfiguration) GetMaximumUnfinalizedParentsLimit() *object.L
 	return nil
 }
 
-func (x *ApplicationConfiguration) GetShardsReplicaA() map[string]*ApplicationConfiguration_Shard {
+func (x *ApplicationConfiguration) GetShardBatching() *grpc1.BatchingConfiguration {
 	if x != nil {
-		return x.ShardsReplicaA
+		return x.ShardBatching
 	}
 	return nil
 }
 
-func (x *ApplicationConfiguration) GetShardsReplicaB() map[string]*ApplicationConfiguration_Shard {
+func (x *ApplicationConfiguration) GetReplicas() []*ApplicationConfiguration_Replica {
 	if x != nil {
-		return x.ShardsReplicaB
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetReadQuorum() uint32 {
+	if x != nil {
+		return x.ReadQuorum
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetWriteQuorum() uint32 {
+	if x != nil {
+		return x.WriteQuorum
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetReplicaRepair() *ApplicationConfiguration_ReplicaRepair {
+	if x != nil {
+		return x.ReplicaRepair
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetReadAuthorizer() *auth.AuthorizerConfiguration {
+	if x != nil {
+		return x.ReadAuthorizer
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetWriteAuthorizer() *auth.AuthorizerConfiguration {
+	if x != nil {
+		return x.WriteAuthorizer
 	}
 	return nil
 }
@@ -168,28 +211,145 @@ func (x *ApplicationConfiguration_Shard) GetWeight() uint32 {
 	return 0
 }
 
+type ApplicationConfiguration_Replica struct {
+	state          protoimpl.MessageState                     `protogen:"open.v1"`
+	Shards         map[string]*ApplicationConfiguration_Shard `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PreviousShards map[string]*ApplicationConfiguration_Shard `protobuf:"bytes,2,rep,name=previous_shards,json=previousShards,proto3" json:"previous_shards,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApplicationConfiguration_Replica) Reset() {
+	*x = ApplicationConfiguration_Replica{}
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationConfiguration_Replica) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationConfiguration_Replica) ProtoMessage() {}
+
+func (x *ApplicationConfiguration_Replica) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationConfiguration_Replica.ProtoReflect.Descriptor instead.
+func (*ApplicationConfiguration_Replica) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ApplicationConfiguration_Replica) GetShards() map[string]*ApplicationConfiguration_Shard {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration_Replica) GetPreviousShards() map[string]*ApplicationConfiguration_Shard {
+	if x != nil {
+		return x.PreviousShards
+	}
+	return nil
+}
+
+type ApplicationConfiguration_ReplicaRepair struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Interval               *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	ObjectStoreConcurrency int64                  `protobuf:"varint,2,opt,name=object_store_concurrency,json=objectStoreConcurrency,proto3" json:"object_store_concurrency,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ApplicationConfiguration_ReplicaRepair) Reset() {
+	*x = ApplicationConfiguration_ReplicaRepair{}
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationConfiguration_ReplicaRepair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationConfiguration_ReplicaRepair) ProtoMessage() {}
+
+func (x *ApplicationConfiguration_ReplicaRepair) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationConfiguration_ReplicaRepair.ProtoReflect.Descriptor instead.
+func (*ApplicationConfiguration_ReplicaRepair) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *ApplicationConfiguration_ReplicaRepair) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration_ReplicaRepair) GetObjectStoreConcurrency() int64 {
+	if x != nil {
+		return x.ObjectStoreConcurrency
+	}
+	return 0
+}
+
 var File_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto protoreflect.FileDescriptor
 
 const file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_rawDesc = "" +
 	"\n" +
-	"]bonanza.build/pkg/proto/configuration/bonanza_storage_frontend/bonanza_storage_frontend.proto\x12.bonanza.configuration.bonanza_storage_frontend\x1a3bonanza.build/pkg/proto/storage/object/object.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\"\xc6\b\n" +
+	"]bonanza.build/pkg/proto/configuration/bonanza_storage_frontend/bonanza_storage_frontend.proto\x12.bonanza.configuration.bonanza_storage_frontend\x1aDbonanza.build/pkg/proto/configuration/storage/object/grpc/grpc.proto\x1a3bonanza.build/pkg/proto/storage/object/object.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/auth/auth.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1a\x1egoogle/protobuf/duration.proto\"\xaf\x0e\n" +
 	"\x18ApplicationConfiguration\x12E\n" +
 	"\x06global\x18\x01 \x01(\v2-.buildbarn.configuration.global.ConfigurationR\x06global\x12T\n" +
 	"\fgrpc_servers\x18\x02 \x03(\v21.buildbarn.configuration.grpc.ServerConfigurationR\vgrpcServers\x128\n" +
 	"\x18object_store_concurrency\x18\x03 \x01(\x03R\x16objectStoreConcurrency\x12C\n" +
 	"\x1emaximum_unfinalized_dags_count\x18\x04 \x01(\rR\x1bmaximumUnfinalizedDagsCount\x12h\n" +
-	"!maximum_unfinalized_parents_limit\x18\x05 \x01(\v2\x1d.bonanza.storage.object.LimitR\x1emaximumUnfinalizedParentsLimit\x12\x86\x01\n" +
-	"\x10shards_replica_a\x18\x06 \x03(\v2\\.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardsReplicaAEntryR\x0eshardsReplicaA\x12\x86\x01\n" +
-	"\x10shards_replica_b\x18\a \x03(\v2\\.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardsReplicaBEntryR\x0eshardsReplicaB\x1aj\n" +
+	"!maximum_unfinalized_parents_limit\x18\x05 \x01(\v2\x1d.bonanza.storage.object.LimitR\x1emaximumUnfinalizedParentsLimit\x12g\n" +
+	"\x0eshard_batching\x18\b \x01(\v2@.bonanza.configuration.storage.object.grpc.BatchingConfigurationR\rshardBatching\x12l\n" +
+	"\breplicas\x18\t \x03(\v2P.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ReplicaR\breplicas\x12\x1f\n" +
+	"\vread_quorum\x18\n" +
+	" \x01(\rR\n" +
+	"readQuorum\x12!\n" +
+	"\fwrite_quorum\x18\v \x01(\rR\vwriteQuorum\x12}\n" +
+	"\x0ereplica_repair\x18\f \x01(\v2V.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ReplicaRepairR\rreplicaRepair\x12^\n" +
+	"\x0fread_authorizer\x18\r \x01(\v25.buildbarn.configuration.auth.AuthorizerConfigurationR\x0ereadAuthorizer\x12`\n" +
+	"\x10write_authorizer\x18\x0e \x01(\v25.buildbarn.configuration.auth.AuthorizerConfigurationR\x0fwriteAuthorizer\x1aj\n" +
 	"\x05Shard\x12I\n" +
 	"\x06client\x18\x01 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x06client\x12\x16\n" +
-	"\x06weight\x18\x02 \x01(\rR\x06weight\x1a\x91\x01\n" +
-	"\x13ShardsReplicaAEntry\x12\x10\n" +
+	"\x06weight\x18\x02 \x01(\rR\x06weight\x1a\xaf\x04\n" +
+	"\aReplica\x12t\n" +
+	"\x06shards\x18\x01 \x03(\v2\\.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.ShardsEntryR\x06shards\x12\x8d\x01\n" +
+	"\x0fprevious_shards\x18\x02 \x03(\v2d.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.PreviousShardsEntryR\x0epreviousShards\x1a\x89\x01\n" +
+	"\vShardsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12d\n" +
 	"\x05value\x18\x02 \x01(\v2N.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardR\x05value:\x028\x01\x1a\x91\x01\n" +
-	"\x13ShardsReplicaBEntry\x12\x10\n" +
+	"\x13PreviousShardsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12d\n" +
-	"\x05value\x18\x02 \x01(\v2N.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardR\x05value:\x028\x01B@Z>bonanza.build/pkg/proto/configuration/bonanza_storage_frontendb\x06proto3"
+	"\x05value\x18\x02 \x01(\v2N.bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardR\x05value:\x028\x01\x1a\x86\x01\n" +
+	"\rReplicaRepair\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x128\n" +
+	"\x18object_store_concurrency\x18\x02 \x01(\x03R\x16objectStoreConcurrencyJ\x04\b\x03\x10\x04J\x04\b\x06\x10\aJ\x04\b\a\x10\bB@Z>bonanza.build/pkg/proto/configuration/bonanza_storage_frontendb\x06proto3"
 
 var (
 	file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_rawDescOnce sync.Once
@@ -203,31 +363,42 @@ func file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza
 	return file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_rawDescData
 }
 
-var file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_goTypes = []any{
-	(*ApplicationConfiguration)(nil),       // 0: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration
-	(*ApplicationConfiguration_Shard)(nil), // 1: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Shard
-	nil,                                    // 2: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardsReplicaAEntry
-	nil,                                    // 3: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardsReplicaBEntry
-	(*global.Configuration)(nil),           // 4: buildbarn.configuration.global.Configuration
-	(*grpc.ServerConfiguration)(nil),       // 5: buildbarn.configuration.grpc.ServerConfiguration
-	(*object.Limit)(nil),                   // 6: bonanza.storage.object.Limit
-	(*grpc.ClientConfiguration)(nil),       // 7: buildbarn.configuration.grpc.ClientConfiguration
+	(*ApplicationConfiguration)(nil),               // 0: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration
+	(*ApplicationConfiguration_Shard)(nil),         // 1: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Shard
+	(*ApplicationConfiguration_Replica)(nil),       // 2: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica
+	(*ApplicationConfiguration_ReplicaRepair)(nil), // 3: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ReplicaRepair
+	nil,                                  // 4: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.ShardsEntry
+	nil,                                  // 5: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.PreviousShardsEntry
+	(*global.Configuration)(nil),         // 6: buildbarn.configuration.global.Configuration
+	(*grpc.ServerConfiguration)(nil),     // 7: buildbarn.configuration.grpc.ServerConfiguration
+	(*object.Limit)(nil),                 // 8: bonanza.storage.object.Limit
+	(*grpc1.BatchingConfiguration)(nil),  // 9: bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	(*auth.AuthorizerConfiguration)(nil), // 10: buildbarn.configuration.auth.AuthorizerConfiguration
+	(*grpc.ClientConfiguration)(nil),     // 11: buildbarn.configuration.grpc.ClientConfiguration
+	(*durationpb.Duration)(nil),          // 12: google.protobuf.Duration
 }
 var file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_depIdxs = []int32{
-	4, // 0: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
-	5, // 1: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
-	6, // 2: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.maximum_unfinalized_parents_limit:type_name -> bonanza.storage.object.Limit
-	2, // 3: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.shards_replica_a:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardsReplicaAEntry
-	3, // 4: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.shards_replica_b:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardsReplicaBEntry
-	7, // 5: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Shard.client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	1, // 6: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardsReplicaAEntry.value:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Shard
-	1, // 7: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ShardsReplicaBEntry.value:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Shard
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	6,  // 0: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
+	7,  // 1: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
+	8,  // 2: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.maximum_unfinalized_parents_limit:type_name -> bonanza.storage.object.Limit
+	9,  // 3: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.shard_batching:type_name -> bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	2,  // 4: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.replicas:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica
+	3,  // 5: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.replica_repair:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ReplicaRepair
+	10, // 6: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.read_authorizer:type_name -> buildbarn.configuration.auth.AuthorizerConfiguration
+	10, // 7: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.write_authorizer:type_name -> buildbarn.configuration.auth.AuthorizerConfiguration
+	11, // 8: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Shard.client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	4,  // 9: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.shards:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.ShardsEntry
+	5,  // 10: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.previous_shards:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.PreviousShardsEntry
+	12, // 11: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.ReplicaRepair.interval:type_name -> google.protobuf.Duration
+	1,  // 12: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.ShardsEntry.value:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Shard
+	1,  // 13: bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Replica.PreviousShardsEntry.value:type_name -> bonanza.configuration.bonanza_storage_frontend.ApplicationConfiguration.Shard
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() {
@@ -243,7 +414,7 @@ func file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_rawDesc), len(file_bonanza_build_pkg_proto_configuration_bonanza_storage_frontend_bonanza_storage_frontend_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
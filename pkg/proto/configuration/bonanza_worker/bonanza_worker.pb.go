@@ -8,6 +8,7 @@ package bonanza_worker
 
 import (
 	parser "bonanza.build/pkg/proto/configuration/model/parser"
+	grpc1 "bonanza.build/pkg/proto/configuration/storage/object/grpc"
 	local "bonanza.build/pkg/proto/configuration/storage/object/local"
 	filesystem "github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem"
 	virtual "github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/virtual"
@@ -30,16 +31,18 @@ const (
 )
 
 type ApplicationConfiguration struct {
-	state               protoimpl.MessageState            `protogen:"open.v1"`
-	Global              *global.Configuration             `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
-	StorageGrpcClient   *grpc.ClientConfiguration         `protobuf:"bytes,2,opt,name=storage_grpc_client,json=storageGrpcClient,proto3" json:"storage_grpc_client,omitempty"`
-	SchedulerGrpcClient *grpc.ClientConfiguration         `protobuf:"bytes,3,opt,name=scheduler_grpc_client,json=schedulerGrpcClient,proto3" json:"scheduler_grpc_client,omitempty"`
-	BuildDirectories    []*BuildDirectoryConfiguration    `protobuf:"bytes,4,rep,name=build_directories,json=buildDirectories,proto3" json:"build_directories,omitempty"`
-	FilePool            *filesystem.FilePoolConfiguration `protobuf:"bytes,5,opt,name=file_pool,json=filePool,proto3" json:"file_pool,omitempty"`
-	LocalObjectStore    *local.StoreConfiguration         `protobuf:"bytes,7,opt,name=local_object_store,json=localObjectStore,proto3" json:"local_object_store,omitempty"`
-	ParsedObjectPool    *parser.ParsedObjectPool          `protobuf:"bytes,6,opt,name=parsed_object_pool,json=parsedObjectPool,proto3" json:"parsed_object_pool,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                               protoimpl.MessageState            `protogen:"open.v1"`
+	Global                              *global.Configuration             `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
+	StorageGrpcClient                   *grpc.ClientConfiguration         `protobuf:"bytes,2,opt,name=storage_grpc_client,json=storageGrpcClient,proto3" json:"storage_grpc_client,omitempty"`
+	StorageBatching                     *grpc1.BatchingConfiguration      `protobuf:"bytes,8,opt,name=storage_batching,json=storageBatching,proto3" json:"storage_batching,omitempty"`
+	SchedulerGrpcClient                 *grpc.ClientConfiguration         `protobuf:"bytes,3,opt,name=scheduler_grpc_client,json=schedulerGrpcClient,proto3" json:"scheduler_grpc_client,omitempty"`
+	BuildDirectories                    []*BuildDirectoryConfiguration    `protobuf:"bytes,4,rep,name=build_directories,json=buildDirectories,proto3" json:"build_directories,omitempty"`
+	FilePool                            *filesystem.FilePoolConfiguration `protobuf:"bytes,5,opt,name=file_pool,json=filePool,proto3" json:"file_pool,omitempty"`
+	LocalObjectStore                    *local.StoreConfiguration         `protobuf:"bytes,7,opt,name=local_object_store,json=localObjectStore,proto3" json:"local_object_store,omitempty"`
+	LocalObjectStorePrefetchConcurrency int64                             `protobuf:"varint,17,opt,name=local_object_store_prefetch_concurrency,json=localObjectStorePrefetchConcurrency,proto3" json:"local_object_store_prefetch_concurrency,omitempty"`
+	ParsedObjectPool                    *parser.ParsedObjectPool          `protobuf:"bytes,6,opt,name=parsed_object_pool,json=parsedObjectPool,proto3" json:"parsed_object_pool,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
 }
 
 func (x *ApplicationConfiguration) Reset() {
@@ -86,6 +89,13 @@ func (x *ApplicationConfiguration) GetStorageGrpcClient() *grpc.ClientConfigurat
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetStorageBatching() *grpc1.BatchingConfiguration {
+	if x != nil {
+		return x.StorageBatching
+	}
+	return nil
+}
+
 func (x *ApplicationConfiguration) GetSchedulerGrpcClient() *grpc.ClientConfiguration {
 	if x != nil {
 		return x.SchedulerGrpcClient
@@ -114,6 +124,13 @@ func (x *ApplicationConfiguration) GetLocalObjectStore() *local.StoreConfigurati
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetLocalObjectStorePrefetchConcurrency() int64 {
+	if x != nil {
+		return x.LocalObjectStorePrefetchConcurrency
+	}
+	return 0
+}
+
 func (x *ApplicationConfiguration) GetParsedObjectPool() *parser.ParsedObjectPool {
 	if x != nil {
 		return x.ParsedObjectPool
@@ -341,14 +358,16 @@ var File_bonanza_build_pkg_proto_configuration_bonanza_worker_bonanza_worker_pro
 
 const file_bonanza_build_pkg_proto_configuration_bonanza_worker_bonanza_worker_proto_rawDesc = "" +
 	"\n" +
-	"Ibonanza.build/pkg/proto/configuration/bonanza_worker/bonanza_worker.proto\x12$bonanza.configuration.bonanza_worker\x1a?bonanza.build/pkg/proto/configuration/model/parser/parser.proto\x1aFbonanza.build/pkg/proto/configuration/storage/object/local/local.proto\x1a\\github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/filesystem.proto\x1aagithub.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/virtual/virtual.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/x509/x509.proto\x1a\x1egoogle/protobuf/duration.proto\"\xc5\x05\n" +
+	"Ibonanza.build/pkg/proto/configuration/bonanza_worker/bonanza_worker.proto\x12$bonanza.configuration.bonanza_worker\x1a?bonanza.build/pkg/proto/configuration/model/parser/parser.proto\x1aDbonanza.build/pkg/proto/configuration/storage/object/grpc/grpc.proto\x1aFbonanza.build/pkg/proto/configuration/storage/object/local/local.proto\x1a\\github.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/filesystem.proto\x1aagithub.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/virtual/virtual.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/x509/x509.proto\x1a\x1egoogle/protobuf/duration.proto\"\x88\a\n" +
 	"\x18ApplicationConfiguration\x12E\n" +
 	"\x06global\x18\x01 \x01(\v2-.buildbarn.configuration.global.ConfigurationR\x06global\x12a\n" +
-	"\x13storage_grpc_client\x18\x02 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x11storageGrpcClient\x12e\n" +
+	"\x13storage_grpc_client\x18\x02 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x11storageGrpcClient\x12k\n" +
+	"\x10storage_batching\x18\b \x01(\v2@.bonanza.configuration.storage.object.grpc.BatchingConfigurationR\x0fstorageBatching\x12e\n" +
 	"\x15scheduler_grpc_client\x18\x03 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\x13schedulerGrpcClient\x12n\n" +
 	"\x11build_directories\x18\x04 \x03(\v2A.bonanza.configuration.bonanza_worker.BuildDirectoryConfigurationR\x10buildDirectories\x12V\n" +
 	"\tfile_pool\x18\x05 \x01(\v29.buildbarn.configuration.filesystem.FilePoolConfigurationR\bfilePool\x12l\n" +
-	"\x12local_object_store\x18\a \x01(\v2>.bonanza.configuration.storage.object.local.StoreConfigurationR\x10localObjectStore\x12b\n" +
+	"\x12local_object_store\x18\a \x01(\v2>.bonanza.configuration.storage.object.local.StoreConfigurationR\x10localObjectStore\x12T\n" +
+	"'local_object_store_prefetch_concurrency\x18\x11 \x01(\x03R#localObjectStorePrefetchConcurrency\x12b\n" +
 	"\x12parsed_object_pool\x18\x06 \x01(\v24.bonanza.configuration.model.parser.ParsedObjectPoolR\x10parsedObjectPool\"\xc8\x01\n" +
 	"\x1bBuildDirectoryConfiguration\x12S\n" +
 	"\arunners\x18\x01 \x03(\v29.bonanza.configuration.bonanza_worker.RunnerConfigurationR\arunners\x12T\n" +
@@ -401,34 +420,36 @@ var file_bonanza_build_pkg_proto_configuration_bonanza_worker_bonanza_worker_pro
 	nil,                                                 // 4: bonanza.configuration.bonanza_worker.RunnerConfiguration.EnvironmentVariablesEntry
 	(*global.Configuration)(nil),                        // 5: buildbarn.configuration.global.Configuration
 	(*grpc.ClientConfiguration)(nil),                    // 6: buildbarn.configuration.grpc.ClientConfiguration
-	(*filesystem.FilePoolConfiguration)(nil),            // 7: buildbarn.configuration.filesystem.FilePoolConfiguration
-	(*local.StoreConfiguration)(nil),                    // 8: bonanza.configuration.storage.object.local.StoreConfiguration
-	(*parser.ParsedObjectPool)(nil),                     // 9: bonanza.configuration.model.parser.ParsedObjectPool
-	(*virtual.MountConfiguration)(nil),                  // 10: buildbarn.configuration.filesystem.virtual.MountConfiguration
-	(*x509.ClientCertificateVerifierConfiguration)(nil), // 11: buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
-	(*durationpb.Duration)(nil),                         // 12: google.protobuf.Duration
+	(*grpc1.BatchingConfiguration)(nil),                 // 7: bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	(*filesystem.FilePoolConfiguration)(nil),            // 8: buildbarn.configuration.filesystem.FilePoolConfiguration
+	(*local.StoreConfiguration)(nil),                    // 9: bonanza.configuration.storage.object.local.StoreConfiguration
+	(*parser.ParsedObjectPool)(nil),                     // 10: bonanza.configuration.model.parser.ParsedObjectPool
+	(*virtual.MountConfiguration)(nil),                  // 11: buildbarn.configuration.filesystem.virtual.MountConfiguration
+	(*x509.ClientCertificateVerifierConfiguration)(nil), // 12: buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
+	(*durationpb.Duration)(nil),                         // 13: google.protobuf.Duration
 }
 var file_bonanza_build_pkg_proto_configuration_bonanza_worker_bonanza_worker_proto_depIdxs = []int32{
 	5,  // 0: bonanza.configuration.bonanza_worker.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
 	6,  // 1: bonanza.configuration.bonanza_worker.ApplicationConfiguration.storage_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	6,  // 2: bonanza.configuration.bonanza_worker.ApplicationConfiguration.scheduler_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	1,  // 3: bonanza.configuration.bonanza_worker.ApplicationConfiguration.build_directories:type_name -> bonanza.configuration.bonanza_worker.BuildDirectoryConfiguration
-	7,  // 4: bonanza.configuration.bonanza_worker.ApplicationConfiguration.file_pool:type_name -> buildbarn.configuration.filesystem.FilePoolConfiguration
-	8,  // 5: bonanza.configuration.bonanza_worker.ApplicationConfiguration.local_object_store:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration
-	9,  // 6: bonanza.configuration.bonanza_worker.ApplicationConfiguration.parsed_object_pool:type_name -> bonanza.configuration.model.parser.ParsedObjectPool
-	2,  // 7: bonanza.configuration.bonanza_worker.BuildDirectoryConfiguration.runners:type_name -> bonanza.configuration.bonanza_worker.RunnerConfiguration
-	10, // 8: bonanza.configuration.bonanza_worker.BuildDirectoryConfiguration.mount:type_name -> buildbarn.configuration.filesystem.virtual.MountConfiguration
-	6,  // 9: bonanza.configuration.bonanza_worker.RunnerConfiguration.endpoint:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	11, // 10: bonanza.configuration.bonanza_worker.RunnerConfiguration.client_certificate_verifier:type_name -> buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
-	12, // 11: bonanza.configuration.bonanza_worker.RunnerConfiguration.maximum_execution_timeout_compensation:type_name -> google.protobuf.Duration
-	12, // 12: bonanza.configuration.bonanza_worker.RunnerConfiguration.maximum_writable_file_upload_delay:type_name -> google.protobuf.Duration
-	3,  // 13: bonanza.configuration.bonanza_worker.RunnerConfiguration.worker_id:type_name -> bonanza.configuration.bonanza_worker.RunnerConfiguration.WorkerIdEntry
-	4,  // 14: bonanza.configuration.bonanza_worker.RunnerConfiguration.environment_variables:type_name -> bonanza.configuration.bonanza_worker.RunnerConfiguration.EnvironmentVariablesEntry
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	7,  // 2: bonanza.configuration.bonanza_worker.ApplicationConfiguration.storage_batching:type_name -> bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	6,  // 3: bonanza.configuration.bonanza_worker.ApplicationConfiguration.scheduler_grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	1,  // 4: bonanza.configuration.bonanza_worker.ApplicationConfiguration.build_directories:type_name -> bonanza.configuration.bonanza_worker.BuildDirectoryConfiguration
+	8,  // 5: bonanza.configuration.bonanza_worker.ApplicationConfiguration.file_pool:type_name -> buildbarn.configuration.filesystem.FilePoolConfiguration
+	9,  // 6: bonanza.configuration.bonanza_worker.ApplicationConfiguration.local_object_store:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration
+	10, // 7: bonanza.configuration.bonanza_worker.ApplicationConfiguration.parsed_object_pool:type_name -> bonanza.configuration.model.parser.ParsedObjectPool
+	2,  // 8: bonanza.configuration.bonanza_worker.BuildDirectoryConfiguration.runners:type_name -> bonanza.configuration.bonanza_worker.RunnerConfiguration
+	11, // 9: bonanza.configuration.bonanza_worker.BuildDirectoryConfiguration.mount:type_name -> buildbarn.configuration.filesystem.virtual.MountConfiguration
+	6,  // 10: bonanza.configuration.bonanza_worker.RunnerConfiguration.endpoint:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	12, // 11: bonanza.configuration.bonanza_worker.RunnerConfiguration.client_certificate_verifier:type_name -> buildbarn.configuration.x509.ClientCertificateVerifierConfiguration
+	13, // 12: bonanza.configuration.bonanza_worker.RunnerConfiguration.maximum_execution_timeout_compensation:type_name -> google.protobuf.Duration
+	13, // 13: bonanza.configuration.bonanza_worker.RunnerConfiguration.maximum_writable_file_upload_delay:type_name -> google.protobuf.Duration
+	3,  // 14: bonanza.configuration.bonanza_worker.RunnerConfiguration.worker_id:type_name -> bonanza.configuration.bonanza_worker.RunnerConfiguration.WorkerIdEntry
+	4,  // 15: bonanza.configuration.bonanza_worker.RunnerConfiguration.environment_variables:type_name -> bonanza.configuration.bonanza_worker.RunnerConfiguration.EnvironmentVariablesEntry
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_configuration_bonanza_worker_bonanza_worker_proto_init() }
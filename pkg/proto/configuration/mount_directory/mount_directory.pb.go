@@ -8,6 +8,7 @@ package mount_directory
 
 import (
 	parser "bonanza.build/pkg/proto/configuration/model/parser"
+	grpc1 "bonanza.build/pkg/proto/configuration/storage/object/grpc"
 	core "bonanza.build/pkg/proto/model/core"
 	encoding "bonanza.build/pkg/proto/model/encoding"
 	object "bonanza.build/pkg/proto/storage/object"
@@ -34,6 +35,7 @@ type ApplicationConfiguration struct {
 	Mount                    *virtual.MountConfiguration  `protobuf:"bytes,2,opt,name=mount,proto3" json:"mount,omitempty"`
 	ParsedObjectPool         *parser.ParsedObjectPool     `protobuf:"bytes,3,opt,name=parsed_object_pool,json=parsedObjectPool,proto3" json:"parsed_object_pool,omitempty"`
 	GrpcClient               *grpc.ClientConfiguration    `protobuf:"bytes,4,opt,name=grpc_client,json=grpcClient,proto3" json:"grpc_client,omitempty"`
+	Batching                 *grpc1.BatchingConfiguration `protobuf:"bytes,10,opt,name=batching,proto3" json:"batching,omitempty"`
 	Namespace                *object.Namespace            `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	RootDirectoryReference   *core.WeakDecodableReference `protobuf:"bytes,6,opt,name=root_directory_reference,json=rootDirectoryReference,proto3" json:"root_directory_reference,omitempty"`
 	DirectoryEncoders        []*encoding.BinaryEncoder    `protobuf:"bytes,7,rep,name=directory_encoders,json=directoryEncoders,proto3" json:"directory_encoders,omitempty"`
@@ -101,6 +103,13 @@ func (x *ApplicationConfiguration) GetGrpcClient() *grpc.ClientConfiguration {
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetBatching() *grpc1.BatchingConfiguration {
+	if x != nil {
+		return x.Batching
+	}
+	return nil
+}
+
 func (x *ApplicationConfiguration) GetNamespace() *object.Namespace {
 	if x != nil {
 		return x.Namespace
@@ -140,13 +149,15 @@ var File_bonanza_build_pkg_proto_configuration_mount_directory_mount_directory_p
 
 const file_bonanza_build_pkg_proto_configuration_mount_directory_mount_directory_proto_rawDesc = "" +
 	"\n" +
-	"Kbonanza.build/pkg/proto/configuration/mount_directory/mount_directory.proto\x12%bonanza.configuration.mount_directory\x1a?bonanza.build/pkg/proto/configuration/model/parser/parser.proto\x1a-bonanza.build/pkg/proto/model/core/core.proto\x1a5bonanza.build/pkg/proto/model/encoding/encoding.proto\x1a3bonanza.build/pkg/proto/storage/object/object.proto\x1aagithub.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/virtual/virtual.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\"\xdb\x06\n" +
+	"Kbonanza.build/pkg/proto/configuration/mount_directory/mount_directory.proto\x12%bonanza.configuration.mount_directory\x1a?bonanza.build/pkg/proto/configuration/model/parser/parser.proto\x1aDbonanza.build/pkg/proto/configuration/storage/object/grpc/grpc.proto\x1a-bonanza.build/pkg/proto/model/core/core.proto\x1a5bonanza.build/pkg/proto/model/encoding/encoding.proto\x1a3bonanza.build/pkg/proto/storage/object/object.proto\x1aagithub.com/buildbarn/bb-remote-execution/pkg/proto/configuration/filesystem/virtual/virtual.proto\x1aKgithub.com/buildbarn/bb-storage/pkg/proto/configuration/global/global.proto\x1aGgithub.com/buildbarn/bb-storage/pkg/proto/configuration/grpc/grpc.proto\"\xb9\a\n" +
 	"\x18ApplicationConfiguration\x12E\n" +
 	"\x06global\x18\x01 \x01(\v2-.buildbarn.configuration.global.ConfigurationR\x06global\x12T\n" +
 	"\x05mount\x18\x02 \x01(\v2>.buildbarn.configuration.filesystem.virtual.MountConfigurationR\x05mount\x12b\n" +
 	"\x12parsed_object_pool\x18\x03 \x01(\v24.bonanza.configuration.model.parser.ParsedObjectPoolR\x10parsedObjectPool\x12R\n" +
 	"\vgrpc_client\x18\x04 \x01(\v21.buildbarn.configuration.grpc.ClientConfigurationR\n" +
-	"grpcClient\x12?\n" +
+	"grpcClient\x12\\\n" +
+	"\bbatching\x18\n" +
+	" \x01(\v2@.bonanza.configuration.storage.object.grpc.BatchingConfigurationR\bbatching\x12?\n" +
 	"\tnamespace\x18\x05 \x01(\v2!.bonanza.storage.object.NamespaceR\tnamespace\x12\x96\x01\n" +
 	"\x18root_directory_reference\x18\x06 \x01(\v2*.bonanza.model.core.WeakDecodableReferenceB0\xea\xd7 ,\x12*bonanza.model.filesystem.DirectoryContentsR\x16rootDirectoryReference\x12T\n" +
 	"\x12directory_encoders\x18\a \x03(\v2%.bonanza.model.encoding.BinaryEncoderR\x11directoryEncoders\x12U\n" +
@@ -172,25 +183,27 @@ var file_bonanza_build_pkg_proto_configuration_mount_directory_mount_directory_p
 	(*virtual.MountConfiguration)(nil),  // 2: buildbarn.configuration.filesystem.virtual.MountConfiguration
 	(*parser.ParsedObjectPool)(nil),     // 3: bonanza.configuration.model.parser.ParsedObjectPool
 	(*grpc.ClientConfiguration)(nil),    // 4: buildbarn.configuration.grpc.ClientConfiguration
-	(*object.Namespace)(nil),            // 5: bonanza.storage.object.Namespace
-	(*core.WeakDecodableReference)(nil), // 6: bonanza.model.core.WeakDecodableReference
-	(*encoding.BinaryEncoder)(nil),      // 7: bonanza.model.encoding.BinaryEncoder
+	(*grpc1.BatchingConfiguration)(nil), // 5: bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	(*object.Namespace)(nil),            // 6: bonanza.storage.object.Namespace
+	(*core.WeakDecodableReference)(nil), // 7: bonanza.model.core.WeakDecodableReference
+	(*encoding.BinaryEncoder)(nil),      // 8: bonanza.model.encoding.BinaryEncoder
 }
 var file_bonanza_build_pkg_proto_configuration_mount_directory_mount_directory_proto_depIdxs = []int32{
-	1, // 0: bonanza.configuration.mount_directory.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
-	2, // 1: bonanza.configuration.mount_directory.ApplicationConfiguration.mount:type_name -> buildbarn.configuration.filesystem.virtual.MountConfiguration
-	3, // 2: bonanza.configuration.mount_directory.ApplicationConfiguration.parsed_object_pool:type_name -> bonanza.configuration.model.parser.ParsedObjectPool
-	4, // 3: bonanza.configuration.mount_directory.ApplicationConfiguration.grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
-	5, // 4: bonanza.configuration.mount_directory.ApplicationConfiguration.namespace:type_name -> bonanza.storage.object.Namespace
-	6, // 5: bonanza.configuration.mount_directory.ApplicationConfiguration.root_directory_reference:type_name -> bonanza.model.core.WeakDecodableReference
-	7, // 6: bonanza.configuration.mount_directory.ApplicationConfiguration.directory_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
-	7, // 7: bonanza.configuration.mount_directory.ApplicationConfiguration.small_file_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
-	7, // 8: bonanza.configuration.mount_directory.ApplicationConfiguration.concatenated_file_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	1,  // 0: bonanza.configuration.mount_directory.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
+	2,  // 1: bonanza.configuration.mount_directory.ApplicationConfiguration.mount:type_name -> buildbarn.configuration.filesystem.virtual.MountConfiguration
+	3,  // 2: bonanza.configuration.mount_directory.ApplicationConfiguration.parsed_object_pool:type_name -> bonanza.configuration.model.parser.ParsedObjectPool
+	4,  // 3: bonanza.configuration.mount_directory.ApplicationConfiguration.grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	5,  // 4: bonanza.configuration.mount_directory.ApplicationConfiguration.batching:type_name -> bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	6,  // 5: bonanza.configuration.mount_directory.ApplicationConfiguration.namespace:type_name -> bonanza.storage.object.Namespace
+	7,  // 6: bonanza.configuration.mount_directory.ApplicationConfiguration.root_directory_reference:type_name -> bonanza.model.core.WeakDecodableReference
+	8,  // 7: bonanza.configuration.mount_directory.ApplicationConfiguration.directory_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
+	8,  // 8: bonanza.configuration.mount_directory.ApplicationConfiguration.small_file_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
+	8,  // 9: bonanza.configuration.mount_directory.ApplicationConfiguration.concatenated_file_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_configuration_mount_directory_mount_directory_proto_init() }
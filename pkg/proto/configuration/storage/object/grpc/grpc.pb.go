@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.31.1
+// source: bonanza.build/pkg/proto/configuration/storage/object/grpc/grpc.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BatchingConfiguration struct {
+	state                    protoimpl.MessageState    `protogen:"open.v1"`
+	MaximumBatchSize         uint32                    `protobuf:"varint,1,opt,name=maximum_batch_size,json=maximumBatchSize,proto3" json:"maximum_batch_size,omitempty"`
+	MaximumConcurrentBatches uint32                    `protobuf:"varint,2,opt,name=maximum_concurrent_batches,json=maximumConcurrentBatches,proto3" json:"maximum_concurrent_batches,omitempty"`
+	Prefetching              *PrefetchingConfiguration `protobuf:"bytes,3,opt,name=prefetching,proto3" json:"prefetching,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *BatchingConfiguration) Reset() {
+	*x = BatchingConfiguration{}
+	mi := &file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchingConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchingConfiguration) ProtoMessage() {}
+
+func (x *BatchingConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchingConfiguration.ProtoReflect.Descriptor instead.
+func (*BatchingConfiguration) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchingConfiguration) GetMaximumBatchSize() uint32 {
+	if x != nil {
+		return x.MaximumBatchSize
+	}
+	return 0
+}
+
+func (x *BatchingConfiguration) GetMaximumConcurrentBatches() uint32 {
+	if x != nil {
+		return x.MaximumConcurrentBatches
+	}
+	return 0
+}
+
+func (x *BatchingConfiguration) GetPrefetching() *PrefetchingConfiguration {
+	if x != nil {
+		return x.Prefetching
+	}
+	return nil
+}
+
+type PrefetchingConfiguration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaximumDepth  uint32                 `protobuf:"varint,1,opt,name=maximum_depth,json=maximumDepth,proto3" json:"maximum_depth,omitempty"`
+	Concurrency   int64                  `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	MaximumCount  uint32                 `protobuf:"varint,3,opt,name=maximum_count,json=maximumCount,proto3" json:"maximum_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefetchingConfiguration) Reset() {
+	*x = PrefetchingConfiguration{}
+	mi := &file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefetchingConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefetchingConfiguration) ProtoMessage() {}
+
+func (x *PrefetchingConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefetchingConfiguration.ProtoReflect.Descriptor instead.
+func (*PrefetchingConfiguration) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PrefetchingConfiguration) GetMaximumDepth() uint32 {
+	if x != nil {
+		return x.MaximumDepth
+	}
+	return 0
+}
+
+func (x *PrefetchingConfiguration) GetConcurrency() int64 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *PrefetchingConfiguration) GetMaximumCount() uint32 {
+	if x != nil {
+		return x.MaximumCount
+	}
+	return 0
+}
+
+var File_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto protoreflect.FileDescriptor
+
+const file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDesc = "" +
+	"\n" +
+	"Dbonanza.build/pkg/proto/configuration/storage/object/grpc/grpc.proto\x12)bonanza.configuration.storage.object.grpc\"\xea\x01\n" +
+	"\x15BatchingConfiguration\x12,\n" +
+	"\x12maximum_batch_size\x18\x01 \x01(\rR\x10maximumBatchSize\x12<\n" +
+	"\x1amaximum_concurrent_batches\x18\x02 \x01(\rR\x18maximumConcurrentBatches\x12e\n" +
+	"\vprefetching\x18\x03 \x01(\v2C.bonanza.configuration.storage.object.grpc.PrefetchingConfigurationR\vprefetching\"\x86\x01\n" +
+	"\x18PrefetchingConfiguration\x12#\n" +
+	"\rmaximum_depth\x18\x01 \x01(\rR\fmaximumDepth\x12 \n" +
+	"\vconcurrency\x18\x02 \x01(\x03R\vconcurrency\x12#\n" +
+	"\rmaximum_count\x18\x03 \x01(\rR\fmaximumCountB;Z9bonanza.build/pkg/proto/configuration/storage/object/grpcb\x06proto3"
+
+var (
+	file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDescOnce sync.Once
+	file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDescData []byte
+)
+
+func file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDescGZIP() []byte {
+	file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDescOnce.Do(func() {
+		file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDesc), len(file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDesc)))
+	})
+	return file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDescData
+}
+
+var file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_goTypes = []any{
+	(*BatchingConfiguration)(nil),    // 0: bonanza.configuration.storage.object.grpc.BatchingConfiguration
+	(*PrefetchingConfiguration)(nil), // 1: bonanza.configuration.storage.object.grpc.PrefetchingConfiguration
+}
+var file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_depIdxs = []int32{
+	1, // 0: bonanza.configuration.storage.object.grpc.BatchingConfiguration.prefetching:type_name -> bonanza.configuration.storage.object.grpc.PrefetchingConfiguration
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_init() }
+func file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_init() {
+	if File_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDesc), len(file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_goTypes,
+		DependencyIndexes: file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_depIdxs,
+		MessageInfos:      file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_msgTypes,
+	}.Build()
+	File_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto = out.File
+	file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_goTypes = nil
+	file_bonanza_build_pkg_proto_configuration_storage_object_grpc_grpc_proto_depIdxs = nil
+}
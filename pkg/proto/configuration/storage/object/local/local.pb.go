@@ -41,6 +41,9 @@ type StoreConfiguration struct {
 	CurrentRegionSizeRatio uint32                                      `protobuf:"varint,8,opt,name=current_region_size_ratio,json=currentRegionSizeRatio,proto3" json:"current_region_size_ratio,omitempty"`
 	NewRegionSizeRatio     uint32                                      `protobuf:"varint,9,opt,name=new_region_size_ratio,json=newRegionSizeRatio,proto3" json:"new_region_size_ratio,omitempty"`
 	Persistent             *StoreConfiguration_Persistent              `protobuf:"bytes,10,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	RefreshRateLimit       *StoreConfiguration_RefreshRateLimit        `protobuf:"bytes,11,opt,name=refresh_rate_limit,json=refreshRateLimit,proto3" json:"refresh_rate_limit,omitempty"`
+	Scrubbing              *StoreConfiguration_Scrubbing               `protobuf:"bytes,12,opt,name=scrubbing,proto3" json:"scrubbing,omitempty"`
+	MaximumTags            uint64                                      `protobuf:"varint,13,opt,name=maximum_tags,json=maximumTags,proto3" json:"maximum_tags,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -167,6 +170,27 @@ func (x *StoreConfiguration) GetPersistent() *StoreConfiguration_Persistent {
 	return nil
 }
 
+func (x *StoreConfiguration) GetRefreshRateLimit() *StoreConfiguration_RefreshRateLimit {
+	if x != nil {
+		return x.RefreshRateLimit
+	}
+	return nil
+}
+
+func (x *StoreConfiguration) GetScrubbing() *StoreConfiguration_Scrubbing {
+	if x != nil {
+		return x.Scrubbing
+	}
+	return nil
+}
+
+func (x *StoreConfiguration) GetMaximumTags() uint64 {
+	if x != nil {
+		return x.MaximumTags
+	}
+	return 0
+}
+
 type isStoreConfiguration_ReferenceLocationMapBackend interface {
 	isStoreConfiguration_ReferenceLocationMapBackend()
 }
@@ -342,12 +366,115 @@ func (x *StoreConfiguration_Persistent) GetMinimumEpochInterval() *durationpb.Du
 	return nil
 }
 
+type StoreConfiguration_RefreshRateLimit struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BytesPerSecond uint64                 `protobuf:"varint,1,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+	BurstSizeBytes uint64                 `protobuf:"varint,2,opt,name=burst_size_bytes,json=burstSizeBytes,proto3" json:"burst_size_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StoreConfiguration_RefreshRateLimit) Reset() {
+	*x = StoreConfiguration_RefreshRateLimit{}
+	mi := &file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreConfiguration_RefreshRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreConfiguration_RefreshRateLimit) ProtoMessage() {}
+
+func (x *StoreConfiguration_RefreshRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreConfiguration_RefreshRateLimit.ProtoReflect.Descriptor instead.
+func (*StoreConfiguration_RefreshRateLimit) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *StoreConfiguration_RefreshRateLimit) GetBytesPerSecond() uint64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
+func (x *StoreConfiguration_RefreshRateLimit) GetBurstSizeBytes() uint64 {
+	if x != nil {
+		return x.BurstSizeBytes
+	}
+	return 0
+}
+
+type StoreConfiguration_Scrubbing struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Interval       *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	BytesPerSecond uint64                 `protobuf:"varint,2,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StoreConfiguration_Scrubbing) Reset() {
+	*x = StoreConfiguration_Scrubbing{}
+	mi := &file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreConfiguration_Scrubbing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreConfiguration_Scrubbing) ProtoMessage() {}
+
+func (x *StoreConfiguration_Scrubbing) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreConfiguration_Scrubbing.ProtoReflect.Descriptor instead.
+func (*StoreConfiguration_Scrubbing) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *StoreConfiguration_Scrubbing) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *StoreConfiguration_Scrubbing) GetBytesPerSecond() uint64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
 var File_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto protoreflect.FileDescriptor
 
 const file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_rawDesc = "" +
 	"\n" +
-	"Fbonanza.build/pkg/proto/configuration/storage/object/local/local.proto\x12*bonanza.configuration.storage.object.local\x1aUgithub.com/buildbarn/bb-storage/pkg/proto/configuration/blockdevice/blockdevice.proto\x1a\x1egoogle/protobuf/duration.proto\"\xe9\n" +
-	"\n" +
+	"Fbonanza.build/pkg/proto/configuration/storage/object/local/local.proto\x12*bonanza.configuration.storage.object.local\x1aUgithub.com/buildbarn/bb-storage/pkg/proto/configuration/blockdevice/blockdevice.proto\x1a\x1egoogle/protobuf/duration.proto\"\xc9\x0e\n" +
 	"\x12StoreConfiguration\x12\xa5\x01\n" +
 	" reference_location_map_in_memory\x18\x01 \x01(\v2[.bonanza.configuration.storage.object.local.StoreConfiguration.ReferenceLocationMapInMemoryH\x00R\x1creferenceLocationMapInMemory\x12\x87\x01\n" +
 	"&reference_location_map_on_block_device\x18\x02 \x01(\v22.buildbarn.configuration.blockdevice.ConfigurationH\x00R!referenceLocationMapOnBlockDevice\x12[\n" +
@@ -361,7 +488,10 @@ const file_bonanza_build_pkg_proto_configuration_storage_object_local_local_prot
 	"\n" +
 	"persistent\x18\n" +
 	" \x01(\v2I.bonanza.configuration.storage.object.local.StoreConfiguration.PersistentR\n" +
-	"persistent\x1a8\n" +
+	"persistent\x12}\n" +
+	"\x12refresh_rate_limit\x18\v \x01(\v2O.bonanza.configuration.storage.object.local.StoreConfiguration.RefreshRateLimitR\x10refreshRateLimit\x12f\n" +
+	"\tscrubbing\x18\f \x01(\v2H.bonanza.configuration.storage.object.local.StoreConfiguration.ScrubbingR\tscrubbing\x12!\n" +
+	"\fmaximum_tags\x18\r \x01(\x04R\vmaximumTags\x1a8\n" +
 	"\x1cReferenceLocationMapInMemory\x12\x18\n" +
 	"\aentries\x18\x01 \x01(\x04R\aentries\x1a8\n" +
 	"\x17LocationBlobMapInMemory\x12\x1d\n" +
@@ -370,7 +500,13 @@ const file_bonanza_build_pkg_proto_configuration_storage_object_local_local_prot
 	"\n" +
 	"Persistent\x120\n" +
 	"\x14state_directory_path\x18\x01 \x01(\tR\x12stateDirectoryPath\x12O\n" +
-	"\x16minimum_epoch_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x14minimumEpochIntervalB \n" +
+	"\x16minimum_epoch_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x14minimumEpochInterval\x1af\n" +
+	"\x10RefreshRateLimit\x12(\n" +
+	"\x10bytes_per_second\x18\x01 \x01(\x04R\x0ebytesPerSecond\x12(\n" +
+	"\x10burst_size_bytes\x18\x02 \x01(\x04R\x0eburstSizeBytes\x1al\n" +
+	"\tScrubbing\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12(\n" +
+	"\x10bytes_per_second\x18\x02 \x01(\x04R\x0ebytesPerSecondB \n" +
 	"\x1ereference_location_map_backendB\x1b\n" +
 	"\x19location_blob_map_backendB<Z:bonanza.build/pkg/proto/configuration/storage/object/localb\x06proto3"
 
@@ -386,27 +522,32 @@ func file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto
 	return file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_rawDescData
 }
 
-var file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_goTypes = []any{
 	(*StoreConfiguration)(nil),                              // 0: bonanza.configuration.storage.object.local.StoreConfiguration
 	(*StoreConfiguration_ReferenceLocationMapInMemory)(nil), // 1: bonanza.configuration.storage.object.local.StoreConfiguration.ReferenceLocationMapInMemory
 	(*StoreConfiguration_LocationBlobMapInMemory)(nil),      // 2: bonanza.configuration.storage.object.local.StoreConfiguration.LocationBlobMapInMemory
 	(*StoreConfiguration_Persistent)(nil),                   // 3: bonanza.configuration.storage.object.local.StoreConfiguration.Persistent
-	(*blockdevice.Configuration)(nil),                       // 4: buildbarn.configuration.blockdevice.Configuration
-	(*durationpb.Duration)(nil),                             // 5: google.protobuf.Duration
+	(*StoreConfiguration_RefreshRateLimit)(nil),             // 4: bonanza.configuration.storage.object.local.StoreConfiguration.RefreshRateLimit
+	(*StoreConfiguration_Scrubbing)(nil),                    // 5: bonanza.configuration.storage.object.local.StoreConfiguration.Scrubbing
+	(*blockdevice.Configuration)(nil),                       // 6: buildbarn.configuration.blockdevice.Configuration
+	(*durationpb.Duration)(nil),                             // 7: google.protobuf.Duration
 }
 var file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_depIdxs = []int32{
 	1, // 0: bonanza.configuration.storage.object.local.StoreConfiguration.reference_location_map_in_memory:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration.ReferenceLocationMapInMemory
-	4, // 1: bonanza.configuration.storage.object.local.StoreConfiguration.reference_location_map_on_block_device:type_name -> buildbarn.configuration.blockdevice.Configuration
+	6, // 1: bonanza.configuration.storage.object.local.StoreConfiguration.reference_location_map_on_block_device:type_name -> buildbarn.configuration.blockdevice.Configuration
 	2, // 2: bonanza.configuration.storage.object.local.StoreConfiguration.location_blob_map_in_memory:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration.LocationBlobMapInMemory
-	4, // 3: bonanza.configuration.storage.object.local.StoreConfiguration.location_blob_map_on_block_device:type_name -> buildbarn.configuration.blockdevice.Configuration
+	6, // 3: bonanza.configuration.storage.object.local.StoreConfiguration.location_blob_map_on_block_device:type_name -> buildbarn.configuration.blockdevice.Configuration
 	3, // 4: bonanza.configuration.storage.object.local.StoreConfiguration.persistent:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration.Persistent
-	5, // 5: bonanza.configuration.storage.object.local.StoreConfiguration.Persistent.minimum_epoch_interval:type_name -> google.protobuf.Duration
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	4, // 5: bonanza.configuration.storage.object.local.StoreConfiguration.refresh_rate_limit:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration.RefreshRateLimit
+	5, // 6: bonanza.configuration.storage.object.local.StoreConfiguration.scrubbing:type_name -> bonanza.configuration.storage.object.local.StoreConfiguration.Scrubbing
+	7, // 7: bonanza.configuration.storage.object.local.StoreConfiguration.Persistent.minimum_epoch_interval:type_name -> google.protobuf.Duration
+	7, // 8: bonanza.configuration.storage.object.local.StoreConfiguration.Scrubbing.interval:type_name -> google.protobuf.Duration
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_init() }
@@ -426,7 +567,7 @@ func file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_rawDesc), len(file_bonanza_build_pkg_proto_configuration_storage_object_local_local_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -125,7 +125,7 @@ func (x Args_Leaf_UseParamFile_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Args_Leaf_UseParamFile_Format.Descriptor instead.
 func (Args_Leaf_UseParamFile_Format) EnumDescriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1, 1, 0}
 }
 
 type HttpArchiveContents_Key_Format int32
@@ -177,7 +177,7 @@ func (x HttpArchiveContents_Key_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HttpArchiveContents_Key_Format.Descriptor instead.
 func (HttpArchiveContents_Key_Format) EnumDescriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 0, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41, 0, 0}
 }
 
 type ActionEncoderObject struct {
@@ -288,6 +288,42 @@ func (*ActionReaders) Descriptor() ([]byte, []int) {
 	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{2}
 }
 
+type ActionQueryResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionQueryResult) Reset() {
+	*x = ActionQueryResult{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionQueryResult) ProtoMessage() {}
+
+func (x *ActionQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionQueryResult.ProtoReflect.Descriptor instead.
+func (*ActionQueryResult) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3}
+}
+
 type ExecuteRequest struct {
 	state                 protoimpl.MessageState   `protogen:"open.v1"`
 	PlatformPkixPublicKey []byte                   `protobuf:"bytes,1,opt,name=platform_pkix_public_key,json=platformPkixPublicKey,proto3" json:"platform_pkix_public_key,omitempty"`
@@ -299,7 +335,7 @@ type ExecuteRequest struct {
 
 func (x *ExecuteRequest) Reset() {
 	*x = ExecuteRequest{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[3]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +347,7 @@ func (x *ExecuteRequest) String() string {
 func (*ExecuteRequest) ProtoMessage() {}
 
 func (x *ExecuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[3]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +360,7 @@ func (x *ExecuteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteRequest.ProtoReflect.Descriptor instead.
 func (*ExecuteRequest) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ExecuteRequest) GetPlatformPkixPublicKey() []byte {
@@ -356,7 +392,7 @@ type ActionResult struct {
 
 func (x *ActionResult) Reset() {
 	*x = ActionResult{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[4]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -368,7 +404,7 @@ func (x *ActionResult) String() string {
 func (*ActionResult) ProtoMessage() {}
 
 func (x *ActionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[4]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,7 +417,7 @@ func (x *ActionResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionResult.ProtoReflect.Descriptor instead.
 func (*ActionResult) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5}
 }
 
 type BuildSpecification struct {
@@ -392,7 +428,7 @@ type BuildSpecification struct {
 
 func (x *BuildSpecification) Reset() {
 	*x = BuildSpecification{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[5]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +440,7 @@ func (x *BuildSpecification) String() string {
 func (*BuildSpecification) ProtoMessage() {}
 
 func (x *BuildSpecification) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[5]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +453,7 @@ func (x *BuildSpecification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSpecification.ProtoReflect.Descriptor instead.
 func (*BuildSpecification) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6}
 }
 
 type BuiltinsModuleNames struct {
@@ -428,7 +464,7 @@ type BuiltinsModuleNames struct {
 
 func (x *BuiltinsModuleNames) Reset() {
 	*x = BuiltinsModuleNames{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[6]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -440,7 +476,7 @@ func (x *BuiltinsModuleNames) String() string {
 func (*BuiltinsModuleNames) ProtoMessage() {}
 
 func (x *BuiltinsModuleNames) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[6]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +489,7 @@ func (x *BuiltinsModuleNames) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuiltinsModuleNames.ProtoReflect.Descriptor instead.
 func (*BuiltinsModuleNames) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7}
 }
 
 type BuildResult struct {
@@ -464,7 +500,7 @@ type BuildResult struct {
 
 func (x *BuildResult) Reset() {
 	*x = BuildResult{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[7]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +512,7 @@ func (x *BuildResult) String() string {
 func (*BuildResult) ProtoMessage() {}
 
 func (x *BuildResult) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[7]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +525,7 @@ func (x *BuildResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildResult.ProtoReflect.Descriptor instead.
 func (*BuildResult) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8}
 }
 
 type CanonicalRepoName struct {
@@ -500,7 +536,7 @@ type CanonicalRepoName struct {
 
 func (x *CanonicalRepoName) Reset() {
 	*x = CanonicalRepoName{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[8]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +548,7 @@ func (x *CanonicalRepoName) String() string {
 func (*CanonicalRepoName) ProtoMessage() {}
 
 func (x *CanonicalRepoName) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[8]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +561,7 @@ func (x *CanonicalRepoName) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanonicalRepoName.ProtoReflect.Descriptor instead.
 func (*CanonicalRepoName) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9}
 }
 
 type CompatibleExecutionPlatforms struct {
@@ -536,7 +572,7 @@ type CompatibleExecutionPlatforms struct {
 
 func (x *CompatibleExecutionPlatforms) Reset() {
 	*x = CompatibleExecutionPlatforms{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[9]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -548,7 +584,7 @@ func (x *CompatibleExecutionPlatforms) String() string {
 func (*CompatibleExecutionPlatforms) ProtoMessage() {}
 
 func (x *CompatibleExecutionPlatforms) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[9]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +597,7 @@ func (x *CompatibleExecutionPlatforms) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompatibleExecutionPlatforms.ProtoReflect.Descriptor instead.
 func (*CompatibleExecutionPlatforms) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10}
 }
 
 type CompatibleToolchainsForType struct {
@@ -572,7 +608,7 @@ type CompatibleToolchainsForType struct {
 
 func (x *CompatibleToolchainsForType) Reset() {
 	*x = CompatibleToolchainsForType{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[10]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +620,7 @@ func (x *CompatibleToolchainsForType) String() string {
 func (*CompatibleToolchainsForType) ProtoMessage() {}
 
 func (x *CompatibleToolchainsForType) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[10]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +633,7 @@ func (x *CompatibleToolchainsForType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompatibleToolchainsForType.ProtoReflect.Descriptor instead.
 func (*CompatibleToolchainsForType) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11}
 }
 
 type CompiledBzlFile struct {
@@ -608,7 +644,7 @@ type CompiledBzlFile struct {
 
 func (x *CompiledBzlFile) Reset() {
 	*x = CompiledBzlFile{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[11]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +656,7 @@ func (x *CompiledBzlFile) String() string {
 func (*CompiledBzlFile) ProtoMessage() {}
 
 func (x *CompiledBzlFile) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[11]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +669,7 @@ func (x *CompiledBzlFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFile.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFile) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12}
 }
 
 type CompiledBzlFileDecodedGlobals struct {
@@ -644,7 +680,7 @@ type CompiledBzlFileDecodedGlobals struct {
 
 func (x *CompiledBzlFileDecodedGlobals) Reset() {
 	*x = CompiledBzlFileDecodedGlobals{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[12]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -656,7 +692,7 @@ func (x *CompiledBzlFileDecodedGlobals) String() string {
 func (*CompiledBzlFileDecodedGlobals) ProtoMessage() {}
 
 func (x *CompiledBzlFileDecodedGlobals) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[12]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +705,7 @@ func (x *CompiledBzlFileDecodedGlobals) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileDecodedGlobals.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileDecodedGlobals) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13}
 }
 
 type CompiledBzlFileFunctionFactory struct {
@@ -680,7 +716,7 @@ type CompiledBzlFileFunctionFactory struct {
 
 func (x *CompiledBzlFileFunctionFactory) Reset() {
 	*x = CompiledBzlFileFunctionFactory{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[13]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -692,7 +728,7 @@ func (x *CompiledBzlFileFunctionFactory) String() string {
 func (*CompiledBzlFileFunctionFactory) ProtoMessage() {}
 
 func (x *CompiledBzlFileFunctionFactory) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[13]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +741,7 @@ func (x *CompiledBzlFileFunctionFactory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileFunctionFactory.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileFunctionFactory) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14}
 }
 
 type CompiledBzlFileGlobal struct {
@@ -716,7 +752,7 @@ type CompiledBzlFileGlobal struct {
 
 func (x *CompiledBzlFileGlobal) Reset() {
 	*x = CompiledBzlFileGlobal{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[14]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -728,7 +764,7 @@ func (x *CompiledBzlFileGlobal) String() string {
 func (*CompiledBzlFileGlobal) ProtoMessage() {}
 
 func (x *CompiledBzlFileGlobal) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[14]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +777,7 @@ func (x *CompiledBzlFileGlobal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileGlobal.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileGlobal) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15}
 }
 
 type BuildSettingOverride struct {
@@ -757,7 +793,7 @@ type BuildSettingOverride struct {
 
 func (x *BuildSettingOverride) Reset() {
 	*x = BuildSettingOverride{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[15]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +805,7 @@ func (x *BuildSettingOverride) String() string {
 func (*BuildSettingOverride) ProtoMessage() {}
 
 func (x *BuildSettingOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[15]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +818,7 @@ func (x *BuildSettingOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSettingOverride.ProtoReflect.Descriptor instead.
 func (*BuildSettingOverride) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *BuildSettingOverride) GetLevel() isBuildSettingOverride_Level {
@@ -839,7 +875,7 @@ type Args struct {
 
 func (x *Args) Reset() {
 	*x = Args{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[16]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -851,7 +887,7 @@ func (x *Args) String() string {
 func (*Args) ProtoMessage() {}
 
 func (x *Args) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[16]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +900,7 @@ func (x *Args) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args.ProtoReflect.Descriptor instead.
 func (*Args) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Args) GetLevel() isArgs_Level {
@@ -921,7 +957,7 @@ type FilesToRunProvider struct {
 
 func (x *FilesToRunProvider) Reset() {
 	*x = FilesToRunProvider{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[17]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -933,7 +969,7 @@ func (x *FilesToRunProvider) String() string {
 func (*FilesToRunProvider) ProtoMessage() {}
 
 func (x *FilesToRunProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[17]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +982,7 @@ func (x *FilesToRunProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilesToRunProvider.ProtoReflect.Descriptor instead.
 func (*FilesToRunProvider) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FilesToRunProvider) GetLevel() isFilesToRunProvider_Level {
@@ -1000,13 +1036,14 @@ type TargetActionDefinition struct {
 	InitialOutputDirectory *filesystem.Directory                      `protobuf:"bytes,6,opt,name=initial_output_directory,json=initialOutputDirectory,proto3" json:"initial_output_directory,omitempty"`
 	Env                    []*command.EnvironmentVariableList_Element `protobuf:"bytes,7,rep,name=env,proto3" json:"env,omitempty"`
 	UseDefaultShellEnv     bool                                       `protobuf:"varint,8,opt,name=use_default_shell_env,json=useDefaultShellEnv,proto3" json:"use_default_shell_env,omitempty"`
+	Mnemonic               string                                     `protobuf:"bytes,9,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
 func (x *TargetActionDefinition) Reset() {
 	*x = TargetActionDefinition{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[18]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1018,7 +1055,7 @@ func (x *TargetActionDefinition) String() string {
 func (*TargetActionDefinition) ProtoMessage() {}
 
 func (x *TargetActionDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[18]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1068,7 @@ func (x *TargetActionDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionDefinition.ProtoReflect.Descriptor instead.
 func (*TargetActionDefinition) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TargetActionDefinition) GetInputs() []*starlark.List_Element {
@@ -1090,6 +1127,13 @@ func (x *TargetActionDefinition) GetUseDefaultShellEnv() bool {
 	return false
 }
 
+func (x *TargetActionDefinition) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
 type TargetOutputDefinition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Source:
@@ -1105,7 +1149,7 @@ type TargetOutputDefinition struct {
 
 func (x *TargetOutputDefinition) Reset() {
 	*x = TargetOutputDefinition{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[19]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1117,7 +1161,7 @@ func (x *TargetOutputDefinition) String() string {
 func (*TargetOutputDefinition) ProtoMessage() {}
 
 func (x *TargetOutputDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[19]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1174,7 @@ func (x *TargetOutputDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetOutputDefinition.ProtoReflect.Descriptor instead.
 func (*TargetOutputDefinition) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TargetOutputDefinition) GetSource() isTargetOutputDefinition_Source {
@@ -1204,6 +1248,42 @@ func (*TargetOutputDefinition_StaticPackageDirectory) isTargetOutputDefinition_S
 
 func (*TargetOutputDefinition_Symlink_) isTargetOutputDefinition_Source() {}
 
+type ConfiguredQueryResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfiguredQueryResult) Reset() {
+	*x = ConfiguredQueryResult{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfiguredQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfiguredQueryResult) ProtoMessage() {}
+
+func (x *ConfiguredQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfiguredQueryResult.ProtoReflect.Descriptor instead.
+func (*ConfiguredQueryResult) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21}
+}
+
 type ConfiguredTarget struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1212,7 +1292,7 @@ type ConfiguredTarget struct {
 
 func (x *ConfiguredTarget) Reset() {
 	*x = ConfiguredTarget{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[20]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1224,7 +1304,7 @@ func (x *ConfiguredTarget) String() string {
 func (*ConfiguredTarget) ProtoMessage() {}
 
 func (x *ConfiguredTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[20]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,7 +1317,7 @@ func (x *ConfiguredTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfiguredTarget.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22}
 }
 
 type TargetOutput struct {
@@ -1248,7 +1328,7 @@ type TargetOutput struct {
 
 func (x *TargetOutput) Reset() {
 	*x = TargetOutput{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[21]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1260,7 +1340,7 @@ func (x *TargetOutput) String() string {
 func (*TargetOutput) ProtoMessage() {}
 
 func (x *TargetOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[21]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1273,7 +1353,7 @@ func (x *TargetOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetOutput.ProtoReflect.Descriptor instead.
 func (*TargetOutput) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23}
 }
 
 type DirectoryAccessParameters struct {
@@ -1284,7 +1364,7 @@ type DirectoryAccessParameters struct {
 
 func (x *DirectoryAccessParameters) Reset() {
 	*x = DirectoryAccessParameters{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[22]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1296,7 +1376,7 @@ func (x *DirectoryAccessParameters) String() string {
 func (*DirectoryAccessParameters) ProtoMessage() {}
 
 func (x *DirectoryAccessParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[22]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,7 +1389,7 @@ func (x *DirectoryAccessParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryAccessParameters.ProtoReflect.Descriptor instead.
 func (*DirectoryAccessParameters) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24}
 }
 
 type DirectoryCreationParameters struct {
@@ -1320,7 +1400,7 @@ type DirectoryCreationParameters struct {
 
 func (x *DirectoryCreationParameters) Reset() {
 	*x = DirectoryCreationParameters{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[23]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1332,7 +1412,7 @@ func (x *DirectoryCreationParameters) String() string {
 func (*DirectoryCreationParameters) ProtoMessage() {}
 
 func (x *DirectoryCreationParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[23]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,7 +1425,7 @@ func (x *DirectoryCreationParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryCreationParameters.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParameters) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25}
 }
 
 type DirectoryCreationParametersObject struct {
@@ -1356,7 +1436,7 @@ type DirectoryCreationParametersObject struct {
 
 func (x *DirectoryCreationParametersObject) Reset() {
 	*x = DirectoryCreationParametersObject{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[24]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1368,7 +1448,7 @@ func (x *DirectoryCreationParametersObject) String() string {
 func (*DirectoryCreationParametersObject) ProtoMessage() {}
 
 func (x *DirectoryCreationParametersObject) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[24]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,7 +1461,7 @@ func (x *DirectoryCreationParametersObject) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DirectoryCreationParametersObject.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParametersObject) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26}
 }
 
 type DirectoryReaders struct {
@@ -1392,7 +1472,7 @@ type DirectoryReaders struct {
 
 func (x *DirectoryReaders) Reset() {
 	*x = DirectoryReaders{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[25]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1404,7 +1484,7 @@ func (x *DirectoryReaders) String() string {
 func (*DirectoryReaders) ProtoMessage() {}
 
 func (x *DirectoryReaders) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[25]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,7 +1497,7 @@ func (x *DirectoryReaders) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryReaders.ProtoReflect.Descriptor instead.
 func (*DirectoryReaders) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27}
 }
 
 type EmptyDefaultInfo struct {
@@ -1428,7 +1508,7 @@ type EmptyDefaultInfo struct {
 
 func (x *EmptyDefaultInfo) Reset() {
 	*x = EmptyDefaultInfo{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[26]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1440,7 +1520,7 @@ func (x *EmptyDefaultInfo) String() string {
 func (*EmptyDefaultInfo) ProtoMessage() {}
 
 func (x *EmptyDefaultInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[26]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,7 +1533,7 @@ func (x *EmptyDefaultInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyDefaultInfo.ProtoReflect.Descriptor instead.
 func (*EmptyDefaultInfo) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28}
 }
 
 type ExecTransition struct {
@@ -1464,7 +1544,7 @@ type ExecTransition struct {
 
 func (x *ExecTransition) Reset() {
 	*x = ExecTransition{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[27]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1476,7 +1556,7 @@ func (x *ExecTransition) String() string {
 func (*ExecTransition) ProtoMessage() {}
 
 func (x *ExecTransition) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[27]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1489,7 +1569,7 @@ func (x *ExecTransition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecTransition.ProtoReflect.Descriptor instead.
 func (*ExecTransition) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29}
 }
 
 type FileAccessParameters struct {
@@ -1500,7 +1580,7 @@ type FileAccessParameters struct {
 
 func (x *FileAccessParameters) Reset() {
 	*x = FileAccessParameters{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[28]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1512,7 +1592,7 @@ func (x *FileAccessParameters) String() string {
 func (*FileAccessParameters) ProtoMessage() {}
 
 func (x *FileAccessParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[28]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1525,7 +1605,7 @@ func (x *FileAccessParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileAccessParameters.ProtoReflect.Descriptor instead.
 func (*FileAccessParameters) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30}
 }
 
 type FileCreationParameters struct {
@@ -1536,7 +1616,7 @@ type FileCreationParameters struct {
 
 func (x *FileCreationParameters) Reset() {
 	*x = FileCreationParameters{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[29]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1548,7 +1628,7 @@ func (x *FileCreationParameters) String() string {
 func (*FileCreationParameters) ProtoMessage() {}
 
 func (x *FileCreationParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[29]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,7 +1641,7 @@ func (x *FileCreationParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParameters.ProtoReflect.Descriptor instead.
 func (*FileCreationParameters) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31}
 }
 
 type FileCreationParametersObject struct {
@@ -1572,7 +1652,7 @@ type FileCreationParametersObject struct {
 
 func (x *FileCreationParametersObject) Reset() {
 	*x = FileCreationParametersObject{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[30]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1584,7 +1664,7 @@ func (x *FileCreationParametersObject) String() string {
 func (*FileCreationParametersObject) ProtoMessage() {}
 
 func (x *FileCreationParametersObject) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[30]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,7 +1677,7 @@ func (x *FileCreationParametersObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParametersObject.ProtoReflect.Descriptor instead.
 func (*FileCreationParametersObject) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32}
 }
 
 type FileProperties struct {
@@ -1608,7 +1688,7 @@ type FileProperties struct {
 
 func (x *FileProperties) Reset() {
 	*x = FileProperties{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[31]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1620,7 +1700,7 @@ func (x *FileProperties) String() string {
 func (*FileProperties) ProtoMessage() {}
 
 func (x *FileProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[31]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,7 +1713,7 @@ func (x *FileProperties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileProperties.ProtoReflect.Descriptor instead.
 func (*FileProperties) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33}
 }
 
 type FileReader struct {
@@ -1644,7 +1724,7 @@ type FileReader struct {
 
 func (x *FileReader) Reset() {
 	*x = FileReader{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[32]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1656,7 +1736,7 @@ func (x *FileReader) String() string {
 func (*FileReader) ProtoMessage() {}
 
 func (x *FileReader) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[32]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,7 +1749,7 @@ func (x *FileReader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileReader.ProtoReflect.Descriptor instead.
 func (*FileReader) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34}
 }
 
 type FileRoot struct {
@@ -1680,7 +1760,7 @@ type FileRoot struct {
 
 func (x *FileRoot) Reset() {
 	*x = FileRoot{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[33]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1692,7 +1772,7 @@ func (x *FileRoot) String() string {
 func (*FileRoot) ProtoMessage() {}
 
 func (x *FileRoot) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[33]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1705,7 +1785,7 @@ func (x *FileRoot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileRoot.ProtoReflect.Descriptor instead.
 func (*FileRoot) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35}
 }
 
 type FilesInPackage struct {
@@ -1716,7 +1796,7 @@ type FilesInPackage struct {
 
 func (x *FilesInPackage) Reset() {
 	*x = FilesInPackage{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[34]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1728,7 +1808,7 @@ func (x *FilesInPackage) String() string {
 func (*FilesInPackage) ProtoMessage() {}
 
 func (x *FilesInPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[34]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,7 +1821,7 @@ func (x *FilesInPackage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilesInPackage.ProtoReflect.Descriptor instead.
 func (*FilesInPackage) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36}
 }
 
 type FilesRoot struct {
@@ -1752,7 +1832,7 @@ type FilesRoot struct {
 
 func (x *FilesRoot) Reset() {
 	*x = FilesRoot{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[35]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1764,7 +1844,7 @@ func (x *FilesRoot) String() string {
 func (*FilesRoot) ProtoMessage() {}
 
 func (x *FilesRoot) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[35]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,7 +1857,43 @@ func (x *FilesRoot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilesRoot.ProtoReflect.Descriptor instead.
 func (*FilesRoot) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37}
+}
+
+type GitRepositoryContents struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitRepositoryContents) Reset() {
+	*x = GitRepositoryContents{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepositoryContents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepositoryContents) ProtoMessage() {}
+
+func (x *GitRepositoryContents) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepositoryContents.ProtoReflect.Descriptor instead.
+func (*GitRepositoryContents) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38}
 }
 
 type Glob struct {
@@ -1788,7 +1904,7 @@ type Glob struct {
 
 func (x *Glob) Reset() {
 	*x = Glob{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[36]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1800,7 +1916,7 @@ func (x *Glob) String() string {
 func (*Glob) ProtoMessage() {}
 
 func (x *Glob) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[36]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1813,7 +1929,7 @@ func (x *Glob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Glob.ProtoReflect.Descriptor instead.
 func (*Glob) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39}
 }
 
 type HttpFetchOptions struct {
@@ -1826,7 +1942,7 @@ type HttpFetchOptions struct {
 
 func (x *HttpFetchOptions) Reset() {
 	*x = HttpFetchOptions{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[37]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1838,7 +1954,7 @@ func (x *HttpFetchOptions) String() string {
 func (*HttpFetchOptions) ProtoMessage() {}
 
 func (x *HttpFetchOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[37]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,7 +1967,7 @@ func (x *HttpFetchOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFetchOptions.ProtoReflect.Descriptor instead.
 func (*HttpFetchOptions) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *HttpFetchOptions) GetTarget() *fetch.Target {
@@ -1876,7 +1992,7 @@ type HttpArchiveContents struct {
 
 func (x *HttpArchiveContents) Reset() {
 	*x = HttpArchiveContents{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[38]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1888,7 +2004,7 @@ func (x *HttpArchiveContents) String() string {
 func (*HttpArchiveContents) ProtoMessage() {}
 
 func (x *HttpArchiveContents) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[38]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1901,7 +2017,7 @@ func (x *HttpArchiveContents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpArchiveContents.ProtoReflect.Descriptor instead.
 func (*HttpArchiveContents) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41}
 }
 
 type HttpFileContents struct {
@@ -1912,7 +2028,7 @@ type HttpFileContents struct {
 
 func (x *HttpFileContents) Reset() {
 	*x = HttpFileContents{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[39]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1924,7 +2040,7 @@ func (x *HttpFileContents) String() string {
 func (*HttpFileContents) ProtoMessage() {}
 
 func (x *HttpFileContents) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[39]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1937,7 +2053,7 @@ func (x *HttpFileContents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFileContents.ProtoReflect.Descriptor instead.
 func (*HttpFileContents) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42}
 }
 
 type ModuleDotBazelContents struct {
@@ -1948,7 +2064,7 @@ type ModuleDotBazelContents struct {
 
 func (x *ModuleDotBazelContents) Reset() {
 	*x = ModuleDotBazelContents{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[40]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1960,7 +2076,7 @@ func (x *ModuleDotBazelContents) String() string {
 func (*ModuleDotBazelContents) ProtoMessage() {}
 
 func (x *ModuleDotBazelContents) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[40]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,7 +2089,7 @@ func (x *ModuleDotBazelContents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleDotBazelContents.ProtoReflect.Descriptor instead.
 func (*ModuleDotBazelContents) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43}
 }
 
 type ModuleRegistryUrls struct {
@@ -1984,7 +2100,7 @@ type ModuleRegistryUrls struct {
 
 func (x *ModuleRegistryUrls) Reset() {
 	*x = ModuleRegistryUrls{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[41]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1996,7 +2112,7 @@ func (x *ModuleRegistryUrls) String() string {
 func (*ModuleRegistryUrls) ProtoMessage() {}
 
 func (x *ModuleRegistryUrls) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[41]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2009,7 +2125,43 @@ func (x *ModuleRegistryUrls) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRegistryUrls.ProtoReflect.Descriptor instead.
 func (*ModuleRegistryUrls) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44}
+}
+
+type ModuleLockfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleLockfile) Reset() {
+	*x = ModuleLockfile{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleLockfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleLockfile) ProtoMessage() {}
+
+func (x *ModuleLockfile) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleLockfile.ProtoReflect.Descriptor instead.
+func (*ModuleLockfile) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45}
 }
 
 type ModuleRepoMapping struct {
@@ -2020,7 +2172,7 @@ type ModuleRepoMapping struct {
 
 func (x *ModuleRepoMapping) Reset() {
 	*x = ModuleRepoMapping{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[42]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2032,7 +2184,7 @@ func (x *ModuleRepoMapping) String() string {
 func (*ModuleRepoMapping) ProtoMessage() {}
 
 func (x *ModuleRepoMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[42]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2045,7 +2197,7 @@ func (x *ModuleRepoMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRepoMapping.ProtoReflect.Descriptor instead.
 func (*ModuleRepoMapping) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46}
 }
 
 type ModuleExtensionRepo struct {
@@ -2056,7 +2208,7 @@ type ModuleExtensionRepo struct {
 
 func (x *ModuleExtensionRepo) Reset() {
 	*x = ModuleExtensionRepo{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[43]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2068,7 +2220,7 @@ func (x *ModuleExtensionRepo) String() string {
 func (*ModuleExtensionRepo) ProtoMessage() {}
 
 func (x *ModuleExtensionRepo) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[43]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2081,7 +2233,7 @@ func (x *ModuleExtensionRepo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepo.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepo) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47}
 }
 
 type ModuleExtensionRepoNames struct {
@@ -2092,7 +2244,7 @@ type ModuleExtensionRepoNames struct {
 
 func (x *ModuleExtensionRepoNames) Reset() {
 	*x = ModuleExtensionRepoNames{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[44]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2104,7 +2256,7 @@ func (x *ModuleExtensionRepoNames) String() string {
 func (*ModuleExtensionRepoNames) ProtoMessage() {}
 
 func (x *ModuleExtensionRepoNames) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[44]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2117,7 +2269,7 @@ func (x *ModuleExtensionRepoNames) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepoNames.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepoNames) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48}
 }
 
 type ModuleExtensionRepos struct {
@@ -2128,7 +2280,7 @@ type ModuleExtensionRepos struct {
 
 func (x *ModuleExtensionRepos) Reset() {
 	*x = ModuleExtensionRepos{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[45]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2140,7 +2292,7 @@ func (x *ModuleExtensionRepos) String() string {
 func (*ModuleExtensionRepos) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[45]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,7 +2305,7 @@ func (x *ModuleExtensionRepos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepos.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49}
 }
 
 type BuildListModule struct {
@@ -2168,7 +2320,7 @@ type BuildListModule struct {
 
 func (x *BuildListModule) Reset() {
 	*x = BuildListModule{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[46]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2180,7 +2332,7 @@ func (x *BuildListModule) String() string {
 func (*BuildListModule) ProtoMessage() {}
 
 func (x *BuildListModule) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[46]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2193,7 +2345,7 @@ func (x *BuildListModule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildListModule.ProtoReflect.Descriptor instead.
 func (*BuildListModule) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *BuildListModule) GetName() string {
@@ -2224,6 +2376,58 @@ func (x *BuildListModule) GetRegistryUrl() string {
 	return ""
 }
 
+type RegistryFileHash struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Sha256        []byte                 `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistryFileHash) Reset() {
+	*x = RegistryFileHash{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistryFileHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistryFileHash) ProtoMessage() {}
+
+func (x *RegistryFileHash) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistryFileHash.ProtoReflect.Descriptor instead.
+func (*RegistryFileHash) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RegistryFileHash) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RegistryFileHash) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
 type ModuleFinalBuildList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -2232,7 +2436,7 @@ type ModuleFinalBuildList struct {
 
 func (x *ModuleFinalBuildList) Reset() {
 	*x = ModuleFinalBuildList{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[47]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2244,7 +2448,7 @@ func (x *ModuleFinalBuildList) String() string {
 func (*ModuleFinalBuildList) ProtoMessage() {}
 
 func (x *ModuleFinalBuildList) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[47]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2257,7 +2461,7 @@ func (x *ModuleFinalBuildList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleFinalBuildList.ProtoReflect.Descriptor instead.
 func (*ModuleFinalBuildList) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52}
 }
 
 type ModuleRoughBuildList struct {
@@ -2268,7 +2472,7 @@ type ModuleRoughBuildList struct {
 
 func (x *ModuleRoughBuildList) Reset() {
 	*x = ModuleRoughBuildList{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[48]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2280,7 +2484,7 @@ func (x *ModuleRoughBuildList) String() string {
 func (*ModuleRoughBuildList) ProtoMessage() {}
 
 func (x *ModuleRoughBuildList) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[48]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2293,7 +2497,7 @@ func (x *ModuleRoughBuildList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRoughBuildList.ProtoReflect.Descriptor instead.
 func (*ModuleRoughBuildList) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53}
 }
 
 type OverridesListModule struct {
@@ -2306,7 +2510,7 @@ type OverridesListModule struct {
 
 func (x *OverridesListModule) Reset() {
 	*x = OverridesListModule{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[49]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2318,7 +2522,7 @@ func (x *OverridesListModule) String() string {
 func (*OverridesListModule) ProtoMessage() {}
 
 func (x *OverridesListModule) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[49]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2331,7 +2535,7 @@ func (x *OverridesListModule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OverridesListModule.ProtoReflect.Descriptor instead.
 func (*OverridesListModule) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *OverridesListModule) GetName() string {
@@ -2356,7 +2560,7 @@ type ModulesWithMultipleVersions struct {
 
 func (x *ModulesWithMultipleVersions) Reset() {
 	*x = ModulesWithMultipleVersions{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[50]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2368,7 +2572,7 @@ func (x *ModulesWithMultipleVersions) String() string {
 func (*ModulesWithMultipleVersions) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersions) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[50]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,7 +2585,7 @@ func (x *ModulesWithMultipleVersions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithMultipleVersions.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersions) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55}
 }
 
 type ModulesWithMultipleVersionsObject struct {
@@ -2392,7 +2596,7 @@ type ModulesWithMultipleVersionsObject struct {
 
 func (x *ModulesWithMultipleVersionsObject) Reset() {
 	*x = ModulesWithMultipleVersionsObject{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[51]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2404,7 +2608,7 @@ func (x *ModulesWithMultipleVersionsObject) String() string {
 func (*ModulesWithMultipleVersionsObject) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersionsObject) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[51]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2417,7 +2621,7 @@ func (x *ModulesWithMultipleVersionsObject) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ModulesWithMultipleVersionsObject.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersionsObject) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56}
 }
 
 type ModulesWithOverrides struct {
@@ -2428,7 +2632,7 @@ type ModulesWithOverrides struct {
 
 func (x *ModulesWithOverrides) Reset() {
 	*x = ModulesWithOverrides{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[52]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2440,7 +2644,7 @@ func (x *ModulesWithOverrides) String() string {
 func (*ModulesWithOverrides) ProtoMessage() {}
 
 func (x *ModulesWithOverrides) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[52]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2453,7 +2657,7 @@ func (x *ModulesWithOverrides) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithOverrides.ProtoReflect.Descriptor instead.
 func (*ModulesWithOverrides) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57}
 }
 
 type ModuleOverride struct {
@@ -2471,7 +2675,7 @@ type ModuleOverride struct {
 
 func (x *ModuleOverride) Reset() {
 	*x = ModuleOverride{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[53]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2483,7 +2687,7 @@ func (x *ModuleOverride) String() string {
 func (*ModuleOverride) ProtoMessage() {}
 
 func (x *ModuleOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[53]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,7 +2700,7 @@ func (x *ModuleOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleOverride.ProtoReflect.Descriptor instead.
 func (*ModuleOverride) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ModuleOverride) GetName() string {
@@ -2570,7 +2774,7 @@ type ModulesWithRemoteOverrides struct {
 
 func (x *ModulesWithRemoteOverrides) Reset() {
 	*x = ModulesWithRemoteOverrides{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[54]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2582,7 +2786,7 @@ func (x *ModulesWithRemoteOverrides) String() string {
 func (*ModulesWithRemoteOverrides) ProtoMessage() {}
 
 func (x *ModulesWithRemoteOverrides) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[54]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2595,7 +2799,7 @@ func (x *ModulesWithRemoteOverrides) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithRemoteOverrides.ProtoReflect.Descriptor instead.
 func (*ModulesWithRemoteOverrides) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59}
 }
 
 type Package struct {
@@ -2606,7 +2810,7 @@ type Package struct {
 
 func (x *Package) Reset() {
 	*x = Package{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[55]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2618,7 +2822,7 @@ func (x *Package) String() string {
 func (*Package) ProtoMessage() {}
 
 func (x *Package) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[55]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,7 +2835,7 @@ func (x *Package) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package.ProtoReflect.Descriptor instead.
 func (*Package) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60}
 }
 
 type PackageGroupContains struct {
@@ -2642,7 +2846,7 @@ type PackageGroupContains struct {
 
 func (x *PackageGroupContains) Reset() {
 	*x = PackageGroupContains{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[56]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2654,7 +2858,7 @@ func (x *PackageGroupContains) String() string {
 func (*PackageGroupContains) ProtoMessage() {}
 
 func (x *PackageGroupContains) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[56]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2667,7 +2871,7 @@ func (x *PackageGroupContains) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageGroupContains.ProtoReflect.Descriptor instead.
 func (*PackageGroupContains) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61}
 }
 
 type PackagesAtAndBelow struct {
@@ -2678,7 +2882,7 @@ type PackagesAtAndBelow struct {
 
 func (x *PackagesAtAndBelow) Reset() {
 	*x = PackagesAtAndBelow{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[57]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2690,7 +2894,7 @@ func (x *PackagesAtAndBelow) String() string {
 func (*PackagesAtAndBelow) ProtoMessage() {}
 
 func (x *PackagesAtAndBelow) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[57]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2703,32 +2907,30 @@ func (x *PackagesAtAndBelow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackagesAtAndBelow.ProtoReflect.Descriptor instead.
 func (*PackagesAtAndBelow) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62}
 }
 
-type Constraint struct {
+type QueryResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Setting       string                 `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Constraint) Reset() {
-	*x = Constraint{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[58]
+func (x *QueryResult) Reset() {
+	*x = QueryResult{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Constraint) String() string {
+func (x *QueryResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Constraint) ProtoMessage() {}
+func (*QueryResult) ProtoMessage() {}
 
-func (x *Constraint) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[58]
+func (x *QueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2739,21 +2941,59 @@ func (x *Constraint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Constraint.ProtoReflect.Descriptor instead.
-func (*Constraint) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *Constraint) GetSetting() string {
-	if x != nil {
-		return x.Setting
-	}
-	return ""
+// Deprecated: Use QueryResult.ProtoReflect.Descriptor instead.
+func (*QueryResult) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Constraint) GetValue() string {
-	if x != nil {
-		return x.Value
+type Constraint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       string                 `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Constraint) Reset() {
+	*x = Constraint{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Constraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constraint) ProtoMessage() {}
+
+func (x *Constraint) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constraint.ProtoReflect.Descriptor instead.
+func (*Constraint) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Constraint) GetSetting() string {
+	if x != nil {
+		return x.Setting
+	}
+	return ""
+}
+
+func (x *Constraint) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
@@ -2769,7 +3009,7 @@ type ExecutionPlatform struct {
 
 func (x *ExecutionPlatform) Reset() {
 	*x = ExecutionPlatform{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[59]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2781,7 +3021,7 @@ func (x *ExecutionPlatform) String() string {
 func (*ExecutionPlatform) ProtoMessage() {}
 
 func (x *ExecutionPlatform) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[59]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2794,7 +3034,7 @@ func (x *ExecutionPlatform) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionPlatform.ProtoReflect.Descriptor instead.
 func (*ExecutionPlatform) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ExecutionPlatform) GetLabel() string {
@@ -2826,7 +3066,7 @@ type RegisteredExecutionPlatforms struct {
 
 func (x *RegisteredExecutionPlatforms) Reset() {
 	*x = RegisteredExecutionPlatforms{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[60]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2838,7 +3078,7 @@ func (x *RegisteredExecutionPlatforms) String() string {
 func (*RegisteredExecutionPlatforms) ProtoMessage() {}
 
 func (x *RegisteredExecutionPlatforms) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[60]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,7 +3091,7 @@ func (x *RegisteredExecutionPlatforms) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredExecutionPlatforms.ProtoReflect.Descriptor instead.
 func (*RegisteredExecutionPlatforms) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66}
 }
 
 type RegisteredFetchPlatform struct {
@@ -2862,7 +3102,7 @@ type RegisteredFetchPlatform struct {
 
 func (x *RegisteredFetchPlatform) Reset() {
 	*x = RegisteredFetchPlatform{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[61]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2874,7 +3114,7 @@ func (x *RegisteredFetchPlatform) String() string {
 func (*RegisteredFetchPlatform) ProtoMessage() {}
 
 func (x *RegisteredFetchPlatform) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[61]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2887,7 +3127,7 @@ func (x *RegisteredFetchPlatform) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredFetchPlatform.ProtoReflect.Descriptor instead.
 func (*RegisteredFetchPlatform) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67}
 }
 
 type RegisteredRepoPlatform struct {
@@ -2898,7 +3138,7 @@ type RegisteredRepoPlatform struct {
 
 func (x *RegisteredRepoPlatform) Reset() {
 	*x = RegisteredRepoPlatform{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[62]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2910,7 +3150,7 @@ func (x *RegisteredRepoPlatform) String() string {
 func (*RegisteredRepoPlatform) ProtoMessage() {}
 
 func (x *RegisteredRepoPlatform) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[62]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,7 +3163,7 @@ func (x *RegisteredRepoPlatform) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredRepoPlatform.ProtoReflect.Descriptor instead.
 func (*RegisteredRepoPlatform) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68}
 }
 
 type RegisteredToolchain struct {
@@ -2938,7 +3178,7 @@ type RegisteredToolchain struct {
 
 func (x *RegisteredToolchain) Reset() {
 	*x = RegisteredToolchain{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[63]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2950,7 +3190,7 @@ func (x *RegisteredToolchain) String() string {
 func (*RegisteredToolchain) ProtoMessage() {}
 
 func (x *RegisteredToolchain) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[63]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2963,7 +3203,7 @@ func (x *RegisteredToolchain) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchain.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchain) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *RegisteredToolchain) GetExecCompatibleWith() []*Constraint {
@@ -3002,7 +3242,7 @@ type RegisteredToolchains struct {
 
 func (x *RegisteredToolchains) Reset() {
 	*x = RegisteredToolchains{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[64]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +3254,7 @@ func (x *RegisteredToolchains) String() string {
 func (*RegisteredToolchains) ProtoMessage() {}
 
 func (x *RegisteredToolchains) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[64]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3027,7 +3267,7 @@ func (x *RegisteredToolchains) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchains.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchains) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{64}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70}
 }
 
 type RegisteredToolchainsForType struct {
@@ -3038,7 +3278,7 @@ type RegisteredToolchainsForType struct {
 
 func (x *RegisteredToolchainsForType) Reset() {
 	*x = RegisteredToolchainsForType{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[65]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3050,7 +3290,7 @@ func (x *RegisteredToolchainsForType) String() string {
 func (*RegisteredToolchainsForType) ProtoMessage() {}
 
 func (x *RegisteredToolchainsForType) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[65]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3063,7 +3303,7 @@ func (x *RegisteredToolchainsForType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchainsForType.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchainsForType) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{65}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{71}
 }
 
 type Repo struct {
@@ -3074,7 +3314,7 @@ type Repo struct {
 
 func (x *Repo) Reset() {
 	*x = Repo{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[66]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3086,7 +3326,7 @@ func (x *Repo) String() string {
 func (*Repo) ProtoMessage() {}
 
 func (x *Repo) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[66]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3099,7 +3339,7 @@ func (x *Repo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Repo.ProtoReflect.Descriptor instead.
 func (*Repo) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72}
 }
 
 type RepoDefaultAttrs struct {
@@ -3110,7 +3350,7 @@ type RepoDefaultAttrs struct {
 
 func (x *RepoDefaultAttrs) Reset() {
 	*x = RepoDefaultAttrs{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[67]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3122,7 +3362,7 @@ func (x *RepoDefaultAttrs) String() string {
 func (*RepoDefaultAttrs) ProtoMessage() {}
 
 func (x *RepoDefaultAttrs) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[67]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3135,7 +3375,7 @@ func (x *RepoDefaultAttrs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoDefaultAttrs.ProtoReflect.Descriptor instead.
 func (*RepoDefaultAttrs) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73}
 }
 
 type RepoPlatformHostPath struct {
@@ -3146,7 +3386,7 @@ type RepoPlatformHostPath struct {
 
 func (x *RepoPlatformHostPath) Reset() {
 	*x = RepoPlatformHostPath{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[68]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3158,7 +3398,7 @@ func (x *RepoPlatformHostPath) String() string {
 func (*RepoPlatformHostPath) ProtoMessage() {}
 
 func (x *RepoPlatformHostPath) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[68]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3171,7 +3411,7 @@ func (x *RepoPlatformHostPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoPlatformHostPath.ProtoReflect.Descriptor instead.
 func (*RepoPlatformHostPath) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{74}
 }
 
 type ResolvedToolchains struct {
@@ -3182,7 +3422,7 @@ type ResolvedToolchains struct {
 
 func (x *ResolvedToolchains) Reset() {
 	*x = ResolvedToolchains{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[69]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3194,7 +3434,7 @@ func (x *ResolvedToolchains) String() string {
 func (*ResolvedToolchains) ProtoMessage() {}
 
 func (x *ResolvedToolchains) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[69]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3207,7 +3447,7 @@ func (x *ResolvedToolchains) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedToolchains.ProtoReflect.Descriptor instead.
 func (*ResolvedToolchains) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{69}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75}
 }
 
 type RootModule struct {
@@ -3218,7 +3458,7 @@ type RootModule struct {
 
 func (x *RootModule) Reset() {
 	*x = RootModule{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[70]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3230,7 +3470,7 @@ func (x *RootModule) String() string {
 func (*RootModule) ProtoMessage() {}
 
 func (x *RootModule) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[70]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3243,7 +3483,7 @@ func (x *RootModule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RootModule.ProtoReflect.Descriptor instead.
 func (*RootModule) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76}
 }
 
 type RuleImplementationWrappers struct {
@@ -3254,7 +3494,7 @@ type RuleImplementationWrappers struct {
 
 func (x *RuleImplementationWrappers) Reset() {
 	*x = RuleImplementationWrappers{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[71]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3266,7 +3506,7 @@ func (x *RuleImplementationWrappers) String() string {
 func (*RuleImplementationWrappers) ProtoMessage() {}
 
 func (x *RuleImplementationWrappers) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[71]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3279,7 +3519,7 @@ func (x *RuleImplementationWrappers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleImplementationWrappers.ProtoReflect.Descriptor instead.
 func (*RuleImplementationWrappers) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{71}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{77}
 }
 
 type Select struct {
@@ -3290,7 +3530,7 @@ type Select struct {
 
 func (x *Select) Reset() {
 	*x = Select{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[72]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3302,7 +3542,7 @@ func (x *Select) String() string {
 func (*Select) ProtoMessage() {}
 
 func (x *Select) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[72]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3315,7 +3555,7 @@ func (x *Select) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Select.ProtoReflect.Descriptor instead.
 func (*Select) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{78}
 }
 
 type StableInputRootPath struct {
@@ -3326,7 +3566,7 @@ type StableInputRootPath struct {
 
 func (x *StableInputRootPath) Reset() {
 	*x = StableInputRootPath{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[73]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3338,7 +3578,7 @@ func (x *StableInputRootPath) String() string {
 func (*StableInputRootPath) ProtoMessage() {}
 
 func (x *StableInputRootPath) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[73]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3351,7 +3591,7 @@ func (x *StableInputRootPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPath.ProtoReflect.Descriptor instead.
 func (*StableInputRootPath) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{79}
 }
 
 type StableInputRootPathObject struct {
@@ -3362,7 +3602,7 @@ type StableInputRootPathObject struct {
 
 func (x *StableInputRootPathObject) Reset() {
 	*x = StableInputRootPathObject{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[74]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3374,7 +3614,7 @@ func (x *StableInputRootPathObject) String() string {
 func (*StableInputRootPathObject) ProtoMessage() {}
 
 func (x *StableInputRootPathObject) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[74]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3387,7 +3627,7 @@ func (x *StableInputRootPathObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPathObject.ProtoReflect.Descriptor instead.
 func (*StableInputRootPathObject) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{74}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{80}
 }
 
 type SuccessfulActionResult struct {
@@ -3398,7 +3638,7 @@ type SuccessfulActionResult struct {
 
 func (x *SuccessfulActionResult) Reset() {
 	*x = SuccessfulActionResult{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[75]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3410,7 +3650,7 @@ func (x *SuccessfulActionResult) String() string {
 func (*SuccessfulActionResult) ProtoMessage() {}
 
 func (x *SuccessfulActionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[75]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3423,7 +3663,7 @@ func (x *SuccessfulActionResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuccessfulActionResult.ProtoReflect.Descriptor instead.
 func (*SuccessfulActionResult) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{81}
 }
 
 type Target struct {
@@ -3434,7 +3674,7 @@ type Target struct {
 
 func (x *Target) Reset() {
 	*x = Target{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[76]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3446,7 +3686,7 @@ func (x *Target) String() string {
 func (*Target) ProtoMessage() {}
 
 func (x *Target) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[76]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3459,7 +3699,7 @@ func (x *Target) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Target.ProtoReflect.Descriptor instead.
 func (*Target) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{82}
 }
 
 type TargetActionId struct {
@@ -3473,7 +3713,7 @@ type TargetActionId struct {
 
 func (x *TargetActionId) Reset() {
 	*x = TargetActionId{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[77]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3485,7 +3725,7 @@ func (x *TargetActionId) String() string {
 func (*TargetActionId) ProtoMessage() {}
 
 func (x *TargetActionId) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[77]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3498,7 +3738,7 @@ func (x *TargetActionId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionId.ProtoReflect.Descriptor instead.
 func (*TargetActionId) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{77}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *TargetActionId) GetLabel() string {
@@ -3530,7 +3770,7 @@ type TargetAction struct {
 
 func (x *TargetAction) Reset() {
 	*x = TargetAction{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[78]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3542,7 +3782,7 @@ func (x *TargetAction) String() string {
 func (*TargetAction) ProtoMessage() {}
 
 func (x *TargetAction) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[78]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3555,7 +3795,7 @@ func (x *TargetAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetAction.ProtoReflect.Descriptor instead.
 func (*TargetAction) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{78}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{84}
 }
 
 type TargetActionCommand struct {
@@ -3566,7 +3806,7 @@ type TargetActionCommand struct {
 
 func (x *TargetActionCommand) Reset() {
 	*x = TargetActionCommand{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[79]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3578,7 +3818,7 @@ func (x *TargetActionCommand) String() string {
 func (*TargetActionCommand) ProtoMessage() {}
 
 func (x *TargetActionCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[79]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3591,7 +3831,7 @@ func (x *TargetActionCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionCommand.ProtoReflect.Descriptor instead.
 func (*TargetActionCommand) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{79}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{85}
 }
 
 type TargetActionInputRoot struct {
@@ -3602,7 +3842,7 @@ type TargetActionInputRoot struct {
 
 func (x *TargetActionInputRoot) Reset() {
 	*x = TargetActionInputRoot{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[80]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3614,7 +3854,7 @@ func (x *TargetActionInputRoot) String() string {
 func (*TargetActionInputRoot) ProtoMessage() {}
 
 func (x *TargetActionInputRoot) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[80]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3627,7 +3867,7 @@ func (x *TargetActionInputRoot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionInputRoot.ProtoReflect.Descriptor instead.
 func (*TargetActionInputRoot) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{80}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{86}
 }
 
 type TargetActionResult struct {
@@ -3638,7 +3878,7 @@ type TargetActionResult struct {
 
 func (x *TargetActionResult) Reset() {
 	*x = TargetActionResult{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[81]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3650,7 +3890,7 @@ func (x *TargetActionResult) String() string {
 func (*TargetActionResult) ProtoMessage() {}
 
 func (x *TargetActionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[81]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3663,7 +3903,7 @@ func (x *TargetActionResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionResult.ProtoReflect.Descriptor instead.
 func (*TargetActionResult) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{81}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{87}
 }
 
 type TargetCompletion struct {
@@ -3674,7 +3914,7 @@ type TargetCompletion struct {
 
 func (x *TargetCompletion) Reset() {
 	*x = TargetCompletion{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[82]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3686,7 +3926,7 @@ func (x *TargetCompletion) String() string {
 func (*TargetCompletion) ProtoMessage() {}
 
 func (x *TargetCompletion) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[82]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3699,30 +3939,30 @@ func (x *TargetCompletion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetCompletion.ProtoReflect.Descriptor instead.
 func (*TargetCompletion) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{82}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{88}
 }
 
-type TargetPatternExpansion struct {
+type TargetExecutable struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TargetPatternExpansion) Reset() {
-	*x = TargetPatternExpansion{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[83]
+func (x *TargetExecutable) Reset() {
+	*x = TargetExecutable{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TargetPatternExpansion) String() string {
+func (x *TargetExecutable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TargetPatternExpansion) ProtoMessage() {}
+func (*TargetExecutable) ProtoMessage() {}
 
-func (x *TargetPatternExpansion) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[83]
+func (x *TargetExecutable) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3733,34 +3973,32 @@ func (x *TargetPatternExpansion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TargetPatternExpansion.ProtoReflect.Descriptor instead.
-func (*TargetPatternExpansion) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use TargetExecutable.ProtoReflect.Descriptor instead.
+func (*TargetExecutable) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{89}
 }
 
-type ModuleExtension struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Identifier    string                  `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Users         []*ModuleExtension_User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+type TargetPatternExpansion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModuleExtension) Reset() {
-	*x = ModuleExtension{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[84]
+func (x *TargetPatternExpansion) Reset() {
+	*x = TargetPatternExpansion{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModuleExtension) String() string {
+func (x *TargetPatternExpansion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModuleExtension) ProtoMessage() {}
+func (*TargetPatternExpansion) ProtoMessage() {}
 
-func (x *ModuleExtension) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[84]
+func (x *TargetPatternExpansion) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3771,46 +4009,32 @@ func (x *ModuleExtension) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModuleExtension.ProtoReflect.Descriptor instead.
-func (*ModuleExtension) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *ModuleExtension) GetIdentifier() string {
-	if x != nil {
-		return x.Identifier
-	}
-	return ""
-}
-
-func (x *ModuleExtension) GetUsers() []*ModuleExtension_User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use TargetPatternExpansion.ProtoReflect.Descriptor instead.
+func (*TargetPatternExpansion) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{90}
 }
 
-type RepositoryRuleObject struct {
+type TestResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RepositoryRuleObject) Reset() {
-	*x = RepositoryRuleObject{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[85]
+func (x *TestResult) Reset() {
+	*x = TestResult{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepositoryRuleObject) String() string {
+func (x *TestResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepositoryRuleObject) ProtoMessage() {}
+func (*TestResult) ProtoMessage() {}
 
-func (x *RepositoryRuleObject) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[85]
+func (x *TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3821,9 +4045,133 @@ func (x *RepositoryRuleObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepositoryRuleObject.ProtoReflect.Descriptor instead.
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{91}
+}
+
+type ModuleExtension struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Identifier    string                  `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Users         []*ModuleExtension_User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleExtension) Reset() {
+	*x = ModuleExtension{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleExtension) ProtoMessage() {}
+
+func (x *ModuleExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleExtension.ProtoReflect.Descriptor instead.
+func (*ModuleExtension) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ModuleExtension) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ModuleExtension) GetUsers() []*ModuleExtension_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type RepositoryRuleObject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepositoryRuleObject) Reset() {
+	*x = RepositoryRuleObject{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepositoryRuleObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryRuleObject) ProtoMessage() {}
+
+func (x *RepositoryRuleObject) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryRuleObject.ProtoReflect.Descriptor instead.
 func (*RepositoryRuleObject) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{85}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{93}
+}
+
+type UpdatedModuleLockfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatedModuleLockfile) Reset() {
+	*x = UpdatedModuleLockfile{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatedModuleLockfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatedModuleLockfile) ProtoMessage() {}
+
+func (x *UpdatedModuleLockfile) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatedModuleLockfile.ProtoReflect.Descriptor instead.
+func (*UpdatedModuleLockfile) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{94}
 }
 
 type UsedModuleExtension struct {
@@ -3834,7 +4182,7 @@ type UsedModuleExtension struct {
 
 func (x *UsedModuleExtension) Reset() {
 	*x = UsedModuleExtension{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[86]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3846,7 +4194,7 @@ func (x *UsedModuleExtension) String() string {
 func (*UsedModuleExtension) ProtoMessage() {}
 
 func (x *UsedModuleExtension) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[86]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3859,7 +4207,7 @@ func (x *UsedModuleExtension) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtension.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtension) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{86}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{95}
 }
 
 type UsedModuleExtensions struct {
@@ -3870,7 +4218,7 @@ type UsedModuleExtensions struct {
 
 func (x *UsedModuleExtensions) Reset() {
 	*x = UsedModuleExtensions{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[87]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3882,7 +4230,7 @@ func (x *UsedModuleExtensions) String() string {
 func (*UsedModuleExtensions) ProtoMessage() {}
 
 func (x *UsedModuleExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[87]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3895,7 +4243,7 @@ func (x *UsedModuleExtensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtensions.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtensions) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{87}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{96}
 }
 
 type UserDefinedTransition struct {
@@ -3906,7 +4254,7 @@ type UserDefinedTransition struct {
 
 func (x *UserDefinedTransition) Reset() {
 	*x = UserDefinedTransition{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[88]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3918,7 +4266,7 @@ func (x *UserDefinedTransition) String() string {
 func (*UserDefinedTransition) ProtoMessage() {}
 
 func (x *UserDefinedTransition) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[88]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3931,7 +4279,7 @@ func (x *UserDefinedTransition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDefinedTransition.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{88}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{97}
 }
 
 type VisibleTarget struct {
@@ -3942,7 +4290,7 @@ type VisibleTarget struct {
 
 func (x *VisibleTarget) Reset() {
 	*x = VisibleTarget{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[89]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3954,7 +4302,7 @@ func (x *VisibleTarget) String() string {
 func (*VisibleTarget) ProtoMessage() {}
 
 func (x *VisibleTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[89]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3967,7 +4315,7 @@ func (x *VisibleTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisibleTarget.ProtoReflect.Descriptor instead.
 func (*VisibleTarget) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{89}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{98}
 }
 
 type ActionEncoderObject_Key struct {
@@ -3978,7 +4326,7 @@ type ActionEncoderObject_Key struct {
 
 func (x *ActionEncoderObject_Key) Reset() {
 	*x = ActionEncoderObject_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[90]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3990,7 +4338,7 @@ func (x *ActionEncoderObject_Key) String() string {
 func (*ActionEncoderObject_Key) ProtoMessage() {}
 
 func (x *ActionEncoderObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[90]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4014,7 +4362,7 @@ type ActionEncoders_Key struct {
 
 func (x *ActionEncoders_Key) Reset() {
 	*x = ActionEncoders_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[91]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4026,7 +4374,7 @@ func (x *ActionEncoders_Key) String() string {
 func (*ActionEncoders_Key) ProtoMessage() {}
 
 func (x *ActionEncoders_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[91]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4051,7 +4399,7 @@ type ActionEncoders_Value struct {
 
 func (x *ActionEncoders_Value) Reset() {
 	*x = ActionEncoders_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[92]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4063,7 +4411,7 @@ func (x *ActionEncoders_Value) String() string {
 func (*ActionEncoders_Value) ProtoMessage() {}
 
 func (x *ActionEncoders_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[92]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4094,7 +4442,7 @@ type ActionReaders_Key struct {
 
 func (x *ActionReaders_Key) Reset() {
 	*x = ActionReaders_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[93]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4106,7 +4454,7 @@ func (x *ActionReaders_Key) String() string {
 func (*ActionReaders_Key) ProtoMessage() {}
 
 func (x *ActionReaders_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[93]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4122,28 +4470,28 @@ func (*ActionReaders_Key) Descriptor() ([]byte, []int) {
 	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{2, 0}
 }
 
-type ActionResult_Key struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ExecuteRequest *ExecuteRequest        `protobuf:"bytes,1,opt,name=execute_request,json=executeRequest,proto3" json:"execute_request,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ActionQueryResult_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActionResult_Key) Reset() {
-	*x = ActionResult_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[94]
+func (x *ActionQueryResult_Key) Reset() {
+	*x = ActionQueryResult_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActionResult_Key) String() string {
+func (x *ActionQueryResult_Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActionResult_Key) ProtoMessage() {}
+func (*ActionQueryResult_Key) ProtoMessage() {}
 
-func (x *ActionResult_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[94]
+func (x *ActionQueryResult_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4154,41 +4502,40 @@ func (x *ActionResult_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActionResult_Key.ProtoReflect.Descriptor instead.
-func (*ActionResult_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use ActionQueryResult_Key.ProtoReflect.Descriptor instead.
+func (*ActionQueryResult_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3, 0}
 }
 
-func (x *ActionResult_Key) GetExecuteRequest() *ExecuteRequest {
+func (x *ActionQueryResult_Key) GetExpression() string {
 	if x != nil {
-		return x.ExecuteRequest
+		return x.Expression
 	}
-	return nil
+	return ""
 }
 
-type ActionResult_Value struct {
-	state            protoimpl.MessageState   `protogen:"open.v1"`
-	ExitCode         int64                    `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	OutputsReference *core.DecodableReference `protobuf:"bytes,2,opt,name=outputs_reference,json=outputsReference,proto3" json:"outputs_reference,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ActionQueryResult_Value struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Actions       []*ActionQueryResult_Value_Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActionResult_Value) Reset() {
-	*x = ActionResult_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[95]
+func (x *ActionQueryResult_Value) Reset() {
+	*x = ActionQueryResult_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActionResult_Value) String() string {
+func (x *ActionQueryResult_Value) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActionResult_Value) ProtoMessage() {}
+func (*ActionQueryResult_Value) ProtoMessage() {}
 
-func (x *ActionResult_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[95]
+func (x *ActionQueryResult_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4199,46 +4546,46 @@ func (x *ActionResult_Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActionResult_Value.ProtoReflect.Descriptor instead.
-func (*ActionResult_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4, 1}
-}
-
-func (x *ActionResult_Value) GetExitCode() int64 {
-	if x != nil {
-		return x.ExitCode
-	}
-	return 0
+// Deprecated: Use ActionQueryResult_Value.ProtoReflect.Descriptor instead.
+func (*ActionQueryResult_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3, 1}
 }
 
-func (x *ActionResult_Value) GetOutputsReference() *core.DecodableReference {
+func (x *ActionQueryResult_Value) GetActions() []*ActionQueryResult_Value_Action {
 	if x != nil {
-		return x.OutputsReference
+		return x.Actions
 	}
 	return nil
 }
 
-type BuildSpecification_Key struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ActionQueryResult_Value_Action struct {
+	state                  protoimpl.MessageState                          `protogen:"open.v1"`
+	Label                  string                                          `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationComponent string                                          `protobuf:"bytes,2,opt,name=configuration_component,json=configurationComponent,proto3" json:"configuration_component,omitempty"`
+	Mnemonic               string                                          `protobuf:"bytes,3,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Arguments              []string                                        `protobuf:"bytes,4,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	EnvironmentVariables   []*command.EnvironmentVariableList_Element_Leaf `protobuf:"bytes,5,rep,name=environment_variables,json=environmentVariables,proto3" json:"environment_variables,omitempty"`
+	Inputs                 []string                                        `protobuf:"bytes,6,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs                []string                                        `protobuf:"bytes,7,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *BuildSpecification_Key) Reset() {
-	*x = BuildSpecification_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[96]
+func (x *ActionQueryResult_Value_Action) Reset() {
+	*x = ActionQueryResult_Value_Action{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildSpecification_Key) String() string {
+func (x *ActionQueryResult_Value_Action) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildSpecification_Key) ProtoMessage() {}
+func (*ActionQueryResult_Value_Action) ProtoMessage() {}
 
-func (x *BuildSpecification_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[96]
+func (x *ActionQueryResult_Value_Action) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4249,47 +4596,228 @@ func (x *BuildSpecification_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildSpecification_Key.ProtoReflect.Descriptor instead.
-func (*BuildSpecification_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use ActionQueryResult_Value_Action.ProtoReflect.Descriptor instead.
+func (*ActionQueryResult_Value_Action) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3, 1, 0}
 }
 
-type BuildSpecification_Value struct {
-	state                                  protoimpl.MessageState                    `protogen:"open.v1"`
-	Modules                                []*BuildSpecification_Value_Module        `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
-	RootModuleName                         string                                    `protobuf:"bytes,2,opt,name=root_module_name,json=rootModuleName,proto3" json:"root_module_name,omitempty"`
-	TargetPatterns                         []string                                  `protobuf:"bytes,3,rep,name=target_patterns,json=targetPatterns,proto3" json:"target_patterns,omitempty"`
-	DirectoryCreationParameters            *filesystem.DirectoryCreationParameters   `protobuf:"bytes,4,opt,name=directory_creation_parameters,json=directoryCreationParameters,proto3" json:"directory_creation_parameters,omitempty"`
-	FileCreationParameters                 *filesystem.FileCreationParameters        `protobuf:"bytes,5,opt,name=file_creation_parameters,json=fileCreationParameters,proto3" json:"file_creation_parameters,omitempty"`
-	UseLockfile                            *BuildSpecification_Value_UseLockfile     `protobuf:"bytes,6,opt,name=use_lockfile,json=useLockfile,proto3" json:"use_lockfile,omitempty"`
-	ModuleRegistryUrls                     []string                                  `protobuf:"bytes,7,rep,name=module_registry_urls,json=moduleRegistryUrls,proto3" json:"module_registry_urls,omitempty"`
-	IgnoreRootModuleDevDependencies        bool                                      `protobuf:"varint,8,opt,name=ignore_root_module_dev_dependencies,json=ignoreRootModuleDevDependencies,proto3" json:"ignore_root_module_dev_dependencies,omitempty"`
-	BuiltinsModuleNames                    []string                                  `protobuf:"bytes,9,rep,name=builtins_module_names,json=builtinsModuleNames,proto3" json:"builtins_module_names,omitempty"`
-	RepoPlatform                           string                                    `protobuf:"bytes,10,opt,name=repo_platform,json=repoPlatform,proto3" json:"repo_platform,omitempty"`
-	ActionEncoders                         []*encoding.BinaryEncoder                 `protobuf:"bytes,11,rep,name=action_encoders,json=actionEncoders,proto3" json:"action_encoders,omitempty"`
-	Configurations                         []*BuildSpecification_Value_Configuration `protobuf:"bytes,12,rep,name=configurations,proto3" json:"configurations,omitempty"`
-	RuleImplementationWrapperIdentifier    string                                    `protobuf:"bytes,13,opt,name=rule_implementation_wrapper_identifier,json=ruleImplementationWrapperIdentifier,proto3" json:"rule_implementation_wrapper_identifier,omitempty"`
-	SubruleImplementationWrapperIdentifier string                                    `protobuf:"bytes,14,opt,name=subrule_implementation_wrapper_identifier,json=subruleImplementationWrapperIdentifier,proto3" json:"subrule_implementation_wrapper_identifier,omitempty"`
-	FetchPlatformPkixPublicKey             []byte                                    `protobuf:"bytes,15,opt,name=fetch_platform_pkix_public_key,json=fetchPlatformPkixPublicKey,proto3" json:"fetch_platform_pkix_public_key,omitempty"`
-	unknownFields                          protoimpl.UnknownFields
-	sizeCache                              protoimpl.SizeCache
+func (x *ActionQueryResult_Value_Action) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (x *BuildSpecification_Value) Reset() {
-	*x = BuildSpecification_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[97]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ActionQueryResult_Value_Action) GetConfigurationComponent() string {
+	if x != nil {
+		return x.ConfigurationComponent
+	}
+	return ""
 }
 
-func (x *BuildSpecification_Value) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActionQueryResult_Value_Action) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
+func (x *ActionQueryResult_Value_Action) GetArguments() []string {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *ActionQueryResult_Value_Action) GetEnvironmentVariables() []*command.EnvironmentVariableList_Element_Leaf {
+	if x != nil {
+		return x.EnvironmentVariables
+	}
+	return nil
+}
+
+func (x *ActionQueryResult_Value_Action) GetInputs() []string {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *ActionQueryResult_Value_Action) GetOutputs() []string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type ActionResult_Key struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExecuteRequest *ExecuteRequest        `protobuf:"bytes,1,opt,name=execute_request,json=executeRequest,proto3" json:"execute_request,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ActionResult_Key) Reset() {
+	*x = ActionResult_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionResult_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionResult_Key) ProtoMessage() {}
+
+func (x *ActionResult_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionResult_Key.ProtoReflect.Descriptor instead.
+func (*ActionResult_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *ActionResult_Key) GetExecuteRequest() *ExecuteRequest {
+	if x != nil {
+		return x.ExecuteRequest
+	}
+	return nil
+}
+
+type ActionResult_Value struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	ExitCode         int64                    `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	OutputsReference *core.DecodableReference `protobuf:"bytes,2,opt,name=outputs_reference,json=outputsReference,proto3" json:"outputs_reference,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ActionResult_Value) Reset() {
+	*x = ActionResult_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionResult_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionResult_Value) ProtoMessage() {}
+
+func (x *ActionResult_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionResult_Value.ProtoReflect.Descriptor instead.
+func (*ActionResult_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *ActionResult_Value) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ActionResult_Value) GetOutputsReference() *core.DecodableReference {
+	if x != nil {
+		return x.OutputsReference
+	}
+	return nil
+}
+
+type BuildSpecification_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildSpecification_Key) Reset() {
+	*x = BuildSpecification_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildSpecification_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildSpecification_Key) ProtoMessage() {}
+
+func (x *BuildSpecification_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildSpecification_Key.ProtoReflect.Descriptor instead.
+func (*BuildSpecification_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type BuildSpecification_Value struct {
+	state                                  protoimpl.MessageState                    `protogen:"open.v1"`
+	Modules                                []*BuildSpecification_Value_Module        `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	RootModuleName                         string                                    `protobuf:"bytes,2,opt,name=root_module_name,json=rootModuleName,proto3" json:"root_module_name,omitempty"`
+	TargetPatterns                         []string                                  `protobuf:"bytes,3,rep,name=target_patterns,json=targetPatterns,proto3" json:"target_patterns,omitempty"`
+	DirectoryCreationParameters            *filesystem.DirectoryCreationParameters   `protobuf:"bytes,4,opt,name=directory_creation_parameters,json=directoryCreationParameters,proto3" json:"directory_creation_parameters,omitempty"`
+	FileCreationParameters                 *filesystem.FileCreationParameters        `protobuf:"bytes,5,opt,name=file_creation_parameters,json=fileCreationParameters,proto3" json:"file_creation_parameters,omitempty"`
+	UseLockfile                            *BuildSpecification_Value_UseLockfile     `protobuf:"bytes,6,opt,name=use_lockfile,json=useLockfile,proto3" json:"use_lockfile,omitempty"`
+	ModuleRegistryUrls                     []string                                  `protobuf:"bytes,7,rep,name=module_registry_urls,json=moduleRegistryUrls,proto3" json:"module_registry_urls,omitempty"`
+	IgnoreRootModuleDevDependencies        bool                                      `protobuf:"varint,8,opt,name=ignore_root_module_dev_dependencies,json=ignoreRootModuleDevDependencies,proto3" json:"ignore_root_module_dev_dependencies,omitempty"`
+	BuiltinsModuleNames                    []string                                  `protobuf:"bytes,9,rep,name=builtins_module_names,json=builtinsModuleNames,proto3" json:"builtins_module_names,omitempty"`
+	RepoPlatform                           string                                    `protobuf:"bytes,10,opt,name=repo_platform,json=repoPlatform,proto3" json:"repo_platform,omitempty"`
+	ActionEncoders                         []*encoding.BinaryEncoder                 `protobuf:"bytes,11,rep,name=action_encoders,json=actionEncoders,proto3" json:"action_encoders,omitempty"`
+	Configurations                         []*BuildSpecification_Value_Configuration `protobuf:"bytes,12,rep,name=configurations,proto3" json:"configurations,omitempty"`
+	RuleImplementationWrapperIdentifier    string                                    `protobuf:"bytes,13,opt,name=rule_implementation_wrapper_identifier,json=ruleImplementationWrapperIdentifier,proto3" json:"rule_implementation_wrapper_identifier,omitempty"`
+	SubruleImplementationWrapperIdentifier string                                    `protobuf:"bytes,14,opt,name=subrule_implementation_wrapper_identifier,json=subruleImplementationWrapperIdentifier,proto3" json:"subrule_implementation_wrapper_identifier,omitempty"`
+	FetchPlatformPkixPublicKey             []byte                                    `protobuf:"bytes,15,opt,name=fetch_platform_pkix_public_key,json=fetchPlatformPkixPublicKey,proto3" json:"fetch_platform_pkix_public_key,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *BuildSpecification_Value) Reset() {
+	*x = BuildSpecification_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildSpecification_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*BuildSpecification_Value) ProtoMessage() {}
 
 func (x *BuildSpecification_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[97]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4302,7 +4830,7 @@ func (x *BuildSpecification_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSpecification_Value.ProtoReflect.Descriptor instead.
 func (*BuildSpecification_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *BuildSpecification_Value) GetModules() []*BuildSpecification_Value_Module {
@@ -4420,7 +4948,7 @@ type BuildSpecification_Value_Module struct {
 
 func (x *BuildSpecification_Value_Module) Reset() {
 	*x = BuildSpecification_Value_Module{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[98]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4432,7 +4960,7 @@ func (x *BuildSpecification_Value_Module) String() string {
 func (*BuildSpecification_Value_Module) ProtoMessage() {}
 
 func (x *BuildSpecification_Value_Module) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[98]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4445,7 +4973,7 @@ func (x *BuildSpecification_Value_Module) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSpecification_Value_Module.ProtoReflect.Descriptor instead.
 func (*BuildSpecification_Value_Module) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 1, 0}
 }
 
 func (x *BuildSpecification_Value_Module) GetName() string {
@@ -4472,7 +5000,7 @@ type BuildSpecification_Value_UseLockfile struct {
 
 func (x *BuildSpecification_Value_UseLockfile) Reset() {
 	*x = BuildSpecification_Value_UseLockfile{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[99]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4484,7 +5012,7 @@ func (x *BuildSpecification_Value_UseLockfile) String() string {
 func (*BuildSpecification_Value_UseLockfile) ProtoMessage() {}
 
 func (x *BuildSpecification_Value_UseLockfile) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[99]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4497,7 +5025,7 @@ func (x *BuildSpecification_Value_UseLockfile) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use BuildSpecification_Value_UseLockfile.ProtoReflect.Descriptor instead.
 func (*BuildSpecification_Value_UseLockfile) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 1, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 1, 1}
 }
 
 func (x *BuildSpecification_Value_UseLockfile) GetError() bool {
@@ -4524,7 +5052,7 @@ type BuildSpecification_Value_BuildSettingOverride struct {
 
 func (x *BuildSpecification_Value_BuildSettingOverride) Reset() {
 	*x = BuildSpecification_Value_BuildSettingOverride{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[100]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4536,7 +5064,7 @@ func (x *BuildSpecification_Value_BuildSettingOverride) String() string {
 func (*BuildSpecification_Value_BuildSettingOverride) ProtoMessage() {}
 
 func (x *BuildSpecification_Value_BuildSettingOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[100]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4549,7 +5077,7 @@ func (x *BuildSpecification_Value_BuildSettingOverride) ProtoReflect() protorefl
 
 // Deprecated: Use BuildSpecification_Value_BuildSettingOverride.ProtoReflect.Descriptor instead.
 func (*BuildSpecification_Value_BuildSettingOverride) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 1, 2}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 1, 2}
 }
 
 func (x *BuildSpecification_Value_BuildSettingOverride) GetLabel() string {
@@ -4575,7 +5103,7 @@ type BuildSpecification_Value_Configuration struct {
 
 func (x *BuildSpecification_Value_Configuration) Reset() {
 	*x = BuildSpecification_Value_Configuration{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[101]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4587,7 +5115,7 @@ func (x *BuildSpecification_Value_Configuration) String() string {
 func (*BuildSpecification_Value_Configuration) ProtoMessage() {}
 
 func (x *BuildSpecification_Value_Configuration) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[101]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4600,7 +5128,7 @@ func (x *BuildSpecification_Value_Configuration) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use BuildSpecification_Value_Configuration.ProtoReflect.Descriptor instead.
 func (*BuildSpecification_Value_Configuration) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 1, 3}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 1, 3}
 }
 
 func (x *BuildSpecification_Value_Configuration) GetBuildSettingOverrides() []*BuildSpecification_Value_BuildSettingOverride {
@@ -4618,7 +5146,7 @@ type BuiltinsModuleNames_Key struct {
 
 func (x *BuiltinsModuleNames_Key) Reset() {
 	*x = BuiltinsModuleNames_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[102]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4630,7 +5158,7 @@ func (x *BuiltinsModuleNames_Key) String() string {
 func (*BuiltinsModuleNames_Key) ProtoMessage() {}
 
 func (x *BuiltinsModuleNames_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[102]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4643,7 +5171,7 @@ func (x *BuiltinsModuleNames_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuiltinsModuleNames_Key.ProtoReflect.Descriptor instead.
 func (*BuiltinsModuleNames_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7, 0}
 }
 
 type BuiltinsModuleNames_Value struct {
@@ -4655,7 +5183,7 @@ type BuiltinsModuleNames_Value struct {
 
 func (x *BuiltinsModuleNames_Value) Reset() {
 	*x = BuiltinsModuleNames_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[103]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4667,7 +5195,7 @@ func (x *BuiltinsModuleNames_Value) String() string {
 func (*BuiltinsModuleNames_Value) ProtoMessage() {}
 
 func (x *BuiltinsModuleNames_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[103]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4680,7 +5208,7 @@ func (x *BuiltinsModuleNames_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuiltinsModuleNames_Value.ProtoReflect.Descriptor instead.
 func (*BuiltinsModuleNames_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *BuiltinsModuleNames_Value) GetBuiltinsModuleNames() []string {
@@ -4691,14 +5219,16 @@ func (x *BuiltinsModuleNames_Value) GetBuiltinsModuleNames() []string {
 }
 
 type BuildResult_Key struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState          `protogen:"open.v1"`
+	TestParameters     *BuildResult_Key_TestParameters `protobuf:"bytes,1,opt,name=test_parameters,json=testParameters,proto3" json:"test_parameters,omitempty"`
+	IncludeExecutables bool                            `protobuf:"varint,2,opt,name=include_executables,json=includeExecutables,proto3" json:"include_executables,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *BuildResult_Key) Reset() {
 	*x = BuildResult_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[104]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4710,7 +5240,7 @@ func (x *BuildResult_Key) String() string {
 func (*BuildResult_Key) ProtoMessage() {}
 
 func (x *BuildResult_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[104]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4723,18 +5253,36 @@ func (x *BuildResult_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildResult_Key.ProtoReflect.Descriptor instead.
 func (*BuildResult_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *BuildResult_Key) GetTestParameters() *BuildResult_Key_TestParameters {
+	if x != nil {
+		return x.TestParameters
+	}
+	return nil
+}
+
+func (x *BuildResult_Key) GetIncludeExecutables() bool {
+	if x != nil {
+		return x.IncludeExecutables
+	}
+	return false
 }
 
 type BuildResult_Value struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState                `protogen:"open.v1"`
+	TargetCompletions []*BuildResult_Value_TargetCompletion `protobuf:"bytes,1,rep,name=target_completions,json=targetCompletions,proto3" json:"target_completions,omitempty"`
+	TestResults       []*BuildResult_Value_TestResult       `protobuf:"bytes,2,rep,name=test_results,json=testResults,proto3" json:"test_results,omitempty"`
+	Executables       []*BuildResult_Value_Executable       `protobuf:"bytes,3,rep,name=executables,proto3" json:"executables,omitempty"`
+	ModuleLockfile    []byte                                `protobuf:"bytes,4,opt,name=module_lockfile,json=moduleLockfile,proto3" json:"module_lockfile,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *BuildResult_Value) Reset() {
 	*x = BuildResult_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[105]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4746,7 +5294,7 @@ func (x *BuildResult_Value) String() string {
 func (*BuildResult_Value) ProtoMessage() {}
 
 func (x *BuildResult_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[105]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4759,7 +5307,283 @@ func (x *BuildResult_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildResult_Value.ProtoReflect.Descriptor instead.
 func (*BuildResult_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *BuildResult_Value) GetTargetCompletions() []*BuildResult_Value_TargetCompletion {
+	if x != nil {
+		return x.TargetCompletions
+	}
+	return nil
+}
+
+func (x *BuildResult_Value) GetTestResults() []*BuildResult_Value_TestResult {
+	if x != nil {
+		return x.TestResults
+	}
+	return nil
+}
+
+func (x *BuildResult_Value) GetExecutables() []*BuildResult_Value_Executable {
+	if x != nil {
+		return x.Executables
+	}
+	return nil
+}
+
+func (x *BuildResult_Value) GetModuleLockfile() []byte {
+	if x != nil {
+		return x.ModuleLockfile
+	}
+	return nil
+}
+
+type BuildResult_Key_TestParameters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunsPerTest   uint32                 `protobuf:"varint,1,opt,name=runs_per_test,json=runsPerTest,proto3" json:"runs_per_test,omitempty"`
+	TestFilter    string                 `protobuf:"bytes,2,opt,name=test_filter,json=testFilter,proto3" json:"test_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildResult_Key_TestParameters) Reset() {
+	*x = BuildResult_Key_TestParameters{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildResult_Key_TestParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildResult_Key_TestParameters) ProtoMessage() {}
+
+func (x *BuildResult_Key_TestParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildResult_Key_TestParameters.ProtoReflect.Descriptor instead.
+func (*BuildResult_Key_TestParameters) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 0, 0}
+}
+
+func (x *BuildResult_Key_TestParameters) GetRunsPerTest() uint32 {
+	if x != nil {
+		return x.RunsPerTest
+	}
+	return 0
+}
+
+func (x *BuildResult_Key_TestParameters) GetTestFilter() string {
+	if x != nil {
+		return x.TestFilter
+	}
+	return ""
+}
+
+type BuildResult_Value_TargetCompletion struct {
+	state                  protoimpl.MessageState        `protogen:"open.v1"`
+	Label                  string                        `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationComponent string                        `protobuf:"bytes,2,opt,name=configuration_component,json=configurationComponent,proto3" json:"configuration_component,omitempty"`
+	RootDirectory          *filesystem.DirectoryContents `protobuf:"bytes,3,opt,name=root_directory,json=rootDirectory,proto3" json:"root_directory,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BuildResult_Value_TargetCompletion) Reset() {
+	*x = BuildResult_Value_TargetCompletion{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildResult_Value_TargetCompletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildResult_Value_TargetCompletion) ProtoMessage() {}
+
+func (x *BuildResult_Value_TargetCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildResult_Value_TargetCompletion.ProtoReflect.Descriptor instead.
+func (*BuildResult_Value_TargetCompletion) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 1, 0}
+}
+
+func (x *BuildResult_Value_TargetCompletion) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildResult_Value_TargetCompletion) GetConfigurationComponent() string {
+	if x != nil {
+		return x.ConfigurationComponent
+	}
+	return ""
+}
+
+func (x *BuildResult_Value_TargetCompletion) GetRootDirectory() *filesystem.DirectoryContents {
+	if x != nil {
+		return x.RootDirectory
+	}
+	return nil
+}
+
+type BuildResult_Value_TestResult struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Label                  string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationComponent string                 `protobuf:"bytes,2,opt,name=configuration_component,json=configurationComponent,proto3" json:"configuration_component,omitempty"`
+	RunNumber              uint32                 `protobuf:"varint,3,opt,name=run_number,json=runNumber,proto3" json:"run_number,omitempty"`
+	Result                 *TestResult_Value      `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BuildResult_Value_TestResult) Reset() {
+	*x = BuildResult_Value_TestResult{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildResult_Value_TestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildResult_Value_TestResult) ProtoMessage() {}
+
+func (x *BuildResult_Value_TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildResult_Value_TestResult.ProtoReflect.Descriptor instead.
+func (*BuildResult_Value_TestResult) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 1, 1}
+}
+
+func (x *BuildResult_Value_TestResult) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildResult_Value_TestResult) GetConfigurationComponent() string {
+	if x != nil {
+		return x.ConfigurationComponent
+	}
+	return ""
+}
+
+func (x *BuildResult_Value_TestResult) GetRunNumber() uint32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *BuildResult_Value_TestResult) GetResult() *TestResult_Value {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type BuildResult_Value_Executable struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	Label                  string                  `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationComponent string                  `protobuf:"bytes,2,opt,name=configuration_component,json=configurationComponent,proto3" json:"configuration_component,omitempty"`
+	Executable             *TargetExecutable_Value `protobuf:"bytes,3,opt,name=executable,proto3" json:"executable,omitempty"`
+	TargetPattern          string                  `protobuf:"bytes,4,opt,name=target_pattern,json=targetPattern,proto3" json:"target_pattern,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BuildResult_Value_Executable) Reset() {
+	*x = BuildResult_Value_Executable{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildResult_Value_Executable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildResult_Value_Executable) ProtoMessage() {}
+
+func (x *BuildResult_Value_Executable) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildResult_Value_Executable.ProtoReflect.Descriptor instead.
+func (*BuildResult_Value_Executable) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 1, 2}
+}
+
+func (x *BuildResult_Value_Executable) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildResult_Value_Executable) GetConfigurationComponent() string {
+	if x != nil {
+		return x.ConfigurationComponent
+	}
+	return ""
+}
+
+func (x *BuildResult_Value_Executable) GetExecutable() *TargetExecutable_Value {
+	if x != nil {
+		return x.Executable
+	}
+	return nil
+}
+
+func (x *BuildResult_Value_Executable) GetTargetPattern() string {
+	if x != nil {
+		return x.TargetPattern
+	}
+	return ""
 }
 
 type CanonicalRepoName_Key struct {
@@ -4772,7 +5596,7 @@ type CanonicalRepoName_Key struct {
 
 func (x *CanonicalRepoName_Key) Reset() {
 	*x = CanonicalRepoName_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[106]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4784,7 +5608,7 @@ func (x *CanonicalRepoName_Key) String() string {
 func (*CanonicalRepoName_Key) ProtoMessage() {}
 
 func (x *CanonicalRepoName_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[106]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4797,7 +5621,7 @@ func (x *CanonicalRepoName_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanonicalRepoName_Key.ProtoReflect.Descriptor instead.
 func (*CanonicalRepoName_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *CanonicalRepoName_Key) GetFromCanonicalRepo() string {
@@ -4823,7 +5647,7 @@ type CanonicalRepoName_Value struct {
 
 func (x *CanonicalRepoName_Value) Reset() {
 	*x = CanonicalRepoName_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[107]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4835,7 +5659,7 @@ func (x *CanonicalRepoName_Value) String() string {
 func (*CanonicalRepoName_Value) ProtoMessage() {}
 
 func (x *CanonicalRepoName_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[107]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4848,7 +5672,7 @@ func (x *CanonicalRepoName_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanonicalRepoName_Value.ProtoReflect.Descriptor instead.
 func (*CanonicalRepoName_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9, 1}
 }
 
 func (x *CanonicalRepoName_Value) GetToCanonicalRepo() string {
@@ -4867,7 +5691,7 @@ type CompatibleExecutionPlatforms_Key struct {
 
 func (x *CompatibleExecutionPlatforms_Key) Reset() {
 	*x = CompatibleExecutionPlatforms_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[108]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4879,7 +5703,7 @@ func (x *CompatibleExecutionPlatforms_Key) String() string {
 func (*CompatibleExecutionPlatforms_Key) ProtoMessage() {}
 
 func (x *CompatibleExecutionPlatforms_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[108]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4892,7 +5716,7 @@ func (x *CompatibleExecutionPlatforms_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompatibleExecutionPlatforms_Key.ProtoReflect.Descriptor instead.
 func (*CompatibleExecutionPlatforms_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *CompatibleExecutionPlatforms_Key) GetConstraints() []*Constraint {
@@ -4911,7 +5735,7 @@ type CompatibleExecutionPlatforms_Value struct {
 
 func (x *CompatibleExecutionPlatforms_Value) Reset() {
 	*x = CompatibleExecutionPlatforms_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[109]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4923,7 +5747,7 @@ func (x *CompatibleExecutionPlatforms_Value) String() string {
 func (*CompatibleExecutionPlatforms_Value) ProtoMessage() {}
 
 func (x *CompatibleExecutionPlatforms_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[109]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4936,7 +5760,7 @@ func (x *CompatibleExecutionPlatforms_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompatibleExecutionPlatforms_Value.ProtoReflect.Descriptor instead.
 func (*CompatibleExecutionPlatforms_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10, 1}
 }
 
 func (x *CompatibleExecutionPlatforms_Value) GetExecutionPlatforms() []*ExecutionPlatform {
@@ -4956,7 +5780,7 @@ type CompatibleToolchainsForType_Key struct {
 
 func (x *CompatibleToolchainsForType_Key) Reset() {
 	*x = CompatibleToolchainsForType_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[110]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4968,7 +5792,7 @@ func (x *CompatibleToolchainsForType_Key) String() string {
 func (*CompatibleToolchainsForType_Key) ProtoMessage() {}
 
 func (x *CompatibleToolchainsForType_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[110]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4981,7 +5805,7 @@ func (x *CompatibleToolchainsForType_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompatibleToolchainsForType_Key.ProtoReflect.Descriptor instead.
 func (*CompatibleToolchainsForType_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *CompatibleToolchainsForType_Key) GetToolchainType() string {
@@ -5007,7 +5831,7 @@ type CompatibleToolchainsForType_Value struct {
 
 func (x *CompatibleToolchainsForType_Value) Reset() {
 	*x = CompatibleToolchainsForType_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[111]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5019,7 +5843,7 @@ func (x *CompatibleToolchainsForType_Value) String() string {
 func (*CompatibleToolchainsForType_Value) ProtoMessage() {}
 
 func (x *CompatibleToolchainsForType_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[111]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5032,7 +5856,7 @@ func (x *CompatibleToolchainsForType_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompatibleToolchainsForType_Value.ProtoReflect.Descriptor instead.
 func (*CompatibleToolchainsForType_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11, 1}
 }
 
 func (x *CompatibleToolchainsForType_Value) GetToolchains() []*RegisteredToolchain {
@@ -5052,7 +5876,7 @@ type CompiledBzlFile_Key struct {
 
 func (x *CompiledBzlFile_Key) Reset() {
 	*x = CompiledBzlFile_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[112]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5064,7 +5888,7 @@ func (x *CompiledBzlFile_Key) String() string {
 func (*CompiledBzlFile_Key) ProtoMessage() {}
 
 func (x *CompiledBzlFile_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[112]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5077,7 +5901,7 @@ func (x *CompiledBzlFile_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFile_Key.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFile_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *CompiledBzlFile_Key) GetLabel() string {
@@ -5103,7 +5927,7 @@ type CompiledBzlFile_Value struct {
 
 func (x *CompiledBzlFile_Value) Reset() {
 	*x = CompiledBzlFile_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[113]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5115,7 +5939,7 @@ func (x *CompiledBzlFile_Value) String() string {
 func (*CompiledBzlFile_Value) ProtoMessage() {}
 
 func (x *CompiledBzlFile_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[113]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5128,7 +5952,7 @@ func (x *CompiledBzlFile_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFile_Value.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFile_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *CompiledBzlFile_Value) GetCompiledProgram() *starlark.CompiledProgram {
@@ -5148,7 +5972,7 @@ type CompiledBzlFileDecodedGlobals_Key struct {
 
 func (x *CompiledBzlFileDecodedGlobals_Key) Reset() {
 	*x = CompiledBzlFileDecodedGlobals_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[114]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5160,7 +5984,7 @@ func (x *CompiledBzlFileDecodedGlobals_Key) String() string {
 func (*CompiledBzlFileDecodedGlobals_Key) ProtoMessage() {}
 
 func (x *CompiledBzlFileDecodedGlobals_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[114]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5173,7 +5997,7 @@ func (x *CompiledBzlFileDecodedGlobals_Key) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompiledBzlFileDecodedGlobals_Key.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileDecodedGlobals_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *CompiledBzlFileDecodedGlobals_Key) GetLabel() string {
@@ -5200,7 +6024,7 @@ type CompiledBzlFileFunctionFactory_Key struct {
 
 func (x *CompiledBzlFileFunctionFactory_Key) Reset() {
 	*x = CompiledBzlFileFunctionFactory_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[115]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5212,7 +6036,7 @@ func (x *CompiledBzlFileFunctionFactory_Key) String() string {
 func (*CompiledBzlFileFunctionFactory_Key) ProtoMessage() {}
 
 func (x *CompiledBzlFileFunctionFactory_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[115]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5225,7 +6049,7 @@ func (x *CompiledBzlFileFunctionFactory_Key) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompiledBzlFileFunctionFactory_Key.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileFunctionFactory_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *CompiledBzlFileFunctionFactory_Key) GetLabel() string {
@@ -5251,7 +6075,7 @@ type CompiledBzlFileGlobal_Key struct {
 
 func (x *CompiledBzlFileGlobal_Key) Reset() {
 	*x = CompiledBzlFileGlobal_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[116]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5263,7 +6087,7 @@ func (x *CompiledBzlFileGlobal_Key) String() string {
 func (*CompiledBzlFileGlobal_Key) ProtoMessage() {}
 
 func (x *CompiledBzlFileGlobal_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[116]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5276,7 +6100,7 @@ func (x *CompiledBzlFileGlobal_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileGlobal_Key.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileGlobal_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *CompiledBzlFileGlobal_Key) GetIdentifier() string {
@@ -5295,7 +6119,7 @@ type CompiledBzlFileGlobal_Value struct {
 
 func (x *CompiledBzlFileGlobal_Value) Reset() {
 	*x = CompiledBzlFileGlobal_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[117]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5307,7 +6131,7 @@ func (x *CompiledBzlFileGlobal_Value) String() string {
 func (*CompiledBzlFileGlobal_Value) ProtoMessage() {}
 
 func (x *CompiledBzlFileGlobal_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[117]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5320,7 +6144,7 @@ func (x *CompiledBzlFileGlobal_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileGlobal_Value.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileGlobal_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15, 1}
 }
 
 func (x *CompiledBzlFileGlobal_Value) GetGlobal() *starlark.Value {
@@ -5340,7 +6164,7 @@ type BuildSettingOverride_Leaf struct {
 
 func (x *BuildSettingOverride_Leaf) Reset() {
 	*x = BuildSettingOverride_Leaf{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[118]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5352,7 +6176,7 @@ func (x *BuildSettingOverride_Leaf) String() string {
 func (*BuildSettingOverride_Leaf) ProtoMessage() {}
 
 func (x *BuildSettingOverride_Leaf) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[118]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5365,7 +6189,7 @@ func (x *BuildSettingOverride_Leaf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSettingOverride_Leaf.ProtoReflect.Descriptor instead.
 func (*BuildSettingOverride_Leaf) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *BuildSettingOverride_Leaf) GetLabel() string {
@@ -5392,7 +6216,7 @@ type BuildSettingOverride_Parent struct {
 
 func (x *BuildSettingOverride_Parent) Reset() {
 	*x = BuildSettingOverride_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[119]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5404,7 +6228,7 @@ func (x *BuildSettingOverride_Parent) String() string {
 func (*BuildSettingOverride_Parent) ProtoMessage() {}
 
 func (x *BuildSettingOverride_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[119]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5417,7 +6241,7 @@ func (x *BuildSettingOverride_Parent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSettingOverride_Parent.ProtoReflect.Descriptor instead.
 func (*BuildSettingOverride_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1}
 }
 
 func (x *BuildSettingOverride_Parent) GetReference() *core.DecodableReference {
@@ -5443,7 +6267,7 @@ type Args_Parent struct {
 
 func (x *Args_Parent) Reset() {
 	*x = Args_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[120]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5455,7 +6279,7 @@ func (x *Args_Parent) String() string {
 func (*Args_Parent) ProtoMessage() {}
 
 func (x *Args_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[120]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5468,7 +6292,7 @@ func (x *Args_Parent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args_Parent.ProtoReflect.Descriptor instead.
 func (*Args_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 0}
 }
 
 func (x *Args_Parent) GetReference() *core.DecodableReference {
@@ -5488,7 +6312,7 @@ type Args_Leaf struct {
 
 func (x *Args_Leaf) Reset() {
 	*x = Args_Leaf{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[121]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5500,7 +6324,7 @@ func (x *Args_Leaf) String() string {
 func (*Args_Leaf) ProtoMessage() {}
 
 func (x *Args_Leaf) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[121]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5513,7 +6337,7 @@ func (x *Args_Leaf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args_Leaf.ProtoReflect.Descriptor instead.
 func (*Args_Leaf) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1}
 }
 
 func (x *Args_Leaf) GetAdds() []*Args_Leaf_Add {
@@ -5543,7 +6367,7 @@ type Args_Leaf_Add struct {
 
 func (x *Args_Leaf_Add) Reset() {
 	*x = Args_Leaf_Add{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[122]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5555,7 +6379,7 @@ func (x *Args_Leaf_Add) String() string {
 func (*Args_Leaf_Add) ProtoMessage() {}
 
 func (x *Args_Leaf_Add) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[122]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5568,7 +6392,7 @@ func (x *Args_Leaf_Add) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args_Leaf_Add.ProtoReflect.Descriptor instead.
 func (*Args_Leaf_Add) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1, 0}
 }
 
 func (x *Args_Leaf_Add) GetLevel() isArgs_Leaf_Add_Level {
@@ -5623,7 +6447,7 @@ type Args_Leaf_UseParamFile struct {
 
 func (x *Args_Leaf_UseParamFile) Reset() {
 	*x = Args_Leaf_UseParamFile{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[123]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5635,7 +6459,7 @@ func (x *Args_Leaf_UseParamFile) String() string {
 func (*Args_Leaf_UseParamFile) ProtoMessage() {}
 
 func (x *Args_Leaf_UseParamFile) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[123]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5648,7 +6472,7 @@ func (x *Args_Leaf_UseParamFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args_Leaf_UseParamFile.ProtoReflect.Descriptor instead.
 func (*Args_Leaf_UseParamFile) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1, 1}
 }
 
 func (x *Args_Leaf_UseParamFile) GetFormat() Args_Leaf_UseParamFile_Format {
@@ -5681,7 +6505,7 @@ type Args_Leaf_Add_Parent struct {
 
 func (x *Args_Leaf_Add_Parent) Reset() {
 	*x = Args_Leaf_Add_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[124]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5693,7 +6517,7 @@ func (x *Args_Leaf_Add_Parent) String() string {
 func (*Args_Leaf_Add_Parent) ProtoMessage() {}
 
 func (x *Args_Leaf_Add_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[124]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5706,7 +6530,7 @@ func (x *Args_Leaf_Add_Parent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args_Leaf_Add_Parent.ProtoReflect.Descriptor instead.
 func (*Args_Leaf_Add_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1, 0, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1, 0, 0}
 }
 
 func (x *Args_Leaf_Add_Parent) GetReference() *core.DecodableReference {
@@ -5736,7 +6560,7 @@ type Args_Leaf_Add_Leaf struct {
 
 func (x *Args_Leaf_Add_Leaf) Reset() {
 	*x = Args_Leaf_Add_Leaf{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[125]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5748,7 +6572,7 @@ func (x *Args_Leaf_Add_Leaf) String() string {
 func (*Args_Leaf_Add_Leaf) ProtoMessage() {}
 
 func (x *Args_Leaf_Add_Leaf) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[125]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5761,7 +6585,7 @@ func (x *Args_Leaf_Add_Leaf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args_Leaf_Add_Leaf.ProtoReflect.Descriptor instead.
 func (*Args_Leaf_Add_Leaf) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1, 0, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1, 0, 1}
 }
 
 func (x *Args_Leaf_Add_Leaf) GetStartWith() *wrapperspb.StringValue {
@@ -5864,7 +6688,7 @@ type Args_Leaf_Add_Leaf_Separate struct {
 
 func (x *Args_Leaf_Add_Leaf_Separate) Reset() {
 	*x = Args_Leaf_Add_Leaf_Separate{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[126]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5876,7 +6700,7 @@ func (x *Args_Leaf_Add_Leaf_Separate) String() string {
 func (*Args_Leaf_Add_Leaf_Separate) ProtoMessage() {}
 
 func (x *Args_Leaf_Add_Leaf_Separate) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[126]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5889,7 +6713,7 @@ func (x *Args_Leaf_Add_Leaf_Separate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args_Leaf_Add_Leaf_Separate.ProtoReflect.Descriptor instead.
 func (*Args_Leaf_Add_Leaf_Separate) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1, 0, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1, 0, 1, 0}
 }
 
 func (x *Args_Leaf_Add_Leaf_Separate) GetBeforeEach() *wrapperspb.StringValue {
@@ -5916,7 +6740,7 @@ type Args_Leaf_Add_Leaf_Joined struct {
 
 func (x *Args_Leaf_Add_Leaf_Joined) Reset() {
 	*x = Args_Leaf_Add_Leaf_Joined{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[127]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5928,7 +6752,7 @@ func (x *Args_Leaf_Add_Leaf_Joined) String() string {
 func (*Args_Leaf_Add_Leaf_Joined) ProtoMessage() {}
 
 func (x *Args_Leaf_Add_Leaf_Joined) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[127]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5941,7 +6765,7 @@ func (x *Args_Leaf_Add_Leaf_Joined) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Args_Leaf_Add_Leaf_Joined.ProtoReflect.Descriptor instead.
 func (*Args_Leaf_Add_Leaf_Joined) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1, 0, 1, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1, 0, 1, 1}
 }
 
 func (x *Args_Leaf_Add_Leaf_Joined) GetJoinWith() string {
@@ -5967,7 +6791,7 @@ type FilesToRunProvider_Parent struct {
 
 func (x *FilesToRunProvider_Parent) Reset() {
 	*x = FilesToRunProvider_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[128]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5979,7 +6803,7 @@ func (x *FilesToRunProvider_Parent) String() string {
 func (*FilesToRunProvider_Parent) ProtoMessage() {}
 
 func (x *FilesToRunProvider_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[128]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5992,7 +6816,7 @@ func (x *FilesToRunProvider_Parent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilesToRunProvider_Parent.ProtoReflect.Descriptor instead.
 func (*FilesToRunProvider_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *FilesToRunProvider_Parent) GetReference() *core.DecodableReference {
@@ -6014,7 +6838,7 @@ type FilesToRunProvider_Leaf struct {
 
 func (x *FilesToRunProvider_Leaf) Reset() {
 	*x = FilesToRunProvider_Leaf{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[129]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6026,7 +6850,7 @@ func (x *FilesToRunProvider_Leaf) String() string {
 func (*FilesToRunProvider_Leaf) ProtoMessage() {}
 
 func (x *FilesToRunProvider_Leaf) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[129]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6039,7 +6863,7 @@ func (x *FilesToRunProvider_Leaf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilesToRunProvider_Leaf.ProtoReflect.Descriptor instead.
 func (*FilesToRunProvider_Leaf) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18, 1}
 }
 
 func (x *FilesToRunProvider_Leaf) GetExecutable() *starlark.File {
@@ -6081,7 +6905,7 @@ type TargetOutputDefinition_ExpandTemplate struct {
 
 func (x *TargetOutputDefinition_ExpandTemplate) Reset() {
 	*x = TargetOutputDefinition_ExpandTemplate{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[130]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6093,7 +6917,7 @@ func (x *TargetOutputDefinition_ExpandTemplate) String() string {
 func (*TargetOutputDefinition_ExpandTemplate) ProtoMessage() {}
 
 func (x *TargetOutputDefinition_ExpandTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[130]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6106,7 +6930,7 @@ func (x *TargetOutputDefinition_ExpandTemplate) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use TargetOutputDefinition_ExpandTemplate.ProtoReflect.Descriptor instead.
 func (*TargetOutputDefinition_ExpandTemplate) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *TargetOutputDefinition_ExpandTemplate) GetTemplate() *starlark.File {
@@ -6140,7 +6964,7 @@ type TargetOutputDefinition_Symlink struct {
 
 func (x *TargetOutputDefinition_Symlink) Reset() {
 	*x = TargetOutputDefinition_Symlink{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[131]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6152,7 +6976,7 @@ func (x *TargetOutputDefinition_Symlink) String() string {
 func (*TargetOutputDefinition_Symlink) ProtoMessage() {}
 
 func (x *TargetOutputDefinition_Symlink) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[131]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6165,7 +6989,7 @@ func (x *TargetOutputDefinition_Symlink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetOutputDefinition_Symlink.ProtoReflect.Descriptor instead.
 func (*TargetOutputDefinition_Symlink) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1}
 }
 
 func (x *TargetOutputDefinition_Symlink) GetTarget() *starlark.File {
@@ -6192,7 +7016,7 @@ type TargetOutputDefinition_ExpandTemplate_Substitution struct {
 
 func (x *TargetOutputDefinition_ExpandTemplate_Substitution) Reset() {
 	*x = TargetOutputDefinition_ExpandTemplate_Substitution{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[132]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6204,7 +7028,7 @@ func (x *TargetOutputDefinition_ExpandTemplate_Substitution) String() string {
 func (*TargetOutputDefinition_ExpandTemplate_Substitution) ProtoMessage() {}
 
 func (x *TargetOutputDefinition_ExpandTemplate_Substitution) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[132]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6217,7 +7041,7 @@ func (x *TargetOutputDefinition_ExpandTemplate_Substitution) ProtoReflect() prot
 
 // Deprecated: Use TargetOutputDefinition_ExpandTemplate_Substitution.ProtoReflect.Descriptor instead.
 func (*TargetOutputDefinition_ExpandTemplate_Substitution) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19, 0, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 0, 0}
 }
 
 func (x *TargetOutputDefinition_ExpandTemplate_Substitution) GetNeedle() []byte {
@@ -6234,6 +7058,170 @@ func (x *TargetOutputDefinition_ExpandTemplate_Substitution) GetReplacement() []
 	return nil
 }
 
+type ConfiguredQueryResult_Key struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Expression         string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	StarlarkExpression string                 `protobuf:"bytes,2,opt,name=starlark_expression,json=starlarkExpression,proto3" json:"starlark_expression,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ConfiguredQueryResult_Key) Reset() {
+	*x = ConfiguredQueryResult_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfiguredQueryResult_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfiguredQueryResult_Key) ProtoMessage() {}
+
+func (x *ConfiguredQueryResult_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfiguredQueryResult_Key.ProtoReflect.Descriptor instead.
+func (*ConfiguredQueryResult_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *ConfiguredQueryResult_Key) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ConfiguredQueryResult_Key) GetStarlarkExpression() string {
+	if x != nil {
+		return x.StarlarkExpression
+	}
+	return ""
+}
+
+type ConfiguredQueryResult_Value struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Targets       []*ConfiguredQueryResult_Value_Target `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfiguredQueryResult_Value) Reset() {
+	*x = ConfiguredQueryResult_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfiguredQueryResult_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfiguredQueryResult_Value) ProtoMessage() {}
+
+func (x *ConfiguredQueryResult_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfiguredQueryResult_Value.ProtoReflect.Descriptor instead.
+func (*ConfiguredQueryResult_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *ConfiguredQueryResult_Value) GetTargets() []*ConfiguredQueryResult_Value_Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type ConfiguredQueryResult_Value_Target struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Label                  string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationComponent string                 `protobuf:"bytes,2,opt,name=configuration_component,json=configurationComponent,proto3" json:"configuration_component,omitempty"`
+	Kind                   string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	StarlarkOutput         string                 `protobuf:"bytes,4,opt,name=starlark_output,json=starlarkOutput,proto3" json:"starlark_output,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ConfiguredQueryResult_Value_Target) Reset() {
+	*x = ConfiguredQueryResult_Value_Target{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfiguredQueryResult_Value_Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfiguredQueryResult_Value_Target) ProtoMessage() {}
+
+func (x *ConfiguredQueryResult_Value_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfiguredQueryResult_Value_Target.ProtoReflect.Descriptor instead.
+func (*ConfiguredQueryResult_Value_Target) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 1, 0}
+}
+
+func (x *ConfiguredQueryResult_Value_Target) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ConfiguredQueryResult_Value_Target) GetConfigurationComponent() string {
+	if x != nil {
+		return x.ConfigurationComponent
+	}
+	return ""
+}
+
+func (x *ConfiguredQueryResult_Value_Target) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConfiguredQueryResult_Value_Target) GetStarlarkOutput() string {
+	if x != nil {
+		return x.StarlarkOutput
+	}
+	return ""
+}
+
 type ConfiguredTarget_Key struct {
 	state                  protoimpl.MessageState   `protogen:"open.v1"`
 	Label                  string                   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
@@ -6244,7 +7232,7 @@ type ConfiguredTarget_Key struct {
 
 func (x *ConfiguredTarget_Key) Reset() {
 	*x = ConfiguredTarget_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[133]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6256,7 +7244,7 @@ func (x *ConfiguredTarget_Key) String() string {
 func (*ConfiguredTarget_Key) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[133]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6269,7 +7257,7 @@ func (x *ConfiguredTarget_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfiguredTarget_Key.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *ConfiguredTarget_Key) GetLabel() string {
@@ -6297,7 +7285,7 @@ type ConfiguredTarget_Value struct {
 
 func (x *ConfiguredTarget_Value) Reset() {
 	*x = ConfiguredTarget_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[134]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6309,7 +7297,7 @@ func (x *ConfiguredTarget_Value) String() string {
 func (*ConfiguredTarget_Value) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[134]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6322,7 +7310,7 @@ func (x *ConfiguredTarget_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfiguredTarget_Value.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1}
 }
 
 func (x *ConfiguredTarget_Value) GetProviderInstances() []*starlark.Struct {
@@ -6359,7 +7347,7 @@ type ConfiguredTarget_Value_Output struct {
 
 func (x *ConfiguredTarget_Value_Output) Reset() {
 	*x = ConfiguredTarget_Value_Output{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[135]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6371,7 +7359,7 @@ func (x *ConfiguredTarget_Value_Output) String() string {
 func (*ConfiguredTarget_Value_Output) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Value_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[135]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6384,7 +7372,7 @@ func (x *ConfiguredTarget_Value_Output) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfiguredTarget_Value_Output.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Value_Output) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1, 0}
 }
 
 func (x *ConfiguredTarget_Value_Output) GetLevel() isConfiguredTarget_Value_Output_Level {
@@ -6441,7 +7429,7 @@ type ConfiguredTarget_Value_Action struct {
 
 func (x *ConfiguredTarget_Value_Action) Reset() {
 	*x = ConfiguredTarget_Value_Action{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[136]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6453,7 +7441,7 @@ func (x *ConfiguredTarget_Value_Action) String() string {
 func (*ConfiguredTarget_Value_Action) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Value_Action) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[136]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6466,7 +7454,7 @@ func (x *ConfiguredTarget_Value_Action) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfiguredTarget_Value_Action.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Value_Action) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1, 1}
 }
 
 func (x *ConfiguredTarget_Value_Action) GetLevel() isConfiguredTarget_Value_Action_Level {
@@ -6520,7 +7508,7 @@ type ConfiguredTarget_Value_Output_Parent struct {
 
 func (x *ConfiguredTarget_Value_Output_Parent) Reset() {
 	*x = ConfiguredTarget_Value_Output_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[137]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6532,7 +7520,7 @@ func (x *ConfiguredTarget_Value_Output_Parent) String() string {
 func (*ConfiguredTarget_Value_Output_Parent) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Value_Output_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[137]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6545,7 +7533,7 @@ func (x *ConfiguredTarget_Value_Output_Parent) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ConfiguredTarget_Value_Output_Parent.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Value_Output_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1, 0, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1, 0, 0}
 }
 
 func (x *ConfiguredTarget_Value_Output_Parent) GetReference() *core.DecodableReference {
@@ -6572,7 +7560,7 @@ type ConfiguredTarget_Value_Output_Leaf struct {
 
 func (x *ConfiguredTarget_Value_Output_Leaf) Reset() {
 	*x = ConfiguredTarget_Value_Output_Leaf{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[138]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6584,7 +7572,7 @@ func (x *ConfiguredTarget_Value_Output_Leaf) String() string {
 func (*ConfiguredTarget_Value_Output_Leaf) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Value_Output_Leaf) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[138]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6597,7 +7585,7 @@ func (x *ConfiguredTarget_Value_Output_Leaf) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ConfiguredTarget_Value_Output_Leaf.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Value_Output_Leaf) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1, 0, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1, 0, 1}
 }
 
 func (x *ConfiguredTarget_Value_Output_Leaf) GetPackageRelativePath() string {
@@ -6624,7 +7612,7 @@ type ConfiguredTarget_Value_Action_Parent struct {
 
 func (x *ConfiguredTarget_Value_Action_Parent) Reset() {
 	*x = ConfiguredTarget_Value_Action_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[139]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6636,7 +7624,7 @@ func (x *ConfiguredTarget_Value_Action_Parent) String() string {
 func (*ConfiguredTarget_Value_Action_Parent) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Value_Action_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[139]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6649,7 +7637,7 @@ func (x *ConfiguredTarget_Value_Action_Parent) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ConfiguredTarget_Value_Action_Parent.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Value_Action_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1, 1, 0}
 }
 
 func (x *ConfiguredTarget_Value_Action_Parent) GetReference() *core.DecodableReference {
@@ -6676,7 +7664,7 @@ type ConfiguredTarget_Value_Action_Leaf struct {
 
 func (x *ConfiguredTarget_Value_Action_Leaf) Reset() {
 	*x = ConfiguredTarget_Value_Action_Leaf{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[140]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6688,7 +7676,7 @@ func (x *ConfiguredTarget_Value_Action_Leaf) String() string {
 func (*ConfiguredTarget_Value_Action_Leaf) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Value_Action_Leaf) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[140]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6701,7 +7689,7 @@ func (x *ConfiguredTarget_Value_Action_Leaf) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ConfiguredTarget_Value_Action_Leaf.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Value_Action_Leaf) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1, 1, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1, 1, 1}
 }
 
 func (x *ConfiguredTarget_Value_Action_Leaf) GetId() []byte {
@@ -6729,7 +7717,7 @@ type TargetOutput_Key struct {
 
 func (x *TargetOutput_Key) Reset() {
 	*x = TargetOutput_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[141]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6741,7 +7729,7 @@ func (x *TargetOutput_Key) String() string {
 func (*TargetOutput_Key) ProtoMessage() {}
 
 func (x *TargetOutput_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[141]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6754,7 +7742,7 @@ func (x *TargetOutput_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetOutput_Key.ProtoReflect.Descriptor instead.
 func (*TargetOutput_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *TargetOutput_Key) GetLabel() string {
@@ -6787,7 +7775,7 @@ type TargetOutput_Value struct {
 
 func (x *TargetOutput_Value) Reset() {
 	*x = TargetOutput_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[142]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6799,7 +7787,7 @@ func (x *TargetOutput_Value) String() string {
 func (*TargetOutput_Value) ProtoMessage() {}
 
 func (x *TargetOutput_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[142]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6812,7 +7800,7 @@ func (x *TargetOutput_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetOutput_Value.ProtoReflect.Descriptor instead.
 func (*TargetOutput_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23, 1}
 }
 
 func (x *TargetOutput_Value) GetDefinition() *TargetOutputDefinition {
@@ -6830,7 +7818,7 @@ type DirectoryAccessParameters_Key struct {
 
 func (x *DirectoryAccessParameters_Key) Reset() {
 	*x = DirectoryAccessParameters_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[143]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6842,7 +7830,7 @@ func (x *DirectoryAccessParameters_Key) String() string {
 func (*DirectoryAccessParameters_Key) ProtoMessage() {}
 
 func (x *DirectoryAccessParameters_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[143]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6855,7 +7843,7 @@ func (x *DirectoryAccessParameters_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryAccessParameters_Key.ProtoReflect.Descriptor instead.
 func (*DirectoryAccessParameters_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24, 0}
 }
 
 type DirectoryAccessParameters_Value struct {
@@ -6867,7 +7855,7 @@ type DirectoryAccessParameters_Value struct {
 
 func (x *DirectoryAccessParameters_Value) Reset() {
 	*x = DirectoryAccessParameters_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[144]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6879,7 +7867,7 @@ func (x *DirectoryAccessParameters_Value) String() string {
 func (*DirectoryAccessParameters_Value) ProtoMessage() {}
 
 func (x *DirectoryAccessParameters_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[144]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6892,7 +7880,7 @@ func (x *DirectoryAccessParameters_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryAccessParameters_Value.ProtoReflect.Descriptor instead.
 func (*DirectoryAccessParameters_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24, 1}
 }
 
 func (x *DirectoryAccessParameters_Value) GetDirectoryAccessParameters() *filesystem.DirectoryAccessParameters {
@@ -6910,7 +7898,7 @@ type DirectoryCreationParameters_Key struct {
 
 func (x *DirectoryCreationParameters_Key) Reset() {
 	*x = DirectoryCreationParameters_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[145]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6922,7 +7910,7 @@ func (x *DirectoryCreationParameters_Key) String() string {
 func (*DirectoryCreationParameters_Key) ProtoMessage() {}
 
 func (x *DirectoryCreationParameters_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[145]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6935,7 +7923,7 @@ func (x *DirectoryCreationParameters_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryCreationParameters_Key.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParameters_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type DirectoryCreationParameters_Value struct {
@@ -6947,7 +7935,7 @@ type DirectoryCreationParameters_Value struct {
 
 func (x *DirectoryCreationParameters_Value) Reset() {
 	*x = DirectoryCreationParameters_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[146]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6959,7 +7947,7 @@ func (x *DirectoryCreationParameters_Value) String() string {
 func (*DirectoryCreationParameters_Value) ProtoMessage() {}
 
 func (x *DirectoryCreationParameters_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[146]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6972,7 +7960,7 @@ func (x *DirectoryCreationParameters_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DirectoryCreationParameters_Value.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParameters_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25, 1}
 }
 
 func (x *DirectoryCreationParameters_Value) GetDirectoryCreationParameters() *filesystem.DirectoryCreationParameters {
@@ -6990,7 +7978,7 @@ type DirectoryCreationParametersObject_Key struct {
 
 func (x *DirectoryCreationParametersObject_Key) Reset() {
 	*x = DirectoryCreationParametersObject_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[147]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7002,7 +7990,7 @@ func (x *DirectoryCreationParametersObject_Key) String() string {
 func (*DirectoryCreationParametersObject_Key) ProtoMessage() {}
 
 func (x *DirectoryCreationParametersObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[147]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7015,7 +8003,7 @@ func (x *DirectoryCreationParametersObject_Key) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use DirectoryCreationParametersObject_Key.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParametersObject_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26, 0}
 }
 
 type DirectoryReaders_Key struct {
@@ -7026,7 +8014,7 @@ type DirectoryReaders_Key struct {
 
 func (x *DirectoryReaders_Key) Reset() {
 	*x = DirectoryReaders_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[148]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7038,7 +8026,7 @@ func (x *DirectoryReaders_Key) String() string {
 func (*DirectoryReaders_Key) ProtoMessage() {}
 
 func (x *DirectoryReaders_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[148]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7051,7 +8039,7 @@ func (x *DirectoryReaders_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryReaders_Key.ProtoReflect.Descriptor instead.
 func (*DirectoryReaders_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type EmptyDefaultInfo_Key struct {
@@ -7062,7 +8050,7 @@ type EmptyDefaultInfo_Key struct {
 
 func (x *EmptyDefaultInfo_Key) Reset() {
 	*x = EmptyDefaultInfo_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[149]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7074,7 +8062,7 @@ func (x *EmptyDefaultInfo_Key) String() string {
 func (*EmptyDefaultInfo_Key) ProtoMessage() {}
 
 func (x *EmptyDefaultInfo_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[149]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7087,7 +8075,7 @@ func (x *EmptyDefaultInfo_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyDefaultInfo_Key.ProtoReflect.Descriptor instead.
 func (*EmptyDefaultInfo_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type EmptyDefaultInfo_Value struct {
@@ -7099,7 +8087,7 @@ type EmptyDefaultInfo_Value struct {
 
 func (x *EmptyDefaultInfo_Value) Reset() {
 	*x = EmptyDefaultInfo_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[150]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7111,7 +8099,7 @@ func (x *EmptyDefaultInfo_Value) String() string {
 func (*EmptyDefaultInfo_Value) ProtoMessage() {}
 
 func (x *EmptyDefaultInfo_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[150]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7124,7 +8112,7 @@ func (x *EmptyDefaultInfo_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyDefaultInfo_Value.ProtoReflect.Descriptor instead.
 func (*EmptyDefaultInfo_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28, 1}
 }
 
 func (x *EmptyDefaultInfo_Value) GetDefaultInfo() *starlark.Struct {
@@ -7144,7 +8132,7 @@ type ExecTransition_Key struct {
 
 func (x *ExecTransition_Key) Reset() {
 	*x = ExecTransition_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[151]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7156,7 +8144,7 @@ func (x *ExecTransition_Key) String() string {
 func (*ExecTransition_Key) ProtoMessage() {}
 
 func (x *ExecTransition_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[151]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7169,7 +8157,7 @@ func (x *ExecTransition_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecTransition_Key.ProtoReflect.Descriptor instead.
 func (*ExecTransition_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *ExecTransition_Key) GetPlatformLabel() string {
@@ -7195,7 +8183,7 @@ type ExecTransition_Value struct {
 
 func (x *ExecTransition_Value) Reset() {
 	*x = ExecTransition_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[152]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7207,7 +8195,7 @@ func (x *ExecTransition_Value) String() string {
 func (*ExecTransition_Value) ProtoMessage() {}
 
 func (x *ExecTransition_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[152]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7220,7 +8208,7 @@ func (x *ExecTransition_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecTransition_Value.ProtoReflect.Descriptor instead.
 func (*ExecTransition_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29, 1}
 }
 
 func (x *ExecTransition_Value) GetOutputConfigurationReference() *core.DecodableReference {
@@ -7238,7 +8226,7 @@ type FileAccessParameters_Key struct {
 
 func (x *FileAccessParameters_Key) Reset() {
 	*x = FileAccessParameters_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[153]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7250,7 +8238,7 @@ func (x *FileAccessParameters_Key) String() string {
 func (*FileAccessParameters_Key) ProtoMessage() {}
 
 func (x *FileAccessParameters_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[153]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7263,7 +8251,7 @@ func (x *FileAccessParameters_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileAccessParameters_Key.ProtoReflect.Descriptor instead.
 func (*FileAccessParameters_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type FileAccessParameters_Value struct {
@@ -7275,7 +8263,7 @@ type FileAccessParameters_Value struct {
 
 func (x *FileAccessParameters_Value) Reset() {
 	*x = FileAccessParameters_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[154]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7287,7 +8275,7 @@ func (x *FileAccessParameters_Value) String() string {
 func (*FileAccessParameters_Value) ProtoMessage() {}
 
 func (x *FileAccessParameters_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[154]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7300,7 +8288,7 @@ func (x *FileAccessParameters_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileAccessParameters_Value.ProtoReflect.Descriptor instead.
 func (*FileAccessParameters_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 1}
 }
 
 func (x *FileAccessParameters_Value) GetFileAccessParameters() *filesystem.FileAccessParameters {
@@ -7318,7 +8306,7 @@ type FileCreationParameters_Key struct {
 
 func (x *FileCreationParameters_Key) Reset() {
 	*x = FileCreationParameters_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[155]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7330,7 +8318,7 @@ func (x *FileCreationParameters_Key) String() string {
 func (*FileCreationParameters_Key) ProtoMessage() {}
 
 func (x *FileCreationParameters_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[155]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7343,7 +8331,7 @@ func (x *FileCreationParameters_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParameters_Key.ProtoReflect.Descriptor instead.
 func (*FileCreationParameters_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31, 0}
 }
 
 type FileCreationParameters_Value struct {
@@ -7355,7 +8343,7 @@ type FileCreationParameters_Value struct {
 
 func (x *FileCreationParameters_Value) Reset() {
 	*x = FileCreationParameters_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[156]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7367,7 +8355,7 @@ func (x *FileCreationParameters_Value) String() string {
 func (*FileCreationParameters_Value) ProtoMessage() {}
 
 func (x *FileCreationParameters_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[156]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7380,7 +8368,7 @@ func (x *FileCreationParameters_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParameters_Value.ProtoReflect.Descriptor instead.
 func (*FileCreationParameters_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31, 1}
 }
 
 func (x *FileCreationParameters_Value) GetFileCreationParameters() *filesystem.FileCreationParameters {
@@ -7398,7 +8386,7 @@ type FileCreationParametersObject_Key struct {
 
 func (x *FileCreationParametersObject_Key) Reset() {
 	*x = FileCreationParametersObject_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[157]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7410,7 +8398,7 @@ func (x *FileCreationParametersObject_Key) String() string {
 func (*FileCreationParametersObject_Key) ProtoMessage() {}
 
 func (x *FileCreationParametersObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[157]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7423,7 +8411,7 @@ func (x *FileCreationParametersObject_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParametersObject_Key.ProtoReflect.Descriptor instead.
 func (*FileCreationParametersObject_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type FileProperties_Key struct {
@@ -7436,7 +8424,7 @@ type FileProperties_Key struct {
 
 func (x *FileProperties_Key) Reset() {
 	*x = FileProperties_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[158]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7448,7 +8436,7 @@ func (x *FileProperties_Key) String() string {
 func (*FileProperties_Key) ProtoMessage() {}
 
 func (x *FileProperties_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[158]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7461,7 +8449,7 @@ func (x *FileProperties_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileProperties_Key.ProtoReflect.Descriptor instead.
 func (*FileProperties_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33, 0}
 }
 
 func (x *FileProperties_Key) GetCanonicalRepo() string {
@@ -7487,7 +8475,7 @@ type FileProperties_Value struct {
 
 func (x *FileProperties_Value) Reset() {
 	*x = FileProperties_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[159]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7499,7 +8487,7 @@ func (x *FileProperties_Value) String() string {
 func (*FileProperties_Value) ProtoMessage() {}
 
 func (x *FileProperties_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[159]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7512,7 +8500,7 @@ func (x *FileProperties_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileProperties_Value.ProtoReflect.Descriptor instead.
 func (*FileProperties_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33, 1}
 }
 
 func (x *FileProperties_Value) GetExists() *filesystem.FileProperties {
@@ -7530,7 +8518,7 @@ type FileReader_Key struct {
 
 func (x *FileReader_Key) Reset() {
 	*x = FileReader_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[160]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7542,7 +8530,7 @@ func (x *FileReader_Key) String() string {
 func (*FileReader_Key) ProtoMessage() {}
 
 func (x *FileReader_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[160]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7555,7 +8543,7 @@ func (x *FileReader_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileReader_Key.ProtoReflect.Descriptor instead.
 func (*FileReader_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type FileRoot_Key struct {
@@ -7568,7 +8556,7 @@ type FileRoot_Key struct {
 
 func (x *FileRoot_Key) Reset() {
 	*x = FileRoot_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[161]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7580,7 +8568,7 @@ func (x *FileRoot_Key) String() string {
 func (*FileRoot_Key) ProtoMessage() {}
 
 func (x *FileRoot_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[161]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7593,7 +8581,7 @@ func (x *FileRoot_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileRoot_Key.ProtoReflect.Descriptor instead.
 func (*FileRoot_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35, 0}
 }
 
 func (x *FileRoot_Key) GetFile() *starlark.File {
@@ -7619,7 +8607,7 @@ type FileRoot_Value struct {
 
 func (x *FileRoot_Value) Reset() {
 	*x = FileRoot_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[162]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7631,7 +8619,7 @@ func (x *FileRoot_Value) String() string {
 func (*FileRoot_Value) ProtoMessage() {}
 
 func (x *FileRoot_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[162]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7644,7 +8632,7 @@ func (x *FileRoot_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileRoot_Value.ProtoReflect.Descriptor instead.
 func (*FileRoot_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35, 1}
 }
 
 func (x *FileRoot_Value) GetRootDirectory() *filesystem.DirectoryContents {
@@ -7663,7 +8651,7 @@ type FilesInPackage_Key struct {
 
 func (x *FilesInPackage_Key) Reset() {
 	*x = FilesInPackage_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[163]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7675,7 +8663,7 @@ func (x *FilesInPackage_Key) String() string {
 func (*FilesInPackage_Key) ProtoMessage() {}
 
 func (x *FilesInPackage_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[163]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7688,7 +8676,7 @@ func (x *FilesInPackage_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilesInPackage_Key.ProtoReflect.Descriptor instead.
 func (*FilesInPackage_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *FilesInPackage_Key) GetPackage() string {
@@ -7707,7 +8695,7 @@ type FilesInPackage_Value struct {
 
 func (x *FilesInPackage_Value) Reset() {
 	*x = FilesInPackage_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[164]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7719,7 +8707,7 @@ func (x *FilesInPackage_Value) String() string {
 func (*FilesInPackage_Value) ProtoMessage() {}
 
 func (x *FilesInPackage_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[164]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7732,7 +8720,7 @@ func (x *FilesInPackage_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilesInPackage_Value.ProtoReflect.Descriptor instead.
 func (*FilesInPackage_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36, 1}
 }
 
 func (x *FilesInPackage_Value) GetDirectory() *filesystem.DirectoryContents {
@@ -7752,7 +8740,7 @@ type FilesRoot_Key struct {
 
 func (x *FilesRoot_Key) Reset() {
 	*x = FilesRoot_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[165]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7764,7 +8752,102 @@ func (x *FilesRoot_Key) String() string {
 func (*FilesRoot_Key) ProtoMessage() {}
 
 func (x *FilesRoot_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[165]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesRoot_Key.ProtoReflect.Descriptor instead.
+func (*FilesRoot_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *FilesRoot_Key) GetListReference() *core.DecodableReference {
+	if x != nil {
+		return x.ListReference
+	}
+	return nil
+}
+
+func (x *FilesRoot_Key) GetDirectoryLayout() DirectoryLayout {
+	if x != nil {
+		return x.DirectoryLayout
+	}
+	return DirectoryLayout_UNKNOWN
+}
+
+type FilesRoot_Value struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	RootDirectory *filesystem.DirectoryContents `protobuf:"bytes,1,opt,name=root_directory,json=rootDirectory,proto3" json:"root_directory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilesRoot_Value) Reset() {
+	*x = FilesRoot_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilesRoot_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesRoot_Value) ProtoMessage() {}
+
+func (x *FilesRoot_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesRoot_Value.ProtoReflect.Descriptor instead.
+func (*FilesRoot_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37, 1}
+}
+
+func (x *FilesRoot_Value) GetRootDirectory() *filesystem.DirectoryContents {
+	if x != nil {
+		return x.RootDirectory
+	}
+	return nil
+}
+
+type GitRepositoryContents_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *fetch.GitTarget       `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitRepositoryContents_Key) Reset() {
+	*x = GitRepositoryContents_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepositoryContents_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepositoryContents_Key) ProtoMessage() {}
+
+func (x *GitRepositoryContents_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7775,47 +8858,41 @@ func (x *FilesRoot_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilesRoot_Key.ProtoReflect.Descriptor instead.
-func (*FilesRoot_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35, 0}
+// Deprecated: Use GitRepositoryContents_Key.ProtoReflect.Descriptor instead.
+func (*GitRepositoryContents_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 0}
 }
 
-func (x *FilesRoot_Key) GetListReference() *core.DecodableReference {
+func (x *GitRepositoryContents_Key) GetTarget() *fetch.GitTarget {
 	if x != nil {
-		return x.ListReference
+		return x.Target
 	}
 	return nil
 }
 
-func (x *FilesRoot_Key) GetDirectoryLayout() DirectoryLayout {
-	if x != nil {
-		return x.DirectoryLayout
-	}
-	return DirectoryLayout_UNKNOWN
-}
-
-type FilesRoot_Value struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	RootDirectory *filesystem.DirectoryContents `protobuf:"bytes,1,opt,name=root_directory,json=rootDirectory,proto3" json:"root_directory,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GitRepositoryContents_Value struct {
+	state                  protoimpl.MessageState         `protogen:"open.v1"`
+	RootDirectoryReference *filesystem.DirectoryReference `protobuf:"bytes,1,opt,name=root_directory_reference,json=rootDirectoryReference,proto3" json:"root_directory_reference,omitempty"`
+	Commit                 string                         `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *FilesRoot_Value) Reset() {
-	*x = FilesRoot_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[166]
+func (x *GitRepositoryContents_Value) Reset() {
+	*x = GitRepositoryContents_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilesRoot_Value) String() string {
+func (x *GitRepositoryContents_Value) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilesRoot_Value) ProtoMessage() {}
+func (*GitRepositoryContents_Value) ProtoMessage() {}
 
-func (x *FilesRoot_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[166]
+func (x *GitRepositoryContents_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7826,18 +8903,25 @@ func (x *FilesRoot_Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilesRoot_Value.ProtoReflect.Descriptor instead.
-func (*FilesRoot_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35, 1}
+// Deprecated: Use GitRepositoryContents_Value.ProtoReflect.Descriptor instead.
+func (*GitRepositoryContents_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 1}
 }
 
-func (x *FilesRoot_Value) GetRootDirectory() *filesystem.DirectoryContents {
+func (x *GitRepositoryContents_Value) GetRootDirectoryReference() *filesystem.DirectoryReference {
 	if x != nil {
-		return x.RootDirectory
+		return x.RootDirectoryReference
 	}
 	return nil
 }
 
+func (x *GitRepositoryContents_Value) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
 type Glob_Key struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Package            string                 `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
@@ -7849,7 +8933,7 @@ type Glob_Key struct {
 
 func (x *Glob_Key) Reset() {
 	*x = Glob_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[167]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7861,7 +8945,7 @@ func (x *Glob_Key) String() string {
 func (*Glob_Key) ProtoMessage() {}
 
 func (x *Glob_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[167]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7874,7 +8958,7 @@ func (x *Glob_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Glob_Key.ProtoReflect.Descriptor instead.
 func (*Glob_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39, 0}
 }
 
 func (x *Glob_Key) GetPackage() string {
@@ -7907,7 +8991,7 @@ type Glob_Value struct {
 
 func (x *Glob_Value) Reset() {
 	*x = Glob_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[168]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7919,7 +9003,7 @@ func (x *Glob_Value) String() string {
 func (*Glob_Value) ProtoMessage() {}
 
 func (x *Glob_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[168]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7932,7 +9016,7 @@ func (x *Glob_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Glob_Value.ProtoReflect.Descriptor instead.
 func (*Glob_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39, 1}
 }
 
 func (x *Glob_Value) GetMatchedPaths() []string {
@@ -7952,7 +9036,7 @@ type HttpArchiveContents_Key struct {
 
 func (x *HttpArchiveContents_Key) Reset() {
 	*x = HttpArchiveContents_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[169]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7964,7 +9048,7 @@ func (x *HttpArchiveContents_Key) String() string {
 func (*HttpArchiveContents_Key) ProtoMessage() {}
 
 func (x *HttpArchiveContents_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[169]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7977,7 +9061,7 @@ func (x *HttpArchiveContents_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpArchiveContents_Key.ProtoReflect.Descriptor instead.
 func (*HttpArchiveContents_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41, 0}
 }
 
 func (x *HttpArchiveContents_Key) GetFetchOptions() *HttpFetchOptions {
@@ -8003,7 +9087,7 @@ type HttpArchiveContents_Value struct {
 
 func (x *HttpArchiveContents_Value) Reset() {
 	*x = HttpArchiveContents_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[170]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8015,7 +9099,7 @@ func (x *HttpArchiveContents_Value) String() string {
 func (*HttpArchiveContents_Value) ProtoMessage() {}
 
 func (x *HttpArchiveContents_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[170]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8028,7 +9112,7 @@ func (x *HttpArchiveContents_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpArchiveContents_Value.ProtoReflect.Descriptor instead.
 func (*HttpArchiveContents_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41, 1}
 }
 
 func (x *HttpArchiveContents_Value) GetExists() *HttpArchiveContents_Value_Exists {
@@ -8048,7 +9132,7 @@ type HttpArchiveContents_Value_Exists struct {
 
 func (x *HttpArchiveContents_Value_Exists) Reset() {
 	*x = HttpArchiveContents_Value_Exists{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[171]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8060,7 +9144,7 @@ func (x *HttpArchiveContents_Value_Exists) String() string {
 func (*HttpArchiveContents_Value_Exists) ProtoMessage() {}
 
 func (x *HttpArchiveContents_Value_Exists) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[171]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8073,7 +9157,7 @@ func (x *HttpArchiveContents_Value_Exists) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpArchiveContents_Value_Exists.ProtoReflect.Descriptor instead.
 func (*HttpArchiveContents_Value_Exists) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41, 1, 0}
 }
 
 func (x *HttpArchiveContents_Value_Exists) GetContents() *filesystem.DirectoryReference {
@@ -8099,7 +9183,7 @@ type HttpFileContents_Key struct {
 
 func (x *HttpFileContents_Key) Reset() {
 	*x = HttpFileContents_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[172]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8111,7 +9195,7 @@ func (x *HttpFileContents_Key) String() string {
 func (*HttpFileContents_Key) ProtoMessage() {}
 
 func (x *HttpFileContents_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[172]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8124,7 +9208,7 @@ func (x *HttpFileContents_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFileContents_Key.ProtoReflect.Descriptor instead.
 func (*HttpFileContents_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42, 0}
 }
 
 func (x *HttpFileContents_Key) GetFetchOptions() *HttpFetchOptions {
@@ -8143,7 +9227,7 @@ type HttpFileContents_Value struct {
 
 func (x *HttpFileContents_Value) Reset() {
 	*x = HttpFileContents_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[173]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8155,7 +9239,7 @@ func (x *HttpFileContents_Value) String() string {
 func (*HttpFileContents_Value) ProtoMessage() {}
 
 func (x *HttpFileContents_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[173]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8168,7 +9252,7 @@ func (x *HttpFileContents_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFileContents_Value.ProtoReflect.Descriptor instead.
 func (*HttpFileContents_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42, 1}
 }
 
 func (x *HttpFileContents_Value) GetExists() *fetch.Result_Success {
@@ -8187,7 +9271,7 @@ type ModuleDotBazelContents_Key struct {
 
 func (x *ModuleDotBazelContents_Key) Reset() {
 	*x = ModuleDotBazelContents_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[174]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8199,7 +9283,7 @@ func (x *ModuleDotBazelContents_Key) String() string {
 func (*ModuleDotBazelContents_Key) ProtoMessage() {}
 
 func (x *ModuleDotBazelContents_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[174]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8212,7 +9296,7 @@ func (x *ModuleDotBazelContents_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleDotBazelContents_Key.ProtoReflect.Descriptor instead.
 func (*ModuleDotBazelContents_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43, 0}
 }
 
 func (x *ModuleDotBazelContents_Key) GetModuleInstance() string {
@@ -8231,7 +9315,7 @@ type ModuleDotBazelContents_Value struct {
 
 func (x *ModuleDotBazelContents_Value) Reset() {
 	*x = ModuleDotBazelContents_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[175]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8243,7 +9327,7 @@ func (x *ModuleDotBazelContents_Value) String() string {
 func (*ModuleDotBazelContents_Value) ProtoMessage() {}
 
 func (x *ModuleDotBazelContents_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[175]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8256,7 +9340,7 @@ func (x *ModuleDotBazelContents_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleDotBazelContents_Value.ProtoReflect.Descriptor instead.
 func (*ModuleDotBazelContents_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43, 1}
 }
 
 func (x *ModuleDotBazelContents_Value) GetContents() *filesystem.FileContents {
@@ -8274,7 +9358,7 @@ type ModuleRegistryUrls_Key struct {
 
 func (x *ModuleRegistryUrls_Key) Reset() {
 	*x = ModuleRegistryUrls_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[176]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8286,7 +9370,7 @@ func (x *ModuleRegistryUrls_Key) String() string {
 func (*ModuleRegistryUrls_Key) ProtoMessage() {}
 
 func (x *ModuleRegistryUrls_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[176]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8299,7 +9383,7 @@ func (x *ModuleRegistryUrls_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRegistryUrls_Key.ProtoReflect.Descriptor instead.
 func (*ModuleRegistryUrls_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 0}
 }
 
 type ModuleRegistryUrls_Value struct {
@@ -8311,7 +9395,7 @@ type ModuleRegistryUrls_Value struct {
 
 func (x *ModuleRegistryUrls_Value) Reset() {
 	*x = ModuleRegistryUrls_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[177]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8323,7 +9407,7 @@ func (x *ModuleRegistryUrls_Value) String() string {
 func (*ModuleRegistryUrls_Value) ProtoMessage() {}
 
 func (x *ModuleRegistryUrls_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[177]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8336,7 +9420,7 @@ func (x *ModuleRegistryUrls_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRegistryUrls_Value.ProtoReflect.Descriptor instead.
 func (*ModuleRegistryUrls_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 1}
 }
 
 func (x *ModuleRegistryUrls_Value) GetRegistryUrls() []string {
@@ -8346,6 +9430,94 @@ func (x *ModuleRegistryUrls_Value) GetRegistryUrls() []string {
 	return nil
 }
 
+type ModuleLockfile_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleLockfile_Key) Reset() {
+	*x = ModuleLockfile_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleLockfile_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleLockfile_Key) ProtoMessage() {}
+
+func (x *ModuleLockfile_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleLockfile_Key.ProtoReflect.Descriptor instead.
+func (*ModuleLockfile_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45, 0}
+}
+
+type ModuleLockfile_Value struct {
+	state              protoimpl.MessageState                `protogen:"open.v1"`
+	UseLockfile        *BuildSpecification_Value_UseLockfile `protobuf:"bytes,1,opt,name=use_lockfile,json=useLockfile,proto3" json:"use_lockfile,omitempty"`
+	RegistryFileHashes []*RegistryFileHash                   `protobuf:"bytes,2,rep,name=registry_file_hashes,json=registryFileHashes,proto3" json:"registry_file_hashes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ModuleLockfile_Value) Reset() {
+	*x = ModuleLockfile_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleLockfile_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleLockfile_Value) ProtoMessage() {}
+
+func (x *ModuleLockfile_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleLockfile_Value.ProtoReflect.Descriptor instead.
+func (*ModuleLockfile_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45, 1}
+}
+
+func (x *ModuleLockfile_Value) GetUseLockfile() *BuildSpecification_Value_UseLockfile {
+	if x != nil {
+		return x.UseLockfile
+	}
+	return nil
+}
+
+func (x *ModuleLockfile_Value) GetRegistryFileHashes() []*RegistryFileHash {
+	if x != nil {
+		return x.RegistryFileHashes
+	}
+	return nil
+}
+
 type ModuleRepoMapping_Key struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ModuleInstance string                 `protobuf:"bytes,1,opt,name=module_instance,json=moduleInstance,proto3" json:"module_instance,omitempty"`
@@ -8355,7 +9527,7 @@ type ModuleRepoMapping_Key struct {
 
 func (x *ModuleRepoMapping_Key) Reset() {
 	*x = ModuleRepoMapping_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[178]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8367,7 +9539,7 @@ func (x *ModuleRepoMapping_Key) String() string {
 func (*ModuleRepoMapping_Key) ProtoMessage() {}
 
 func (x *ModuleRepoMapping_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[178]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8380,7 +9552,7 @@ func (x *ModuleRepoMapping_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRepoMapping_Key.ProtoReflect.Descriptor instead.
 func (*ModuleRepoMapping_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 0}
 }
 
 func (x *ModuleRepoMapping_Key) GetModuleInstance() string {
@@ -8399,7 +9571,7 @@ type ModuleRepoMapping_Value struct {
 
 func (x *ModuleRepoMapping_Value) Reset() {
 	*x = ModuleRepoMapping_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[179]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8411,7 +9583,7 @@ func (x *ModuleRepoMapping_Value) String() string {
 func (*ModuleRepoMapping_Value) ProtoMessage() {}
 
 func (x *ModuleRepoMapping_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[179]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8424,7 +9596,7 @@ func (x *ModuleRepoMapping_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRepoMapping_Value.ProtoReflect.Descriptor instead.
 func (*ModuleRepoMapping_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 1}
 }
 
 func (x *ModuleRepoMapping_Value) GetMappings() []*ModuleRepoMapping_Value_Mapping {
@@ -8444,7 +9616,7 @@ type ModuleRepoMapping_Value_Mapping struct {
 
 func (x *ModuleRepoMapping_Value_Mapping) Reset() {
 	*x = ModuleRepoMapping_Value_Mapping{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[180]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8456,7 +9628,7 @@ func (x *ModuleRepoMapping_Value_Mapping) String() string {
 func (*ModuleRepoMapping_Value_Mapping) ProtoMessage() {}
 
 func (x *ModuleRepoMapping_Value_Mapping) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[180]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8469,7 +9641,7 @@ func (x *ModuleRepoMapping_Value_Mapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRepoMapping_Value_Mapping.ProtoReflect.Descriptor instead.
 func (*ModuleRepoMapping_Value_Mapping) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 1, 0}
 }
 
 func (x *ModuleRepoMapping_Value_Mapping) GetFromApparentRepo() string {
@@ -8495,7 +9667,7 @@ type ModuleExtensionRepo_Key struct {
 
 func (x *ModuleExtensionRepo_Key) Reset() {
 	*x = ModuleExtensionRepo_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[181]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8507,7 +9679,7 @@ func (x *ModuleExtensionRepo_Key) String() string {
 func (*ModuleExtensionRepo_Key) ProtoMessage() {}
 
 func (x *ModuleExtensionRepo_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[181]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8520,7 +9692,7 @@ func (x *ModuleExtensionRepo_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepo_Key.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepo_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47, 0}
 }
 
 func (x *ModuleExtensionRepo_Key) GetCanonicalRepo() string {
@@ -8539,7 +9711,7 @@ type ModuleExtensionRepo_Value struct {
 
 func (x *ModuleExtensionRepo_Value) Reset() {
 	*x = ModuleExtensionRepo_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[182]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8551,7 +9723,7 @@ func (x *ModuleExtensionRepo_Value) String() string {
 func (*ModuleExtensionRepo_Value) ProtoMessage() {}
 
 func (x *ModuleExtensionRepo_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[182]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8564,7 +9736,7 @@ func (x *ModuleExtensionRepo_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepo_Value.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepo_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47, 1}
 }
 
 func (x *ModuleExtensionRepo_Value) GetDefinition() *starlark.Repo_Definition {
@@ -8583,7 +9755,7 @@ type ModuleExtensionRepoNames_Key struct {
 
 func (x *ModuleExtensionRepoNames_Key) Reset() {
 	*x = ModuleExtensionRepoNames_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[183]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8595,7 +9767,7 @@ func (x *ModuleExtensionRepoNames_Key) String() string {
 func (*ModuleExtensionRepoNames_Key) ProtoMessage() {}
 
 func (x *ModuleExtensionRepoNames_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[183]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8608,7 +9780,7 @@ func (x *ModuleExtensionRepoNames_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepoNames_Key.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepoNames_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48, 0}
 }
 
 func (x *ModuleExtensionRepoNames_Key) GetModuleExtension() string {
@@ -8627,7 +9799,7 @@ type ModuleExtensionRepoNames_Value struct {
 
 func (x *ModuleExtensionRepoNames_Value) Reset() {
 	*x = ModuleExtensionRepoNames_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[184]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8639,7 +9811,7 @@ func (x *ModuleExtensionRepoNames_Value) String() string {
 func (*ModuleExtensionRepoNames_Value) ProtoMessage() {}
 
 func (x *ModuleExtensionRepoNames_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[184]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8652,7 +9824,7 @@ func (x *ModuleExtensionRepoNames_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepoNames_Value.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepoNames_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48, 1}
 }
 
 func (x *ModuleExtensionRepoNames_Value) GetRepoNames() []string {
@@ -8671,7 +9843,7 @@ type ModuleExtensionRepos_Key struct {
 
 func (x *ModuleExtensionRepos_Key) Reset() {
 	*x = ModuleExtensionRepos_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[185]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8683,7 +9855,7 @@ func (x *ModuleExtensionRepos_Key) String() string {
 func (*ModuleExtensionRepos_Key) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[185]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8696,7 +9868,7 @@ func (x *ModuleExtensionRepos_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepos_Key.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49, 0}
 }
 
 func (x *ModuleExtensionRepos_Key) GetModuleExtension() string {
@@ -8715,7 +9887,7 @@ type ModuleExtensionRepos_Value struct {
 
 func (x *ModuleExtensionRepos_Value) Reset() {
 	*x = ModuleExtensionRepos_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[186]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8727,7 +9899,7 @@ func (x *ModuleExtensionRepos_Value) String() string {
 func (*ModuleExtensionRepos_Value) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[186]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8740,7 +9912,7 @@ func (x *ModuleExtensionRepos_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepos_Value.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49, 1}
 }
 
 func (x *ModuleExtensionRepos_Value) GetRepos() []*ModuleExtensionRepos_Value_Repo {
@@ -8763,7 +9935,7 @@ type ModuleExtensionRepos_Value_Repo struct {
 
 func (x *ModuleExtensionRepos_Value_Repo) Reset() {
 	*x = ModuleExtensionRepos_Value_Repo{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[187]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8775,7 +9947,7 @@ func (x *ModuleExtensionRepos_Value_Repo) String() string {
 func (*ModuleExtensionRepos_Value_Repo) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos_Value_Repo) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[187]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8788,7 +9960,7 @@ func (x *ModuleExtensionRepos_Value_Repo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepos_Value_Repo.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos_Value_Repo) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49, 1, 0}
 }
 
 func (x *ModuleExtensionRepos_Value_Repo) GetLevel() isModuleExtensionRepos_Value_Repo_Level {
@@ -8842,7 +10014,7 @@ type ModuleExtensionRepos_Value_Repo_Parent struct {
 
 func (x *ModuleExtensionRepos_Value_Repo_Parent) Reset() {
 	*x = ModuleExtensionRepos_Value_Repo_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[188]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8854,7 +10026,7 @@ func (x *ModuleExtensionRepos_Value_Repo_Parent) String() string {
 func (*ModuleExtensionRepos_Value_Repo_Parent) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos_Value_Repo_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[188]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8867,7 +10039,7 @@ func (x *ModuleExtensionRepos_Value_Repo_Parent) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use ModuleExtensionRepos_Value_Repo_Parent.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos_Value_Repo_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45, 1, 0, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49, 1, 0, 0}
 }
 
 func (x *ModuleExtensionRepos_Value_Repo_Parent) GetReference() *core.DecodableReference {
@@ -8892,7 +10064,7 @@ type ModuleFinalBuildList_Key struct {
 
 func (x *ModuleFinalBuildList_Key) Reset() {
 	*x = ModuleFinalBuildList_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[189]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8904,7 +10076,7 @@ func (x *ModuleFinalBuildList_Key) String() string {
 func (*ModuleFinalBuildList_Key) ProtoMessage() {}
 
 func (x *ModuleFinalBuildList_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[189]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8917,7 +10089,7 @@ func (x *ModuleFinalBuildList_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleFinalBuildList_Key.ProtoReflect.Descriptor instead.
 func (*ModuleFinalBuildList_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type ModuleFinalBuildList_Value struct {
@@ -8929,7 +10101,7 @@ type ModuleFinalBuildList_Value struct {
 
 func (x *ModuleFinalBuildList_Value) Reset() {
 	*x = ModuleFinalBuildList_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[190]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8941,7 +10113,7 @@ func (x *ModuleFinalBuildList_Value) String() string {
 func (*ModuleFinalBuildList_Value) ProtoMessage() {}
 
 func (x *ModuleFinalBuildList_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[190]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8954,7 +10126,7 @@ func (x *ModuleFinalBuildList_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleFinalBuildList_Value.ProtoReflect.Descriptor instead.
 func (*ModuleFinalBuildList_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52, 1}
 }
 
 func (x *ModuleFinalBuildList_Value) GetBuildList() []*BuildListModule {
@@ -8972,7 +10144,7 @@ type ModuleRoughBuildList_Key struct {
 
 func (x *ModuleRoughBuildList_Key) Reset() {
 	*x = ModuleRoughBuildList_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[191]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8984,7 +10156,7 @@ func (x *ModuleRoughBuildList_Key) String() string {
 func (*ModuleRoughBuildList_Key) ProtoMessage() {}
 
 func (x *ModuleRoughBuildList_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[191]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8997,19 +10169,20 @@ func (x *ModuleRoughBuildList_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRoughBuildList_Key.ProtoReflect.Descriptor instead.
 func (*ModuleRoughBuildList_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 0}
 }
 
 type ModuleRoughBuildList_Value struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuildList     []*BuildListModule     `protobuf:"bytes,1,rep,name=build_list,json=buildList,proto3" json:"build_list,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BuildList          []*BuildListModule     `protobuf:"bytes,1,rep,name=build_list,json=buildList,proto3" json:"build_list,omitempty"`
+	RegistryFileHashes []*RegistryFileHash    `protobuf:"bytes,2,rep,name=registry_file_hashes,json=registryFileHashes,proto3" json:"registry_file_hashes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ModuleRoughBuildList_Value) Reset() {
 	*x = ModuleRoughBuildList_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[192]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9021,7 +10194,7 @@ func (x *ModuleRoughBuildList_Value) String() string {
 func (*ModuleRoughBuildList_Value) ProtoMessage() {}
 
 func (x *ModuleRoughBuildList_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[192]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9034,7 +10207,7 @@ func (x *ModuleRoughBuildList_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRoughBuildList_Value.ProtoReflect.Descriptor instead.
 func (*ModuleRoughBuildList_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 1}
 }
 
 func (x *ModuleRoughBuildList_Value) GetBuildList() []*BuildListModule {
@@ -9044,6 +10217,13 @@ func (x *ModuleRoughBuildList_Value) GetBuildList() []*BuildListModule {
 	return nil
 }
 
+func (x *ModuleRoughBuildList_Value) GetRegistryFileHashes() []*RegistryFileHash {
+	if x != nil {
+		return x.RegistryFileHashes
+	}
+	return nil
+}
+
 type ModulesWithMultipleVersions_Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -9052,7 +10232,7 @@ type ModulesWithMultipleVersions_Key struct {
 
 func (x *ModulesWithMultipleVersions_Key) Reset() {
 	*x = ModulesWithMultipleVersions_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[193]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9064,7 +10244,7 @@ func (x *ModulesWithMultipleVersions_Key) String() string {
 func (*ModulesWithMultipleVersions_Key) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersions_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[193]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9077,7 +10257,7 @@ func (x *ModulesWithMultipleVersions_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithMultipleVersions_Key.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersions_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55, 0}
 }
 
 type ModulesWithMultipleVersions_Value struct {
@@ -9089,7 +10269,7 @@ type ModulesWithMultipleVersions_Value struct {
 
 func (x *ModulesWithMultipleVersions_Value) Reset() {
 	*x = ModulesWithMultipleVersions_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[194]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9101,7 +10281,7 @@ func (x *ModulesWithMultipleVersions_Value) String() string {
 func (*ModulesWithMultipleVersions_Value) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersions_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[194]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9114,7 +10294,7 @@ func (x *ModulesWithMultipleVersions_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ModulesWithMultipleVersions_Value.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersions_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55, 1}
 }
 
 func (x *ModulesWithMultipleVersions_Value) GetOverridesList() []*OverridesListModule {
@@ -9132,7 +10312,7 @@ type ModulesWithMultipleVersionsObject_Key struct {
 
 func (x *ModulesWithMultipleVersionsObject_Key) Reset() {
 	*x = ModulesWithMultipleVersionsObject_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[195]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9144,7 +10324,7 @@ func (x *ModulesWithMultipleVersionsObject_Key) String() string {
 func (*ModulesWithMultipleVersionsObject_Key) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersionsObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[195]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9157,7 +10337,7 @@ func (x *ModulesWithMultipleVersionsObject_Key) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ModulesWithMultipleVersionsObject_Key.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersionsObject_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56, 0}
 }
 
 type ModulesWithOverrides_Key struct {
@@ -9168,7 +10348,7 @@ type ModulesWithOverrides_Key struct {
 
 func (x *ModulesWithOverrides_Key) Reset() {
 	*x = ModulesWithOverrides_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[196]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9180,7 +10360,7 @@ func (x *ModulesWithOverrides_Key) String() string {
 func (*ModulesWithOverrides_Key) ProtoMessage() {}
 
 func (x *ModulesWithOverrides_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[196]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9193,7 +10373,7 @@ func (x *ModulesWithOverrides_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithOverrides_Key.ProtoReflect.Descriptor instead.
 func (*ModulesWithOverrides_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 0}
 }
 
 type ModulesWithOverrides_Value struct {
@@ -9205,7 +10385,7 @@ type ModulesWithOverrides_Value struct {
 
 func (x *ModulesWithOverrides_Value) Reset() {
 	*x = ModulesWithOverrides_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[197]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9217,7 +10397,7 @@ func (x *ModulesWithOverrides_Value) String() string {
 func (*ModulesWithOverrides_Value) ProtoMessage() {}
 
 func (x *ModulesWithOverrides_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[197]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9230,7 +10410,7 @@ func (x *ModulesWithOverrides_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithOverrides_Value.ProtoReflect.Descriptor instead.
 func (*ModulesWithOverrides_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 1}
 }
 
 func (x *ModulesWithOverrides_Value) GetOverridesList() []*OverridesListModule {
@@ -9253,7 +10433,7 @@ type ModuleOverride_SingleVersion struct {
 
 func (x *ModuleOverride_SingleVersion) Reset() {
 	*x = ModuleOverride_SingleVersion{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[198]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9265,7 +10445,7 @@ func (x *ModuleOverride_SingleVersion) String() string {
 func (*ModuleOverride_SingleVersion) ProtoMessage() {}
 
 func (x *ModuleOverride_SingleVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[198]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9278,7 +10458,7 @@ func (x *ModuleOverride_SingleVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleOverride_SingleVersion.ProtoReflect.Descriptor instead.
 func (*ModuleOverride_SingleVersion) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58, 0}
 }
 
 func (x *ModuleOverride_SingleVersion) GetVersion() string {
@@ -9326,7 +10506,7 @@ type ModuleOverride_MultipleVersions struct {
 
 func (x *ModuleOverride_MultipleVersions) Reset() {
 	*x = ModuleOverride_MultipleVersions{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[199]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9338,7 +10518,7 @@ func (x *ModuleOverride_MultipleVersions) String() string {
 func (*ModuleOverride_MultipleVersions) ProtoMessage() {}
 
 func (x *ModuleOverride_MultipleVersions) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[199]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9351,7 +10531,7 @@ func (x *ModuleOverride_MultipleVersions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleOverride_MultipleVersions.ProtoReflect.Descriptor instead.
 func (*ModuleOverride_MultipleVersions) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58, 1}
 }
 
 func (x *ModuleOverride_MultipleVersions) GetVersions() []string {
@@ -9376,7 +10556,7 @@ type ModulesWithRemoteOverrides_Key struct {
 
 func (x *ModulesWithRemoteOverrides_Key) Reset() {
 	*x = ModulesWithRemoteOverrides_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[200]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9388,7 +10568,7 @@ func (x *ModulesWithRemoteOverrides_Key) String() string {
 func (*ModulesWithRemoteOverrides_Key) ProtoMessage() {}
 
 func (x *ModulesWithRemoteOverrides_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[200]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9401,7 +10581,7 @@ func (x *ModulesWithRemoteOverrides_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithRemoteOverrides_Key.ProtoReflect.Descriptor instead.
 func (*ModulesWithRemoteOverrides_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59, 0}
 }
 
 type ModulesWithRemoteOverrides_Value struct {
@@ -9413,7 +10593,7 @@ type ModulesWithRemoteOverrides_Value struct {
 
 func (x *ModulesWithRemoteOverrides_Value) Reset() {
 	*x = ModulesWithRemoteOverrides_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[201]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9425,7 +10605,7 @@ func (x *ModulesWithRemoteOverrides_Value) String() string {
 func (*ModulesWithRemoteOverrides_Value) ProtoMessage() {}
 
 func (x *ModulesWithRemoteOverrides_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[201]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9438,7 +10618,7 @@ func (x *ModulesWithRemoteOverrides_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithRemoteOverrides_Value.ProtoReflect.Descriptor instead.
 func (*ModulesWithRemoteOverrides_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59, 1}
 }
 
 func (x *ModulesWithRemoteOverrides_Value) GetModuleOverrides() []*ModuleOverride {
@@ -9457,7 +10637,7 @@ type Package_Key struct {
 
 func (x *Package_Key) Reset() {
 	*x = Package_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[202]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9469,7 +10649,7 @@ func (x *Package_Key) String() string {
 func (*Package_Key) ProtoMessage() {}
 
 func (x *Package_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[202]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9482,7 +10662,7 @@ func (x *Package_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package_Key.ProtoReflect.Descriptor instead.
 func (*Package_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 0}
 }
 
 func (x *Package_Key) GetLabel() string {
@@ -9501,7 +10681,7 @@ type Package_Value struct {
 
 func (x *Package_Value) Reset() {
 	*x = Package_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[203]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9513,7 +10693,7 @@ func (x *Package_Value) String() string {
 func (*Package_Value) ProtoMessage() {}
 
 func (x *Package_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[203]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9526,7 +10706,7 @@ func (x *Package_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package_Value.ProtoReflect.Descriptor instead.
 func (*Package_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 1}
 }
 
 func (x *Package_Value) GetTargets() []*Package_Value_Target {
@@ -9549,7 +10729,7 @@ type Package_Value_Target struct {
 
 func (x *Package_Value_Target) Reset() {
 	*x = Package_Value_Target{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[204]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9561,7 +10741,7 @@ func (x *Package_Value_Target) String() string {
 func (*Package_Value_Target) ProtoMessage() {}
 
 func (x *Package_Value_Target) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[204]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9574,7 +10754,7 @@ func (x *Package_Value_Target) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package_Value_Target.ProtoReflect.Descriptor instead.
 func (*Package_Value_Target) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 1, 0}
 }
 
 func (x *Package_Value_Target) GetLevel() isPackage_Value_Target_Level {
@@ -9616,31 +10796,223 @@ type Package_Value_Target_Parent_ struct {
 
 func (*Package_Value_Target_Leaf) isPackage_Value_Target_Level() {}
 
-func (*Package_Value_Target_Parent_) isPackage_Value_Target_Level() {}
+func (*Package_Value_Target_Parent_) isPackage_Value_Target_Level() {}
+
+type Package_Value_Target_Parent struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Reference     *core.DecodableReference `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	FirstName     string                   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Package_Value_Target_Parent) Reset() {
+	*x = Package_Value_Target_Parent{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Package_Value_Target_Parent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Package_Value_Target_Parent) ProtoMessage() {}
+
+func (x *Package_Value_Target_Parent) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Package_Value_Target_Parent.ProtoReflect.Descriptor instead.
+func (*Package_Value_Target_Parent) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 1, 0, 0}
+}
+
+func (x *Package_Value_Target_Parent) GetReference() *core.DecodableReference {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *Package_Value_Target_Parent) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+type PackageGroupContains_Key struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PackageGroupLabel string                 `protobuf:"bytes,1,opt,name=package_group_label,json=packageGroupLabel,proto3" json:"package_group_label,omitempty"`
+	Package           string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PackageGroupContains_Key) Reset() {
+	*x = PackageGroupContains_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageGroupContains_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageGroupContains_Key) ProtoMessage() {}
+
+func (x *PackageGroupContains_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageGroupContains_Key.ProtoReflect.Descriptor instead.
+func (*PackageGroupContains_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *PackageGroupContains_Key) GetPackageGroupLabel() string {
+	if x != nil {
+		return x.PackageGroupLabel
+	}
+	return ""
+}
+
+func (x *PackageGroupContains_Key) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+type PackageGroupContains_Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contains      bool                   `protobuf:"varint,1,opt,name=contains,proto3" json:"contains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackageGroupContains_Value) Reset() {
+	*x = PackageGroupContains_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageGroupContains_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageGroupContains_Value) ProtoMessage() {}
+
+func (x *PackageGroupContains_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageGroupContains_Value.ProtoReflect.Descriptor instead.
+func (*PackageGroupContains_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61, 1}
+}
+
+func (x *PackageGroupContains_Value) GetContains() bool {
+	if x != nil {
+		return x.Contains
+	}
+	return false
+}
+
+type PackagesAtAndBelow_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BasePackage   string                 `protobuf:"bytes,1,opt,name=base_package,json=basePackage,proto3" json:"base_package,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackagesAtAndBelow_Key) Reset() {
+	*x = PackagesAtAndBelow_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackagesAtAndBelow_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagesAtAndBelow_Key) ProtoMessage() {}
+
+func (x *PackagesAtAndBelow_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagesAtAndBelow_Key.ProtoReflect.Descriptor instead.
+func (*PackagesAtAndBelow_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *PackagesAtAndBelow_Key) GetBasePackage() string {
+	if x != nil {
+		return x.BasePackage
+	}
+	return ""
+}
 
-type Package_Value_Target_Parent struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Reference     *core.DecodableReference `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
-	FirstName     string                   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PackagesAtAndBelow_Value struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	PackageAtBasePackage     bool                   `protobuf:"varint,1,opt,name=package_at_base_package,json=packageAtBasePackage,proto3" json:"package_at_base_package,omitempty"`
+	PackagesBelowBasePackage []string               `protobuf:"bytes,2,rep,name=packages_below_base_package,json=packagesBelowBasePackage,proto3" json:"packages_below_base_package,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *Package_Value_Target_Parent) Reset() {
-	*x = Package_Value_Target_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[205]
+func (x *PackagesAtAndBelow_Value) Reset() {
+	*x = PackagesAtAndBelow_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Package_Value_Target_Parent) String() string {
+func (x *PackagesAtAndBelow_Value) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Package_Value_Target_Parent) ProtoMessage() {}
+func (*PackagesAtAndBelow_Value) ProtoMessage() {}
 
-func (x *Package_Value_Target_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[205]
+func (x *PackagesAtAndBelow_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9651,48 +11023,47 @@ func (x *Package_Value_Target_Parent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Package_Value_Target_Parent.ProtoReflect.Descriptor instead.
-func (*Package_Value_Target_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55, 1, 0, 0}
+// Deprecated: Use PackagesAtAndBelow_Value.ProtoReflect.Descriptor instead.
+func (*PackagesAtAndBelow_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 1}
 }
 
-func (x *Package_Value_Target_Parent) GetReference() *core.DecodableReference {
+func (x *PackagesAtAndBelow_Value) GetPackageAtBasePackage() bool {
 	if x != nil {
-		return x.Reference
+		return x.PackageAtBasePackage
 	}
-	return nil
+	return false
 }
 
-func (x *Package_Value_Target_Parent) GetFirstName() string {
+func (x *PackagesAtAndBelow_Value) GetPackagesBelowBasePackage() []string {
 	if x != nil {
-		return x.FirstName
+		return x.PackagesBelowBasePackage
 	}
-	return ""
+	return nil
 }
 
-type PackageGroupContains_Key struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	PackageGroupLabel string                 `protobuf:"bytes,1,opt,name=package_group_label,json=packageGroupLabel,proto3" json:"package_group_label,omitempty"`
-	Package           string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type QueryResult_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PackageGroupContains_Key) Reset() {
-	*x = PackageGroupContains_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[206]
+func (x *QueryResult_Key) Reset() {
+	*x = QueryResult_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PackageGroupContains_Key) String() string {
+func (x *QueryResult_Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackageGroupContains_Key) ProtoMessage() {}
+func (*QueryResult_Key) ProtoMessage() {}
 
-func (x *PackageGroupContains_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[206]
+func (x *QueryResult_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9703,47 +11074,40 @@ func (x *PackageGroupContains_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackageGroupContains_Key.ProtoReflect.Descriptor instead.
-func (*PackageGroupContains_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56, 0}
-}
-
-func (x *PackageGroupContains_Key) GetPackageGroupLabel() string {
-	if x != nil {
-		return x.PackageGroupLabel
-	}
-	return ""
+// Deprecated: Use QueryResult_Key.ProtoReflect.Descriptor instead.
+func (*QueryResult_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63, 0}
 }
 
-func (x *PackageGroupContains_Key) GetPackage() string {
+func (x *QueryResult_Key) GetExpression() string {
 	if x != nil {
-		return x.Package
+		return x.Expression
 	}
 	return ""
 }
 
-type PackageGroupContains_Value struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contains      bool                   `protobuf:"varint,1,opt,name=contains,proto3" json:"contains,omitempty"`
+type QueryResult_Value struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Targets       []*QueryResult_Value_Target `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PackageGroupContains_Value) Reset() {
-	*x = PackageGroupContains_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[207]
+func (x *QueryResult_Value) Reset() {
+	*x = QueryResult_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PackageGroupContains_Value) String() string {
+func (x *QueryResult_Value) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackageGroupContains_Value) ProtoMessage() {}
+func (*QueryResult_Value) ProtoMessage() {}
 
-func (x *PackageGroupContains_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[207]
+func (x *QueryResult_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9754,40 +11118,42 @@ func (x *PackageGroupContains_Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackageGroupContains_Value.ProtoReflect.Descriptor instead.
-func (*PackageGroupContains_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56, 1}
+// Deprecated: Use QueryResult_Value.ProtoReflect.Descriptor instead.
+func (*QueryResult_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63, 1}
 }
 
-func (x *PackageGroupContains_Value) GetContains() bool {
+func (x *QueryResult_Value) GetTargets() []*QueryResult_Value_Target {
 	if x != nil {
-		return x.Contains
+		return x.Targets
 	}
-	return false
+	return nil
 }
 
-type PackagesAtAndBelow_Key struct {
+type QueryResult_Value_Attribute struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BasePackage   string                 `protobuf:"bytes,1,opt,name=base_package,json=basePackage,proto3" json:"base_package,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Explicit      bool                   `protobuf:"varint,3,opt,name=explicit,proto3" json:"explicit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PackagesAtAndBelow_Key) Reset() {
-	*x = PackagesAtAndBelow_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[208]
+func (x *QueryResult_Value_Attribute) Reset() {
+	*x = QueryResult_Value_Attribute{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PackagesAtAndBelow_Key) String() string {
+func (x *QueryResult_Value_Attribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackagesAtAndBelow_Key) ProtoMessage() {}
+func (*QueryResult_Value_Attribute) ProtoMessage() {}
 
-func (x *PackagesAtAndBelow_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[208]
+func (x *QueryResult_Value_Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9798,41 +11164,57 @@ func (x *PackagesAtAndBelow_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackagesAtAndBelow_Key.ProtoReflect.Descriptor instead.
-func (*PackagesAtAndBelow_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 0}
+// Deprecated: Use QueryResult_Value_Attribute.ProtoReflect.Descriptor instead.
+func (*QueryResult_Value_Attribute) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63, 1, 0}
 }
 
-func (x *PackagesAtAndBelow_Key) GetBasePackage() string {
+func (x *QueryResult_Value_Attribute) GetName() string {
 	if x != nil {
-		return x.BasePackage
+		return x.Name
 	}
 	return ""
 }
 
-type PackagesAtAndBelow_Value struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	PackageAtBasePackage     bool                   `protobuf:"varint,1,opt,name=package_at_base_package,json=packageAtBasePackage,proto3" json:"package_at_base_package,omitempty"`
-	PackagesBelowBasePackage []string               `protobuf:"bytes,2,rep,name=packages_below_base_package,json=packagesBelowBasePackage,proto3" json:"packages_below_base_package,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+func (x *QueryResult_Value_Attribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
-func (x *PackagesAtAndBelow_Value) Reset() {
-	*x = PackagesAtAndBelow_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[209]
+func (x *QueryResult_Value_Attribute) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+type QueryResult_Value_Target struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Label         string                         `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Kind          string                         `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Attributes    []*QueryResult_Value_Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Dependencies  []string                       `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryResult_Value_Target) Reset() {
+	*x = QueryResult_Value_Target{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PackagesAtAndBelow_Value) String() string {
+func (x *QueryResult_Value_Target) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackagesAtAndBelow_Value) ProtoMessage() {}
+func (*QueryResult_Value_Target) ProtoMessage() {}
 
-func (x *PackagesAtAndBelow_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[209]
+func (x *QueryResult_Value_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9843,21 +11225,35 @@ func (x *PackagesAtAndBelow_Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackagesAtAndBelow_Value.ProtoReflect.Descriptor instead.
-func (*PackagesAtAndBelow_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 1}
+// Deprecated: Use QueryResult_Value_Target.ProtoReflect.Descriptor instead.
+func (*QueryResult_Value_Target) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63, 1, 1}
 }
 
-func (x *PackagesAtAndBelow_Value) GetPackageAtBasePackage() bool {
+func (x *QueryResult_Value_Target) GetLabel() string {
 	if x != nil {
-		return x.PackageAtBasePackage
+		return x.Label
 	}
-	return false
+	return ""
 }
 
-func (x *PackagesAtAndBelow_Value) GetPackagesBelowBasePackage() []string {
+func (x *QueryResult_Value_Target) GetKind() string {
 	if x != nil {
-		return x.PackagesBelowBasePackage
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *QueryResult_Value_Target) GetAttributes() []*QueryResult_Value_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *QueryResult_Value_Target) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
 	}
 	return nil
 }
@@ -9870,7 +11266,7 @@ type RegisteredExecutionPlatforms_Key struct {
 
 func (x *RegisteredExecutionPlatforms_Key) Reset() {
 	*x = RegisteredExecutionPlatforms_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[210]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9882,7 +11278,7 @@ func (x *RegisteredExecutionPlatforms_Key) String() string {
 func (*RegisteredExecutionPlatforms_Key) ProtoMessage() {}
 
 func (x *RegisteredExecutionPlatforms_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[210]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9895,7 +11291,7 @@ func (x *RegisteredExecutionPlatforms_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredExecutionPlatforms_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredExecutionPlatforms_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66, 0}
 }
 
 type RegisteredExecutionPlatforms_Value struct {
@@ -9907,7 +11303,7 @@ type RegisteredExecutionPlatforms_Value struct {
 
 func (x *RegisteredExecutionPlatforms_Value) Reset() {
 	*x = RegisteredExecutionPlatforms_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[211]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9919,7 +11315,7 @@ func (x *RegisteredExecutionPlatforms_Value) String() string {
 func (*RegisteredExecutionPlatforms_Value) ProtoMessage() {}
 
 func (x *RegisteredExecutionPlatforms_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[211]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9932,7 +11328,7 @@ func (x *RegisteredExecutionPlatforms_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RegisteredExecutionPlatforms_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredExecutionPlatforms_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66, 1}
 }
 
 func (x *RegisteredExecutionPlatforms_Value) GetExecutionPlatforms() []*ExecutionPlatform {
@@ -9950,7 +11346,7 @@ type RegisteredFetchPlatform_Key struct {
 
 func (x *RegisteredFetchPlatform_Key) Reset() {
 	*x = RegisteredFetchPlatform_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[212]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9962,7 +11358,7 @@ func (x *RegisteredFetchPlatform_Key) String() string {
 func (*RegisteredFetchPlatform_Key) ProtoMessage() {}
 
 func (x *RegisteredFetchPlatform_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[212]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9975,7 +11371,7 @@ func (x *RegisteredFetchPlatform_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredFetchPlatform_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredFetchPlatform_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67, 0}
 }
 
 type RegisteredFetchPlatform_Value struct {
@@ -9987,7 +11383,7 @@ type RegisteredFetchPlatform_Value struct {
 
 func (x *RegisteredFetchPlatform_Value) Reset() {
 	*x = RegisteredFetchPlatform_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[213]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9999,7 +11395,7 @@ func (x *RegisteredFetchPlatform_Value) String() string {
 func (*RegisteredFetchPlatform_Value) ProtoMessage() {}
 
 func (x *RegisteredFetchPlatform_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[213]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10012,7 +11408,7 @@ func (x *RegisteredFetchPlatform_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredFetchPlatform_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredFetchPlatform_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67, 1}
 }
 
 func (x *RegisteredFetchPlatform_Value) GetFetchPlatformPkixPublicKey() []byte {
@@ -10030,7 +11426,7 @@ type RegisteredRepoPlatform_Key struct {
 
 func (x *RegisteredRepoPlatform_Key) Reset() {
 	*x = RegisteredRepoPlatform_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[214]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10042,7 +11438,7 @@ func (x *RegisteredRepoPlatform_Key) String() string {
 func (*RegisteredRepoPlatform_Key) ProtoMessage() {}
 
 func (x *RegisteredRepoPlatform_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[214]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10055,7 +11451,7 @@ func (x *RegisteredRepoPlatform_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredRepoPlatform_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredRepoPlatform_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68, 0}
 }
 
 type RegisteredRepoPlatform_Value struct {
@@ -10070,7 +11466,7 @@ type RegisteredRepoPlatform_Value struct {
 
 func (x *RegisteredRepoPlatform_Value) Reset() {
 	*x = RegisteredRepoPlatform_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[215]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10082,7 +11478,7 @@ func (x *RegisteredRepoPlatform_Value) String() string {
 func (*RegisteredRepoPlatform_Value) ProtoMessage() {}
 
 func (x *RegisteredRepoPlatform_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[215]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10095,7 +11491,7 @@ func (x *RegisteredRepoPlatform_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredRepoPlatform_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredRepoPlatform_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68, 1}
 }
 
 func (x *RegisteredRepoPlatform_Value) GetExecPkixPublicKey() []byte {
@@ -10136,7 +11532,7 @@ type RegisteredRepoPlatform_Value_EnvironmentVariable struct {
 
 func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) Reset() {
 	*x = RegisteredRepoPlatform_Value_EnvironmentVariable{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[216]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10148,7 +11544,7 @@ func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) String() string {
 func (*RegisteredRepoPlatform_Value_EnvironmentVariable) ProtoMessage() {}
 
 func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[216]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10161,7 +11557,7 @@ func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) ProtoReflect() protor
 
 // Deprecated: Use RegisteredRepoPlatform_Value_EnvironmentVariable.ProtoReflect.Descriptor instead.
 func (*RegisteredRepoPlatform_Value_EnvironmentVariable) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68, 1, 0}
 }
 
 func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) GetName() string {
@@ -10186,7 +11582,7 @@ type RegisteredToolchains_Key struct {
 
 func (x *RegisteredToolchains_Key) Reset() {
 	*x = RegisteredToolchains_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[217]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10198,7 +11594,7 @@ func (x *RegisteredToolchains_Key) String() string {
 func (*RegisteredToolchains_Key) ProtoMessage() {}
 
 func (x *RegisteredToolchains_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[217]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10211,7 +11607,7 @@ func (x *RegisteredToolchains_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchains_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchains_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{64, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70, 0}
 }
 
 type RegisteredToolchains_Value struct {
@@ -10223,7 +11619,7 @@ type RegisteredToolchains_Value struct {
 
 func (x *RegisteredToolchains_Value) Reset() {
 	*x = RegisteredToolchains_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[218]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10235,7 +11631,7 @@ func (x *RegisteredToolchains_Value) String() string {
 func (*RegisteredToolchains_Value) ProtoMessage() {}
 
 func (x *RegisteredToolchains_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[218]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10248,7 +11644,7 @@ func (x *RegisteredToolchains_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchains_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchains_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{64, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70, 1}
 }
 
 func (x *RegisteredToolchains_Value) GetToolchainTypes() []*RegisteredToolchains_Value_RegisteredToolchainType {
@@ -10268,7 +11664,7 @@ type RegisteredToolchains_Value_RegisteredToolchainType struct {
 
 func (x *RegisteredToolchains_Value_RegisteredToolchainType) Reset() {
 	*x = RegisteredToolchains_Value_RegisteredToolchainType{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[219]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10280,7 +11676,7 @@ func (x *RegisteredToolchains_Value_RegisteredToolchainType) String() string {
 func (*RegisteredToolchains_Value_RegisteredToolchainType) ProtoMessage() {}
 
 func (x *RegisteredToolchains_Value_RegisteredToolchainType) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[219]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10293,7 +11689,7 @@ func (x *RegisteredToolchains_Value_RegisteredToolchainType) ProtoReflect() prot
 
 // Deprecated: Use RegisteredToolchains_Value_RegisteredToolchainType.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchains_Value_RegisteredToolchainType) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{64, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70, 1, 0}
 }
 
 func (x *RegisteredToolchains_Value_RegisteredToolchainType) GetToolchainType() string {
@@ -10319,7 +11715,7 @@ type RegisteredToolchainsForType_Key struct {
 
 func (x *RegisteredToolchainsForType_Key) Reset() {
 	*x = RegisteredToolchainsForType_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[220]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10331,7 +11727,7 @@ func (x *RegisteredToolchainsForType_Key) String() string {
 func (*RegisteredToolchainsForType_Key) ProtoMessage() {}
 
 func (x *RegisteredToolchainsForType_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[220]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10344,7 +11740,7 @@ func (x *RegisteredToolchainsForType_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchainsForType_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchainsForType_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{65, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{71, 0}
 }
 
 func (x *RegisteredToolchainsForType_Key) GetToolchainType() string {
@@ -10363,7 +11759,7 @@ type RegisteredToolchainsForType_Value struct {
 
 func (x *RegisteredToolchainsForType_Value) Reset() {
 	*x = RegisteredToolchainsForType_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[221]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10375,7 +11771,7 @@ func (x *RegisteredToolchainsForType_Value) String() string {
 func (*RegisteredToolchainsForType_Value) ProtoMessage() {}
 
 func (x *RegisteredToolchainsForType_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[221]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10388,7 +11784,7 @@ func (x *RegisteredToolchainsForType_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RegisteredToolchainsForType_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchainsForType_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{65, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{71, 1}
 }
 
 func (x *RegisteredToolchainsForType_Value) GetToolchains() []*RegisteredToolchain {
@@ -10407,7 +11803,7 @@ type Repo_Key struct {
 
 func (x *Repo_Key) Reset() {
 	*x = Repo_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[222]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10419,7 +11815,7 @@ func (x *Repo_Key) String() string {
 func (*Repo_Key) ProtoMessage() {}
 
 func (x *Repo_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[222]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10432,7 +11828,7 @@ func (x *Repo_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Repo_Key.ProtoReflect.Descriptor instead.
 func (*Repo_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72, 0}
 }
 
 func (x *Repo_Key) GetCanonicalRepo() string {
@@ -10451,7 +11847,7 @@ type Repo_Value struct {
 
 func (x *Repo_Value) Reset() {
 	*x = Repo_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[223]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10463,7 +11859,7 @@ func (x *Repo_Value) String() string {
 func (*Repo_Value) ProtoMessage() {}
 
 func (x *Repo_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[223]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10476,7 +11872,7 @@ func (x *Repo_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Repo_Value.ProtoReflect.Descriptor instead.
 func (*Repo_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72, 1}
 }
 
 func (x *Repo_Value) GetRootDirectoryReference() *filesystem.DirectoryReference {
@@ -10495,7 +11891,7 @@ type RepoDefaultAttrs_Key struct {
 
 func (x *RepoDefaultAttrs_Key) Reset() {
 	*x = RepoDefaultAttrs_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[224]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10507,7 +11903,7 @@ func (x *RepoDefaultAttrs_Key) String() string {
 func (*RepoDefaultAttrs_Key) ProtoMessage() {}
 
 func (x *RepoDefaultAttrs_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[224]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10520,7 +11916,7 @@ func (x *RepoDefaultAttrs_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoDefaultAttrs_Key.ProtoReflect.Descriptor instead.
 func (*RepoDefaultAttrs_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73, 0}
 }
 
 func (x *RepoDefaultAttrs_Key) GetCanonicalRepo() string {
@@ -10539,7 +11935,7 @@ type RepoDefaultAttrs_Value struct {
 
 func (x *RepoDefaultAttrs_Value) Reset() {
 	*x = RepoDefaultAttrs_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[225]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10551,7 +11947,7 @@ func (x *RepoDefaultAttrs_Value) String() string {
 func (*RepoDefaultAttrs_Value) ProtoMessage() {}
 
 func (x *RepoDefaultAttrs_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[225]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10564,7 +11960,7 @@ func (x *RepoDefaultAttrs_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoDefaultAttrs_Value.ProtoReflect.Descriptor instead.
 func (*RepoDefaultAttrs_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73, 1}
 }
 
 func (x *RepoDefaultAttrs_Value) GetInheritableAttrs() *starlark.InheritableAttrs {
@@ -10583,7 +11979,7 @@ type RepoPlatformHostPath_Key struct {
 
 func (x *RepoPlatformHostPath_Key) Reset() {
 	*x = RepoPlatformHostPath_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[226]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10595,7 +11991,7 @@ func (x *RepoPlatformHostPath_Key) String() string {
 func (*RepoPlatformHostPath_Key) ProtoMessage() {}
 
 func (x *RepoPlatformHostPath_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[226]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10608,7 +12004,7 @@ func (x *RepoPlatformHostPath_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoPlatformHostPath_Key.ProtoReflect.Descriptor instead.
 func (*RepoPlatformHostPath_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{74, 0}
 }
 
 func (x *RepoPlatformHostPath_Key) GetAbsolutePath() string {
@@ -10631,7 +12027,7 @@ type RepoPlatformHostPath_Value struct {
 
 func (x *RepoPlatformHostPath_Value) Reset() {
 	*x = RepoPlatformHostPath_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[227]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10643,7 +12039,7 @@ func (x *RepoPlatformHostPath_Value) String() string {
 func (*RepoPlatformHostPath_Value) ProtoMessage() {}
 
 func (x *RepoPlatformHostPath_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[227]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10656,7 +12052,7 @@ func (x *RepoPlatformHostPath_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoPlatformHostPath_Value.ProtoReflect.Descriptor instead.
 func (*RepoPlatformHostPath_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{74, 1}
 }
 
 func (x *RepoPlatformHostPath_Value) GetCapturedPath() isRepoPlatformHostPath_Value_CapturedPath {
@@ -10711,7 +12107,7 @@ type ResolvedToolchains_Key struct {
 
 func (x *ResolvedToolchains_Key) Reset() {
 	*x = ResolvedToolchains_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[228]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10723,7 +12119,7 @@ func (x *ResolvedToolchains_Key) String() string {
 func (*ResolvedToolchains_Key) ProtoMessage() {}
 
 func (x *ResolvedToolchains_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[228]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10736,7 +12132,7 @@ func (x *ResolvedToolchains_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedToolchains_Key.ProtoReflect.Descriptor instead.
 func (*ResolvedToolchains_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{69, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 0}
 }
 
 func (x *ResolvedToolchains_Key) GetExecCompatibleWith() []*Constraint {
@@ -10771,7 +12167,7 @@ type ResolvedToolchains_Value struct {
 
 func (x *ResolvedToolchains_Value) Reset() {
 	*x = ResolvedToolchains_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[229]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10783,7 +12179,7 @@ func (x *ResolvedToolchains_Value) String() string {
 func (*ResolvedToolchains_Value) ProtoMessage() {}
 
 func (x *ResolvedToolchains_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[229]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10796,7 +12192,7 @@ func (x *ResolvedToolchains_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedToolchains_Value.ProtoReflect.Descriptor instead.
 func (*ResolvedToolchains_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{69, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 1}
 }
 
 func (x *ResolvedToolchains_Value) GetToolchainIdentifiers() []string {
@@ -10828,7 +12224,7 @@ type RootModule_Key struct {
 
 func (x *RootModule_Key) Reset() {
 	*x = RootModule_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[230]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10840,7 +12236,7 @@ func (x *RootModule_Key) String() string {
 func (*RootModule_Key) ProtoMessage() {}
 
 func (x *RootModule_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[230]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10853,7 +12249,7 @@ func (x *RootModule_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RootModule_Key.ProtoReflect.Descriptor instead.
 func (*RootModule_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76, 0}
 }
 
 type RootModule_Value struct {
@@ -10866,7 +12262,7 @@ type RootModule_Value struct {
 
 func (x *RootModule_Value) Reset() {
 	*x = RootModule_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[231]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[258]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10878,7 +12274,7 @@ func (x *RootModule_Value) String() string {
 func (*RootModule_Value) ProtoMessage() {}
 
 func (x *RootModule_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[231]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[258]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10891,7 +12287,7 @@ func (x *RootModule_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RootModule_Value.ProtoReflect.Descriptor instead.
 func (*RootModule_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76, 1}
 }
 
 func (x *RootModule_Value) GetRootModuleName() string {
@@ -10916,7 +12312,7 @@ type RuleImplementationWrappers_Key struct {
 
 func (x *RuleImplementationWrappers_Key) Reset() {
 	*x = RuleImplementationWrappers_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[232]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[259]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10928,7 +12324,7 @@ func (x *RuleImplementationWrappers_Key) String() string {
 func (*RuleImplementationWrappers_Key) ProtoMessage() {}
 
 func (x *RuleImplementationWrappers_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[232]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[259]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10941,7 +12337,7 @@ func (x *RuleImplementationWrappers_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleImplementationWrappers_Key.ProtoReflect.Descriptor instead.
 func (*RuleImplementationWrappers_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{71, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{77, 0}
 }
 
 type Select_Key struct {
@@ -10955,7 +12351,7 @@ type Select_Key struct {
 
 func (x *Select_Key) Reset() {
 	*x = Select_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[233]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[260]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10967,7 +12363,7 @@ func (x *Select_Key) String() string {
 func (*Select_Key) ProtoMessage() {}
 
 func (x *Select_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[233]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[260]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10980,7 +12376,7 @@ func (x *Select_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Select_Key.ProtoReflect.Descriptor instead.
 func (*Select_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{78, 0}
 }
 
 func (x *Select_Key) GetConditionIdentifiers() []string {
@@ -11013,7 +12409,7 @@ type Select_Value struct {
 
 func (x *Select_Value) Reset() {
 	*x = Select_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[234]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[261]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11025,7 +12421,7 @@ func (x *Select_Value) String() string {
 func (*Select_Value) ProtoMessage() {}
 
 func (x *Select_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[234]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[261]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11038,7 +12434,7 @@ func (x *Select_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Select_Value.ProtoReflect.Descriptor instead.
 func (*Select_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{78, 1}
 }
 
 func (x *Select_Value) GetConditionIndices() []uint32 {
@@ -11056,7 +12452,7 @@ type StableInputRootPath_Key struct {
 
 func (x *StableInputRootPath_Key) Reset() {
 	*x = StableInputRootPath_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[235]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[262]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11068,7 +12464,7 @@ func (x *StableInputRootPath_Key) String() string {
 func (*StableInputRootPath_Key) ProtoMessage() {}
 
 func (x *StableInputRootPath_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[235]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[262]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11081,7 +12477,7 @@ func (x *StableInputRootPath_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPath_Key.ProtoReflect.Descriptor instead.
 func (*StableInputRootPath_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{79, 0}
 }
 
 type StableInputRootPath_Value struct {
@@ -11093,7 +12489,7 @@ type StableInputRootPath_Value struct {
 
 func (x *StableInputRootPath_Value) Reset() {
 	*x = StableInputRootPath_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[236]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[263]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11105,7 +12501,7 @@ func (x *StableInputRootPath_Value) String() string {
 func (*StableInputRootPath_Value) ProtoMessage() {}
 
 func (x *StableInputRootPath_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[236]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[263]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11118,7 +12514,7 @@ func (x *StableInputRootPath_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPath_Value.ProtoReflect.Descriptor instead.
 func (*StableInputRootPath_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{79, 1}
 }
 
 func (x *StableInputRootPath_Value) GetInputRootPath() string {
@@ -11136,7 +12532,7 @@ type StableInputRootPathObject_Key struct {
 
 func (x *StableInputRootPathObject_Key) Reset() {
 	*x = StableInputRootPathObject_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[237]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[264]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11148,7 +12544,7 @@ func (x *StableInputRootPathObject_Key) String() string {
 func (*StableInputRootPathObject_Key) ProtoMessage() {}
 
 func (x *StableInputRootPathObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[237]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[264]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11161,7 +12557,7 @@ func (x *StableInputRootPathObject_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPathObject_Key.ProtoReflect.Descriptor instead.
 func (*StableInputRootPathObject_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{74, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{80, 0}
 }
 
 type SuccessfulActionResult_Key struct {
@@ -11173,7 +12569,7 @@ type SuccessfulActionResult_Key struct {
 
 func (x *SuccessfulActionResult_Key) Reset() {
 	*x = SuccessfulActionResult_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[238]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11185,7 +12581,7 @@ func (x *SuccessfulActionResult_Key) String() string {
 func (*SuccessfulActionResult_Key) ProtoMessage() {}
 
 func (x *SuccessfulActionResult_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[238]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11198,7 +12594,7 @@ func (x *SuccessfulActionResult_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuccessfulActionResult_Key.ProtoReflect.Descriptor instead.
 func (*SuccessfulActionResult_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{81, 0}
 }
 
 func (x *SuccessfulActionResult_Key) GetExecuteRequest() *ExecuteRequest {
@@ -11217,7 +12613,7 @@ type SuccessfulActionResult_Value struct {
 
 func (x *SuccessfulActionResult_Value) Reset() {
 	*x = SuccessfulActionResult_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[239]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[266]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11229,7 +12625,7 @@ func (x *SuccessfulActionResult_Value) String() string {
 func (*SuccessfulActionResult_Value) ProtoMessage() {}
 
 func (x *SuccessfulActionResult_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[239]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[266]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11242,7 +12638,7 @@ func (x *SuccessfulActionResult_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuccessfulActionResult_Value.ProtoReflect.Descriptor instead.
 func (*SuccessfulActionResult_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{81, 1}
 }
 
 func (x *SuccessfulActionResult_Value) GetOutputsReference() *core.DecodableReference {
@@ -11261,7 +12657,7 @@ type Target_Key struct {
 
 func (x *Target_Key) Reset() {
 	*x = Target_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[240]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[267]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11273,7 +12669,7 @@ func (x *Target_Key) String() string {
 func (*Target_Key) ProtoMessage() {}
 
 func (x *Target_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[240]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[267]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11286,7 +12682,7 @@ func (x *Target_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Target_Key.ProtoReflect.Descriptor instead.
 func (*Target_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{82, 0}
 }
 
 func (x *Target_Key) GetLabel() string {
@@ -11305,7 +12701,7 @@ type Target_Value struct {
 
 func (x *Target_Value) Reset() {
 	*x = Target_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[241]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[268]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11317,7 +12713,7 @@ func (x *Target_Value) String() string {
 func (*Target_Value) ProtoMessage() {}
 
 func (x *Target_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[241]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[268]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11330,7 +12726,7 @@ func (x *Target_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Target_Value.ProtoReflect.Descriptor instead.
 func (*Target_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{82, 1}
 }
 
 func (x *Target_Value) GetDefinition() *starlark.Target_Definition {
@@ -11349,7 +12745,7 @@ type TargetAction_Key struct {
 
 func (x *TargetAction_Key) Reset() {
 	*x = TargetAction_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[242]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[269]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11361,7 +12757,7 @@ func (x *TargetAction_Key) String() string {
 func (*TargetAction_Key) ProtoMessage() {}
 
 func (x *TargetAction_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[242]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[269]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11374,7 +12770,7 @@ func (x *TargetAction_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetAction_Key.ProtoReflect.Descriptor instead.
 func (*TargetAction_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{78, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{84, 0}
 }
 
 func (x *TargetAction_Key) GetId() *TargetActionId {
@@ -11393,7 +12789,7 @@ type TargetAction_Value struct {
 
 func (x *TargetAction_Value) Reset() {
 	*x = TargetAction_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[243]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[270]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11405,7 +12801,7 @@ func (x *TargetAction_Value) String() string {
 func (*TargetAction_Value) ProtoMessage() {}
 
 func (x *TargetAction_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[243]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[270]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11418,7 +12814,7 @@ func (x *TargetAction_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetAction_Value.ProtoReflect.Descriptor instead.
 func (*TargetAction_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{78, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{84, 1}
 }
 
 func (x *TargetAction_Value) GetDefinition() *TargetActionDefinition {
@@ -11437,7 +12833,7 @@ type TargetActionCommand_Key struct {
 
 func (x *TargetActionCommand_Key) Reset() {
 	*x = TargetActionCommand_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[244]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[271]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11449,7 +12845,7 @@ func (x *TargetActionCommand_Key) String() string {
 func (*TargetActionCommand_Key) ProtoMessage() {}
 
 func (x *TargetActionCommand_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[244]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[271]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11462,7 +12858,7 @@ func (x *TargetActionCommand_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionCommand_Key.ProtoReflect.Descriptor instead.
 func (*TargetActionCommand_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{79, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{85, 0}
 }
 
 func (x *TargetActionCommand_Key) GetId() *TargetActionId {
@@ -11481,7 +12877,7 @@ type TargetActionCommand_Value struct {
 
 func (x *TargetActionCommand_Value) Reset() {
 	*x = TargetActionCommand_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[245]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[272]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11493,7 +12889,7 @@ func (x *TargetActionCommand_Value) String() string {
 func (*TargetActionCommand_Value) ProtoMessage() {}
 
 func (x *TargetActionCommand_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[245]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[272]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11506,7 +12902,7 @@ func (x *TargetActionCommand_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionCommand_Value.ProtoReflect.Descriptor instead.
 func (*TargetActionCommand_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{79, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{85, 1}
 }
 
 func (x *TargetActionCommand_Value) GetCommandReference() *core.DecodableReference {
@@ -11525,7 +12921,7 @@ type TargetActionInputRoot_Key struct {
 
 func (x *TargetActionInputRoot_Key) Reset() {
 	*x = TargetActionInputRoot_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[246]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[273]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11537,7 +12933,7 @@ func (x *TargetActionInputRoot_Key) String() string {
 func (*TargetActionInputRoot_Key) ProtoMessage() {}
 
 func (x *TargetActionInputRoot_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[246]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[273]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11550,7 +12946,7 @@ func (x *TargetActionInputRoot_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionInputRoot_Key.ProtoReflect.Descriptor instead.
 func (*TargetActionInputRoot_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{80, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{86, 0}
 }
 
 func (x *TargetActionInputRoot_Key) GetId() *TargetActionId {
@@ -11569,7 +12965,7 @@ type TargetActionInputRoot_Value struct {
 
 func (x *TargetActionInputRoot_Value) Reset() {
 	*x = TargetActionInputRoot_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[247]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[274]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11581,7 +12977,7 @@ func (x *TargetActionInputRoot_Value) String() string {
 func (*TargetActionInputRoot_Value) ProtoMessage() {}
 
 func (x *TargetActionInputRoot_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[247]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[274]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11594,7 +12990,7 @@ func (x *TargetActionInputRoot_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionInputRoot_Value.ProtoReflect.Descriptor instead.
 func (*TargetActionInputRoot_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{80, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{86, 1}
 }
 
 func (x *TargetActionInputRoot_Value) GetInputRootReference() *filesystem.DirectoryReference {
@@ -11613,7 +13009,7 @@ type TargetActionResult_Key struct {
 
 func (x *TargetActionResult_Key) Reset() {
 	*x = TargetActionResult_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[248]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[275]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11625,7 +13021,7 @@ func (x *TargetActionResult_Key) String() string {
 func (*TargetActionResult_Key) ProtoMessage() {}
 
 func (x *TargetActionResult_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[248]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[275]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11638,7 +13034,7 @@ func (x *TargetActionResult_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionResult_Key.ProtoReflect.Descriptor instead.
 func (*TargetActionResult_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{81, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{87, 0}
 }
 
 func (x *TargetActionResult_Key) GetId() *TargetActionId {
@@ -11657,7 +13053,7 @@ type TargetActionResult_Value struct {
 
 func (x *TargetActionResult_Value) Reset() {
 	*x = TargetActionResult_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[249]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[276]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11669,7 +13065,7 @@ func (x *TargetActionResult_Value) String() string {
 func (*TargetActionResult_Value) ProtoMessage() {}
 
 func (x *TargetActionResult_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[249]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[276]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11682,7 +13078,7 @@ func (x *TargetActionResult_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetActionResult_Value.ProtoReflect.Descriptor instead.
 func (*TargetActionResult_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{81, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{87, 1}
 }
 
 func (x *TargetActionResult_Value) GetOutputRoot() *filesystem.DirectoryContents {
@@ -11702,7 +13098,7 @@ type TargetCompletion_Key struct {
 
 func (x *TargetCompletion_Key) Reset() {
 	*x = TargetCompletion_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[250]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[277]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11714,7 +13110,7 @@ func (x *TargetCompletion_Key) String() string {
 func (*TargetCompletion_Key) ProtoMessage() {}
 
 func (x *TargetCompletion_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[250]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[277]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11727,7 +13123,7 @@ func (x *TargetCompletion_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetCompletion_Key.ProtoReflect.Descriptor instead.
 func (*TargetCompletion_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{82, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{88, 0}
 }
 
 func (x *TargetCompletion_Key) GetLabel() string {
@@ -11745,26 +13141,192 @@ func (x *TargetCompletion_Key) GetConfigurationReference() *core.DecodableRefere
 }
 
 type TargetCompletion_Value struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	RootDirectory *filesystem.DirectoryContents `protobuf:"bytes,1,opt,name=root_directory,json=rootDirectory,proto3" json:"root_directory,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TargetCompletion_Value) Reset() {
 	*x = TargetCompletion_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[251]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[278]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetCompletion_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetCompletion_Value) ProtoMessage() {}
+
+func (x *TargetCompletion_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[278]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetCompletion_Value.ProtoReflect.Descriptor instead.
+func (*TargetCompletion_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{88, 1}
+}
+
+func (x *TargetCompletion_Value) GetRootDirectory() *filesystem.DirectoryContents {
+	if x != nil {
+		return x.RootDirectory
+	}
+	return nil
+}
+
+type TargetExecutable_Key struct {
+	state                  protoimpl.MessageState   `protogen:"open.v1"`
+	Label                  string                   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationReference *core.DecodableReference `protobuf:"bytes,2,opt,name=configuration_reference,json=configurationReference,proto3" json:"configuration_reference,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TargetExecutable_Key) Reset() {
+	*x = TargetExecutable_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[279]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetExecutable_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetExecutable_Key) ProtoMessage() {}
+
+func (x *TargetExecutable_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[279]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetExecutable_Key.ProtoReflect.Descriptor instead.
+func (*TargetExecutable_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{89, 0}
+}
+
+func (x *TargetExecutable_Key) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TargetExecutable_Key) GetConfigurationReference() *core.DecodableReference {
+	if x != nil {
+		return x.ConfigurationReference
+	}
+	return nil
+}
+
+type TargetExecutable_Value struct {
+	state                protoimpl.MessageState                        `protogen:"open.v1"`
+	RootDirectory        *filesystem.DirectoryContents                 `protobuf:"bytes,1,opt,name=root_directory,json=rootDirectory,proto3" json:"root_directory,omitempty"`
+	ExecutablePath       string                                        `protobuf:"bytes,2,opt,name=executable_path,json=executablePath,proto3" json:"executable_path,omitempty"`
+	Environment          []*TargetExecutable_Value_EnvironmentVariable `protobuf:"bytes,3,rep,name=environment,proto3" json:"environment,omitempty"`
+	InheritedEnvironment []string                                      `protobuf:"bytes,4,rep,name=inherited_environment,json=inheritedEnvironment,proto3" json:"inherited_environment,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TargetExecutable_Value) Reset() {
+	*x = TargetExecutable_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[280]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetExecutable_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetExecutable_Value) ProtoMessage() {}
+
+func (x *TargetExecutable_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[280]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetExecutable_Value.ProtoReflect.Descriptor instead.
+func (*TargetExecutable_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{89, 1}
+}
+
+func (x *TargetExecutable_Value) GetRootDirectory() *filesystem.DirectoryContents {
+	if x != nil {
+		return x.RootDirectory
+	}
+	return nil
+}
+
+func (x *TargetExecutable_Value) GetExecutablePath() string {
+	if x != nil {
+		return x.ExecutablePath
+	}
+	return ""
+}
+
+func (x *TargetExecutable_Value) GetEnvironment() []*TargetExecutable_Value_EnvironmentVariable {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+func (x *TargetExecutable_Value) GetInheritedEnvironment() []string {
+	if x != nil {
+		return x.InheritedEnvironment
+	}
+	return nil
+}
+
+type TargetExecutable_Value_EnvironmentVariable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetExecutable_Value_EnvironmentVariable) Reset() {
+	*x = TargetExecutable_Value_EnvironmentVariable{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[281]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TargetCompletion_Value) String() string {
+func (x *TargetExecutable_Value_EnvironmentVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TargetCompletion_Value) ProtoMessage() {}
+func (*TargetExecutable_Value_EnvironmentVariable) ProtoMessage() {}
 
-func (x *TargetCompletion_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[251]
+func (x *TargetExecutable_Value_EnvironmentVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[281]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11775,9 +13337,23 @@ func (x *TargetCompletion_Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TargetCompletion_Value.ProtoReflect.Descriptor instead.
-func (*TargetCompletion_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{82, 1}
+// Deprecated: Use TargetExecutable_Value_EnvironmentVariable.ProtoReflect.Descriptor instead.
+func (*TargetExecutable_Value_EnvironmentVariable) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{89, 1, 0}
+}
+
+func (x *TargetExecutable_Value_EnvironmentVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TargetExecutable_Value_EnvironmentVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
 type TargetPatternExpansion_Key struct {
@@ -11790,7 +13366,7 @@ type TargetPatternExpansion_Key struct {
 
 func (x *TargetPatternExpansion_Key) Reset() {
 	*x = TargetPatternExpansion_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[252]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[282]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11802,7 +13378,7 @@ func (x *TargetPatternExpansion_Key) String() string {
 func (*TargetPatternExpansion_Key) ProtoMessage() {}
 
 func (x *TargetPatternExpansion_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[252]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[282]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11815,7 +13391,7 @@ func (x *TargetPatternExpansion_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetPatternExpansion_Key.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{83, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{90, 0}
 }
 
 func (x *TargetPatternExpansion_Key) GetTargetPattern() string {
@@ -11841,7 +13417,7 @@ type TargetPatternExpansion_Value struct {
 
 func (x *TargetPatternExpansion_Value) Reset() {
 	*x = TargetPatternExpansion_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[253]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[283]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11853,7 +13429,7 @@ func (x *TargetPatternExpansion_Value) String() string {
 func (*TargetPatternExpansion_Value) ProtoMessage() {}
 
 func (x *TargetPatternExpansion_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[253]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[283]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11866,7 +13442,7 @@ func (x *TargetPatternExpansion_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetPatternExpansion_Value.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{83, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{90, 1}
 }
 
 func (x *TargetPatternExpansion_Value) GetTargetLabels() []*TargetPatternExpansion_Value_TargetLabel {
@@ -11889,7 +13465,7 @@ type TargetPatternExpansion_Value_TargetLabel struct {
 
 func (x *TargetPatternExpansion_Value_TargetLabel) Reset() {
 	*x = TargetPatternExpansion_Value_TargetLabel{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[254]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[284]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11901,7 +13477,7 @@ func (x *TargetPatternExpansion_Value_TargetLabel) String() string {
 func (*TargetPatternExpansion_Value_TargetLabel) ProtoMessage() {}
 
 func (x *TargetPatternExpansion_Value_TargetLabel) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[254]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[284]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11914,7 +13490,7 @@ func (x *TargetPatternExpansion_Value_TargetLabel) ProtoReflect() protoreflect.M
 
 // Deprecated: Use TargetPatternExpansion_Value_TargetLabel.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion_Value_TargetLabel) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{83, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{90, 1, 0}
 }
 
 func (x *TargetPatternExpansion_Value_TargetLabel) GetLevel() isTargetPatternExpansion_Value_TargetLabel_Level {
@@ -11969,7 +13545,7 @@ type TargetPatternExpansion_Value_TargetLabel_Parent struct {
 
 func (x *TargetPatternExpansion_Value_TargetLabel_Parent) Reset() {
 	*x = TargetPatternExpansion_Value_TargetLabel_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[255]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[285]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11981,7 +13557,7 @@ func (x *TargetPatternExpansion_Value_TargetLabel_Parent) String() string {
 func (*TargetPatternExpansion_Value_TargetLabel_Parent) ProtoMessage() {}
 
 func (x *TargetPatternExpansion_Value_TargetLabel_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[255]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[285]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11994,7 +13570,7 @@ func (x *TargetPatternExpansion_Value_TargetLabel_Parent) ProtoReflect() protore
 
 // Deprecated: Use TargetPatternExpansion_Value_TargetLabel_Parent.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion_Value_TargetLabel_Parent) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{83, 1, 0, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{90, 1, 0, 0}
 }
 
 func (x *TargetPatternExpansion_Value_TargetLabel_Parent) GetReference() *core.DecodableReference {
@@ -12004,6 +13580,142 @@ func (x *TargetPatternExpansion_Value_TargetLabel_Parent) GetReference() *core.D
 	return nil
 }
 
+type TestResult_Key struct {
+	state                  protoimpl.MessageState   `protogen:"open.v1"`
+	Label                  string                   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationReference *core.DecodableReference `protobuf:"bytes,2,opt,name=configuration_reference,json=configurationReference,proto3" json:"configuration_reference,omitempty"`
+	RunNumber              uint32                   `protobuf:"varint,3,opt,name=run_number,json=runNumber,proto3" json:"run_number,omitempty"`
+	TestFilter             string                   `protobuf:"bytes,4,opt,name=test_filter,json=testFilter,proto3" json:"test_filter,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestResult_Key) Reset() {
+	*x = TestResult_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[286]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResult_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResult_Key) ProtoMessage() {}
+
+func (x *TestResult_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[286]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResult_Key.ProtoReflect.Descriptor instead.
+func (*TestResult_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{91, 0}
+}
+
+func (x *TestResult_Key) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TestResult_Key) GetConfigurationReference() *core.DecodableReference {
+	if x != nil {
+		return x.ConfigurationReference
+	}
+	return nil
+}
+
+func (x *TestResult_Key) GetRunNumber() uint32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *TestResult_Key) GetTestFilter() string {
+	if x != nil {
+		return x.TestFilter
+	}
+	return ""
+}
+
+type TestResult_Value struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	ExitCode      int64                         `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Stdout        *filesystem.FileContents      `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        *filesystem.FileContents      `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	OutputRoot    *filesystem.DirectoryContents `protobuf:"bytes,4,opt,name=output_root,json=outputRoot,proto3" json:"output_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestResult_Value) Reset() {
+	*x = TestResult_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[287]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResult_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResult_Value) ProtoMessage() {}
+
+func (x *TestResult_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[287]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResult_Value.ProtoReflect.Descriptor instead.
+func (*TestResult_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{91, 1}
+}
+
+func (x *TestResult_Value) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *TestResult_Value) GetStdout() *filesystem.FileContents {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *TestResult_Value) GetStderr() *filesystem.FileContents {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *TestResult_Value) GetOutputRoot() *filesystem.DirectoryContents {
+	if x != nil {
+		return x.OutputRoot
+	}
+	return nil
+}
+
 type ModuleExtension_User struct {
 	state          protoimpl.MessageState      `protogen:"open.v1"`
 	ModuleInstance string                      `protobuf:"bytes,1,opt,name=module_instance,json=moduleInstance,proto3" json:"module_instance,omitempty"`
@@ -12015,7 +13727,7 @@ type ModuleExtension_User struct {
 
 func (x *ModuleExtension_User) Reset() {
 	*x = ModuleExtension_User{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[256]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[288]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12027,7 +13739,7 @@ func (x *ModuleExtension_User) String() string {
 func (*ModuleExtension_User) ProtoMessage() {}
 
 func (x *ModuleExtension_User) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[256]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[288]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12040,7 +13752,7 @@ func (x *ModuleExtension_User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtension_User.ProtoReflect.Descriptor instead.
 func (*ModuleExtension_User) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{84, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{92, 0}
 }
 
 func (x *ModuleExtension_User) GetModuleInstance() string {
@@ -12074,7 +13786,7 @@ type ModuleExtension_TagClass struct {
 
 func (x *ModuleExtension_TagClass) Reset() {
 	*x = ModuleExtension_TagClass{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[257]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[289]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12086,7 +13798,7 @@ func (x *ModuleExtension_TagClass) String() string {
 func (*ModuleExtension_TagClass) ProtoMessage() {}
 
 func (x *ModuleExtension_TagClass) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[257]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[289]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12099,7 +13811,7 @@ func (x *ModuleExtension_TagClass) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtension_TagClass.ProtoReflect.Descriptor instead.
 func (*ModuleExtension_TagClass) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{84, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{92, 1}
 }
 
 func (x *ModuleExtension_TagClass) GetName() string {
@@ -12126,7 +13838,7 @@ type ModuleExtension_Tag struct {
 
 func (x *ModuleExtension_Tag) Reset() {
 	*x = ModuleExtension_Tag{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[258]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[290]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12138,7 +13850,7 @@ func (x *ModuleExtension_Tag) String() string {
 func (*ModuleExtension_Tag) ProtoMessage() {}
 
 func (x *ModuleExtension_Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[258]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[290]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12151,7 +13863,7 @@ func (x *ModuleExtension_Tag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtension_Tag.ProtoReflect.Descriptor instead.
 func (*ModuleExtension_Tag) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{84, 2}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{92, 2}
 }
 
 func (x *ModuleExtension_Tag) GetAttrs() *starlark.Struct_Fields {
@@ -12177,7 +13889,7 @@ type RepositoryRuleObject_Key struct {
 
 func (x *RepositoryRuleObject_Key) Reset() {
 	*x = RepositoryRuleObject_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[259]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[291]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12189,7 +13901,7 @@ func (x *RepositoryRuleObject_Key) String() string {
 func (*RepositoryRuleObject_Key) ProtoMessage() {}
 
 func (x *RepositoryRuleObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[259]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[291]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12202,7 +13914,7 @@ func (x *RepositoryRuleObject_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepositoryRuleObject_Key.ProtoReflect.Descriptor instead.
 func (*RepositoryRuleObject_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{85, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{93, 0}
 }
 
 func (x *RepositoryRuleObject_Key) GetIdentifier() string {
@@ -12212,6 +13924,86 @@ func (x *RepositoryRuleObject_Key) GetIdentifier() string {
 	return ""
 }
 
+type UpdatedModuleLockfile_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatedModuleLockfile_Key) Reset() {
+	*x = UpdatedModuleLockfile_Key{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[292]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatedModuleLockfile_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatedModuleLockfile_Key) ProtoMessage() {}
+
+func (x *UpdatedModuleLockfile_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[292]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatedModuleLockfile_Key.ProtoReflect.Descriptor instead.
+func (*UpdatedModuleLockfile_Key) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{94, 0}
+}
+
+type UpdatedModuleLockfile_Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []byte                 `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatedModuleLockfile_Value) Reset() {
+	*x = UpdatedModuleLockfile_Value{}
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[293]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatedModuleLockfile_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatedModuleLockfile_Value) ProtoMessage() {}
+
+func (x *UpdatedModuleLockfile_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[293]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatedModuleLockfile_Value.ProtoReflect.Descriptor instead.
+func (*UpdatedModuleLockfile_Value) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{94, 1}
+}
+
+func (x *UpdatedModuleLockfile_Value) GetContents() []byte {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
 type UsedModuleExtension_Key struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ModuleExtension string                 `protobuf:"bytes,1,opt,name=module_extension,json=moduleExtension,proto3" json:"module_extension,omitempty"`
@@ -12221,7 +14013,7 @@ type UsedModuleExtension_Key struct {
 
 func (x *UsedModuleExtension_Key) Reset() {
 	*x = UsedModuleExtension_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[260]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[294]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12233,7 +14025,7 @@ func (x *UsedModuleExtension_Key) String() string {
 func (*UsedModuleExtension_Key) ProtoMessage() {}
 
 func (x *UsedModuleExtension_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[260]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[294]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12246,7 +14038,7 @@ func (x *UsedModuleExtension_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtension_Key.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtension_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{86, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{95, 0}
 }
 
 func (x *UsedModuleExtension_Key) GetModuleExtension() string {
@@ -12265,7 +14057,7 @@ type UsedModuleExtension_Value struct {
 
 func (x *UsedModuleExtension_Value) Reset() {
 	*x = UsedModuleExtension_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[261]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[295]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12277,7 +14069,7 @@ func (x *UsedModuleExtension_Value) String() string {
 func (*UsedModuleExtension_Value) ProtoMessage() {}
 
 func (x *UsedModuleExtension_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[261]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[295]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12290,7 +14082,7 @@ func (x *UsedModuleExtension_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtension_Value.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtension_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{86, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{95, 1}
 }
 
 func (x *UsedModuleExtension_Value) GetModuleExtension() *ModuleExtension {
@@ -12308,7 +14100,7 @@ type UsedModuleExtensions_Key struct {
 
 func (x *UsedModuleExtensions_Key) Reset() {
 	*x = UsedModuleExtensions_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[262]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[296]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12320,7 +14112,7 @@ func (x *UsedModuleExtensions_Key) String() string {
 func (*UsedModuleExtensions_Key) ProtoMessage() {}
 
 func (x *UsedModuleExtensions_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[262]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[296]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12333,7 +14125,7 @@ func (x *UsedModuleExtensions_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtensions_Key.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtensions_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{87, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{96, 0}
 }
 
 type UsedModuleExtensions_Value struct {
@@ -12345,7 +14137,7 @@ type UsedModuleExtensions_Value struct {
 
 func (x *UsedModuleExtensions_Value) Reset() {
 	*x = UsedModuleExtensions_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[263]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[297]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12357,7 +14149,7 @@ func (x *UsedModuleExtensions_Value) String() string {
 func (*UsedModuleExtensions_Value) ProtoMessage() {}
 
 func (x *UsedModuleExtensions_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[263]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[297]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12370,7 +14162,7 @@ func (x *UsedModuleExtensions_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtensions_Value.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtensions_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{87, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{96, 1}
 }
 
 func (x *UsedModuleExtensions_Value) GetModuleExtensions() []*ModuleExtension {
@@ -12390,7 +14182,7 @@ type UserDefinedTransition_Key struct {
 
 func (x *UserDefinedTransition_Key) Reset() {
 	*x = UserDefinedTransition_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[264]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[298]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12402,7 +14194,7 @@ func (x *UserDefinedTransition_Key) String() string {
 func (*UserDefinedTransition_Key) ProtoMessage() {}
 
 func (x *UserDefinedTransition_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[264]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[298]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12415,7 +14207,7 @@ func (x *UserDefinedTransition_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDefinedTransition_Key.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{88, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{97, 0}
 }
 
 func (x *UserDefinedTransition_Key) GetTransitionIdentifier() string {
@@ -12445,7 +14237,7 @@ type UserDefinedTransition_Value struct {
 
 func (x *UserDefinedTransition_Value) Reset() {
 	*x = UserDefinedTransition_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[265]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[299]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12457,7 +14249,7 @@ func (x *UserDefinedTransition_Value) String() string {
 func (*UserDefinedTransition_Value) ProtoMessage() {}
 
 func (x *UserDefinedTransition_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[265]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[299]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12470,7 +14262,7 @@ func (x *UserDefinedTransition_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDefinedTransition_Value.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{88, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{97, 1}
 }
 
 func (x *UserDefinedTransition_Value) GetResult() isUserDefinedTransition_Value_Result {
@@ -12523,7 +14315,7 @@ type UserDefinedTransition_Value_Success struct {
 
 func (x *UserDefinedTransition_Value_Success) Reset() {
 	*x = UserDefinedTransition_Value_Success{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[266]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[300]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12535,7 +14327,7 @@ func (x *UserDefinedTransition_Value_Success) String() string {
 func (*UserDefinedTransition_Value_Success) ProtoMessage() {}
 
 func (x *UserDefinedTransition_Value_Success) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[266]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[300]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12548,7 +14340,7 @@ func (x *UserDefinedTransition_Value_Success) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UserDefinedTransition_Value_Success.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition_Value_Success) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{88, 1, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{97, 1, 0}
 }
 
 func (x *UserDefinedTransition_Value_Success) GetEntries() []*UserDefinedTransition_Value_Success_Entry {
@@ -12568,7 +14360,7 @@ type UserDefinedTransition_Value_Success_Entry struct {
 
 func (x *UserDefinedTransition_Value_Success_Entry) Reset() {
 	*x = UserDefinedTransition_Value_Success_Entry{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[267]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[301]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12580,7 +14372,7 @@ func (x *UserDefinedTransition_Value_Success_Entry) String() string {
 func (*UserDefinedTransition_Value_Success_Entry) ProtoMessage() {}
 
 func (x *UserDefinedTransition_Value_Success_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[267]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[301]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12593,7 +14385,7 @@ func (x *UserDefinedTransition_Value_Success_Entry) ProtoReflect() protoreflect.
 
 // Deprecated: Use UserDefinedTransition_Value_Success_Entry.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition_Value_Success_Entry) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{88, 1, 0, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{97, 1, 0, 0}
 }
 
 func (x *UserDefinedTransition_Value_Success_Entry) GetKey() string {
@@ -12623,7 +14415,7 @@ type VisibleTarget_Key struct {
 
 func (x *VisibleTarget_Key) Reset() {
 	*x = VisibleTarget_Key{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[268]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[302]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12635,7 +14427,7 @@ func (x *VisibleTarget_Key) String() string {
 func (*VisibleTarget_Key) ProtoMessage() {}
 
 func (x *VisibleTarget_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[268]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[302]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12648,7 +14440,7 @@ func (x *VisibleTarget_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisibleTarget_Key.ProtoReflect.Descriptor instead.
 func (*VisibleTarget_Key) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{89, 0}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{98, 0}
 }
 
 func (x *VisibleTarget_Key) GetFromPackage() string {
@@ -12695,7 +14487,7 @@ type VisibleTarget_Value struct {
 
 func (x *VisibleTarget_Value) Reset() {
 	*x = VisibleTarget_Value{}
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[269]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[303]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12707,7 +14499,7 @@ func (x *VisibleTarget_Value) String() string {
 func (*VisibleTarget_Value) ProtoMessage() {}
 
 func (x *VisibleTarget_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[269]
+	mi := &file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[303]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12720,7 +14512,7 @@ func (x *VisibleTarget_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisibleTarget_Value.ProtoReflect.Descriptor instead.
 func (*VisibleTarget_Value) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{89, 1}
+	return file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{98, 1}
 }
 
 func (x *VisibleTarget_Value) GetLabel() string {
@@ -12742,7 +14534,22 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x05Value\x12N\n" +
 	"\x0faction_encoders\x18\x01 \x03(\v2%.bonanza.model.encoding.BinaryEncoderR\x0eactionEncoders\"\x16\n" +
 	"\rActionReaders\x1a\x05\n" +
-	"\x03Key\"\x88\x02\n" +
+	"\x03Key\"\xce\x03\n" +
+	"\x11ActionQueryResult\x1a%\n" +
+	"\x03Key\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x1a\x91\x03\n" +
+	"\x05Value\x12P\n" +
+	"\aactions\x18\x01 \x03(\v26.bonanza.model.analysis.ActionQueryResult.Value.ActionR\aactions\x1a\xb5\x02\n" +
+	"\x06Action\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x127\n" +
+	"\x17configuration_component\x18\x02 \x01(\tR\x16configurationComponent\x12\x1a\n" +
+	"\bmnemonic\x18\x03 \x01(\tR\bmnemonic\x12\x1c\n" +
+	"\targuments\x18\x04 \x03(\tR\targuments\x12p\n" +
+	"\x15environment_variables\x18\x05 \x03(\v2;.bonanza.model.command.EnvironmentVariableList.Element.LeafR\x14environmentVariables\x12\x16\n" +
+	"\x06inputs\x18\x06 \x03(\tR\x06inputs\x12\x18\n" +
+	"\aoutputs\x18\a \x03(\tR\aoutputs\"\x88\x02\n" +
 	"\x0eExecuteRequest\x127\n" +
 	"\x18platform_pkix_public_key\x18\x01 \x01(\fR\x15platformPkixPublicKey\x12u\n" +
 	"\x10action_reference\x18\x02 \x01(\v2&.bonanza.model.core.DecodableReferenceB\"\xea\xd7 \x1e\x12\x1cbonanza.model.command.ActionR\x0factionReference\x12F\n" +
@@ -12786,10 +14593,39 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x13BuiltinsModuleNames\x1a\x05\n" +
 	"\x03Key\x1a;\n" +
 	"\x05Value\x122\n" +
-	"\x15builtins_module_names\x18\x01 \x03(\tR\x13builtinsModuleNames\"\x1d\n" +
-	"\vBuildResult\x1a\x05\n" +
-	"\x03Key\x1a\a\n" +
-	"\x05Value\"\xa9\x01\n" +
+	"\x15builtins_module_names\x18\x01 \x03(\tR\x13builtinsModuleNames\"\x99\t\n" +
+	"\vBuildResult\x1a\xee\x01\n" +
+	"\x03Key\x12_\n" +
+	"\x0ftest_parameters\x18\x01 \x01(\v26.bonanza.model.analysis.BuildResult.Key.TestParametersR\x0etestParameters\x12/\n" +
+	"\x13include_executables\x18\x02 \x01(\bR\x12includeExecutables\x1aU\n" +
+	"\x0eTestParameters\x12\"\n" +
+	"\rruns_per_test\x18\x01 \x01(\rR\vrunsPerTest\x12\x1f\n" +
+	"\vtest_filter\x18\x02 \x01(\tR\n" +
+	"testFilter\x1a\x98\a\n" +
+	"\x05Value\x12i\n" +
+	"\x12target_completions\x18\x01 \x03(\v2:.bonanza.model.analysis.BuildResult.Value.TargetCompletionR\x11targetCompletions\x12W\n" +
+	"\ftest_results\x18\x02 \x03(\v24.bonanza.model.analysis.BuildResult.Value.TestResultR\vtestResults\x12V\n" +
+	"\vexecutables\x18\x03 \x03(\v24.bonanza.model.analysis.BuildResult.Value.ExecutableR\vexecutables\x12'\n" +
+	"\x0fmodule_lockfile\x18\x04 \x01(\fR\x0emoduleLockfile\x1a\xb5\x01\n" +
+	"\x10TargetCompletion\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x127\n" +
+	"\x17configuration_component\x18\x02 \x01(\tR\x16configurationComponent\x12R\n" +
+	"\x0eroot_directory\x18\x03 \x01(\v2+.bonanza.model.filesystem.DirectoryContentsR\rrootDirectory\x1a\xbc\x01\n" +
+	"\n" +
+	"TestResult\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x127\n" +
+	"\x17configuration_component\x18\x02 \x01(\tR\x16configurationComponent\x12\x1d\n" +
+	"\n" +
+	"run_number\x18\x03 \x01(\rR\trunNumber\x12@\n" +
+	"\x06result\x18\x04 \x01(\v2(.bonanza.model.analysis.TestResult.ValueR\x06result\x1a\xd2\x01\n" +
+	"\n" +
+	"Executable\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x127\n" +
+	"\x17configuration_component\x18\x02 \x01(\tR\x16configurationComponent\x12N\n" +
+	"\n" +
+	"executable\x18\x03 \x01(\v2..bonanza.model.analysis.TargetExecutable.ValueR\n" +
+	"executable\x12%\n" +
+	"\x0etarget_pattern\x18\x04 \x01(\tR\rtargetPattern\"\xa9\x01\n" +
 	"\x11CanonicalRepoName\x1a_\n" +
 	"\x03Key\x12.\n" +
 	"\x13from_canonical_repo\x18\x01 \x01(\tR\x11fromCanonicalRepo\x12(\n" +
@@ -12898,7 +14734,7 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x0erunfiles_files\x18\x02 \x03(\v2$.bonanza.model.starlark.List.ElementR\rrunfilesFiles\x12Q\n" +
 	"\x11runfiles_symlinks\x18\x03 \x03(\v2$.bonanza.model.starlark.List.ElementR\x10runfilesSymlinks\x12Z\n" +
 	"\x16runfiles_root_symlinks\x18\x04 \x03(\v2$.bonanza.model.starlark.List.ElementR\x14runfilesRootSymlinksB\a\n" +
-	"\x05level\"\xbd\x04\n" +
+	"\x05level\"\xd9\x04\n" +
 	"\x16TargetActionDefinition\x12<\n" +
 	"\x06inputs\x18\x01 \x03(\v2$.bonanza.model.starlark.List.ElementR\x06inputs\x12@\n" +
 	"\x05tools\x18\x02 \x03(\v2*.bonanza.model.analysis.FilesToRunProviderR\x05tools\x127\n" +
@@ -12907,7 +14743,8 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x13output_path_pattern\x18\x05 \x01(\v2\".bonanza.model.command.PathPatternR\x11outputPathPattern\x12]\n" +
 	"\x18initial_output_directory\x18\x06 \x01(\v2#.bonanza.model.filesystem.DirectoryR\x16initialOutputDirectory\x12H\n" +
 	"\x03env\x18\a \x03(\v26.bonanza.model.command.EnvironmentVariableList.ElementR\x03env\x121\n" +
-	"\x15use_default_shell_env\x18\b \x01(\bR\x12useDefaultShellEnv\"\xfc\x05\n" +
+	"\x15use_default_shell_env\x18\b \x01(\bR\x12useDefaultShellEnv\x12\x1a\n" +
+	"\bmnemonic\x18\t \x01(\tR\bmnemonic\"\xfc\x05\n" +
 	"\x16TargetOutputDefinition\x12\x1d\n" +
 	"\taction_id\x18\x02 \x01(\fH\x00R\bactionId\x12h\n" +
 	"\x0fexpand_template\x18\x03 \x01(\v2=.bonanza.model.analysis.TargetOutputDefinition.ExpandTemplateH\x00R\x0eexpandTemplate\x12g\n" +
@@ -12923,7 +14760,20 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\aSymlink\x124\n" +
 	"\x06target\x18\x01 \x01(\v2\x1c.bonanza.model.starlark.FileR\x06target\x12#\n" +
 	"\ris_executable\x18\x02 \x01(\bR\fisExecutableB\b\n" +
-	"\x06source\"\xa7\v\n" +
+	"\x06source\"\xe6\x02\n" +
+	"\x15ConfiguredQueryResult\x1aV\n" +
+	"\x03Key\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x12/\n" +
+	"\x13starlark_expression\x18\x02 \x01(\tR\x12starlarkExpression\x1a\xf4\x01\n" +
+	"\x05Value\x12T\n" +
+	"\atargets\x18\x01 \x03(\v2:.bonanza.model.analysis.ConfiguredQueryResult.Value.TargetR\atargets\x1a\x94\x01\n" +
+	"\x06Target\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x127\n" +
+	"\x17configuration_component\x18\x02 \x01(\tR\x16configurationComponent\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12'\n" +
+	"\x0fstarlark_output\x18\x04 \x01(\tR\x0estarlarkOutput\"\xa7\v\n" +
 	"\x10ConfiguredTarget\x1a\xb0\x01\n" +
 	"\x03Key\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\x92\x01\n" +
@@ -13022,7 +14872,13 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x0elist_reference\x18\x01 \x01(\v2&.bonanza.model.core.DecodableReferenceB)\xea\xd7 %\x1a#bonanza.model.starlark.List.ElementR\rlistReference\x12R\n" +
 	"\x10directory_layout\x18\x02 \x01(\x0e2'.bonanza.model.analysis.DirectoryLayoutR\x0fdirectoryLayout\x1a[\n" +
 	"\x05Value\x12R\n" +
-	"\x0eroot_directory\x18\x01 \x01(\v2+.bonanza.model.filesystem.DirectoryContentsR\rrootDirectory\"\xa0\x01\n" +
+	"\x0eroot_directory\x18\x01 \x01(\v2+.bonanza.model.filesystem.DirectoryContentsR\rrootDirectory\"\xe0\x01\n" +
+	"\x15GitRepositoryContents\x1a=\n" +
+	"\x03Key\x126\n" +
+	"\x06target\x18\x01 \x01(\v2\x1e.bonanza.model.fetch.GitTargetR\x06target\x1a\x87\x01\n" +
+	"\x05Value\x12f\n" +
+	"\x18root_directory_reference\x18\x01 \x01(\v2,.bonanza.model.filesystem.DirectoryReferenceR\x16rootDirectoryReference\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\"\xa0\x01\n" +
 	"\x04Glob\x1aj\n" +
 	"\x03Key\x12\x18\n" +
 	"\apackage\x18\x01 \x01(\tR\apackage\x12\x18\n" +
@@ -13063,7 +14919,12 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x12ModuleRegistryUrls\x1a\x05\n" +
 	"\x03Key\x1a,\n" +
 	"\x05Value\x12#\n" +
-	"\rregistry_urls\x18\x01 \x03(\tR\fregistryUrls\"\x87\x02\n" +
+	"\rregistry_urls\x18\x01 \x03(\tR\fregistryUrls\"\xde\x01\n" +
+	"\x0eModuleLockfile\x1a\x05\n" +
+	"\x03Key\x1a\xc4\x01\n" +
+	"\x05Value\x12_\n" +
+	"\fuse_lockfile\x18\x01 \x01(\v2<.bonanza.model.analysis.BuildSpecification.Value.UseLockfileR\vuseLockfile\x12Z\n" +
+	"\x14registry_file_hashes\x18\x02 \x03(\v2(.bonanza.model.analysis.RegistryFileHashR\x12registryFileHashes\"\x87\x02\n" +
 	"\x11ModuleRepoMapping\x1a.\n" +
 	"\x03Key\x12'\n" +
 	"\x0fmodule_instance\x18\x01 \x01(\tR\x0emoduleInstance\x1a\xc1\x01\n" +
@@ -13102,17 +14963,21 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12/\n" +
 	"\x13compatibility_level\x18\x03 \x01(\x05R\x12compatibilityLevel\x12!\n" +
-	"\fregistry_url\x18\x04 \x01(\tR\vregistryUrl\"n\n" +
+	"\fregistry_url\x18\x04 \x01(\tR\vregistryUrl\"<\n" +
+	"\x10RegistryFileHash\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06sha256\x18\x02 \x01(\fR\x06sha256\"n\n" +
 	"\x14ModuleFinalBuildList\x1a\x05\n" +
 	"\x03Key\x1aO\n" +
 	"\x05Value\x12F\n" +
 	"\n" +
-	"build_list\x18\x01 \x03(\v2'.bonanza.model.analysis.BuildListModuleR\tbuildList\"n\n" +
+	"build_list\x18\x01 \x03(\v2'.bonanza.model.analysis.BuildListModuleR\tbuildList\"\xcb\x01\n" +
 	"\x14ModuleRoughBuildList\x1a\x05\n" +
-	"\x03Key\x1aO\n" +
+	"\x03Key\x1a\xab\x01\n" +
 	"\x05Value\x12F\n" +
 	"\n" +
-	"build_list\x18\x01 \x03(\v2'.bonanza.model.analysis.BuildListModuleR\tbuildList\"E\n" +
+	"build_list\x18\x01 \x03(\v2'.bonanza.model.analysis.BuildListModuleR\tbuildList\x12Z\n" +
+	"\x14registry_file_hashes\x18\x02 \x03(\v2(.bonanza.model.analysis.RegistryFileHashR\x12registryFileHashes\"E\n" +
 	"\x13OverridesListModule\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bversions\x18\x02 \x03(\tR\bversions\"\x81\x01\n" +
@@ -13170,7 +15035,25 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\fbase_package\x18\x01 \x01(\tR\vbasePackage\x1a}\n" +
 	"\x05Value\x125\n" +
 	"\x17package_at_base_package\x18\x01 \x01(\bR\x14packageAtBasePackage\x12=\n" +
-	"\x1bpackages_below_base_package\x18\x02 \x03(\tR\x18packagesBelowBasePackage\"<\n" +
+	"\x1bpackages_below_base_package\x18\x02 \x03(\tR\x18packagesBelowBasePackage\"\x8b\x03\n" +
+	"\vQueryResult\x1a%\n" +
+	"\x03Key\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x1a\xd4\x02\n" +
+	"\x05Value\x12J\n" +
+	"\atargets\x18\x01 \x03(\v20.bonanza.model.analysis.QueryResult.Value.TargetR\atargets\x1aQ\n" +
+	"\tAttribute\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1a\n" +
+	"\bexplicit\x18\x03 \x01(\bR\bexplicit\x1a\xab\x01\n" +
+	"\x06Target\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12S\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v23.bonanza.model.analysis.QueryResult.Value.AttributeR\n" +
+	"attributes\x12\"\n" +
+	"\fdependencies\x18\x04 \x03(\tR\fdependencies\"<\n" +
 	"\n" +
 	"Constraint\x12\x18\n" +
 	"\asetting\x18\x01 \x01(\tR\asetting\x12\x14\n" +
@@ -13305,12 +15188,25 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\v2&.bonanza.model.analysis.TargetActionIdR\x02id\x1aU\n" +
 	"\x05Value\x12L\n" +
 	"\voutput_root\x18\x01 \x01(\v2+.bonanza.model.filesystem.DirectoryContentsR\n" +
-	"outputRoot\"\xce\x01\n" +
+	"outputRoot\"\xa2\x02\n" +
 	"\x10TargetCompletion\x1a\xb0\x01\n" +
 	"\x03Key\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\x92\x01\n" +
-	"\x17configuration_reference\x18\x02 \x01(\v2&.bonanza.model.core.DecodableReferenceB1\xea\xd7 -\x1a+bonanza.model.analysis.BuildSettingOverrideR\x16configurationReference\x1a\a\n" +
-	"\x05Value\"\x98\x04\n" +
+	"\x17configuration_reference\x18\x02 \x01(\v2&.bonanza.model.core.DecodableReferenceB1\xea\xd7 -\x1a+bonanza.model.analysis.BuildSettingOverrideR\x16configurationReference\x1a[\n" +
+	"\x05Value\x12R\n" +
+	"\x0eroot_directory\x18\x01 \x01(\v2+.bonanza.model.filesystem.DirectoryContentsR\rrootDirectory\"\xa8\x04\n" +
+	"\x10TargetExecutable\x1a\xb0\x01\n" +
+	"\x03Key\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x92\x01\n" +
+	"\x17configuration_reference\x18\x02 \x01(\v2&.bonanza.model.core.DecodableReferenceB1\xea\xd7 -\x1a+bonanza.model.analysis.BuildSettingOverrideR\x16configurationReference\x1a\xe0\x02\n" +
+	"\x05Value\x12R\n" +
+	"\x0eroot_directory\x18\x01 \x01(\v2+.bonanza.model.filesystem.DirectoryContentsR\rrootDirectory\x12'\n" +
+	"\x0fexecutable_path\x18\x02 \x01(\tR\x0eexecutablePath\x12d\n" +
+	"\venvironment\x18\x03 \x03(\v2B.bonanza.model.analysis.TargetExecutable.Value.EnvironmentVariableR\venvironment\x123\n" +
+	"\x15inherited_environment\x18\x04 \x03(\tR\x14inheritedEnvironment\x1a?\n" +
+	"\x13EnvironmentVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x98\x04\n" +
 	"\x16TargetPatternExpansion\x1ab\n" +
 	"\x03Key\x12%\n" +
 	"\x0etarget_pattern\x18\x01 \x01(\tR\rtargetPattern\x124\n" +
@@ -13322,7 +15218,22 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x06parent\x18\x02 \x01(\v2G.bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.ParentH\x00R\x06parent\x1a\x96\x01\n" +
 	"\x06Parent\x12\x8b\x01\n" +
 	"\treference\x18\x01 \x01(\v2&.bonanza.model.core.DecodableReferenceBE\xea\xd7 A\x1a?bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabelR\treferenceB\a\n" +
-	"\x05level\"\xe4\x03\n" +
+	"\x05level\"\xf4\x03\n" +
+	"\n" +
+	"TestResult\x1a\xf0\x01\n" +
+	"\x03Key\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x92\x01\n" +
+	"\x17configuration_reference\x18\x02 \x01(\v2&.bonanza.model.core.DecodableReferenceB1\xea\xd7 -\x1a+bonanza.model.analysis.BuildSettingOverrideR\x16configurationReference\x12\x1d\n" +
+	"\n" +
+	"run_number\x18\x03 \x01(\rR\trunNumber\x12\x1f\n" +
+	"\vtest_filter\x18\x04 \x01(\tR\n" +
+	"testFilter\x1a\xf2\x01\n" +
+	"\x05Value\x12\x1b\n" +
+	"\texit_code\x18\x01 \x01(\x03R\bexitCode\x12>\n" +
+	"\x06stdout\x18\x02 \x01(\v2&.bonanza.model.filesystem.FileContentsR\x06stdout\x12>\n" +
+	"\x06stderr\x18\x03 \x01(\v2&.bonanza.model.filesystem.FileContentsR\x06stderr\x12L\n" +
+	"\voutput_root\x18\x04 \x01(\v2+.bonanza.model.filesystem.DirectoryContentsR\n" +
+	"outputRoot\"\xe4\x03\n" +
 	"\x0fModuleExtension\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
@@ -13343,7 +15254,11 @@ const file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc = "" +
 	"\x03Key\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
-	"identifier\"\xa4\x01\n" +
+	"identifier\"C\n" +
+	"\x15UpdatedModuleLockfile\x1a\x05\n" +
+	"\x03Key\x1a#\n" +
+	"\x05Value\x12\x1a\n" +
+	"\bcontents\x18\x01 \x01(\fR\bcontents\"\xa4\x01\n" +
 	"\x13UsedModuleExtension\x1a0\n" +
 	"\x03Key\x12)\n" +
 	"\x10module_extension\x18\x01 \x01(\tR\x0fmoduleExtension\x1a[\n" +
@@ -13394,7 +15309,7 @@ func file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDescGZIP() []
 }
 
 var file_bonanza_build_pkg_proto_model_analysis_analysis_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 270)
+var file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 304)
 var file_bonanza_build_pkg_proto_model_analysis_analysis_proto_goTypes = []any{
 	(DirectoryLayout)(0),                                       // 0: bonanza.model.analysis.DirectoryLayout
 	(Args_Leaf_UseParamFile_Format)(0),                         // 1: bonanza.model.analysis.Args.Leaf.UseParamFile.Format
@@ -13402,469 +15317,530 @@ var file_bonanza_build_pkg_proto_model_analysis_analysis_proto_goTypes = []any{
 	(*ActionEncoderObject)(nil),                                // 3: bonanza.model.analysis.ActionEncoderObject
 	(*ActionEncoders)(nil),                                     // 4: bonanza.model.analysis.ActionEncoders
 	(*ActionReaders)(nil),                                      // 5: bonanza.model.analysis.ActionReaders
-	(*ExecuteRequest)(nil),                                     // 6: bonanza.model.analysis.ExecuteRequest
-	(*ActionResult)(nil),                                       // 7: bonanza.model.analysis.ActionResult
-	(*BuildSpecification)(nil),                                 // 8: bonanza.model.analysis.BuildSpecification
-	(*BuiltinsModuleNames)(nil),                                // 9: bonanza.model.analysis.BuiltinsModuleNames
-	(*BuildResult)(nil),                                        // 10: bonanza.model.analysis.BuildResult
-	(*CanonicalRepoName)(nil),                                  // 11: bonanza.model.analysis.CanonicalRepoName
-	(*CompatibleExecutionPlatforms)(nil),                       // 12: bonanza.model.analysis.CompatibleExecutionPlatforms
-	(*CompatibleToolchainsForType)(nil),                        // 13: bonanza.model.analysis.CompatibleToolchainsForType
-	(*CompiledBzlFile)(nil),                                    // 14: bonanza.model.analysis.CompiledBzlFile
-	(*CompiledBzlFileDecodedGlobals)(nil),                      // 15: bonanza.model.analysis.CompiledBzlFileDecodedGlobals
-	(*CompiledBzlFileFunctionFactory)(nil),                     // 16: bonanza.model.analysis.CompiledBzlFileFunctionFactory
-	(*CompiledBzlFileGlobal)(nil),                              // 17: bonanza.model.analysis.CompiledBzlFileGlobal
-	(*BuildSettingOverride)(nil),                               // 18: bonanza.model.analysis.BuildSettingOverride
-	(*Args)(nil),                                               // 19: bonanza.model.analysis.Args
-	(*FilesToRunProvider)(nil),                                 // 20: bonanza.model.analysis.FilesToRunProvider
-	(*TargetActionDefinition)(nil),                             // 21: bonanza.model.analysis.TargetActionDefinition
-	(*TargetOutputDefinition)(nil),                             // 22: bonanza.model.analysis.TargetOutputDefinition
-	(*ConfiguredTarget)(nil),                                   // 23: bonanza.model.analysis.ConfiguredTarget
-	(*TargetOutput)(nil),                                       // 24: bonanza.model.analysis.TargetOutput
-	(*DirectoryAccessParameters)(nil),                          // 25: bonanza.model.analysis.DirectoryAccessParameters
-	(*DirectoryCreationParameters)(nil),                        // 26: bonanza.model.analysis.DirectoryCreationParameters
-	(*DirectoryCreationParametersObject)(nil),                  // 27: bonanza.model.analysis.DirectoryCreationParametersObject
-	(*DirectoryReaders)(nil),                                   // 28: bonanza.model.analysis.DirectoryReaders
-	(*EmptyDefaultInfo)(nil),                                   // 29: bonanza.model.analysis.EmptyDefaultInfo
-	(*ExecTransition)(nil),                                     // 30: bonanza.model.analysis.ExecTransition
-	(*FileAccessParameters)(nil),                               // 31: bonanza.model.analysis.FileAccessParameters
-	(*FileCreationParameters)(nil),                             // 32: bonanza.model.analysis.FileCreationParameters
-	(*FileCreationParametersObject)(nil),                       // 33: bonanza.model.analysis.FileCreationParametersObject
-	(*FileProperties)(nil),                                     // 34: bonanza.model.analysis.FileProperties
-	(*FileReader)(nil),                                         // 35: bonanza.model.analysis.FileReader
-	(*FileRoot)(nil),                                           // 36: bonanza.model.analysis.FileRoot
-	(*FilesInPackage)(nil),                                     // 37: bonanza.model.analysis.FilesInPackage
-	(*FilesRoot)(nil),                                          // 38: bonanza.model.analysis.FilesRoot
-	(*Glob)(nil),                                               // 39: bonanza.model.analysis.Glob
-	(*HttpFetchOptions)(nil),                                   // 40: bonanza.model.analysis.HttpFetchOptions
-	(*HttpArchiveContents)(nil),                                // 41: bonanza.model.analysis.HttpArchiveContents
-	(*HttpFileContents)(nil),                                   // 42: bonanza.model.analysis.HttpFileContents
-	(*ModuleDotBazelContents)(nil),                             // 43: bonanza.model.analysis.ModuleDotBazelContents
-	(*ModuleRegistryUrls)(nil),                                 // 44: bonanza.model.analysis.ModuleRegistryUrls
-	(*ModuleRepoMapping)(nil),                                  // 45: bonanza.model.analysis.ModuleRepoMapping
-	(*ModuleExtensionRepo)(nil),                                // 46: bonanza.model.analysis.ModuleExtensionRepo
-	(*ModuleExtensionRepoNames)(nil),                           // 47: bonanza.model.analysis.ModuleExtensionRepoNames
-	(*ModuleExtensionRepos)(nil),                               // 48: bonanza.model.analysis.ModuleExtensionRepos
-	(*BuildListModule)(nil),                                    // 49: bonanza.model.analysis.BuildListModule
-	(*ModuleFinalBuildList)(nil),                               // 50: bonanza.model.analysis.ModuleFinalBuildList
-	(*ModuleRoughBuildList)(nil),                               // 51: bonanza.model.analysis.ModuleRoughBuildList
-	(*OverridesListModule)(nil),                                // 52: bonanza.model.analysis.OverridesListModule
-	(*ModulesWithMultipleVersions)(nil),                        // 53: bonanza.model.analysis.ModulesWithMultipleVersions
-	(*ModulesWithMultipleVersionsObject)(nil),                  // 54: bonanza.model.analysis.ModulesWithMultipleVersionsObject
-	(*ModulesWithOverrides)(nil),                               // 55: bonanza.model.analysis.ModulesWithOverrides
-	(*ModuleOverride)(nil),                                     // 56: bonanza.model.analysis.ModuleOverride
-	(*ModulesWithRemoteOverrides)(nil),                         // 57: bonanza.model.analysis.ModulesWithRemoteOverrides
-	(*Package)(nil),                                            // 58: bonanza.model.analysis.Package
-	(*PackageGroupContains)(nil),                               // 59: bonanza.model.analysis.PackageGroupContains
-	(*PackagesAtAndBelow)(nil),                                 // 60: bonanza.model.analysis.PackagesAtAndBelow
-	(*Constraint)(nil),                                         // 61: bonanza.model.analysis.Constraint
-	(*ExecutionPlatform)(nil),                                  // 62: bonanza.model.analysis.ExecutionPlatform
-	(*RegisteredExecutionPlatforms)(nil),                       // 63: bonanza.model.analysis.RegisteredExecutionPlatforms
-	(*RegisteredFetchPlatform)(nil),                            // 64: bonanza.model.analysis.RegisteredFetchPlatform
-	(*RegisteredRepoPlatform)(nil),                             // 65: bonanza.model.analysis.RegisteredRepoPlatform
-	(*RegisteredToolchain)(nil),                                // 66: bonanza.model.analysis.RegisteredToolchain
-	(*RegisteredToolchains)(nil),                               // 67: bonanza.model.analysis.RegisteredToolchains
-	(*RegisteredToolchainsForType)(nil),                        // 68: bonanza.model.analysis.RegisteredToolchainsForType
-	(*Repo)(nil),                                               // 69: bonanza.model.analysis.Repo
-	(*RepoDefaultAttrs)(nil),                                   // 70: bonanza.model.analysis.RepoDefaultAttrs
-	(*RepoPlatformHostPath)(nil),                               // 71: bonanza.model.analysis.RepoPlatformHostPath
-	(*ResolvedToolchains)(nil),                                 // 72: bonanza.model.analysis.ResolvedToolchains
-	(*RootModule)(nil),                                         // 73: bonanza.model.analysis.RootModule
-	(*RuleImplementationWrappers)(nil),                         // 74: bonanza.model.analysis.RuleImplementationWrappers
-	(*Select)(nil),                                             // 75: bonanza.model.analysis.Select
-	(*StableInputRootPath)(nil),                                // 76: bonanza.model.analysis.StableInputRootPath
-	(*StableInputRootPathObject)(nil),                          // 77: bonanza.model.analysis.StableInputRootPathObject
-	(*SuccessfulActionResult)(nil),                             // 78: bonanza.model.analysis.SuccessfulActionResult
-	(*Target)(nil),                                             // 79: bonanza.model.analysis.Target
-	(*TargetActionId)(nil),                                     // 80: bonanza.model.analysis.TargetActionId
-	(*TargetAction)(nil),                                       // 81: bonanza.model.analysis.TargetAction
-	(*TargetActionCommand)(nil),                                // 82: bonanza.model.analysis.TargetActionCommand
-	(*TargetActionInputRoot)(nil),                              // 83: bonanza.model.analysis.TargetActionInputRoot
-	(*TargetActionResult)(nil),                                 // 84: bonanza.model.analysis.TargetActionResult
-	(*TargetCompletion)(nil),                                   // 85: bonanza.model.analysis.TargetCompletion
-	(*TargetPatternExpansion)(nil),                             // 86: bonanza.model.analysis.TargetPatternExpansion
-	(*ModuleExtension)(nil),                                    // 87: bonanza.model.analysis.ModuleExtension
-	(*RepositoryRuleObject)(nil),                               // 88: bonanza.model.analysis.RepositoryRuleObject
-	(*UsedModuleExtension)(nil),                                // 89: bonanza.model.analysis.UsedModuleExtension
-	(*UsedModuleExtensions)(nil),                               // 90: bonanza.model.analysis.UsedModuleExtensions
-	(*UserDefinedTransition)(nil),                              // 91: bonanza.model.analysis.UserDefinedTransition
-	(*VisibleTarget)(nil),                                      // 92: bonanza.model.analysis.VisibleTarget
-	(*ActionEncoderObject_Key)(nil),                            // 93: bonanza.model.analysis.ActionEncoderObject.Key
-	(*ActionEncoders_Key)(nil),                                 // 94: bonanza.model.analysis.ActionEncoders.Key
-	(*ActionEncoders_Value)(nil),                               // 95: bonanza.model.analysis.ActionEncoders.Value
-	(*ActionReaders_Key)(nil),                                  // 96: bonanza.model.analysis.ActionReaders.Key
-	(*ActionResult_Key)(nil),                                   // 97: bonanza.model.analysis.ActionResult.Key
-	(*ActionResult_Value)(nil),                                 // 98: bonanza.model.analysis.ActionResult.Value
-	(*BuildSpecification_Key)(nil),                             // 99: bonanza.model.analysis.BuildSpecification.Key
-	(*BuildSpecification_Value)(nil),                           // 100: bonanza.model.analysis.BuildSpecification.Value
-	(*BuildSpecification_Value_Module)(nil),                    // 101: bonanza.model.analysis.BuildSpecification.Value.Module
-	(*BuildSpecification_Value_UseLockfile)(nil),               // 102: bonanza.model.analysis.BuildSpecification.Value.UseLockfile
-	(*BuildSpecification_Value_BuildSettingOverride)(nil),      // 103: bonanza.model.analysis.BuildSpecification.Value.BuildSettingOverride
-	(*BuildSpecification_Value_Configuration)(nil),             // 104: bonanza.model.analysis.BuildSpecification.Value.Configuration
-	(*BuiltinsModuleNames_Key)(nil),                            // 105: bonanza.model.analysis.BuiltinsModuleNames.Key
-	(*BuiltinsModuleNames_Value)(nil),                          // 106: bonanza.model.analysis.BuiltinsModuleNames.Value
-	(*BuildResult_Key)(nil),                                    // 107: bonanza.model.analysis.BuildResult.Key
-	(*BuildResult_Value)(nil),                                  // 108: bonanza.model.analysis.BuildResult.Value
-	(*CanonicalRepoName_Key)(nil),                              // 109: bonanza.model.analysis.CanonicalRepoName.Key
-	(*CanonicalRepoName_Value)(nil),                            // 110: bonanza.model.analysis.CanonicalRepoName.Value
-	(*CompatibleExecutionPlatforms_Key)(nil),                   // 111: bonanza.model.analysis.CompatibleExecutionPlatforms.Key
-	(*CompatibleExecutionPlatforms_Value)(nil),                 // 112: bonanza.model.analysis.CompatibleExecutionPlatforms.Value
-	(*CompatibleToolchainsForType_Key)(nil),                    // 113: bonanza.model.analysis.CompatibleToolchainsForType.Key
-	(*CompatibleToolchainsForType_Value)(nil),                  // 114: bonanza.model.analysis.CompatibleToolchainsForType.Value
-	(*CompiledBzlFile_Key)(nil),                                // 115: bonanza.model.analysis.CompiledBzlFile.Key
-	(*CompiledBzlFile_Value)(nil),                              // 116: bonanza.model.analysis.CompiledBzlFile.Value
-	(*CompiledBzlFileDecodedGlobals_Key)(nil),                  // 117: bonanza.model.analysis.CompiledBzlFileDecodedGlobals.Key
-	(*CompiledBzlFileFunctionFactory_Key)(nil),                 // 118: bonanza.model.analysis.CompiledBzlFileFunctionFactory.Key
-	(*CompiledBzlFileGlobal_Key)(nil),                          // 119: bonanza.model.analysis.CompiledBzlFileGlobal.Key
-	(*CompiledBzlFileGlobal_Value)(nil),                        // 120: bonanza.model.analysis.CompiledBzlFileGlobal.Value
-	(*BuildSettingOverride_Leaf)(nil),                          // 121: bonanza.model.analysis.BuildSettingOverride.Leaf
-	(*BuildSettingOverride_Parent)(nil),                        // 122: bonanza.model.analysis.BuildSettingOverride.Parent
-	(*Args_Parent)(nil),                                        // 123: bonanza.model.analysis.Args.Parent
-	(*Args_Leaf)(nil),                                          // 124: bonanza.model.analysis.Args.Leaf
-	(*Args_Leaf_Add)(nil),                                      // 125: bonanza.model.analysis.Args.Leaf.Add
-	(*Args_Leaf_UseParamFile)(nil),                             // 126: bonanza.model.analysis.Args.Leaf.UseParamFile
-	(*Args_Leaf_Add_Parent)(nil),                               // 127: bonanza.model.analysis.Args.Leaf.Add.Parent
-	(*Args_Leaf_Add_Leaf)(nil),                                 // 128: bonanza.model.analysis.Args.Leaf.Add.Leaf
-	(*Args_Leaf_Add_Leaf_Separate)(nil),                        // 129: bonanza.model.analysis.Args.Leaf.Add.Leaf.Separate
-	(*Args_Leaf_Add_Leaf_Joined)(nil),                          // 130: bonanza.model.analysis.Args.Leaf.Add.Leaf.Joined
-	(*FilesToRunProvider_Parent)(nil),                          // 131: bonanza.model.analysis.FilesToRunProvider.Parent
-	(*FilesToRunProvider_Leaf)(nil),                            // 132: bonanza.model.analysis.FilesToRunProvider.Leaf
-	(*TargetOutputDefinition_ExpandTemplate)(nil),              // 133: bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate
-	(*TargetOutputDefinition_Symlink)(nil),                     // 134: bonanza.model.analysis.TargetOutputDefinition.Symlink
-	(*TargetOutputDefinition_ExpandTemplate_Substitution)(nil), // 135: bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate.Substitution
-	(*ConfiguredTarget_Key)(nil),                               // 136: bonanza.model.analysis.ConfiguredTarget.Key
-	(*ConfiguredTarget_Value)(nil),                             // 137: bonanza.model.analysis.ConfiguredTarget.Value
-	(*ConfiguredTarget_Value_Output)(nil),                      // 138: bonanza.model.analysis.ConfiguredTarget.Value.Output
-	(*ConfiguredTarget_Value_Action)(nil),                      // 139: bonanza.model.analysis.ConfiguredTarget.Value.Action
-	(*ConfiguredTarget_Value_Output_Parent)(nil),               // 140: bonanza.model.analysis.ConfiguredTarget.Value.Output.Parent
-	(*ConfiguredTarget_Value_Output_Leaf)(nil),                 // 141: bonanza.model.analysis.ConfiguredTarget.Value.Output.Leaf
-	(*ConfiguredTarget_Value_Action_Parent)(nil),               // 142: bonanza.model.analysis.ConfiguredTarget.Value.Action.Parent
-	(*ConfiguredTarget_Value_Action_Leaf)(nil),                 // 143: bonanza.model.analysis.ConfiguredTarget.Value.Action.Leaf
-	(*TargetOutput_Key)(nil),                                   // 144: bonanza.model.analysis.TargetOutput.Key
-	(*TargetOutput_Value)(nil),                                 // 145: bonanza.model.analysis.TargetOutput.Value
-	(*DirectoryAccessParameters_Key)(nil),                      // 146: bonanza.model.analysis.DirectoryAccessParameters.Key
-	(*DirectoryAccessParameters_Value)(nil),                    // 147: bonanza.model.analysis.DirectoryAccessParameters.Value
-	(*DirectoryCreationParameters_Key)(nil),                    // 148: bonanza.model.analysis.DirectoryCreationParameters.Key
-	(*DirectoryCreationParameters_Value)(nil),                  // 149: bonanza.model.analysis.DirectoryCreationParameters.Value
-	(*DirectoryCreationParametersObject_Key)(nil),              // 150: bonanza.model.analysis.DirectoryCreationParametersObject.Key
-	(*DirectoryReaders_Key)(nil),                               // 151: bonanza.model.analysis.DirectoryReaders.Key
-	(*EmptyDefaultInfo_Key)(nil),                               // 152: bonanza.model.analysis.EmptyDefaultInfo.Key
-	(*EmptyDefaultInfo_Value)(nil),                             // 153: bonanza.model.analysis.EmptyDefaultInfo.Value
-	(*ExecTransition_Key)(nil),                                 // 154: bonanza.model.analysis.ExecTransition.Key
-	(*ExecTransition_Value)(nil),                               // 155: bonanza.model.analysis.ExecTransition.Value
-	(*FileAccessParameters_Key)(nil),                           // 156: bonanza.model.analysis.FileAccessParameters.Key
-	(*FileAccessParameters_Value)(nil),                         // 157: bonanza.model.analysis.FileAccessParameters.Value
-	(*FileCreationParameters_Key)(nil),                         // 158: bonanza.model.analysis.FileCreationParameters.Key
-	(*FileCreationParameters_Value)(nil),                       // 159: bonanza.model.analysis.FileCreationParameters.Value
-	(*FileCreationParametersObject_Key)(nil),                   // 160: bonanza.model.analysis.FileCreationParametersObject.Key
-	(*FileProperties_Key)(nil),                                 // 161: bonanza.model.analysis.FileProperties.Key
-	(*FileProperties_Value)(nil),                               // 162: bonanza.model.analysis.FileProperties.Value
-	(*FileReader_Key)(nil),                                     // 163: bonanza.model.analysis.FileReader.Key
-	(*FileRoot_Key)(nil),                                       // 164: bonanza.model.analysis.FileRoot.Key
-	(*FileRoot_Value)(nil),                                     // 165: bonanza.model.analysis.FileRoot.Value
-	(*FilesInPackage_Key)(nil),                                 // 166: bonanza.model.analysis.FilesInPackage.Key
-	(*FilesInPackage_Value)(nil),                               // 167: bonanza.model.analysis.FilesInPackage.Value
-	(*FilesRoot_Key)(nil),                                      // 168: bonanza.model.analysis.FilesRoot.Key
-	(*FilesRoot_Value)(nil),                                    // 169: bonanza.model.analysis.FilesRoot.Value
-	(*Glob_Key)(nil),                                           // 170: bonanza.model.analysis.Glob.Key
-	(*Glob_Value)(nil),                                         // 171: bonanza.model.analysis.Glob.Value
-	(*HttpArchiveContents_Key)(nil),                            // 172: bonanza.model.analysis.HttpArchiveContents.Key
-	(*HttpArchiveContents_Value)(nil),                          // 173: bonanza.model.analysis.HttpArchiveContents.Value
-	(*HttpArchiveContents_Value_Exists)(nil),                   // 174: bonanza.model.analysis.HttpArchiveContents.Value.Exists
-	(*HttpFileContents_Key)(nil),                               // 175: bonanza.model.analysis.HttpFileContents.Key
-	(*HttpFileContents_Value)(nil),                             // 176: bonanza.model.analysis.HttpFileContents.Value
-	(*ModuleDotBazelContents_Key)(nil),                         // 177: bonanza.model.analysis.ModuleDotBazelContents.Key
-	(*ModuleDotBazelContents_Value)(nil),                       // 178: bonanza.model.analysis.ModuleDotBazelContents.Value
-	(*ModuleRegistryUrls_Key)(nil),                             // 179: bonanza.model.analysis.ModuleRegistryUrls.Key
-	(*ModuleRegistryUrls_Value)(nil),                           // 180: bonanza.model.analysis.ModuleRegistryUrls.Value
-	(*ModuleRepoMapping_Key)(nil),                              // 181: bonanza.model.analysis.ModuleRepoMapping.Key
-	(*ModuleRepoMapping_Value)(nil),                            // 182: bonanza.model.analysis.ModuleRepoMapping.Value
-	(*ModuleRepoMapping_Value_Mapping)(nil),                    // 183: bonanza.model.analysis.ModuleRepoMapping.Value.Mapping
-	(*ModuleExtensionRepo_Key)(nil),                            // 184: bonanza.model.analysis.ModuleExtensionRepo.Key
-	(*ModuleExtensionRepo_Value)(nil),                          // 185: bonanza.model.analysis.ModuleExtensionRepo.Value
-	(*ModuleExtensionRepoNames_Key)(nil),                       // 186: bonanza.model.analysis.ModuleExtensionRepoNames.Key
-	(*ModuleExtensionRepoNames_Value)(nil),                     // 187: bonanza.model.analysis.ModuleExtensionRepoNames.Value
-	(*ModuleExtensionRepos_Key)(nil),                           // 188: bonanza.model.analysis.ModuleExtensionRepos.Key
-	(*ModuleExtensionRepos_Value)(nil),                         // 189: bonanza.model.analysis.ModuleExtensionRepos.Value
-	(*ModuleExtensionRepos_Value_Repo)(nil),                    // 190: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo
-	(*ModuleExtensionRepos_Value_Repo_Parent)(nil),             // 191: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent
-	(*ModuleFinalBuildList_Key)(nil),                           // 192: bonanza.model.analysis.ModuleFinalBuildList.Key
-	(*ModuleFinalBuildList_Value)(nil),                         // 193: bonanza.model.analysis.ModuleFinalBuildList.Value
-	(*ModuleRoughBuildList_Key)(nil),                           // 194: bonanza.model.analysis.ModuleRoughBuildList.Key
-	(*ModuleRoughBuildList_Value)(nil),                         // 195: bonanza.model.analysis.ModuleRoughBuildList.Value
-	(*ModulesWithMultipleVersions_Key)(nil),                    // 196: bonanza.model.analysis.ModulesWithMultipleVersions.Key
-	(*ModulesWithMultipleVersions_Value)(nil),                  // 197: bonanza.model.analysis.ModulesWithMultipleVersions.Value
-	(*ModulesWithMultipleVersionsObject_Key)(nil),              // 198: bonanza.model.analysis.ModulesWithMultipleVersionsObject.Key
-	(*ModulesWithOverrides_Key)(nil),                           // 199: bonanza.model.analysis.ModulesWithOverrides.Key
-	(*ModulesWithOverrides_Value)(nil),                         // 200: bonanza.model.analysis.ModulesWithOverrides.Value
-	(*ModuleOverride_SingleVersion)(nil),                       // 201: bonanza.model.analysis.ModuleOverride.SingleVersion
-	(*ModuleOverride_MultipleVersions)(nil),                    // 202: bonanza.model.analysis.ModuleOverride.MultipleVersions
-	(*ModulesWithRemoteOverrides_Key)(nil),                     // 203: bonanza.model.analysis.ModulesWithRemoteOverrides.Key
-	(*ModulesWithRemoteOverrides_Value)(nil),                   // 204: bonanza.model.analysis.ModulesWithRemoteOverrides.Value
-	(*Package_Key)(nil),                                        // 205: bonanza.model.analysis.Package.Key
-	(*Package_Value)(nil),                                      // 206: bonanza.model.analysis.Package.Value
-	(*Package_Value_Target)(nil),                               // 207: bonanza.model.analysis.Package.Value.Target
-	(*Package_Value_Target_Parent)(nil),                        // 208: bonanza.model.analysis.Package.Value.Target.Parent
-	(*PackageGroupContains_Key)(nil),                           // 209: bonanza.model.analysis.PackageGroupContains.Key
-	(*PackageGroupContains_Value)(nil),                         // 210: bonanza.model.analysis.PackageGroupContains.Value
-	(*PackagesAtAndBelow_Key)(nil),                             // 211: bonanza.model.analysis.PackagesAtAndBelow.Key
-	(*PackagesAtAndBelow_Value)(nil),                           // 212: bonanza.model.analysis.PackagesAtAndBelow.Value
-	(*RegisteredExecutionPlatforms_Key)(nil),                   // 213: bonanza.model.analysis.RegisteredExecutionPlatforms.Key
-	(*RegisteredExecutionPlatforms_Value)(nil),                 // 214: bonanza.model.analysis.RegisteredExecutionPlatforms.Value
-	(*RegisteredFetchPlatform_Key)(nil),                        // 215: bonanza.model.analysis.RegisteredFetchPlatform.Key
-	(*RegisteredFetchPlatform_Value)(nil),                      // 216: bonanza.model.analysis.RegisteredFetchPlatform.Value
-	(*RegisteredRepoPlatform_Key)(nil),                         // 217: bonanza.model.analysis.RegisteredRepoPlatform.Key
-	(*RegisteredRepoPlatform_Value)(nil),                       // 218: bonanza.model.analysis.RegisteredRepoPlatform.Value
-	(*RegisteredRepoPlatform_Value_EnvironmentVariable)(nil),   // 219: bonanza.model.analysis.RegisteredRepoPlatform.Value.EnvironmentVariable
-	(*RegisteredToolchains_Key)(nil),                           // 220: bonanza.model.analysis.RegisteredToolchains.Key
-	(*RegisteredToolchains_Value)(nil),                         // 221: bonanza.model.analysis.RegisteredToolchains.Value
-	(*RegisteredToolchains_Value_RegisteredToolchainType)(nil), // 222: bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType
-	(*RegisteredToolchainsForType_Key)(nil),                    // 223: bonanza.model.analysis.RegisteredToolchainsForType.Key
-	(*RegisteredToolchainsForType_Value)(nil),                  // 224: bonanza.model.analysis.RegisteredToolchainsForType.Value
-	(*Repo_Key)(nil),                                           // 225: bonanza.model.analysis.Repo.Key
-	(*Repo_Value)(nil),                                         // 226: bonanza.model.analysis.Repo.Value
-	(*RepoDefaultAttrs_Key)(nil),                               // 227: bonanza.model.analysis.RepoDefaultAttrs.Key
-	(*RepoDefaultAttrs_Value)(nil),                             // 228: bonanza.model.analysis.RepoDefaultAttrs.Value
-	(*RepoPlatformHostPath_Key)(nil),                           // 229: bonanza.model.analysis.RepoPlatformHostPath.Key
-	(*RepoPlatformHostPath_Value)(nil),                         // 230: bonanza.model.analysis.RepoPlatformHostPath.Value
-	(*ResolvedToolchains_Key)(nil),                             // 231: bonanza.model.analysis.ResolvedToolchains.Key
-	(*ResolvedToolchains_Value)(nil),                           // 232: bonanza.model.analysis.ResolvedToolchains.Value
-	(*RootModule_Key)(nil),                                     // 233: bonanza.model.analysis.RootModule.Key
-	(*RootModule_Value)(nil),                                   // 234: bonanza.model.analysis.RootModule.Value
-	(*RuleImplementationWrappers_Key)(nil),                     // 235: bonanza.model.analysis.RuleImplementationWrappers.Key
-	(*Select_Key)(nil),                                         // 236: bonanza.model.analysis.Select.Key
-	(*Select_Value)(nil),                                       // 237: bonanza.model.analysis.Select.Value
-	(*StableInputRootPath_Key)(nil),                            // 238: bonanza.model.analysis.StableInputRootPath.Key
-	(*StableInputRootPath_Value)(nil),                          // 239: bonanza.model.analysis.StableInputRootPath.Value
-	(*StableInputRootPathObject_Key)(nil),                      // 240: bonanza.model.analysis.StableInputRootPathObject.Key
-	(*SuccessfulActionResult_Key)(nil),                         // 241: bonanza.model.analysis.SuccessfulActionResult.Key
-	(*SuccessfulActionResult_Value)(nil),                       // 242: bonanza.model.analysis.SuccessfulActionResult.Value
-	(*Target_Key)(nil),                                         // 243: bonanza.model.analysis.Target.Key
-	(*Target_Value)(nil),                                       // 244: bonanza.model.analysis.Target.Value
-	(*TargetAction_Key)(nil),                                   // 245: bonanza.model.analysis.TargetAction.Key
-	(*TargetAction_Value)(nil),                                 // 246: bonanza.model.analysis.TargetAction.Value
-	(*TargetActionCommand_Key)(nil),                            // 247: bonanza.model.analysis.TargetActionCommand.Key
-	(*TargetActionCommand_Value)(nil),                          // 248: bonanza.model.analysis.TargetActionCommand.Value
-	(*TargetActionInputRoot_Key)(nil),                          // 249: bonanza.model.analysis.TargetActionInputRoot.Key
-	(*TargetActionInputRoot_Value)(nil),                        // 250: bonanza.model.analysis.TargetActionInputRoot.Value
-	(*TargetActionResult_Key)(nil),                             // 251: bonanza.model.analysis.TargetActionResult.Key
-	(*TargetActionResult_Value)(nil),                           // 252: bonanza.model.analysis.TargetActionResult.Value
-	(*TargetCompletion_Key)(nil),                               // 253: bonanza.model.analysis.TargetCompletion.Key
-	(*TargetCompletion_Value)(nil),                             // 254: bonanza.model.analysis.TargetCompletion.Value
-	(*TargetPatternExpansion_Key)(nil),                         // 255: bonanza.model.analysis.TargetPatternExpansion.Key
-	(*TargetPatternExpansion_Value)(nil),                       // 256: bonanza.model.analysis.TargetPatternExpansion.Value
-	(*TargetPatternExpansion_Value_TargetLabel)(nil),           // 257: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel
-	(*TargetPatternExpansion_Value_TargetLabel_Parent)(nil),    // 258: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent
-	(*ModuleExtension_User)(nil),                               // 259: bonanza.model.analysis.ModuleExtension.User
-	(*ModuleExtension_TagClass)(nil),                           // 260: bonanza.model.analysis.ModuleExtension.TagClass
-	(*ModuleExtension_Tag)(nil),                                // 261: bonanza.model.analysis.ModuleExtension.Tag
-	(*RepositoryRuleObject_Key)(nil),                           // 262: bonanza.model.analysis.RepositoryRuleObject.Key
-	(*UsedModuleExtension_Key)(nil),                            // 263: bonanza.model.analysis.UsedModuleExtension.Key
-	(*UsedModuleExtension_Value)(nil),                          // 264: bonanza.model.analysis.UsedModuleExtension.Value
-	(*UsedModuleExtensions_Key)(nil),                           // 265: bonanza.model.analysis.UsedModuleExtensions.Key
-	(*UsedModuleExtensions_Value)(nil),                         // 266: bonanza.model.analysis.UsedModuleExtensions.Value
-	(*UserDefinedTransition_Key)(nil),                          // 267: bonanza.model.analysis.UserDefinedTransition.Key
-	(*UserDefinedTransition_Value)(nil),                        // 268: bonanza.model.analysis.UserDefinedTransition.Value
-	(*UserDefinedTransition_Value_Success)(nil),                // 269: bonanza.model.analysis.UserDefinedTransition.Value.Success
-	(*UserDefinedTransition_Value_Success_Entry)(nil),          // 270: bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry
-	(*VisibleTarget_Key)(nil),                                  // 271: bonanza.model.analysis.VisibleTarget.Key
-	(*VisibleTarget_Value)(nil),                                // 272: bonanza.model.analysis.VisibleTarget.Value
-	(*core.DecodableReference)(nil),                            // 273: bonanza.model.core.DecodableReference
-	(*durationpb.Duration)(nil),                                // 274: google.protobuf.Duration
-	(*starlark.List_Element)(nil),                              // 275: bonanza.model.starlark.List.Element
-	(*command.PathPattern)(nil),                                // 276: bonanza.model.command.PathPattern
-	(*filesystem.Directory)(nil),                               // 277: bonanza.model.filesystem.Directory
-	(*command.EnvironmentVariableList_Element)(nil),            // 278: bonanza.model.command.EnvironmentVariableList.Element
-	(*filesystem.DirectoryContents)(nil),                       // 279: bonanza.model.filesystem.DirectoryContents
-	(*fetch.Target)(nil),                                       // 280: bonanza.model.fetch.Target
-	(*starlark.Repo_Definition)(nil),                           // 281: bonanza.model.starlark.Repo.Definition
-	(*encoding.BinaryEncoder)(nil),                             // 282: bonanza.model.encoding.BinaryEncoder
-	(*filesystem.DirectoryCreationParameters)(nil),             // 283: bonanza.model.filesystem.DirectoryCreationParameters
-	(*filesystem.FileCreationParameters)(nil),                  // 284: bonanza.model.filesystem.FileCreationParameters
-	(*filesystem.DirectoryReference)(nil),                      // 285: bonanza.model.filesystem.DirectoryReference
-	(*starlark.CompiledProgram)(nil),                           // 286: bonanza.model.starlark.CompiledProgram
-	(*starlark.Value)(nil),                                     // 287: bonanza.model.starlark.Value
-	(*wrapperspb.StringValue)(nil),                             // 288: google.protobuf.StringValue
-	(*starlark.Function)(nil),                                  // 289: bonanza.model.starlark.Function
-	(*starlark.File)(nil),                                      // 290: bonanza.model.starlark.File
-	(*starlark.Struct)(nil),                                    // 291: bonanza.model.starlark.Struct
-	(*filesystem.DirectoryAccessParameters)(nil),               // 292: bonanza.model.filesystem.DirectoryAccessParameters
-	(*filesystem.FileAccessParameters)(nil),                    // 293: bonanza.model.filesystem.FileAccessParameters
-	(*filesystem.FileProperties)(nil),                          // 294: bonanza.model.filesystem.FileProperties
-	(*fetch.Result_Success)(nil),                               // 295: bonanza.model.fetch.Result.Success
-	(*filesystem.FileContents)(nil),                            // 296: bonanza.model.filesystem.FileContents
-	(*starlark.Repo)(nil),                                      // 297: bonanza.model.starlark.Repo
-	(*starlark.Target)(nil),                                    // 298: bonanza.model.starlark.Target
-	(*starlark.InheritableAttrs)(nil),                          // 299: bonanza.model.starlark.InheritableAttrs
-	(*starlark.ToolchainType)(nil),                             // 300: bonanza.model.starlark.ToolchainType
-	(*starlark.Target_Definition)(nil),                         // 301: bonanza.model.starlark.Target.Definition
-	(*starlark.Struct_Fields)(nil),                             // 302: bonanza.model.starlark.Struct.Fields
-	(*emptypb.Empty)(nil),                                      // 303: google.protobuf.Empty
+	(*ActionQueryResult)(nil),                                  // 6: bonanza.model.analysis.ActionQueryResult
+	(*ExecuteRequest)(nil),                                     // 7: bonanza.model.analysis.ExecuteRequest
+	(*ActionResult)(nil),                                       // 8: bonanza.model.analysis.ActionResult
+	(*BuildSpecification)(nil),                                 // 9: bonanza.model.analysis.BuildSpecification
+	(*BuiltinsModuleNames)(nil),                                // 10: bonanza.model.analysis.BuiltinsModuleNames
+	(*BuildResult)(nil),                                        // 11: bonanza.model.analysis.BuildResult
+	(*CanonicalRepoName)(nil),                                  // 12: bonanza.model.analysis.CanonicalRepoName
+	(*CompatibleExecutionPlatforms)(nil),                       // 13: bonanza.model.analysis.CompatibleExecutionPlatforms
+	(*CompatibleToolchainsForType)(nil),                        // 14: bonanza.model.analysis.CompatibleToolchainsForType
+	(*CompiledBzlFile)(nil),                                    // 15: bonanza.model.analysis.CompiledBzlFile
+	(*CompiledBzlFileDecodedGlobals)(nil),                      // 16: bonanza.model.analysis.CompiledBzlFileDecodedGlobals
+	(*CompiledBzlFileFunctionFactory)(nil),                     // 17: bonanza.model.analysis.CompiledBzlFileFunctionFactory
+	(*CompiledBzlFileGlobal)(nil),                              // 18: bonanza.model.analysis.CompiledBzlFileGlobal
+	(*BuildSettingOverride)(nil),                               // 19: bonanza.model.analysis.BuildSettingOverride
+	(*Args)(nil),                                               // 20: bonanza.model.analysis.Args
+	(*FilesToRunProvider)(nil),                                 // 21: bonanza.model.analysis.FilesToRunProvider
+	(*TargetActionDefinition)(nil),                             // 22: bonanza.model.analysis.TargetActionDefinition
+	(*TargetOutputDefinition)(nil),                             // 23: bonanza.model.analysis.TargetOutputDefinition
+	(*ConfiguredQueryResult)(nil),                              // 24: bonanza.model.analysis.ConfiguredQueryResult
+	(*ConfiguredTarget)(nil),                                   // 25: bonanza.model.analysis.ConfiguredTarget
+	(*TargetOutput)(nil),                                       // 26: bonanza.model.analysis.TargetOutput
+	(*DirectoryAccessParameters)(nil),                          // 27: bonanza.model.analysis.DirectoryAccessParameters
+	(*DirectoryCreationParameters)(nil),                        // 28: bonanza.model.analysis.DirectoryCreationParameters
+	(*DirectoryCreationParametersObject)(nil),                  // 29: bonanza.model.analysis.DirectoryCreationParametersObject
+	(*DirectoryReaders)(nil),                                   // 30: bonanza.model.analysis.DirectoryReaders
+	(*EmptyDefaultInfo)(nil),                                   // 31: bonanza.model.analysis.EmptyDefaultInfo
+	(*ExecTransition)(nil),                                     // 32: bonanza.model.analysis.ExecTransition
+	(*FileAccessParameters)(nil),                               // 33: bonanza.model.analysis.FileAccessParameters
+	(*FileCreationParameters)(nil),                             // 34: bonanza.model.analysis.FileCreationParameters
+	(*FileCreationParametersObject)(nil),                       // 35: bonanza.model.analysis.FileCreationParametersObject
+	(*FileProperties)(nil),                                     // 36: bonanza.model.analysis.FileProperties
+	(*FileReader)(nil),                                         // 37: bonanza.model.analysis.FileReader
+	(*FileRoot)(nil),                                           // 38: bonanza.model.analysis.FileRoot
+	(*FilesInPackage)(nil),                                     // 39: bonanza.model.analysis.FilesInPackage
+	(*FilesRoot)(nil),                                          // 40: bonanza.model.analysis.FilesRoot
+	(*GitRepositoryContents)(nil),                              // 41: bonanza.model.analysis.GitRepositoryContents
+	(*Glob)(nil),                                               // 42: bonanza.model.analysis.Glob
+	(*HttpFetchOptions)(nil),                                   // 43: bonanza.model.analysis.HttpFetchOptions
+	(*HttpArchiveContents)(nil),                                // 44: bonanza.model.analysis.HttpArchiveContents
+	(*HttpFileContents)(nil),                                   // 45: bonanza.model.analysis.HttpFileContents
+	(*ModuleDotBazelContents)(nil),                             // 46: bonanza.model.analysis.ModuleDotBazelContents
+	(*ModuleRegistryUrls)(nil),                                 // 47: bonanza.model.analysis.ModuleRegistryUrls
+	(*ModuleLockfile)(nil),                                     // 48: bonanza.model.analysis.ModuleLockfile
+	(*ModuleRepoMapping)(nil),                                  // 49: bonanza.model.analysis.ModuleRepoMapping
+	(*ModuleExtensionRepo)(nil),                                // 50: bonanza.model.analysis.ModuleExtensionRepo
+	(*ModuleExtensionRepoNames)(nil),                           // 51: bonanza.model.analysis.ModuleExtensionRepoNames
+	(*ModuleExtensionRepos)(nil),                               // 52: bonanza.model.analysis.ModuleExtensionRepos
+	(*BuildListModule)(nil),                                    // 53: bonanza.model.analysis.BuildListModule
+	(*RegistryFileHash)(nil),                                   // 54: bonanza.model.analysis.RegistryFileHash
+	(*ModuleFinalBuildList)(nil),                               // 55: bonanza.model.analysis.ModuleFinalBuildList
+	(*ModuleRoughBuildList)(nil),                               // 56: bonanza.model.analysis.ModuleRoughBuildList
+	(*OverridesListModule)(nil),                                // 57: bonanza.model.analysis.OverridesListModule
+	(*ModulesWithMultipleVersions)(nil),                        // 58: bonanza.model.analysis.ModulesWithMultipleVersions
+	(*ModulesWithMultipleVersionsObject)(nil),                  // 59: bonanza.model.analysis.ModulesWithMultipleVersionsObject
+	(*ModulesWithOverrides)(nil),                               // 60: bonanza.model.analysis.ModulesWithOverrides
+	(*ModuleOverride)(nil),                                     // 61: bonanza.model.analysis.ModuleOverride
+	(*ModulesWithRemoteOverrides)(nil),                         // 62: bonanza.model.analysis.ModulesWithRemoteOverrides
+	(*Package)(nil),                                            // 63: bonanza.model.analysis.Package
+	(*PackageGroupContains)(nil),                               // 64: bonanza.model.analysis.PackageGroupContains
+	(*PackagesAtAndBelow)(nil),                                 // 65: bonanza.model.analysis.PackagesAtAndBelow
+	(*QueryResult)(nil),                                        // 66: bonanza.model.analysis.QueryResult
+	(*Constraint)(nil),                                         // 67: bonanza.model.analysis.Constraint
+	(*ExecutionPlatform)(nil),                                  // 68: bonanza.model.analysis.ExecutionPlatform
+	(*RegisteredExecutionPlatforms)(nil),                       // 69: bonanza.model.analysis.RegisteredExecutionPlatforms
+	(*RegisteredFetchPlatform)(nil),                            // 70: bonanza.model.analysis.RegisteredFetchPlatform
+	(*RegisteredRepoPlatform)(nil),                             // 71: bonanza.model.analysis.RegisteredRepoPlatform
+	(*RegisteredToolchain)(nil),                                // 72: bonanza.model.analysis.RegisteredToolchain
+	(*RegisteredToolchains)(nil),                               // 73: bonanza.model.analysis.RegisteredToolchains
+	(*RegisteredToolchainsForType)(nil),                        // 74: bonanza.model.analysis.RegisteredToolchainsForType
+	(*Repo)(nil),                                               // 75: bonanza.model.analysis.Repo
+	(*RepoDefaultAttrs)(nil),                                   // 76: bonanza.model.analysis.RepoDefaultAttrs
+	(*RepoPlatformHostPath)(nil),                               // 77: bonanza.model.analysis.RepoPlatformHostPath
+	(*ResolvedToolchains)(nil),                                 // 78: bonanza.model.analysis.ResolvedToolchains
+	(*RootModule)(nil),                                         // 79: bonanza.model.analysis.RootModule
+	(*RuleImplementationWrappers)(nil),                         // 80: bonanza.model.analysis.RuleImplementationWrappers
+	(*Select)(nil),                                             // 81: bonanza.model.analysis.Select
+	(*StableInputRootPath)(nil),                                // 82: bonanza.model.analysis.StableInputRootPath
+	(*StableInputRootPathObject)(nil),                          // 83: bonanza.model.analysis.StableInputRootPathObject
+	(*SuccessfulActionResult)(nil),                             // 84: bonanza.model.analysis.SuccessfulActionResult
+	(*Target)(nil),                                             // 85: bonanza.model.analysis.Target
+	(*TargetActionId)(nil),                                     // 86: bonanza.model.analysis.TargetActionId
+	(*TargetAction)(nil),                                       // 87: bonanza.model.analysis.TargetAction
+	(*TargetActionCommand)(nil),                                // 88: bonanza.model.analysis.TargetActionCommand
+	(*TargetActionInputRoot)(nil),                              // 89: bonanza.model.analysis.TargetActionInputRoot
+	(*TargetActionResult)(nil),                                 // 90: bonanza.model.analysis.TargetActionResult
+	(*TargetCompletion)(nil),                                   // 91: bonanza.model.analysis.TargetCompletion
+	(*TargetExecutable)(nil),                                   // 92: bonanza.model.analysis.TargetExecutable
+	(*TargetPatternExpansion)(nil),                             // 93: bonanza.model.analysis.TargetPatternExpansion
+	(*TestResult)(nil),                                         // 94: bonanza.model.analysis.TestResult
+	(*ModuleExtension)(nil),                                    // 95: bonanza.model.analysis.ModuleExtension
+	(*RepositoryRuleObject)(nil),                               // 96: bonanza.model.analysis.RepositoryRuleObject
+	(*UpdatedModuleLockfile)(nil),                              // 97: bonanza.model.analysis.UpdatedModuleLockfile
+	(*UsedModuleExtension)(nil),                                // 98: bonanza.model.analysis.UsedModuleExtension
+	(*UsedModuleExtensions)(nil),                               // 99: bonanza.model.analysis.UsedModuleExtensions
+	(*UserDefinedTransition)(nil),                              // 100: bonanza.model.analysis.UserDefinedTransition
+	(*VisibleTarget)(nil),                                      // 101: bonanza.model.analysis.VisibleTarget
+	(*ActionEncoderObject_Key)(nil),                            // 102: bonanza.model.analysis.ActionEncoderObject.Key
+	(*ActionEncoders_Key)(nil),                                 // 103: bonanza.model.analysis.ActionEncoders.Key
+	(*ActionEncoders_Value)(nil),                               // 104: bonanza.model.analysis.ActionEncoders.Value
+	(*ActionReaders_Key)(nil),                                  // 105: bonanza.model.analysis.ActionReaders.Key
+	(*ActionQueryResult_Key)(nil),                              // 106: bonanza.model.analysis.ActionQueryResult.Key
+	(*ActionQueryResult_Value)(nil),                            // 107: bonanza.model.analysis.ActionQueryResult.Value
+	(*ActionQueryResult_Value_Action)(nil),                     // 108: bonanza.model.analysis.ActionQueryResult.Value.Action
+	(*ActionResult_Key)(nil),                                   // 109: bonanza.model.analysis.ActionResult.Key
+	(*ActionResult_Value)(nil),                                 // 110: bonanza.model.analysis.ActionResult.Value
+	(*BuildSpecification_Key)(nil),                             // 111: bonanza.model.analysis.BuildSpecification.Key
+	(*BuildSpecification_Value)(nil),                           // 112: bonanza.model.analysis.BuildSpecification.Value
+	(*BuildSpecification_Value_Module)(nil),                    // 113: bonanza.model.analysis.BuildSpecification.Value.Module
+	(*BuildSpecification_Value_UseLockfile)(nil),               // 114: bonanza.model.analysis.BuildSpecification.Value.UseLockfile
+	(*BuildSpecification_Value_BuildSettingOverride)(nil),      // 115: bonanza.model.analysis.BuildSpecification.Value.BuildSettingOverride
+	(*BuildSpecification_Value_Configuration)(nil),             // 116: bonanza.model.analysis.BuildSpecification.Value.Configuration
+	(*BuiltinsModuleNames_Key)(nil),                            // 117: bonanza.model.analysis.BuiltinsModuleNames.Key
+	(*BuiltinsModuleNames_Value)(nil),                          // 118: bonanza.model.analysis.BuiltinsModuleNames.Value
+	(*BuildResult_Key)(nil),                                    // 119: bonanza.model.analysis.BuildResult.Key
+	(*BuildResult_Value)(nil),                                  // 120: bonanza.model.analysis.BuildResult.Value
+	(*BuildResult_Key_TestParameters)(nil),                     // 121: bonanza.model.analysis.BuildResult.Key.TestParameters
+	(*BuildResult_Value_TargetCompletion)(nil),                 // 122: bonanza.model.analysis.BuildResult.Value.TargetCompletion
+	(*BuildResult_Value_TestResult)(nil),                       // 123: bonanza.model.analysis.BuildResult.Value.TestResult
+	(*BuildResult_Value_Executable)(nil),                       // 124: bonanza.model.analysis.BuildResult.Value.Executable
+	(*CanonicalRepoName_Key)(nil),                              // 125: bonanza.model.analysis.CanonicalRepoName.Key
+	(*CanonicalRepoName_Value)(nil),                            // 126: bonanza.model.analysis.CanonicalRepoName.Value
+	(*CompatibleExecutionPlatforms_Key)(nil),                   // 127: bonanza.model.analysis.CompatibleExecutionPlatforms.Key
+	(*CompatibleExecutionPlatforms_Value)(nil),                 // 128: bonanza.model.analysis.CompatibleExecutionPlatforms.Value
+	(*CompatibleToolchainsForType_Key)(nil),                    // 129: bonanza.model.analysis.CompatibleToolchainsForType.Key
+	(*CompatibleToolchainsForType_Value)(nil),                  // 130: bonanza.model.analysis.CompatibleToolchainsForType.Value
+	(*CompiledBzlFile_Key)(nil),                                // 131: bonanza.model.analysis.CompiledBzlFile.Key
+	(*CompiledBzlFile_Value)(nil),                              // 132: bonanza.model.analysis.CompiledBzlFile.Value
+	(*CompiledBzlFileDecodedGlobals_Key)(nil),                  // 133: bonanza.model.analysis.CompiledBzlFileDecodedGlobals.Key
+	(*CompiledBzlFileFunctionFactory_Key)(nil),                 // 134: bonanza.model.analysis.CompiledBzlFileFunctionFactory.Key
+	(*CompiledBzlFileGlobal_Key)(nil),                          // 135: bonanza.model.analysis.CompiledBzlFileGlobal.Key
+	(*CompiledBzlFileGlobal_Value)(nil),                        // 136: bonanza.model.analysis.CompiledBzlFileGlobal.Value
+	(*BuildSettingOverride_Leaf)(nil),                          // 137: bonanza.model.analysis.BuildSettingOverride.Leaf
+	(*BuildSettingOverride_Parent)(nil),                        // 138: bonanza.model.analysis.BuildSettingOverride.Parent
+	(*Args_Parent)(nil),                                        // 139: bonanza.model.analysis.Args.Parent
+	(*Args_Leaf)(nil),                                          // 140: bonanza.model.analysis.Args.Leaf
+	(*Args_Leaf_Add)(nil),                                      // 141: bonanza.model.analysis.Args.Leaf.Add
+	(*Args_Leaf_UseParamFile)(nil),                             // 142: bonanza.model.analysis.Args.Leaf.UseParamFile
+	(*Args_Leaf_Add_Parent)(nil),                               // 143: bonanza.model.analysis.Args.Leaf.Add.Parent
+	(*Args_Leaf_Add_Leaf)(nil),                                 // 144: bonanza.model.analysis.Args.Leaf.Add.Leaf
+	(*Args_Leaf_Add_Leaf_Separate)(nil),                        // 145: bonanza.model.analysis.Args.Leaf.Add.Leaf.Separate
+	(*Args_Leaf_Add_Leaf_Joined)(nil),                          // 146: bonanza.model.analysis.Args.Leaf.Add.Leaf.Joined
+	(*FilesToRunProvider_Parent)(nil),                          // 147: bonanza.model.analysis.FilesToRunProvider.Parent
+	(*FilesToRunProvider_Leaf)(nil),                            // 148: bonanza.model.analysis.FilesToRunProvider.Leaf
+	(*TargetOutputDefinition_ExpandTemplate)(nil),              // 149: bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate
+	(*TargetOutputDefinition_Symlink)(nil),                     // 150: bonanza.model.analysis.TargetOutputDefinition.Symlink
+	(*TargetOutputDefinition_ExpandTemplate_Substitution)(nil), // 151: bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate.Substitution
+	(*ConfiguredQueryResult_Key)(nil),                          // 152: bonanza.model.analysis.ConfiguredQueryResult.Key
+	(*ConfiguredQueryResult_Value)(nil),                        // 153: bonanza.model.analysis.ConfiguredQueryResult.Value
+	(*ConfiguredQueryResult_Value_Target)(nil),                 // 154: bonanza.model.analysis.ConfiguredQueryResult.Value.Target
+	(*ConfiguredTarget_Key)(nil),                               // 155: bonanza.model.analysis.ConfiguredTarget.Key
+	(*ConfiguredTarget_Value)(nil),                             // 156: bonanza.model.analysis.ConfiguredTarget.Value
+	(*ConfiguredTarget_Value_Output)(nil),                      // 157: bonanza.model.analysis.ConfiguredTarget.Value.Output
+	(*ConfiguredTarget_Value_Action)(nil),                      // 158: bonanza.model.analysis.ConfiguredTarget.Value.Action
+	(*ConfiguredTarget_Value_Output_Parent)(nil),               // 159: bonanza.model.analysis.ConfiguredTarget.Value.Output.Parent
+	(*ConfiguredTarget_Value_Output_Leaf)(nil),                 // 160: bonanza.model.analysis.ConfiguredTarget.Value.Output.Leaf
+	(*ConfiguredTarget_Value_Action_Parent)(nil),               // 161: bonanza.model.analysis.ConfiguredTarget.Value.Action.Parent
+	(*ConfiguredTarget_Value_Action_Leaf)(nil),                 // 162: bonanza.model.analysis.ConfiguredTarget.Value.Action.Leaf
+	(*TargetOutput_Key)(nil),                                   // 163: bonanza.model.analysis.TargetOutput.Key
+	(*TargetOutput_Value)(nil),                                 // 164: bonanza.model.analysis.TargetOutput.Value
+	(*DirectoryAccessParameters_Key)(nil),                      // 165: bonanza.model.analysis.DirectoryAccessParameters.Key
+	(*DirectoryAccessParameters_Value)(nil),                    // 166: bonanza.model.analysis.DirectoryAccessParameters.Value
+	(*DirectoryCreationParameters_Key)(nil),                    // 167: bonanza.model.analysis.DirectoryCreationParameters.Key
+	(*DirectoryCreationParameters_Value)(nil),                  // 168: bonanza.model.analysis.DirectoryCreationParameters.Value
+	(*DirectoryCreationParametersObject_Key)(nil),              // 169: bonanza.model.analysis.DirectoryCreationParametersObject.Key
+	(*DirectoryReaders_Key)(nil),                               // 170: bonanza.model.analysis.DirectoryReaders.Key
+	(*EmptyDefaultInfo_Key)(nil),                               // 171: bonanza.model.analysis.EmptyDefaultInfo.Key
+	(*EmptyDefaultInfo_Value)(nil),                             // 172: bonanza.model.analysis.EmptyDefaultInfo.Value
+	(*ExecTransition_Key)(nil),                                 // 173: bonanza.model.analysis.ExecTransition.Key
+	(*ExecTransition_Value)(nil),                               // 174: bonanza.model.analysis.ExecTransition.Value
+	(*FileAccessParameters_Key)(nil),                           // 175: bonanza.model.analysis.FileAccessParameters.Key
+	(*FileAccessParameters_Value)(nil),                         // 176: bonanza.model.analysis.FileAccessParameters.Value
+	(*FileCreationParameters_Key)(nil),                         // 177: bonanza.model.analysis.FileCreationParameters.Key
+	(*FileCreationParameters_Value)(nil),                       // 178: bonanza.model.analysis.FileCreationParameters.Value
+	(*FileCreationParametersObject_Key)(nil),                   // 179: bonanza.model.analysis.FileCreationParametersObject.Key
+	(*FileProperties_Key)(nil),                                 // 180: bonanza.model.analysis.FileProperties.Key
+	(*FileProperties_Value)(nil),                               // 181: bonanza.model.analysis.FileProperties.Value
+	(*FileReader_Key)(nil),                                     // 182: bonanza.model.analysis.FileReader.Key
+	(*FileRoot_Key)(nil),                                       // 183: bonanza.model.analysis.FileRoot.Key
+	(*FileRoot_Value)(nil),                                     // 184: bonanza.model.analysis.FileRoot.Value
+	(*FilesInPackage_Key)(nil),                                 // 185: bonanza.model.analysis.FilesInPackage.Key
+	(*FilesInPackage_Value)(nil),                               // 186: bonanza.model.analysis.FilesInPackage.Value
+	(*FilesRoot_Key)(nil),                                      // 187: bonanza.model.analysis.FilesRoot.Key
+	(*FilesRoot_Value)(nil),                                    // 188: bonanza.model.analysis.FilesRoot.Value
+	(*GitRepositoryContents_Key)(nil),                          // 189: bonanza.model.analysis.GitRepositoryContents.Key
+	(*GitRepositoryContents_Value)(nil),                        // 190: bonanza.model.analysis.GitRepositoryContents.Value
+	(*Glob_Key)(nil),                                           // 191: bonanza.model.analysis.Glob.Key
+	(*Glob_Value)(nil),                                         // 192: bonanza.model.analysis.Glob.Value
+	(*HttpArchiveContents_Key)(nil),                            // 193: bonanza.model.analysis.HttpArchiveContents.Key
+	(*HttpArchiveContents_Value)(nil),                          // 194: bonanza.model.analysis.HttpArchiveContents.Value
+	(*HttpArchiveContents_Value_Exists)(nil),                   // 195: bonanza.model.analysis.HttpArchiveContents.Value.Exists
+	(*HttpFileContents_Key)(nil),                               // 196: bonanza.model.analysis.HttpFileContents.Key
+	(*HttpFileContents_Value)(nil),                             // 197: bonanza.model.analysis.HttpFileContents.Value
+	(*ModuleDotBazelContents_Key)(nil),                         // 198: bonanza.model.analysis.ModuleDotBazelContents.Key
+	(*ModuleDotBazelContents_Value)(nil),                       // 199: bonanza.model.analysis.ModuleDotBazelContents.Value
+	(*ModuleRegistryUrls_Key)(nil),                             // 200: bonanza.model.analysis.ModuleRegistryUrls.Key
+	(*ModuleRegistryUrls_Value)(nil),                           // 201: bonanza.model.analysis.ModuleRegistryUrls.Value
+	(*ModuleLockfile_Key)(nil),                                 // 202: bonanza.model.analysis.ModuleLockfile.Key
+	(*ModuleLockfile_Value)(nil),                               // 203: bonanza.model.analysis.ModuleLockfile.Value
+	(*ModuleRepoMapping_Key)(nil),                              // 204: bonanza.model.analysis.ModuleRepoMapping.Key
+	(*ModuleRepoMapping_Value)(nil),                            // 205: bonanza.model.analysis.ModuleRepoMapping.Value
+	(*ModuleRepoMapping_Value_Mapping)(nil),                    // 206: bonanza.model.analysis.ModuleRepoMapping.Value.Mapping
+	(*ModuleExtensionRepo_Key)(nil),                            // 207: bonanza.model.analysis.ModuleExtensionRepo.Key
+	(*ModuleExtensionRepo_Value)(nil),                          // 208: bonanza.model.analysis.ModuleExtensionRepo.Value
+	(*ModuleExtensionRepoNames_Key)(nil),                       // 209: bonanza.model.analysis.ModuleExtensionRepoNames.Key
+	(*ModuleExtensionRepoNames_Value)(nil),                     // 210: bonanza.model.analysis.ModuleExtensionRepoNames.Value
+	(*ModuleExtensionRepos_Key)(nil),                           // 211: bonanza.model.analysis.ModuleExtensionRepos.Key
+	(*ModuleExtensionRepos_Value)(nil),                         // 212: bonanza.model.analysis.ModuleExtensionRepos.Value
+	(*ModuleExtensionRepos_Value_Repo)(nil),                    // 213: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo
+	(*ModuleExtensionRepos_Value_Repo_Parent)(nil),             // 214: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent
+	(*ModuleFinalBuildList_Key)(nil),                           // 215: bonanza.model.analysis.ModuleFinalBuildList.Key
+	(*ModuleFinalBuildList_Value)(nil),                         // 216: bonanza.model.analysis.ModuleFinalBuildList.Value
+	(*ModuleRoughBuildList_Key)(nil),                           // 217: bonanza.model.analysis.ModuleRoughBuildList.Key
+	(*ModuleRoughBuildList_Value)(nil),                         // 218: bonanza.model.analysis.ModuleRoughBuildList.Value
+	(*ModulesWithMultipleVersions_Key)(nil),                    // 219: bonanza.model.analysis.ModulesWithMultipleVersions.Key
+	(*ModulesWithMultipleVersions_Value)(nil),                  // 220: bonanza.model.analysis.ModulesWithMultipleVersions.Value
+	(*ModulesWithMultipleVersionsObject_Key)(nil),              // 221: bonanza.model.analysis.ModulesWithMultipleVersionsObject.Key
+	(*ModulesWithOverrides_Key)(nil),                           // 222: bonanza.model.analysis.ModulesWithOverrides.Key
+	(*ModulesWithOverrides_Value)(nil),                         // 223: bonanza.model.analysis.ModulesWithOverrides.Value
+	(*ModuleOverride_SingleVersion)(nil),                       // 224: bonanza.model.analysis.ModuleOverride.SingleVersion
+	(*ModuleOverride_MultipleVersions)(nil),                    // 225: bonanza.model.analysis.ModuleOverride.MultipleVersions
+	(*ModulesWithRemoteOverrides_Key)(nil),                     // 226: bonanza.model.analysis.ModulesWithRemoteOverrides.Key
+	(*ModulesWithRemoteOverrides_Value)(nil),                   // 227: bonanza.model.analysis.ModulesWithRemoteOverrides.Value
+	(*Package_Key)(nil),                                        // 228: bonanza.model.analysis.Package.Key
+	(*Package_Value)(nil),                                      // 229: bonanza.model.analysis.Package.Value
+	(*Package_Value_Target)(nil),                               // 230: bonanza.model.analysis.Package.Value.Target
+	(*Package_Value_Target_Parent)(nil),                        // 231: bonanza.model.analysis.Package.Value.Target.Parent
+	(*PackageGroupContains_Key)(nil),                           // 232: bonanza.model.analysis.PackageGroupContains.Key
+	(*PackageGroupContains_Value)(nil),                         // 233: bonanza.model.analysis.PackageGroupContains.Value
+	(*PackagesAtAndBelow_Key)(nil),                             // 234: bonanza.model.analysis.PackagesAtAndBelow.Key
+	(*PackagesAtAndBelow_Value)(nil),                           // 235: bonanza.model.analysis.PackagesAtAndBelow.Value
+	(*QueryResult_Key)(nil),                                    // 236: bonanza.model.analysis.QueryResult.Key
+	(*QueryResult_Value)(nil),                                  // 237: bonanza.model.analysis.QueryResult.Value
+	(*QueryResult_Value_Attribute)(nil),                        // 238: bonanza.model.analysis.QueryResult.Value.Attribute
+	(*QueryResult_Value_Target)(nil),                           // 239: bonanza.model.analysis.QueryResult.Value.Target
+	(*RegisteredExecutionPlatforms_Key)(nil),                   // 240: bonanza.model.analysis.RegisteredExecutionPlatforms.Key
+	(*RegisteredExecutionPlatforms_Value)(nil),                 // 241: bonanza.model.analysis.RegisteredExecutionPlatforms.Value
+	(*RegisteredFetchPlatform_Key)(nil),                        // 242: bonanza.model.analysis.RegisteredFetchPlatform.Key
+	(*RegisteredFetchPlatform_Value)(nil),                      // 243: bonanza.model.analysis.RegisteredFetchPlatform.Value
+	(*RegisteredRepoPlatform_Key)(nil),                         // 244: bonanza.model.analysis.RegisteredRepoPlatform.Key
+	(*RegisteredRepoPlatform_Value)(nil),                       // 245: bonanza.model.analysis.RegisteredRepoPlatform.Value
+	(*RegisteredRepoPlatform_Value_EnvironmentVariable)(nil),   // 246: bonanza.model.analysis.RegisteredRepoPlatform.Value.EnvironmentVariable
+	(*RegisteredToolchains_Key)(nil),                           // 247: bonanza.model.analysis.RegisteredToolchains.Key
+	(*RegisteredToolchains_Value)(nil),                         // 248: bonanza.model.analysis.RegisteredToolchains.Value
+	(*RegisteredToolchains_Value_RegisteredToolchainType)(nil), // 249: bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType
+	(*RegisteredToolchainsForType_Key)(nil),                    // 250: bonanza.model.analysis.RegisteredToolchainsForType.Key
+	(*RegisteredToolchainsForType_Value)(nil),                  // 251: bonanza.model.analysis.RegisteredToolchainsForType.Value
+	(*Repo_Key)(nil),                                           // 252: bonanza.model.analysis.Repo.Key
+	(*Repo_Value)(nil),                                         // 253: bonanza.model.analysis.Repo.Value
+	(*RepoDefaultAttrs_Key)(nil),                               // 254: bonanza.model.analysis.RepoDefaultAttrs.Key
+	(*RepoDefaultAttrs_Value)(nil),                             // 255: bonanza.model.analysis.RepoDefaultAttrs.Value
+	(*RepoPlatformHostPath_Key)(nil),                           // 256: bonanza.model.analysis.RepoPlatformHostPath.Key
+	(*RepoPlatformHostPath_Value)(nil),                         // 257: bonanza.model.analysis.RepoPlatformHostPath.Value
+	(*ResolvedToolchains_Key)(nil),                             // 258: bonanza.model.analysis.ResolvedToolchains.Key
+	(*ResolvedToolchains_Value)(nil),                           // 259: bonanza.model.analysis.ResolvedToolchains.Value
+	(*RootModule_Key)(nil),                                     // 260: bonanza.model.analysis.RootModule.Key
+	(*RootModule_Value)(nil),                                   // 261: bonanza.model.analysis.RootModule.Value
+	(*RuleImplementationWrappers_Key)(nil),                     // 262: bonanza.model.analysis.RuleImplementationWrappers.Key
+	(*Select_Key)(nil),                                         // 263: bonanza.model.analysis.Select.Key
+	(*Select_Value)(nil),                                       // 264: bonanza.model.analysis.Select.Value
+	(*StableInputRootPath_Key)(nil),                            // 265: bonanza.model.analysis.StableInputRootPath.Key
+	(*StableInputRootPath_Value)(nil),                          // 266: bonanza.model.analysis.StableInputRootPath.Value
+	(*StableInputRootPathObject_Key)(nil),                      // 267: bonanza.model.analysis.StableInputRootPathObject.Key
+	(*SuccessfulActionResult_Key)(nil),                         // 268: bonanza.model.analysis.SuccessfulActionResult.Key
+	(*SuccessfulActionResult_Value)(nil),                       // 269: bonanza.model.analysis.SuccessfulActionResult.Value
+	(*Target_Key)(nil),                                         // 270: bonanza.model.analysis.Target.Key
+	(*Target_Value)(nil),                                       // 271: bonanza.model.analysis.Target.Value
+	(*TargetAction_Key)(nil),                                   // 272: bonanza.model.analysis.TargetAction.Key
+	(*TargetAction_Value)(nil),                                 // 273: bonanza.model.analysis.TargetAction.Value
+	(*TargetActionCommand_Key)(nil),                            // 274: bonanza.model.analysis.TargetActionCommand.Key
+	(*TargetActionCommand_Value)(nil),                          // 275: bonanza.model.analysis.TargetActionCommand.Value
+	(*TargetActionInputRoot_Key)(nil),                          // 276: bonanza.model.analysis.TargetActionInputRoot.Key
+	(*TargetActionInputRoot_Value)(nil),                        // 277: bonanza.model.analysis.TargetActionInputRoot.Value
+	(*TargetActionResult_Key)(nil),                             // 278: bonanza.model.analysis.TargetActionResult.Key
+	(*TargetActionResult_Value)(nil),                           // 279: bonanza.model.analysis.TargetActionResult.Value
+	(*TargetCompletion_Key)(nil),                               // 280: bonanza.model.analysis.TargetCompletion.Key
+	(*TargetCompletion_Value)(nil),                             // 281: bonanza.model.analysis.TargetCompletion.Value
+	(*TargetExecutable_Key)(nil),                               // 282: bonanza.model.analysis.TargetExecutable.Key
+	(*TargetExecutable_Value)(nil),                             // 283: bonanza.model.analysis.TargetExecutable.Value
+	(*TargetExecutable_Value_EnvironmentVariable)(nil),         // 284: bonanza.model.analysis.TargetExecutable.Value.EnvironmentVariable
+	(*TargetPatternExpansion_Key)(nil),                         // 285: bonanza.model.analysis.TargetPatternExpansion.Key
+	(*TargetPatternExpansion_Value)(nil),                       // 286: bonanza.model.analysis.TargetPatternExpansion.Value
+	(*TargetPatternExpansion_Value_TargetLabel)(nil),           // 287: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel
+	(*TargetPatternExpansion_Value_TargetLabel_Parent)(nil),    // 288: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent
+	(*TestResult_Key)(nil),                                     // 289: bonanza.model.analysis.TestResult.Key
+	(*TestResult_Value)(nil),                                   // 290: bonanza.model.analysis.TestResult.Value
+	(*ModuleExtension_User)(nil),                               // 291: bonanza.model.analysis.ModuleExtension.User
+	(*ModuleExtension_TagClass)(nil),                           // 292: bonanza.model.analysis.ModuleExtension.TagClass
+	(*ModuleExtension_Tag)(nil),                                // 293: bonanza.model.analysis.ModuleExtension.Tag
+	(*RepositoryRuleObject_Key)(nil),                           // 294: bonanza.model.analysis.RepositoryRuleObject.Key
+	(*UpdatedModuleLockfile_Key)(nil),                          // 295: bonanza.model.analysis.UpdatedModuleLockfile.Key
+	(*UpdatedModuleLockfile_Value)(nil),                        // 296: bonanza.model.analysis.UpdatedModuleLockfile.Value
+	(*UsedModuleExtension_Key)(nil),                            // 297: bonanza.model.analysis.UsedModuleExtension.Key
+	(*UsedModuleExtension_Value)(nil),                          // 298: bonanza.model.analysis.UsedModuleExtension.Value
+	(*UsedModuleExtensions_Key)(nil),                           // 299: bonanza.model.analysis.UsedModuleExtensions.Key
+	(*UsedModuleExtensions_Value)(nil),                         // 300: bonanza.model.analysis.UsedModuleExtensions.Value
+	(*UserDefinedTransition_Key)(nil),                          // 301: bonanza.model.analysis.UserDefinedTransition.Key
+	(*UserDefinedTransition_Value)(nil),                        // 302: bonanza.model.analysis.UserDefinedTransition.Value
+	(*UserDefinedTransition_Value_Success)(nil),                // 303: bonanza.model.analysis.UserDefinedTransition.Value.Success
+	(*UserDefinedTransition_Value_Success_Entry)(nil),          // 304: bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry
+	(*VisibleTarget_Key)(nil),                                  // 305: bonanza.model.analysis.VisibleTarget.Key
+	(*VisibleTarget_Value)(nil),                                // 306: bonanza.model.analysis.VisibleTarget.Value
+	(*core.DecodableReference)(nil),                            // 307: bonanza.model.core.DecodableReference
+	(*durationpb.Duration)(nil),                                // 308: google.protobuf.Duration
+	(*starlark.List_Element)(nil),                              // 309: bonanza.model.starlark.List.Element
+	(*command.PathPattern)(nil),                                // 310: bonanza.model.command.PathPattern
+	(*filesystem.Directory)(nil),                               // 311: bonanza.model.filesystem.Directory
+	(*command.EnvironmentVariableList_Element)(nil),            // 312: bonanza.model.command.EnvironmentVariableList.Element
+	(*filesystem.DirectoryContents)(nil),                       // 313: bonanza.model.filesystem.DirectoryContents
+	(*fetch.Target)(nil),                                       // 314: bonanza.model.fetch.Target
+	(*starlark.Repo_Definition)(nil),                           // 315: bonanza.model.starlark.Repo.Definition
+	(*encoding.BinaryEncoder)(nil),                             // 316: bonanza.model.encoding.BinaryEncoder
+	(*command.EnvironmentVariableList_Element_Leaf)(nil),       // 317: bonanza.model.command.EnvironmentVariableList.Element.Leaf
+	(*filesystem.DirectoryCreationParameters)(nil),             // 318: bonanza.model.filesystem.DirectoryCreationParameters
+	(*filesystem.FileCreationParameters)(nil),                  // 319: bonanza.model.filesystem.FileCreationParameters
+	(*filesystem.DirectoryReference)(nil),                      // 320: bonanza.model.filesystem.DirectoryReference
+	(*starlark.CompiledProgram)(nil),                           // 321: bonanza.model.starlark.CompiledProgram
+	(*starlark.Value)(nil),                                     // 322: bonanza.model.starlark.Value
+	(*wrapperspb.StringValue)(nil),                             // 323: google.protobuf.StringValue
+	(*starlark.Function)(nil),                                  // 324: bonanza.model.starlark.Function
+	(*starlark.File)(nil),                                      // 325: bonanza.model.starlark.File
+	(*starlark.Struct)(nil),                                    // 326: bonanza.model.starlark.Struct
+	(*filesystem.DirectoryAccessParameters)(nil),               // 327: bonanza.model.filesystem.DirectoryAccessParameters
+	(*filesystem.FileAccessParameters)(nil),                    // 328: bonanza.model.filesystem.FileAccessParameters
+	(*filesystem.FileProperties)(nil),                          // 329: bonanza.model.filesystem.FileProperties
+	(*fetch.GitTarget)(nil),                                    // 330: bonanza.model.fetch.GitTarget
+	(*fetch.Result_Success)(nil),                               // 331: bonanza.model.fetch.Result.Success
+	(*filesystem.FileContents)(nil),                            // 332: bonanza.model.filesystem.FileContents
+	(*starlark.Repo)(nil),                                      // 333: bonanza.model.starlark.Repo
+	(*starlark.Target)(nil),                                    // 334: bonanza.model.starlark.Target
+	(*starlark.InheritableAttrs)(nil),                          // 335: bonanza.model.starlark.InheritableAttrs
+	(*starlark.ToolchainType)(nil),                             // 336: bonanza.model.starlark.ToolchainType
+	(*starlark.Target_Definition)(nil),                         // 337: bonanza.model.starlark.Target.Definition
+	(*starlark.Struct_Fields)(nil),                             // 338: bonanza.model.starlark.Struct.Fields
+	(*emptypb.Empty)(nil),                                      // 339: google.protobuf.Empty
 }
 var file_bonanza_build_pkg_proto_model_analysis_analysis_proto_depIdxs = []int32{
-	273, // 0: bonanza.model.analysis.ExecuteRequest.action_reference:type_name -> bonanza.model.core.DecodableReference
-	274, // 1: bonanza.model.analysis.ExecuteRequest.execution_timeout:type_name -> google.protobuf.Duration
-	121, // 2: bonanza.model.analysis.BuildSettingOverride.leaf:type_name -> bonanza.model.analysis.BuildSettingOverride.Leaf
-	122, // 3: bonanza.model.analysis.BuildSettingOverride.parent:type_name -> bonanza.model.analysis.BuildSettingOverride.Parent
-	124, // 4: bonanza.model.analysis.Args.leaf:type_name -> bonanza.model.analysis.Args.Leaf
-	123, // 5: bonanza.model.analysis.Args.parent:type_name -> bonanza.model.analysis.Args.Parent
-	132, // 6: bonanza.model.analysis.FilesToRunProvider.leaf:type_name -> bonanza.model.analysis.FilesToRunProvider.Leaf
-	131, // 7: bonanza.model.analysis.FilesToRunProvider.parent:type_name -> bonanza.model.analysis.FilesToRunProvider.Parent
-	275, // 8: bonanza.model.analysis.TargetActionDefinition.inputs:type_name -> bonanza.model.starlark.List.Element
-	20,  // 9: bonanza.model.analysis.TargetActionDefinition.tools:type_name -> bonanza.model.analysis.FilesToRunProvider
-	19,  // 10: bonanza.model.analysis.TargetActionDefinition.arguments:type_name -> bonanza.model.analysis.Args
-	276, // 11: bonanza.model.analysis.TargetActionDefinition.output_path_pattern:type_name -> bonanza.model.command.PathPattern
-	277, // 12: bonanza.model.analysis.TargetActionDefinition.initial_output_directory:type_name -> bonanza.model.filesystem.Directory
-	278, // 13: bonanza.model.analysis.TargetActionDefinition.env:type_name -> bonanza.model.command.EnvironmentVariableList.Element
-	133, // 14: bonanza.model.analysis.TargetOutputDefinition.expand_template:type_name -> bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate
-	279, // 15: bonanza.model.analysis.TargetOutputDefinition.static_package_directory:type_name -> bonanza.model.filesystem.DirectoryContents
-	134, // 16: bonanza.model.analysis.TargetOutputDefinition.symlink:type_name -> bonanza.model.analysis.TargetOutputDefinition.Symlink
-	280, // 17: bonanza.model.analysis.HttpFetchOptions.target:type_name -> bonanza.model.fetch.Target
-	201, // 18: bonanza.model.analysis.ModuleOverride.single_version:type_name -> bonanza.model.analysis.ModuleOverride.SingleVersion
-	202, // 19: bonanza.model.analysis.ModuleOverride.multiple_versions:type_name -> bonanza.model.analysis.ModuleOverride.MultipleVersions
-	281, // 20: bonanza.model.analysis.ModuleOverride.repository_rule:type_name -> bonanza.model.starlark.Repo.Definition
-	61,  // 21: bonanza.model.analysis.ExecutionPlatform.constraints:type_name -> bonanza.model.analysis.Constraint
-	61,  // 22: bonanza.model.analysis.RegisteredToolchain.exec_compatible_with:type_name -> bonanza.model.analysis.Constraint
-	61,  // 23: bonanza.model.analysis.RegisteredToolchain.target_compatible_with:type_name -> bonanza.model.analysis.Constraint
-	273, // 24: bonanza.model.analysis.TargetActionId.configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	259, // 25: bonanza.model.analysis.ModuleExtension.users:type_name -> bonanza.model.analysis.ModuleExtension.User
-	282, // 26: bonanza.model.analysis.ActionEncoders.Value.action_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
-	6,   // 27: bonanza.model.analysis.ActionResult.Key.execute_request:type_name -> bonanza.model.analysis.ExecuteRequest
-	273, // 28: bonanza.model.analysis.ActionResult.Value.outputs_reference:type_name -> bonanza.model.core.DecodableReference
-	101, // 29: bonanza.model.analysis.BuildSpecification.Value.modules:type_name -> bonanza.model.analysis.BuildSpecification.Value.Module
-	283, // 30: bonanza.model.analysis.BuildSpecification.Value.directory_creation_parameters:type_name -> bonanza.model.filesystem.DirectoryCreationParameters
-	284, // 31: bonanza.model.analysis.BuildSpecification.Value.file_creation_parameters:type_name -> bonanza.model.filesystem.FileCreationParameters
-	102, // 32: bonanza.model.analysis.BuildSpecification.Value.use_lockfile:type_name -> bonanza.model.analysis.BuildSpecification.Value.UseLockfile
-	282, // 33: bonanza.model.analysis.BuildSpecification.Value.action_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
-	104, // 34: bonanza.model.analysis.BuildSpecification.Value.configurations:type_name -> bonanza.model.analysis.BuildSpecification.Value.Configuration
-	285, // 35: bonanza.model.analysis.BuildSpecification.Value.Module.root_directory_reference:type_name -> bonanza.model.filesystem.DirectoryReference
-	274, // 36: bonanza.model.analysis.BuildSpecification.Value.UseLockfile.maximum_cache_duration:type_name -> google.protobuf.Duration
-	103, // 37: bonanza.model.analysis.BuildSpecification.Value.Configuration.build_setting_overrides:type_name -> bonanza.model.analysis.BuildSpecification.Value.BuildSettingOverride
-	61,  // 38: bonanza.model.analysis.CompatibleExecutionPlatforms.Key.constraints:type_name -> bonanza.model.analysis.Constraint
-	62,  // 39: bonanza.model.analysis.CompatibleExecutionPlatforms.Value.execution_platforms:type_name -> bonanza.model.analysis.ExecutionPlatform
-	273, // 40: bonanza.model.analysis.CompatibleToolchainsForType.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	66,  // 41: bonanza.model.analysis.CompatibleToolchainsForType.Value.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
-	286, // 42: bonanza.model.analysis.CompiledBzlFile.Value.compiled_program:type_name -> bonanza.model.starlark.CompiledProgram
-	287, // 43: bonanza.model.analysis.CompiledBzlFileGlobal.Value.global:type_name -> bonanza.model.starlark.Value
-	287, // 44: bonanza.model.analysis.BuildSettingOverride.Leaf.value:type_name -> bonanza.model.starlark.Value
-	273, // 45: bonanza.model.analysis.BuildSettingOverride.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	273, // 46: bonanza.model.analysis.Args.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	125, // 47: bonanza.model.analysis.Args.Leaf.adds:type_name -> bonanza.model.analysis.Args.Leaf.Add
-	126, // 48: bonanza.model.analysis.Args.Leaf.use_param_file:type_name -> bonanza.model.analysis.Args.Leaf.UseParamFile
-	128, // 49: bonanza.model.analysis.Args.Leaf.Add.leaf:type_name -> bonanza.model.analysis.Args.Leaf.Add.Leaf
-	127, // 50: bonanza.model.analysis.Args.Leaf.Add.parent:type_name -> bonanza.model.analysis.Args.Leaf.Add.Parent
-	1,   // 51: bonanza.model.analysis.Args.Leaf.UseParamFile.format:type_name -> bonanza.model.analysis.Args.Leaf.UseParamFile.Format
-	273, // 52: bonanza.model.analysis.Args.Leaf.Add.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	288, // 53: bonanza.model.analysis.Args.Leaf.Add.Leaf.start_with:type_name -> google.protobuf.StringValue
-	287, // 54: bonanza.model.analysis.Args.Leaf.Add.Leaf.values:type_name -> bonanza.model.starlark.Value
-	289, // 55: bonanza.model.analysis.Args.Leaf.Add.Leaf.map_each:type_name -> bonanza.model.starlark.Function
-	129, // 56: bonanza.model.analysis.Args.Leaf.Add.Leaf.separate:type_name -> bonanza.model.analysis.Args.Leaf.Add.Leaf.Separate
-	130, // 57: bonanza.model.analysis.Args.Leaf.Add.Leaf.joined:type_name -> bonanza.model.analysis.Args.Leaf.Add.Leaf.Joined
-	288, // 58: bonanza.model.analysis.Args.Leaf.Add.Leaf.Separate.before_each:type_name -> google.protobuf.StringValue
-	288, // 59: bonanza.model.analysis.Args.Leaf.Add.Leaf.Separate.terminate_with:type_name -> google.protobuf.StringValue
-	273, // 60: bonanza.model.analysis.FilesToRunProvider.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	290, // 61: bonanza.model.analysis.FilesToRunProvider.Leaf.executable:type_name -> bonanza.model.starlark.File
-	275, // 62: bonanza.model.analysis.FilesToRunProvider.Leaf.runfiles_files:type_name -> bonanza.model.starlark.List.Element
-	275, // 63: bonanza.model.analysis.FilesToRunProvider.Leaf.runfiles_symlinks:type_name -> bonanza.model.starlark.List.Element
-	275, // 64: bonanza.model.analysis.FilesToRunProvider.Leaf.runfiles_root_symlinks:type_name -> bonanza.model.starlark.List.Element
-	290, // 65: bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate.template:type_name -> bonanza.model.starlark.File
-	135, // 66: bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate.substitutions:type_name -> bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate.Substitution
-	290, // 67: bonanza.model.analysis.TargetOutputDefinition.Symlink.target:type_name -> bonanza.model.starlark.File
-	273, // 68: bonanza.model.analysis.ConfiguredTarget.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	291, // 69: bonanza.model.analysis.ConfiguredTarget.Value.provider_instances:type_name -> bonanza.model.starlark.Struct
-	138, // 70: bonanza.model.analysis.ConfiguredTarget.Value.outputs:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Output
-	139, // 71: bonanza.model.analysis.ConfiguredTarget.Value.actions:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Action
-	141, // 72: bonanza.model.analysis.ConfiguredTarget.Value.Output.leaf:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Output.Leaf
-	140, // 73: bonanza.model.analysis.ConfiguredTarget.Value.Output.parent:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Output.Parent
-	143, // 74: bonanza.model.analysis.ConfiguredTarget.Value.Action.leaf:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Action.Leaf
-	142, // 75: bonanza.model.analysis.ConfiguredTarget.Value.Action.parent:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Action.Parent
-	273, // 76: bonanza.model.analysis.ConfiguredTarget.Value.Output.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	22,  // 77: bonanza.model.analysis.ConfiguredTarget.Value.Output.Leaf.definition:type_name -> bonanza.model.analysis.TargetOutputDefinition
-	273, // 78: bonanza.model.analysis.ConfiguredTarget.Value.Action.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	21,  // 79: bonanza.model.analysis.ConfiguredTarget.Value.Action.Leaf.definition:type_name -> bonanza.model.analysis.TargetActionDefinition
-	273, // 80: bonanza.model.analysis.TargetOutput.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	22,  // 81: bonanza.model.analysis.TargetOutput.Value.definition:type_name -> bonanza.model.analysis.TargetOutputDefinition
-	292, // 82: bonanza.model.analysis.DirectoryAccessParameters.Value.directory_access_parameters:type_name -> bonanza.model.filesystem.DirectoryAccessParameters
-	283, // 83: bonanza.model.analysis.DirectoryCreationParameters.Value.directory_creation_parameters:type_name -> bonanza.model.filesystem.DirectoryCreationParameters
-	291, // 84: bonanza.model.analysis.EmptyDefaultInfo.Value.default_info:type_name -> bonanza.model.starlark.Struct
-	273, // 85: bonanza.model.analysis.ExecTransition.Key.input_configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	273, // 86: bonanza.model.analysis.ExecTransition.Value.output_configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	293, // 87: bonanza.model.analysis.FileAccessParameters.Value.file_access_parameters:type_name -> bonanza.model.filesystem.FileAccessParameters
-	284, // 88: bonanza.model.analysis.FileCreationParameters.Value.file_creation_parameters:type_name -> bonanza.model.filesystem.FileCreationParameters
-	294, // 89: bonanza.model.analysis.FileProperties.Value.exists:type_name -> bonanza.model.filesystem.FileProperties
-	290, // 90: bonanza.model.analysis.FileRoot.Key.file:type_name -> bonanza.model.starlark.File
-	0,   // 91: bonanza.model.analysis.FileRoot.Key.directory_layout:type_name -> bonanza.model.analysis.DirectoryLayout
-	279, // 92: bonanza.model.analysis.FileRoot.Value.root_directory:type_name -> bonanza.model.filesystem.DirectoryContents
-	279, // 93: bonanza.model.analysis.FilesInPackage.Value.directory:type_name -> bonanza.model.filesystem.DirectoryContents
-	273, // 94: bonanza.model.analysis.FilesRoot.Key.list_reference:type_name -> bonanza.model.core.DecodableReference
-	0,   // 95: bonanza.model.analysis.FilesRoot.Key.directory_layout:type_name -> bonanza.model.analysis.DirectoryLayout
-	279, // 96: bonanza.model.analysis.FilesRoot.Value.root_directory:type_name -> bonanza.model.filesystem.DirectoryContents
-	40,  // 97: bonanza.model.analysis.HttpArchiveContents.Key.fetch_options:type_name -> bonanza.model.analysis.HttpFetchOptions
-	2,   // 98: bonanza.model.analysis.HttpArchiveContents.Key.format:type_name -> bonanza.model.analysis.HttpArchiveContents.Key.Format
-	174, // 99: bonanza.model.analysis.HttpArchiveContents.Value.exists:type_name -> bonanza.model.analysis.HttpArchiveContents.Value.Exists
-	285, // 100: bonanza.model.analysis.HttpArchiveContents.Value.Exists.contents:type_name -> bonanza.model.filesystem.DirectoryReference
-	40,  // 101: bonanza.model.analysis.HttpFileContents.Key.fetch_options:type_name -> bonanza.model.analysis.HttpFetchOptions
-	295, // 102: bonanza.model.analysis.HttpFileContents.Value.exists:type_name -> bonanza.model.fetch.Result.Success
-	296, // 103: bonanza.model.analysis.ModuleDotBazelContents.Value.contents:type_name -> bonanza.model.filesystem.FileContents
-	183, // 104: bonanza.model.analysis.ModuleRepoMapping.Value.mappings:type_name -> bonanza.model.analysis.ModuleRepoMapping.Value.Mapping
-	281, // 105: bonanza.model.analysis.ModuleExtensionRepo.Value.definition:type_name -> bonanza.model.starlark.Repo.Definition
-	190, // 106: bonanza.model.analysis.ModuleExtensionRepos.Value.repos:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.Repo
-	297, // 107: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.leaf:type_name -> bonanza.model.starlark.Repo
-	191, // 108: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.parent:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent
-	273, // 109: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	49,  // 110: bonanza.model.analysis.ModuleFinalBuildList.Value.build_list:type_name -> bonanza.model.analysis.BuildListModule
-	49,  // 111: bonanza.model.analysis.ModuleRoughBuildList.Value.build_list:type_name -> bonanza.model.analysis.BuildListModule
-	52,  // 112: bonanza.model.analysis.ModulesWithMultipleVersions.Value.overrides_list:type_name -> bonanza.model.analysis.OverridesListModule
-	52,  // 113: bonanza.model.analysis.ModulesWithOverrides.Value.overrides_list:type_name -> bonanza.model.analysis.OverridesListModule
-	56,  // 114: bonanza.model.analysis.ModulesWithRemoteOverrides.Value.module_overrides:type_name -> bonanza.model.analysis.ModuleOverride
-	207, // 115: bonanza.model.analysis.Package.Value.targets:type_name -> bonanza.model.analysis.Package.Value.Target
-	298, // 116: bonanza.model.analysis.Package.Value.Target.leaf:type_name -> bonanza.model.starlark.Target
-	208, // 117: bonanza.model.analysis.Package.Value.Target.parent:type_name -> bonanza.model.analysis.Package.Value.Target.Parent
-	273, // 118: bonanza.model.analysis.Package.Value.Target.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	62,  // 119: bonanza.model.analysis.RegisteredExecutionPlatforms.Value.execution_platforms:type_name -> bonanza.model.analysis.ExecutionPlatform
-	219, // 120: bonanza.model.analysis.RegisteredRepoPlatform.Value.repository_os_environ:type_name -> bonanza.model.analysis.RegisteredRepoPlatform.Value.EnvironmentVariable
-	222, // 121: bonanza.model.analysis.RegisteredToolchains.Value.toolchain_types:type_name -> bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType
-	66,  // 122: bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
-	66,  // 123: bonanza.model.analysis.RegisteredToolchainsForType.Value.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
-	285, // 124: bonanza.model.analysis.Repo.Value.root_directory_reference:type_name -> bonanza.model.filesystem.DirectoryReference
-	299, // 125: bonanza.model.analysis.RepoDefaultAttrs.Value.inheritable_attrs:type_name -> bonanza.model.starlark.InheritableAttrs
-	294, // 126: bonanza.model.analysis.RepoPlatformHostPath.Value.file:type_name -> bonanza.model.filesystem.FileProperties
-	279, // 127: bonanza.model.analysis.RepoPlatformHostPath.Value.directory:type_name -> bonanza.model.filesystem.DirectoryContents
-	61,  // 128: bonanza.model.analysis.ResolvedToolchains.Key.exec_compatible_with:type_name -> bonanza.model.analysis.Constraint
-	273, // 129: bonanza.model.analysis.ResolvedToolchains.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	300, // 130: bonanza.model.analysis.ResolvedToolchains.Key.toolchains:type_name -> bonanza.model.starlark.ToolchainType
-	273, // 131: bonanza.model.analysis.Select.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	6,   // 132: bonanza.model.analysis.SuccessfulActionResult.Key.execute_request:type_name -> bonanza.model.analysis.ExecuteRequest
-	273, // 133: bonanza.model.analysis.SuccessfulActionResult.Value.outputs_reference:type_name -> bonanza.model.core.DecodableReference
-	301, // 134: bonanza.model.analysis.Target.Value.definition:type_name -> bonanza.model.starlark.Target.Definition
-	80,  // 135: bonanza.model.analysis.TargetAction.Key.id:type_name -> bonanza.model.analysis.TargetActionId
-	21,  // 136: bonanza.model.analysis.TargetAction.Value.definition:type_name -> bonanza.model.analysis.TargetActionDefinition
-	80,  // 137: bonanza.model.analysis.TargetActionCommand.Key.id:type_name -> bonanza.model.analysis.TargetActionId
-	273, // 138: bonanza.model.analysis.TargetActionCommand.Value.command_reference:type_name -> bonanza.model.core.DecodableReference
-	80,  // 139: bonanza.model.analysis.TargetActionInputRoot.Key.id:type_name -> bonanza.model.analysis.TargetActionId
-	285, // 140: bonanza.model.analysis.TargetActionInputRoot.Value.input_root_reference:type_name -> bonanza.model.filesystem.DirectoryReference
-	80,  // 141: bonanza.model.analysis.TargetActionResult.Key.id:type_name -> bonanza.model.analysis.TargetActionId
-	279, // 142: bonanza.model.analysis.TargetActionResult.Value.output_root:type_name -> bonanza.model.filesystem.DirectoryContents
-	273, // 143: bonanza.model.analysis.TargetCompletion.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	257, // 144: bonanza.model.analysis.TargetPatternExpansion.Value.target_labels:type_name -> bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel
-	258, // 145: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.parent:type_name -> bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent
-	273, // 146: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	260, // 147: bonanza.model.analysis.ModuleExtension.User.tag_classes:type_name -> bonanza.model.analysis.ModuleExtension.TagClass
-	261, // 148: bonanza.model.analysis.ModuleExtension.TagClass.tags:type_name -> bonanza.model.analysis.ModuleExtension.Tag
-	302, // 149: bonanza.model.analysis.ModuleExtension.Tag.attrs:type_name -> bonanza.model.starlark.Struct.Fields
-	87,  // 150: bonanza.model.analysis.UsedModuleExtension.Value.module_extension:type_name -> bonanza.model.analysis.ModuleExtension
-	87,  // 151: bonanza.model.analysis.UsedModuleExtensions.Value.module_extensions:type_name -> bonanza.model.analysis.ModuleExtension
-	273, // 152: bonanza.model.analysis.UserDefinedTransition.Key.input_configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	303, // 153: bonanza.model.analysis.UserDefinedTransition.Value.transition_depends_on_attrs:type_name -> google.protobuf.Empty
-	269, // 154: bonanza.model.analysis.UserDefinedTransition.Value.success:type_name -> bonanza.model.analysis.UserDefinedTransition.Value.Success
-	270, // 155: bonanza.model.analysis.UserDefinedTransition.Value.Success.entries:type_name -> bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry
-	273, // 156: bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry.output_configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	273, // 157: bonanza.model.analysis.VisibleTarget.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
-	158, // [158:158] is the sub-list for method output_type
-	158, // [158:158] is the sub-list for method input_type
-	158, // [158:158] is the sub-list for extension type_name
-	158, // [158:158] is the sub-list for extension extendee
-	0,   // [0:158] is the sub-list for field type_name
+	307, // 0: bonanza.model.analysis.ExecuteRequest.action_reference:type_name -> bonanza.model.core.DecodableReference
+	308, // 1: bonanza.model.analysis.ExecuteRequest.execution_timeout:type_name -> google.protobuf.Duration
+	137, // 2: bonanza.model.analysis.BuildSettingOverride.leaf:type_name -> bonanza.model.analysis.BuildSettingOverride.Leaf
+	138, // 3: bonanza.model.analysis.BuildSettingOverride.parent:type_name -> bonanza.model.analysis.BuildSettingOverride.Parent
+	140, // 4: bonanza.model.analysis.Args.leaf:type_name -> bonanza.model.analysis.Args.Leaf
+	139, // 5: bonanza.model.analysis.Args.parent:type_name -> bonanza.model.analysis.Args.Parent
+	148, // 6: bonanza.model.analysis.FilesToRunProvider.leaf:type_name -> bonanza.model.analysis.FilesToRunProvider.Leaf
+	147, // 7: bonanza.model.analysis.FilesToRunProvider.parent:type_name -> bonanza.model.analysis.FilesToRunProvider.Parent
+	309, // 8: bonanza.model.analysis.TargetActionDefinition.inputs:type_name -> bonanza.model.starlark.List.Element
+	21,  // 9: bonanza.model.analysis.TargetActionDefinition.tools:type_name -> bonanza.model.analysis.FilesToRunProvider
+	20,  // 10: bonanza.model.analysis.TargetActionDefinition.arguments:type_name -> bonanza.model.analysis.Args
+	310, // 11: bonanza.model.analysis.TargetActionDefinition.output_path_pattern:type_name -> bonanza.model.command.PathPattern
+	311, // 12: bonanza.model.analysis.TargetActionDefinition.initial_output_directory:type_name -> bonanza.model.filesystem.Directory
+	312, // 13: bonanza.model.analysis.TargetActionDefinition.env:type_name -> bonanza.model.command.EnvironmentVariableList.Element
+	149, // 14: bonanza.model.analysis.TargetOutputDefinition.expand_template:type_name -> bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate
+	313, // 15: bonanza.model.analysis.TargetOutputDefinition.static_package_directory:type_name -> bonanza.model.filesystem.DirectoryContents
+	150, // 16: bonanza.model.analysis.TargetOutputDefinition.symlink:type_name -> bonanza.model.analysis.TargetOutputDefinition.Symlink
+	314, // 17: bonanza.model.analysis.HttpFetchOptions.target:type_name -> bonanza.model.fetch.Target
+	224, // 18: bonanza.model.analysis.ModuleOverride.single_version:type_name -> bonanza.model.analysis.ModuleOverride.SingleVersion
+	225, // 19: bonanza.model.analysis.ModuleOverride.multiple_versions:type_name -> bonanza.model.analysis.ModuleOverride.MultipleVersions
+	315, // 20: bonanza.model.analysis.ModuleOverride.repository_rule:type_name -> bonanza.model.starlark.Repo.Definition
+	67,  // 21: bonanza.model.analysis.ExecutionPlatform.constraints:type_name -> bonanza.model.analysis.Constraint
+	67,  // 22: bonanza.model.analysis.RegisteredToolchain.exec_compatible_with:type_name -> bonanza.model.analysis.Constraint
+	67,  // 23: bonanza.model.analysis.RegisteredToolchain.target_compatible_with:type_name -> bonanza.model.analysis.Constraint
+	307, // 24: bonanza.model.analysis.TargetActionId.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	291, // 25: bonanza.model.analysis.ModuleExtension.users:type_name -> bonanza.model.analysis.ModuleExtension.User
+	316, // 26: bonanza.model.analysis.ActionEncoders.Value.action_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
+	108, // 27: bonanza.model.analysis.ActionQueryResult.Value.actions:type_name -> bonanza.model.analysis.ActionQueryResult.Value.Action
+	317, // 28: bonanza.model.analysis.ActionQueryResult.Value.Action.environment_variables:type_name -> bonanza.model.command.EnvironmentVariableList.Element.Leaf
+	7,   // 29: bonanza.model.analysis.ActionResult.Key.execute_request:type_name -> bonanza.model.analysis.ExecuteRequest
+	307, // 30: bonanza.model.analysis.ActionResult.Value.outputs_reference:type_name -> bonanza.model.core.DecodableReference
+	113, // 31: bonanza.model.analysis.BuildSpecification.Value.modules:type_name -> bonanza.model.analysis.BuildSpecification.Value.Module
+	318, // 32: bonanza.model.analysis.BuildSpecification.Value.directory_creation_parameters:type_name -> bonanza.model.filesystem.DirectoryCreationParameters
+	319, // 33: bonanza.model.analysis.BuildSpecification.Value.file_creation_parameters:type_name -> bonanza.model.filesystem.FileCreationParameters
+	114, // 34: bonanza.model.analysis.BuildSpecification.Value.use_lockfile:type_name -> bonanza.model.analysis.BuildSpecification.Value.UseLockfile
+	316, // 35: bonanza.model.analysis.BuildSpecification.Value.action_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
+	116, // 36: bonanza.model.analysis.BuildSpecification.Value.configurations:type_name -> bonanza.model.analysis.BuildSpecification.Value.Configuration
+	320, // 37: bonanza.model.analysis.BuildSpecification.Value.Module.root_directory_reference:type_name -> bonanza.model.filesystem.DirectoryReference
+	308, // 38: bonanza.model.analysis.BuildSpecification.Value.UseLockfile.maximum_cache_duration:type_name -> google.protobuf.Duration
+	115, // 39: bonanza.model.analysis.BuildSpecification.Value.Configuration.build_setting_overrides:type_name -> bonanza.model.analysis.BuildSpecification.Value.BuildSettingOverride
+	121, // 40: bonanza.model.analysis.BuildResult.Key.test_parameters:type_name -> bonanza.model.analysis.BuildResult.Key.TestParameters
+	122, // 41: bonanza.model.analysis.BuildResult.Value.target_completions:type_name -> bonanza.model.analysis.BuildResult.Value.TargetCompletion
+	123, // 42: bonanza.model.analysis.BuildResult.Value.test_results:type_name -> bonanza.model.analysis.BuildResult.Value.TestResult
+	124, // 43: bonanza.model.analysis.BuildResult.Value.executables:type_name -> bonanza.model.analysis.BuildResult.Value.Executable
+	313, // 44: bonanza.model.analysis.BuildResult.Value.TargetCompletion.root_directory:type_name -> bonanza.model.filesystem.DirectoryContents
+	290, // 45: bonanza.model.analysis.BuildResult.Value.TestResult.result:type_name -> bonanza.model.analysis.TestResult.Value
+	283, // 46: bonanza.model.analysis.BuildResult.Value.Executable.executable:type_name -> bonanza.model.analysis.TargetExecutable.Value
+	67,  // 47: bonanza.model.analysis.CompatibleExecutionPlatforms.Key.constraints:type_name -> bonanza.model.analysis.Constraint
+	68,  // 48: bonanza.model.analysis.CompatibleExecutionPlatforms.Value.execution_platforms:type_name -> bonanza.model.analysis.ExecutionPlatform
+	307, // 49: bonanza.model.analysis.CompatibleToolchainsForType.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	72,  // 50: bonanza.model.analysis.CompatibleToolchainsForType.Value.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
+	321, // 51: bonanza.model.analysis.CompiledBzlFile.Value.compiled_program:type_name -> bonanza.model.starlark.CompiledProgram
+	322, // 52: bonanza.model.analysis.CompiledBzlFileGlobal.Value.global:type_name -> bonanza.model.starlark.Value
+	322, // 53: bonanza.model.analysis.BuildSettingOverride.Leaf.value:type_name -> bonanza.model.starlark.Value
+	307, // 54: bonanza.model.analysis.BuildSettingOverride.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	307, // 55: bonanza.model.analysis.Args.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	141, // 56: bonanza.model.analysis.Args.Leaf.adds:type_name -> bonanza.model.analysis.Args.Leaf.Add
+	142, // 57: bonanza.model.analysis.Args.Leaf.use_param_file:type_name -> bonanza.model.analysis.Args.Leaf.UseParamFile
+	144, // 58: bonanza.model.analysis.Args.Leaf.Add.leaf:type_name -> bonanza.model.analysis.Args.Leaf.Add.Leaf
+	143, // 59: bonanza.model.analysis.Args.Leaf.Add.parent:type_name -> bonanza.model.analysis.Args.Leaf.Add.Parent
+	1,   // 60: bonanza.model.analysis.Args.Leaf.UseParamFile.format:type_name -> bonanza.model.analysis.Args.Leaf.UseParamFile.Format
+	307, // 61: bonanza.model.analysis.Args.Leaf.Add.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	323, // 62: bonanza.model.analysis.Args.Leaf.Add.Leaf.start_with:type_name -> google.protobuf.StringValue
+	322, // 63: bonanza.model.analysis.Args.Leaf.Add.Leaf.values:type_name -> bonanza.model.starlark.Value
+	324, // 64: bonanza.model.analysis.Args.Leaf.Add.Leaf.map_each:type_name -> bonanza.model.starlark.Function
+	145, // 65: bonanza.model.analysis.Args.Leaf.Add.Leaf.separate:type_name -> bonanza.model.analysis.Args.Leaf.Add.Leaf.Separate
+	146, // 66: bonanza.model.analysis.Args.Leaf.Add.Leaf.joined:type_name -> bonanza.model.analysis.Args.Leaf.Add.Leaf.Joined
+	323, // 67: bonanza.model.analysis.Args.Leaf.Add.Leaf.Separate.before_each:type_name -> google.protobuf.StringValue
+	323, // 68: bonanza.model.analysis.Args.Leaf.Add.Leaf.Separate.terminate_with:type_name -> google.protobuf.StringValue
+	307, // 69: bonanza.model.analysis.FilesToRunProvider.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	325, // 70: bonanza.model.analysis.FilesToRunProvider.Leaf.executable:type_name -> bonanza.model.starlark.File
+	309, // 71: bonanza.model.analysis.FilesToRunProvider.Leaf.runfiles_files:type_name -> bonanza.model.starlark.List.Element
+	309, // 72: bonanza.model.analysis.FilesToRunProvider.Leaf.runfiles_symlinks:type_name -> bonanza.model.starlark.List.Element
+	309, // 73: bonanza.model.analysis.FilesToRunProvider.Leaf.runfiles_root_symlinks:type_name -> bonanza.model.starlark.List.Element
+	325, // 74: bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate.template:type_name -> bonanza.model.starlark.File
+	151, // 75: bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate.substitutions:type_name -> bonanza.model.analysis.TargetOutputDefinition.ExpandTemplate.Substitution
+	325, // 76: bonanza.model.analysis.TargetOutputDefinition.Symlink.target:type_name -> bonanza.model.starlark.File
+	154, // 77: bonanza.model.analysis.ConfiguredQueryResult.Value.targets:type_name -> bonanza.model.analysis.ConfiguredQueryResult.Value.Target
+	307, // 78: bonanza.model.analysis.ConfiguredTarget.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	326, // 79: bonanza.model.analysis.ConfiguredTarget.Value.provider_instances:type_name -> bonanza.model.starlark.Struct
+	157, // 80: bonanza.model.analysis.ConfiguredTarget.Value.outputs:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Output
+	158, // 81: bonanza.model.analysis.ConfiguredTarget.Value.actions:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Action
+	160, // 82: bonanza.model.analysis.ConfiguredTarget.Value.Output.leaf:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Output.Leaf
+	159, // 83: bonanza.model.analysis.ConfiguredTarget.Value.Output.parent:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Output.Parent
+	162, // 84: bonanza.model.analysis.ConfiguredTarget.Value.Action.leaf:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Action.Leaf
+	161, // 85: bonanza.model.analysis.ConfiguredTarget.Value.Action.parent:type_name -> bonanza.model.analysis.ConfiguredTarget.Value.Action.Parent
+	307, // 86: bonanza.model.analysis.ConfiguredTarget.Value.Output.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	23,  // 87: bonanza.model.analysis.ConfiguredTarget.Value.Output.Leaf.definition:type_name -> bonanza.model.analysis.TargetOutputDefinition
+	307, // 88: bonanza.model.analysis.ConfiguredTarget.Value.Action.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	22,  // 89: bonanza.model.analysis.ConfiguredTarget.Value.Action.Leaf.definition:type_name -> bonanza.model.analysis.TargetActionDefinition
+	307, // 90: bonanza.model.analysis.TargetOutput.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	23,  // 91: bonanza.model.analysis.TargetOutput.Value.definition:type_name -> bonanza.model.analysis.TargetOutputDefinition
+	327, // 92: bonanza.model.analysis.DirectoryAccessParameters.Value.directory_access_parameters:type_name -> bonanza.model.filesystem.DirectoryAccessParameters
+	318, // 93: bonanza.model.analysis.DirectoryCreationParameters.Value.directory_creation_parameters:type_name -> bonanza.model.filesystem.DirectoryCreationParameters
+	326, // 94: bonanza.model.analysis.EmptyDefaultInfo.Value.default_info:type_name -> bonanza.model.starlark.Struct
+	307, // 95: bonanza.model.analysis.ExecTransition.Key.input_configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	307, // 96: bonanza.model.analysis.ExecTransition.Value.output_configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	328, // 97: bonanza.model.analysis.FileAccessParameters.Value.file_access_parameters:type_name -> bonanza.model.filesystem.FileAccessParameters
+	319, // 98: bonanza.model.analysis.FileCreationParameters.Value.file_creation_parameters:type_name -> bonanza.model.filesystem.FileCreationParameters
+	329, // 99: bonanza.model.analysis.FileProperties.Value.exists:type_name -> bonanza.model.filesystem.FileProperties
+	325, // 100: bonanza.model.analysis.FileRoot.Key.file:type_name -> bonanza.model.starlark.File
+	0,   // 101: bonanza.model.analysis.FileRoot.Key.directory_layout:type_name -> bonanza.model.analysis.DirectoryLayout
+	313, // 102: bonanza.model.analysis.FileRoot.Value.root_directory:type_name -> bonanza.model.filesystem.DirectoryContents
+	313, // 103: bonanza.model.analysis.FilesInPackage.Value.directory:type_name -> bonanza.model.filesystem.DirectoryContents
+	307, // 104: bonanza.model.analysis.FilesRoot.Key.list_reference:type_name -> bonanza.model.core.DecodableReference
+	0,   // 105: bonanza.model.analysis.FilesRoot.Key.directory_layout:type_name -> bonanza.model.analysis.DirectoryLayout
+	313, // 106: bonanza.model.analysis.FilesRoot.Value.root_directory:type_name -> bonanza.model.filesystem.DirectoryContents
+	330, // 107: bonanza.model.analysis.GitRepositoryContents.Key.target:type_name -> bonanza.model.fetch.GitTarget
+	320, // 108: bonanza.model.analysis.GitRepositoryContents.Value.root_directory_reference:type_name -> bonanza.model.filesystem.DirectoryReference
+	43,  // 109: bonanza.model.analysis.HttpArchiveContents.Key.fetch_options:type_name -> bonanza.model.analysis.HttpFetchOptions
+	2,   // 110: bonanza.model.analysis.HttpArchiveContents.Key.format:type_name -> bonanza.model.analysis.HttpArchiveContents.Key.Format
+	195, // 111: bonanza.model.analysis.HttpArchiveContents.Value.exists:type_name -> bonanza.model.analysis.HttpArchiveContents.Value.Exists
+	320, // 112: bonanza.model.analysis.HttpArchiveContents.Value.Exists.contents:type_name -> bonanza.model.filesystem.DirectoryReference
+	43,  // 113: bonanza.model.analysis.HttpFileContents.Key.fetch_options:type_name -> bonanza.model.analysis.HttpFetchOptions
+	331, // 114: bonanza.model.analysis.HttpFileContents.Value.exists:type_name -> bonanza.model.fetch.Result.Success
+	332, // 115: bonanza.model.analysis.ModuleDotBazelContents.Value.contents:type_name -> bonanza.model.filesystem.FileContents
+	114, // 116: bonanza.model.analysis.ModuleLockfile.Value.use_lockfile:type_name -> bonanza.model.analysis.BuildSpecification.Value.UseLockfile
+	54,  // 117: bonanza.model.analysis.ModuleLockfile.Value.registry_file_hashes:type_name -> bonanza.model.analysis.RegistryFileHash
+	206, // 118: bonanza.model.analysis.ModuleRepoMapping.Value.mappings:type_name -> bonanza.model.analysis.ModuleRepoMapping.Value.Mapping
+	315, // 119: bonanza.model.analysis.ModuleExtensionRepo.Value.definition:type_name -> bonanza.model.starlark.Repo.Definition
+	213, // 120: bonanza.model.analysis.ModuleExtensionRepos.Value.repos:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.Repo
+	333, // 121: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.leaf:type_name -> bonanza.model.starlark.Repo
+	214, // 122: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.parent:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent
+	307, // 123: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	53,  // 124: bonanza.model.analysis.ModuleFinalBuildList.Value.build_list:type_name -> bonanza.model.analysis.BuildListModule
+	53,  // 125: bonanza.model.analysis.ModuleRoughBuildList.Value.build_list:type_name -> bonanza.model.analysis.BuildListModule
+	54,  // 126: bonanza.model.analysis.ModuleRoughBuildList.Value.registry_file_hashes:type_name -> bonanza.model.analysis.RegistryFileHash
+	57,  // 127: bonanza.model.analysis.ModulesWithMultipleVersions.Value.overrides_list:type_name -> bonanza.model.analysis.OverridesListModule
+	57,  // 128: bonanza.model.analysis.ModulesWithOverrides.Value.overrides_list:type_name -> bonanza.model.analysis.OverridesListModule
+	61,  // 129: bonanza.model.analysis.ModulesWithRemoteOverrides.Value.module_overrides:type_name -> bonanza.model.analysis.ModuleOverride
+	230, // 130: bonanza.model.analysis.Package.Value.targets:type_name -> bonanza.model.analysis.Package.Value.Target
+	334, // 131: bonanza.model.analysis.Package.Value.Target.leaf:type_name -> bonanza.model.starlark.Target
+	231, // 132: bonanza.model.analysis.Package.Value.Target.parent:type_name -> bonanza.model.analysis.Package.Value.Target.Parent
+	307, // 133: bonanza.model.analysis.Package.Value.Target.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	239, // 134: bonanza.model.analysis.QueryResult.Value.targets:type_name -> bonanza.model.analysis.QueryResult.Value.Target
+	238, // 135: bonanza.model.analysis.QueryResult.Value.Target.attributes:type_name -> bonanza.model.analysis.QueryResult.Value.Attribute
+	68,  // 136: bonanza.model.analysis.RegisteredExecutionPlatforms.Value.execution_platforms:type_name -> bonanza.model.analysis.ExecutionPlatform
+	246, // 137: bonanza.model.analysis.RegisteredRepoPlatform.Value.repository_os_environ:type_name -> bonanza.model.analysis.RegisteredRepoPlatform.Value.EnvironmentVariable
+	249, // 138: bonanza.model.analysis.RegisteredToolchains.Value.toolchain_types:type_name -> bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType
+	72,  // 139: bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
+	72,  // 140: bonanza.model.analysis.RegisteredToolchainsForType.Value.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
+	320, // 141: bonanza.model.analysis.Repo.Value.root_directory_reference:type_name -> bonanza.model.filesystem.DirectoryReference
+	335, // 142: bonanza.model.analysis.RepoDefaultAttrs.Value.inheritable_attrs:type_name -> bonanza.model.starlark.InheritableAttrs
+	329, // 143: bonanza.model.analysis.RepoPlatformHostPath.Value.file:type_name -> bonanza.model.filesystem.FileProperties
+	313, // 144: bonanza.model.analysis.RepoPlatformHostPath.Value.directory:type_name -> bonanza.model.filesystem.DirectoryContents
+	67,  // 145: bonanza.model.analysis.ResolvedToolchains.Key.exec_compatible_with:type_name -> bonanza.model.analysis.Constraint
+	307, // 146: bonanza.model.analysis.ResolvedToolchains.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	336, // 147: bonanza.model.analysis.ResolvedToolchains.Key.toolchains:type_name -> bonanza.model.starlark.ToolchainType
+	307, // 148: bonanza.model.analysis.Select.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	7,   // 149: bonanza.model.analysis.SuccessfulActionResult.Key.execute_request:type_name -> bonanza.model.analysis.ExecuteRequest
+	307, // 150: bonanza.model.analysis.SuccessfulActionResult.Value.outputs_reference:type_name -> bonanza.model.core.DecodableReference
+	337, // 151: bonanza.model.analysis.Target.Value.definition:type_name -> bonanza.model.starlark.Target.Definition
+	86,  // 152: bonanza.model.analysis.TargetAction.Key.id:type_name -> bonanza.model.analysis.TargetActionId
+	22,  // 153: bonanza.model.analysis.TargetAction.Value.definition:type_name -> bonanza.model.analysis.TargetActionDefinition
+	86,  // 154: bonanza.model.analysis.TargetActionCommand.Key.id:type_name -> bonanza.model.analysis.TargetActionId
+	307, // 155: bonanza.model.analysis.TargetActionCommand.Value.command_reference:type_name -> bonanza.model.core.DecodableReference
+	86,  // 156: bonanza.model.analysis.TargetActionInputRoot.Key.id:type_name -> bonanza.model.analysis.TargetActionId
+	320, // 157: bonanza.model.analysis.TargetActionInputRoot.Value.input_root_reference:type_name -> bonanza.model.filesystem.DirectoryReference
+	86,  // 158: bonanza.model.analysis.TargetActionResult.Key.id:type_name -> bonanza.model.analysis.TargetActionId
+	313, // 159: bonanza.model.analysis.TargetActionResult.Value.output_root:type_name -> bonanza.model.filesystem.DirectoryContents
+	307, // 160: bonanza.model.analysis.TargetCompletion.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	313, // 161: bonanza.model.analysis.TargetCompletion.Value.root_directory:type_name -> bonanza.model.filesystem.DirectoryContents
+	307, // 162: bonanza.model.analysis.TargetExecutable.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	313, // 163: bonanza.model.analysis.TargetExecutable.Value.root_directory:type_name -> bonanza.model.filesystem.DirectoryContents
+	284, // 164: bonanza.model.analysis.TargetExecutable.Value.environment:type_name -> bonanza.model.analysis.TargetExecutable.Value.EnvironmentVariable
+	287, // 165: bonanza.model.analysis.TargetPatternExpansion.Value.target_labels:type_name -> bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel
+	288, // 166: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.parent:type_name -> bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent
+	307, // 167: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	307, // 168: bonanza.model.analysis.TestResult.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	332, // 169: bonanza.model.analysis.TestResult.Value.stdout:type_name -> bonanza.model.filesystem.FileContents
+	332, // 170: bonanza.model.analysis.TestResult.Value.stderr:type_name -> bonanza.model.filesystem.FileContents
+	313, // 171: bonanza.model.analysis.TestResult.Value.output_root:type_name -> bonanza.model.filesystem.DirectoryContents
+	292, // 172: bonanza.model.analysis.ModuleExtension.User.tag_classes:type_name -> bonanza.model.analysis.ModuleExtension.TagClass
+	293, // 173: bonanza.model.analysis.ModuleExtension.TagClass.tags:type_name -> bonanza.model.analysis.ModuleExtension.Tag
+	338, // 174: bonanza.model.analysis.ModuleExtension.Tag.attrs:type_name -> bonanza.model.starlark.Struct.Fields
+	95,  // 175: bonanza.model.analysis.UsedModuleExtension.Value.module_extension:type_name -> bonanza.model.analysis.ModuleExtension
+	95,  // 176: bonanza.model.analysis.UsedModuleExtensions.Value.module_extensions:type_name -> bonanza.model.analysis.ModuleExtension
+	307, // 177: bonanza.model.analysis.UserDefinedTransition.Key.input_configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	339, // 178: bonanza.model.analysis.UserDefinedTransition.Value.transition_depends_on_attrs:type_name -> google.protobuf.Empty
+	303, // 179: bonanza.model.analysis.UserDefinedTransition.Value.success:type_name -> bonanza.model.analysis.UserDefinedTransition.Value.Success
+	304, // 180: bonanza.model.analysis.UserDefinedTransition.Value.Success.entries:type_name -> bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry
+	307, // 181: bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry.output_configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	307, // 182: bonanza.model.analysis.VisibleTarget.Key.configuration_reference:type_name -> bonanza.model.core.DecodableReference
+	183, // [183:183] is the sub-list for method output_type
+	183, // [183:183] is the sub-list for method input_type
+	183, // [183:183] is the sub-list for extension type_name
+	183, // [183:183] is the sub-list for extension extendee
+	0,   // [0:183] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_model_analysis_analysis_proto_init() }
@@ -13872,62 +15848,62 @@ func file_bonanza_build_pkg_proto_model_analysis_analysis_proto_init() {
 	if File_bonanza_build_pkg_proto_model_analysis_analysis_proto != nil {
 		return
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[15].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[16].OneofWrappers = []any{
 		(*BuildSettingOverride_Leaf_)(nil),
 		(*BuildSettingOverride_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[16].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[17].OneofWrappers = []any{
 		(*Args_Leaf_)(nil),
 		(*Args_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[17].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[18].OneofWrappers = []any{
 		(*FilesToRunProvider_Leaf_)(nil),
 		(*FilesToRunProvider_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[19].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[20].OneofWrappers = []any{
 		(*TargetOutputDefinition_ActionId)(nil),
 		(*TargetOutputDefinition_ExpandTemplate_)(nil),
 		(*TargetOutputDefinition_StaticPackageDirectory)(nil),
 		(*TargetOutputDefinition_Symlink_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[53].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[58].OneofWrappers = []any{
 		(*ModuleOverride_SingleVersion_)(nil),
 		(*ModuleOverride_MultipleVersions_)(nil),
 		(*ModuleOverride_RepositoryRule)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[122].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[138].OneofWrappers = []any{
 		(*Args_Leaf_Add_Leaf_)(nil),
 		(*Args_Leaf_Add_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[125].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[141].OneofWrappers = []any{
 		(*Args_Leaf_Add_Leaf_Separate_)(nil),
 		(*Args_Leaf_Add_Leaf_Joined_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[135].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[154].OneofWrappers = []any{
 		(*ConfiguredTarget_Value_Output_Leaf_)(nil),
 		(*ConfiguredTarget_Value_Output_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[136].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[155].OneofWrappers = []any{
 		(*ConfiguredTarget_Value_Action_Leaf_)(nil),
 		(*ConfiguredTarget_Value_Action_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[187].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[210].OneofWrappers = []any{
 		(*ModuleExtensionRepos_Value_Repo_Leaf)(nil),
 		(*ModuleExtensionRepos_Value_Repo_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[204].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[227].OneofWrappers = []any{
 		(*Package_Value_Target_Leaf)(nil),
 		(*Package_Value_Target_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[227].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[254].OneofWrappers = []any{
 		(*RepoPlatformHostPath_Value_File)(nil),
 		(*RepoPlatformHostPath_Value_Directory)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[254].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[284].OneofWrappers = []any{
 		(*TargetPatternExpansion_Value_TargetLabel_Leaf)(nil),
 		(*TargetPatternExpansion_Value_TargetLabel_Parent_)(nil),
 	}
-	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[265].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_analysis_analysis_proto_msgTypes[299].OneofWrappers = []any{
 		(*UserDefinedTransition_Value_TransitionDependsOnAttrs)(nil),
 		(*UserDefinedTransition_Value_Success_)(nil),
 	}
@@ -13937,7 +15913,7 @@ func file_bonanza_build_pkg_proto_model_analysis_analysis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc), len(file_bonanza_build_pkg_proto_model_analysis_analysis_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   270,
+			NumMessages:   304,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
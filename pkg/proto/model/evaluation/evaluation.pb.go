@@ -189,11 +189,14 @@ func (*Evaluation_Leaf_) isEvaluation_Level() {}
 func (*Evaluation_Parent_) isEvaluation_Level() {}
 
 type Action struct {
-	state              protoimpl.MessageState   `protogen:"open.v1"`
-	OverridesReference *core.DecodableReference `protobuf:"bytes,1,opt,name=overrides_reference,json=overridesReference,proto3" json:"overrides_reference,omitempty"`
-	RequestedKeys      []*Keys                  `protobuf:"bytes,2,rep,name=requested_keys,json=requestedKeys,proto3" json:"requested_keys,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                     protoimpl.MessageState          `protogen:"open.v1"`
+	OverridesReference        *core.DecodableReference        `protobuf:"bytes,1,opt,name=overrides_reference,json=overridesReference,proto3" json:"overrides_reference,omitempty"`
+	RequestedKeys             []*Keys                         `protobuf:"bytes,2,rep,name=requested_keys,json=requestedKeys,proto3" json:"requested_keys,omitempty"`
+	PreviousOutcomesReference *core.WeakDecodableReference    `protobuf:"bytes,3,opt,name=previous_outcomes_reference,json=previousOutcomesReference,proto3" json:"previous_outcomes_reference,omitempty"`
+	Priority                  int32                           `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	ExecutionRequestMetadata  []*Action_RequestMetadataHeader `protobuf:"bytes,5,rep,name=execution_request_metadata,json=executionRequestMetadata,proto3" json:"execution_request_metadata,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *Action) Reset() {
@@ -240,6 +243,27 @@ func (x *Action) GetRequestedKeys() []*Keys {
 	return nil
 }
 
+func (x *Action) GetPreviousOutcomesReference() *core.WeakDecodableReference {
+	if x != nil {
+		return x.PreviousOutcomesReference
+	}
+	return nil
+}
+
+func (x *Action) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Action) GetExecutionRequestMetadata() []*Action_RequestMetadataHeader {
+	if x != nil {
+		return x.ExecutionRequestMetadata
+	}
+	return nil
+}
+
 type Progress struct {
 	state                         protoimpl.MessageState    `protogen:"open.v1"`
 	CompletedKeysCount            uint64                    `protobuf:"varint,1,opt,name=completed_keys_count,json=completedKeysCount,proto3" json:"completed_keys_count,omitempty"`
@@ -320,6 +344,7 @@ type Result struct {
 	state             protoimpl.MessageState   `protogen:"open.v1"`
 	Failure           *Result_Failure          `protobuf:"bytes,1,opt,name=failure,proto3" json:"failure,omitempty"`
 	OutcomesReference *core.DecodableReference `protobuf:"bytes,2,opt,name=outcomes_reference,json=outcomesReference,proto3" json:"outcomes_reference,omitempty"`
+	RequestedValues   []*core.Any              `protobuf:"bytes,3,rep,name=requested_values,json=requestedValues,proto3" json:"requested_values,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -368,6 +393,57 @@ func (x *Result) GetOutcomesReference() *core.DecodableReference {
 	return nil
 }
 
+func (x *Result) GetRequestedValues() []*core.Any {
+	if x != nil {
+		return x.RequestedValues
+	}
+	return nil
+}
+
+type PreviousOutcomesTag struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceSha256 []byte                 `protobuf:"bytes,1,opt,name=workspace_sha256,json=workspaceSha256,proto3" json:"workspace_sha256,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PreviousOutcomesTag) Reset() {
+	*x = PreviousOutcomesTag{}
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviousOutcomesTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviousOutcomesTag) ProtoMessage() {}
+
+func (x *PreviousOutcomesTag) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviousOutcomesTag.ProtoReflect.Descriptor instead.
+func (*PreviousOutcomesTag) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PreviousOutcomesTag) GetWorkspaceSha256() []byte {
+	if x != nil {
+		return x.WorkspaceSha256
+	}
+	return nil
+}
+
 type Keys_Parent struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Reference     *core.DecodableReference `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
@@ -377,7 +453,7 @@ type Keys_Parent struct {
 
 func (x *Keys_Parent) Reset() {
 	*x = Keys_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[5]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +465,7 @@ func (x *Keys_Parent) String() string {
 func (*Keys_Parent) ProtoMessage() {}
 
 func (x *Keys_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[5]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +498,7 @@ type Evaluation_Parent struct {
 
 func (x *Evaluation_Parent) Reset() {
 	*x = Evaluation_Parent{}
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[6]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +510,7 @@ func (x *Evaluation_Parent) String() string {
 func (*Evaluation_Parent) ProtoMessage() {}
 
 func (x *Evaluation_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[6]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,13 +545,15 @@ type Evaluation_Leaf struct {
 	Key           *core.Any              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value         *core.Any              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	Dependencies  []*Keys                `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	NativeValue   bool                   `protobuf:"varint,4,opt,name=native_value,json=nativeValue,proto3" json:"native_value,omitempty"`
+	Overridden    bool                   `protobuf:"varint,5,opt,name=overridden,proto3" json:"overridden,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Evaluation_Leaf) Reset() {
 	*x = Evaluation_Leaf{}
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[7]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -487,7 +565,7 @@ func (x *Evaluation_Leaf) String() string {
 func (*Evaluation_Leaf) ProtoMessage() {}
 
 func (x *Evaluation_Leaf) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[7]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,6 +602,72 @@ func (x *Evaluation_Leaf) GetDependencies() []*Keys {
 	return nil
 }
 
+func (x *Evaluation_Leaf) GetNativeValue() bool {
+	if x != nil {
+		return x.NativeValue
+	}
+	return false
+}
+
+func (x *Evaluation_Leaf) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+type Action_RequestMetadataHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Action_RequestMetadataHeader) Reset() {
+	*x = Action_RequestMetadataHeader{}
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Action_RequestMetadataHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action_RequestMetadataHeader) ProtoMessage() {}
+
+func (x *Action_RequestMetadataHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action_RequestMetadataHeader.ProtoReflect.Descriptor instead.
+func (*Action_RequestMetadataHeader) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Action_RequestMetadataHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Action_RequestMetadataHeader) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type Progress_EvaluatingKey struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	Key                    *core.Any              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -536,7 +680,7 @@ type Progress_EvaluatingKey struct {
 
 func (x *Progress_EvaluatingKey) Reset() {
 	*x = Progress_EvaluatingKey{}
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[8]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -548,7 +692,7 @@ func (x *Progress_EvaluatingKey) String() string {
 func (*Progress_EvaluatingKey) ProtoMessage() {}
 
 func (x *Progress_EvaluatingKey) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[8]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +746,7 @@ type Result_Failure struct {
 
 func (x *Result_Failure) Reset() {
 	*x = Result_Failure{}
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[9]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -614,7 +758,7 @@ func (x *Result_Failure) String() string {
 func (*Result_Failure) ProtoMessage() {}
 
 func (x *Result_Failure) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[9]
+	mi := &file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,22 +798,32 @@ const file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDesc = "
 	"\x06parent\x18\x02 \x01(\v2%.bonanza.model.evaluation.Keys.ParentH\x00R\x06parent\x1as\n" +
 	"\x06Parent\x12i\n" +
 	"\treference\x18\x01 \x01(\v2&.bonanza.model.core.DecodableReferenceB#\xea\xd7 \x1f\x1a\x1dbonanza.model.evaluation.KeysR\treferenceB\a\n" +
-	"\x05level\"\xea\x03\n" +
+	"\x05level\"\xad\x04\n" +
 	"\n" +
 	"Evaluation\x12?\n" +
 	"\x04leaf\x18\x01 \x01(\v2).bonanza.model.evaluation.Evaluation.LeafH\x00R\x04leaf\x12E\n" +
 	"\x06parent\x18\x02 \x01(\v2+.bonanza.model.evaluation.Evaluation.ParentH\x00R\x06parent\x1a\xa3\x01\n" +
 	"\x06Parent\x12o\n" +
 	"\treference\x18\x01 \x01(\v2&.bonanza.model.core.DecodableReferenceB)\xea\xd7 %\x1a#bonanza.model.evaluation.EvaluationR\treference\x12(\n" +
-	"\x10first_key_sha256\x18\x02 \x01(\fR\x0efirstKeySha256\x1a\xa4\x01\n" +
+	"\x10first_key_sha256\x18\x02 \x01(\fR\x0efirstKeySha256\x1a\xe7\x01\n" +
 	"\x04Leaf\x12)\n" +
 	"\x03key\x18\x01 \x01(\v2\x17.bonanza.model.core.AnyR\x03key\x12-\n" +
 	"\x05value\x18\x02 \x01(\v2\x17.bonanza.model.core.AnyR\x05value\x12B\n" +
-	"\fdependencies\x18\x03 \x03(\v2\x1e.bonanza.model.evaluation.KeysR\fdependenciesB\a\n" +
-	"\x05level\"\xd4\x01\n" +
+	"\fdependencies\x18\x03 \x03(\v2\x1e.bonanza.model.evaluation.KeysR\fdependencies\x12!\n" +
+	"\fnative_value\x18\x04 \x01(\bR\vnativeValue\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x05 \x01(\bR\n" +
+	"overriddenB\a\n" +
+	"\x05level\"\xc1\x04\n" +
 	"\x06Action\x12\x82\x01\n" +
 	"\x13overrides_reference\x18\x01 \x01(\v2&.bonanza.model.core.DecodableReferenceB)\xea\xd7 %\x1a#bonanza.model.evaluation.EvaluationR\x12overridesReference\x12E\n" +
-	"\x0erequested_keys\x18\x02 \x03(\v2\x1e.bonanza.model.evaluation.KeysR\rrequestedKeys\"\xc8\x04\n" +
+	"\x0erequested_keys\x18\x02 \x03(\v2\x1e.bonanza.model.evaluation.KeysR\rrequestedKeys\x12\x95\x01\n" +
+	"\x1bprevious_outcomes_reference\x18\x03 \x01(\v2*.bonanza.model.core.WeakDecodableReferenceB)\xea\xd7 %\x1a#bonanza.model.evaluation.EvaluationR\x19previousOutcomesReference\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12t\n" +
+	"\x1aexecution_request_metadata\x18\x05 \x03(\v26.bonanza.model.evaluation.Action.RequestMetadataHeaderR\x18executionRequestMetadata\x1aA\n" +
+	"\x15RequestMetadataHeader\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xc8\x04\n" +
 	"\bProgress\x120\n" +
 	"\x14completed_keys_count\x18\x01 \x01(\x04R\x12completedKeysCount\x12f\n" +
 	"\x16oldest_evaluating_keys\x18\x02 \x03(\v20.bonanza.model.evaluation.Progress.EvaluatingKeyR\x14oldestEvaluatingKeys\x12G\n" +
@@ -680,13 +834,16 @@ const file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDesc = "
 	"\x03key\x18\x01 \x01(\v2\x17.bonanza.model.core.AnyR\x03key\x12P\n" +
 	"\x16first_evaluation_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x14firstEvaluationStart\x12T\n" +
 	"\x18current_evaluation_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x16currentEvaluationStart\x12\x1a\n" +
-	"\brestarts\x18\x04 \x01(\rR\brestarts\"\xc9\x02\n" +
+	"\brestarts\x18\x04 \x01(\rR\brestarts\"\x8d\x03\n" +
 	"\x06Result\x12B\n" +
 	"\afailure\x18\x01 \x01(\v2(.bonanza.model.evaluation.Result.FailureR\afailure\x12\x80\x01\n" +
-	"\x12outcomes_reference\x18\x02 \x01(\v2&.bonanza.model.core.DecodableReferenceB)\xea\xd7 %\x1a#bonanza.model.evaluation.EvaluationR\x11outcomesReference\x1ax\n" +
+	"\x12outcomes_reference\x18\x02 \x01(\v2&.bonanza.model.core.DecodableReferenceB)\xea\xd7 %\x1a#bonanza.model.evaluation.EvaluationR\x11outcomesReference\x12B\n" +
+	"\x10requested_values\x18\x03 \x03(\v2\x17.bonanza.model.core.AnyR\x0frequestedValues\x1ax\n" +
 	"\aFailure\x12A\n" +
 	"\x10stack_trace_keys\x18\x01 \x03(\v2\x17.bonanza.model.core.AnyR\x0estackTraceKeys\x12*\n" +
-	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusR\x06statusB*Z(bonanza.build/pkg/proto/model/evaluationb\x06proto3"
+	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusR\x06status\"@\n" +
+	"\x13PreviousOutcomesTag\x12)\n" +
+	"\x10workspace_sha256\x18\x01 \x01(\fR\x0fworkspaceSha256B*Z(bonanza.build/pkg/proto/model/evaluationb\x06proto3"
 
 var (
 	file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDescOnce sync.Once
@@ -700,48 +857,54 @@ func file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDescGZIP(
 	return file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDescData
 }
 
-var file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_goTypes = []any{
-	(*Keys)(nil),                    // 0: bonanza.model.evaluation.Keys
-	(*Evaluation)(nil),              // 1: bonanza.model.evaluation.Evaluation
-	(*Action)(nil),                  // 2: bonanza.model.evaluation.Action
-	(*Progress)(nil),                // 3: bonanza.model.evaluation.Progress
-	(*Result)(nil),                  // 4: bonanza.model.evaluation.Result
-	(*Keys_Parent)(nil),             // 5: bonanza.model.evaluation.Keys.Parent
-	(*Evaluation_Parent)(nil),       // 6: bonanza.model.evaluation.Evaluation.Parent
-	(*Evaluation_Leaf)(nil),         // 7: bonanza.model.evaluation.Evaluation.Leaf
-	(*Progress_EvaluatingKey)(nil),  // 8: bonanza.model.evaluation.Progress.EvaluatingKey
-	(*Result_Failure)(nil),          // 9: bonanza.model.evaluation.Result.Failure
-	(*core.Any)(nil),                // 10: bonanza.model.core.Any
-	(*core.DecodableReference)(nil), // 11: bonanza.model.core.DecodableReference
-	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
-	(*status.Status)(nil),           // 13: google.rpc.Status
+	(*Keys)(nil),                         // 0: bonanza.model.evaluation.Keys
+	(*Evaluation)(nil),                   // 1: bonanza.model.evaluation.Evaluation
+	(*Action)(nil),                       // 2: bonanza.model.evaluation.Action
+	(*Progress)(nil),                     // 3: bonanza.model.evaluation.Progress
+	(*Result)(nil),                       // 4: bonanza.model.evaluation.Result
+	(*PreviousOutcomesTag)(nil),          // 5: bonanza.model.evaluation.PreviousOutcomesTag
+	(*Keys_Parent)(nil),                  // 6: bonanza.model.evaluation.Keys.Parent
+	(*Evaluation_Parent)(nil),            // 7: bonanza.model.evaluation.Evaluation.Parent
+	(*Evaluation_Leaf)(nil),              // 8: bonanza.model.evaluation.Evaluation.Leaf
+	(*Action_RequestMetadataHeader)(nil), // 9: bonanza.model.evaluation.Action.RequestMetadataHeader
+	(*Progress_EvaluatingKey)(nil),       // 10: bonanza.model.evaluation.Progress.EvaluatingKey
+	(*Result_Failure)(nil),               // 11: bonanza.model.evaluation.Result.Failure
+	(*core.Any)(nil),                     // 12: bonanza.model.core.Any
+	(*core.DecodableReference)(nil),      // 13: bonanza.model.core.DecodableReference
+	(*core.WeakDecodableReference)(nil),  // 14: bonanza.model.core.WeakDecodableReference
+	(*timestamppb.Timestamp)(nil),        // 15: google.protobuf.Timestamp
+	(*status.Status)(nil),                // 16: google.rpc.Status
 }
 var file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_depIdxs = []int32{
-	10, // 0: bonanza.model.evaluation.Keys.leaf:type_name -> bonanza.model.core.Any
-	5,  // 1: bonanza.model.evaluation.Keys.parent:type_name -> bonanza.model.evaluation.Keys.Parent
-	7,  // 2: bonanza.model.evaluation.Evaluation.leaf:type_name -> bonanza.model.evaluation.Evaluation.Leaf
-	6,  // 3: bonanza.model.evaluation.Evaluation.parent:type_name -> bonanza.model.evaluation.Evaluation.Parent
-	11, // 4: bonanza.model.evaluation.Action.overrides_reference:type_name -> bonanza.model.core.DecodableReference
+	12, // 0: bonanza.model.evaluation.Keys.leaf:type_name -> bonanza.model.core.Any
+	6,  // 1: bonanza.model.evaluation.Keys.parent:type_name -> bonanza.model.evaluation.Keys.Parent
+	8,  // 2: bonanza.model.evaluation.Evaluation.leaf:type_name -> bonanza.model.evaluation.Evaluation.Leaf
+	7,  // 3: bonanza.model.evaluation.Evaluation.parent:type_name -> bonanza.model.evaluation.Evaluation.Parent
+	13, // 4: bonanza.model.evaluation.Action.overrides_reference:type_name -> bonanza.model.core.DecodableReference
 	0,  // 5: bonanza.model.evaluation.Action.requested_keys:type_name -> bonanza.model.evaluation.Keys
-	8,  // 6: bonanza.model.evaluation.Progress.oldest_evaluating_keys:type_name -> bonanza.model.evaluation.Progress.EvaluatingKey
-	9,  // 7: bonanza.model.evaluation.Result.failure:type_name -> bonanza.model.evaluation.Result.Failure
-	11, // 8: bonanza.model.evaluation.Result.outcomes_reference:type_name -> bonanza.model.core.DecodableReference
-	11, // 9: bonanza.model.evaluation.Keys.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	11, // 10: bonanza.model.evaluation.Evaluation.Parent.reference:type_name -> bonanza.model.core.DecodableReference
-	10, // 11: bonanza.model.evaluation.Evaluation.Leaf.key:type_name -> bonanza.model.core.Any
-	10, // 12: bonanza.model.evaluation.Evaluation.Leaf.value:type_name -> bonanza.model.core.Any
-	0,  // 13: bonanza.model.evaluation.Evaluation.Leaf.dependencies:type_name -> bonanza.model.evaluation.Keys
-	10, // 14: bonanza.model.evaluation.Progress.EvaluatingKey.key:type_name -> bonanza.model.core.Any
-	12, // 15: bonanza.model.evaluation.Progress.EvaluatingKey.first_evaluation_start:type_name -> google.protobuf.Timestamp
-	12, // 16: bonanza.model.evaluation.Progress.EvaluatingKey.current_evaluation_start:type_name -> google.protobuf.Timestamp
-	10, // 17: bonanza.model.evaluation.Result.Failure.stack_trace_keys:type_name -> bonanza.model.core.Any
-	13, // 18: bonanza.model.evaluation.Result.Failure.status:type_name -> google.rpc.Status
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	14, // 6: bonanza.model.evaluation.Action.previous_outcomes_reference:type_name -> bonanza.model.core.WeakDecodableReference
+	9,  // 7: bonanza.model.evaluation.Action.execution_request_metadata:type_name -> bonanza.model.evaluation.Action.RequestMetadataHeader
+	10, // 8: bonanza.model.evaluation.Progress.oldest_evaluating_keys:type_name -> bonanza.model.evaluation.Progress.EvaluatingKey
+	11, // 9: bonanza.model.evaluation.Result.failure:type_name -> bonanza.model.evaluation.Result.Failure
+	13, // 10: bonanza.model.evaluation.Result.outcomes_reference:type_name -> bonanza.model.core.DecodableReference
+	12, // 11: bonanza.model.evaluation.Result.requested_values:type_name -> bonanza.model.core.Any
+	13, // 12: bonanza.model.evaluation.Keys.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	13, // 13: bonanza.model.evaluation.Evaluation.Parent.reference:type_name -> bonanza.model.core.DecodableReference
+	12, // 14: bonanza.model.evaluation.Evaluation.Leaf.key:type_name -> bonanza.model.core.Any
+	12, // 15: bonanza.model.evaluation.Evaluation.Leaf.value:type_name -> bonanza.model.core.Any
+	0,  // 16: bonanza.model.evaluation.Evaluation.Leaf.dependencies:type_name -> bonanza.model.evaluation.Keys
+	12, // 17: bonanza.model.evaluation.Progress.EvaluatingKey.key:type_name -> bonanza.model.core.Any
+	15, // 18: bonanza.model.evaluation.Progress.EvaluatingKey.first_evaluation_start:type_name -> google.protobuf.Timestamp
+	15, // 19: bonanza.model.evaluation.Progress.EvaluatingKey.current_evaluation_start:type_name -> google.protobuf.Timestamp
+	12, // 20: bonanza.model.evaluation.Result.Failure.stack_trace_keys:type_name -> bonanza.model.core.Any
+	16, // 21: bonanza.model.evaluation.Result.Failure.status:type_name -> google.rpc.Status
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_init() }
@@ -763,7 +926,7 @@ func file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDesc), len(file_bonanza_build_pkg_proto_model_evaluation_evaluation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
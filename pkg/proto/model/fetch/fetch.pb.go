@@ -184,17 +184,95 @@ func (x *Target) GetHeaders() []*Target_Header {
 	return nil
 }
 
+type GitTarget struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Remote         string                 `protobuf:"bytes,1,opt,name=remote,proto3" json:"remote,omitempty"`
+	Commit         string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Tag            string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	InitSubmodules bool                   `protobuf:"varint,4,opt,name=init_submodules,json=initSubmodules,proto3" json:"init_submodules,omitempty"`
+	StripPrefix    string                 `protobuf:"bytes,5,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GitTarget) Reset() {
+	*x = GitTarget{}
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitTarget) ProtoMessage() {}
+
+func (x *GitTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitTarget.ProtoReflect.Descriptor instead.
+func (*GitTarget) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GitTarget) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *GitTarget) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *GitTarget) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GitTarget) GetInitSubmodules() bool {
+	if x != nil {
+		return x.InitSubmodules
+	}
+	return false
+}
+
+func (x *GitTarget) GetStripPrefix() string {
+	if x != nil {
+		return x.StripPrefix
+	}
+	return ""
+}
+
 type Action struct {
-	state                  protoimpl.MessageState             `protogen:"open.v1"`
-	FileCreationParameters *filesystem.FileCreationParameters `protobuf:"bytes,1,opt,name=file_creation_parameters,json=fileCreationParameters,proto3" json:"file_creation_parameters,omitempty"`
-	Target                 *Target                            `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                       protoimpl.MessageState                  `protogen:"open.v1"`
+	FileCreationParameters      *filesystem.FileCreationParameters      `protobuf:"bytes,1,opt,name=file_creation_parameters,json=fileCreationParameters,proto3" json:"file_creation_parameters,omitempty"`
+	Target                      *Target                                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	GitTarget                   *GitTarget                              `protobuf:"bytes,3,opt,name=git_target,json=gitTarget,proto3" json:"git_target,omitempty"`
+	DirectoryCreationParameters *filesystem.DirectoryCreationParameters `protobuf:"bytes,4,opt,name=directory_creation_parameters,json=directoryCreationParameters,proto3" json:"directory_creation_parameters,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *Action) Reset() {
 	*x = Action{}
-	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[2]
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +284,7 @@ func (x *Action) String() string {
 func (*Action) ProtoMessage() {}
 
 func (x *Action) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[2]
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +297,7 @@ func (x *Action) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Action.ProtoReflect.Descriptor instead.
 func (*Action) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP(), []int{2}
+	return file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Action) GetFileCreationParameters() *filesystem.FileCreationParameters {
@@ -236,12 +314,27 @@ func (x *Action) GetTarget() *Target {
 	return nil
 }
 
+func (x *Action) GetGitTarget() *GitTarget {
+	if x != nil {
+		return x.GitTarget
+	}
+	return nil
+}
+
+func (x *Action) GetDirectoryCreationParameters() *filesystem.DirectoryCreationParameters {
+	if x != nil {
+		return x.DirectoryCreationParameters
+	}
+	return nil
+}
+
 type Result struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Outcome:
 	//
 	//	*Result_Failure
 	//	*Result_Success_
+	//	*Result_GitSuccess_
 	Outcome       isResult_Outcome `protobuf_oneof:"outcome"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -249,7 +342,7 @@ type Result struct {
 
 func (x *Result) Reset() {
 	*x = Result{}
-	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[3]
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +354,7 @@ func (x *Result) String() string {
 func (*Result) ProtoMessage() {}
 
 func (x *Result) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[3]
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +367,7 @@ func (x *Result) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Result.ProtoReflect.Descriptor instead.
 func (*Result) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP(), []int{3}
+	return file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Result) GetOutcome() isResult_Outcome {
@@ -302,6 +395,15 @@ func (x *Result) GetSuccess() *Result_Success {
 	return nil
 }
 
+func (x *Result) GetGitSuccess() *Result_GitSuccess {
+	if x != nil {
+		if x, ok := x.Outcome.(*Result_GitSuccess_); ok {
+			return x.GitSuccess
+		}
+	}
+	return nil
+}
+
 type isResult_Outcome interface {
 	isResult_Outcome()
 }
@@ -314,10 +416,16 @@ type Result_Success_ struct {
 	Success *Result_Success `protobuf:"bytes,2,opt,name=success,proto3,oneof"`
 }
 
+type Result_GitSuccess_ struct {
+	GitSuccess *Result_GitSuccess `protobuf:"bytes,3,opt,name=git_success,json=gitSuccess,proto3,oneof"`
+}
+
 func (*Result_Failure) isResult_Outcome() {}
 
 func (*Result_Success_) isResult_Outcome() {}
 
+func (*Result_GitSuccess_) isResult_Outcome() {}
+
 type Target_Header struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -328,7 +436,7 @@ type Target_Header struct {
 
 func (x *Target_Header) Reset() {
 	*x = Target_Header{}
-	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[4]
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -340,7 +448,7 @@ func (x *Target_Header) String() string {
 func (*Target_Header) ProtoMessage() {}
 
 func (x *Target_Header) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[4]
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +488,7 @@ type Result_Success struct {
 
 func (x *Result_Success) Reset() {
 	*x = Result_Success{}
-	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[5]
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -392,7 +500,7 @@ func (x *Result_Success) String() string {
 func (*Result_Success) ProtoMessage() {}
 
 func (x *Result_Success) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[5]
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +513,7 @@ func (x *Result_Success) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Result_Success.ProtoReflect.Descriptor instead.
 func (*Result_Success) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP(), []int{3, 0}
+	return file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *Result_Success) GetContents() *filesystem.FileContents {
@@ -422,6 +530,58 @@ func (x *Result_Success) GetSha256() []byte {
 	return nil
 }
 
+type Result_GitSuccess struct {
+	state                  protoimpl.MessageState         `protogen:"open.v1"`
+	RootDirectoryReference *filesystem.DirectoryReference `protobuf:"bytes,1,opt,name=root_directory_reference,json=rootDirectoryReference,proto3" json:"root_directory_reference,omitempty"`
+	Commit                 string                         `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Result_GitSuccess) Reset() {
+	*x = Result_GitSuccess{}
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Result_GitSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result_GitSuccess) ProtoMessage() {}
+
+func (x *Result_GitSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result_GitSuccess.ProtoReflect.Descriptor instead.
+func (*Result_GitSuccess) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *Result_GitSuccess) GetRootDirectoryReference() *filesystem.DirectoryReference {
+	if x != nil {
+		return x.RootDirectoryReference
+	}
+	return nil
+}
+
+func (x *Result_GitSuccess) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
 var File_bonanza_build_pkg_proto_model_fetch_fetch_proto protoreflect.FileDescriptor
 
 const file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDesc = "" +
@@ -443,16 +603,31 @@ const file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDesc = "" +
 	"\aheaders\x18\x03 \x03(\v2\".bonanza.model.fetch.Target.HeaderR\aheaders\x1a2\n" +
 	"\x06Header\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"\xa9\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x99\x01\n" +
+	"\tGitTarget\x12\x16\n" +
+	"\x06remote\x18\x01 \x01(\tR\x06remote\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x12'\n" +
+	"\x0finit_submodules\x18\x04 \x01(\bR\x0einitSubmodules\x12!\n" +
+	"\fstrip_prefix\x18\x05 \x01(\tR\vstripPrefix\"\xe3\x02\n" +
 	"\x06Action\x12j\n" +
 	"\x18file_creation_parameters\x18\x01 \x01(\v20.bonanza.model.filesystem.FileCreationParametersR\x16fileCreationParameters\x123\n" +
-	"\x06target\x18\x02 \x01(\v2\x1b.bonanza.model.fetch.TargetR\x06target\"\xeb\x01\n" +
+	"\x06target\x18\x02 \x01(\v2\x1b.bonanza.model.fetch.TargetR\x06target\x12=\n" +
+	"\n" +
+	"git_target\x18\x03 \x01(\v2\x1e.bonanza.model.fetch.GitTargetR\tgitTarget\x12y\n" +
+	"\x1ddirectory_creation_parameters\x18\x04 \x01(\v25.bonanza.model.filesystem.DirectoryCreationParametersR\x1bdirectoryCreationParameters\"\xc5\x03\n" +
 	"\x06Result\x12.\n" +
 	"\afailure\x18\x01 \x01(\v2\x12.google.rpc.StatusH\x00R\afailure\x12?\n" +
-	"\asuccess\x18\x02 \x01(\v2#.bonanza.model.fetch.Result.SuccessH\x00R\asuccess\x1ae\n" +
+	"\asuccess\x18\x02 \x01(\v2#.bonanza.model.fetch.Result.SuccessH\x00R\asuccess\x12I\n" +
+	"\vgit_success\x18\x03 \x01(\v2&.bonanza.model.fetch.Result.GitSuccessH\x00R\n" +
+	"gitSuccess\x1ae\n" +
 	"\aSuccess\x12B\n" +
 	"\bcontents\x18\x01 \x01(\v2&.bonanza.model.filesystem.FileContentsR\bcontents\x12\x16\n" +
-	"\x06sha256\x18\x02 \x01(\fR\x06sha256B\t\n" +
+	"\x06sha256\x18\x02 \x01(\fR\x06sha256\x1a\x8c\x01\n" +
+	"\n" +
+	"GitSuccess\x12f\n" +
+	"\x18root_directory_reference\x18\x01 \x01(\v2,.bonanza.model.filesystem.DirectoryReferenceR\x16rootDirectoryReference\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commitB\t\n" +
 	"\aoutcomeB%Z#bonanza.build/pkg/proto/model/fetchb\x06proto3"
 
 var (
@@ -468,33 +643,41 @@ func file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDescGZIP() []byte {
 }
 
 var file_bonanza_build_pkg_proto_model_fetch_fetch_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_bonanza_build_pkg_proto_model_fetch_fetch_proto_goTypes = []any{
-	(SubresourceIntegrity_HashAlgorithm)(0),   // 0: bonanza.model.fetch.SubresourceIntegrity.HashAlgorithm
-	(*SubresourceIntegrity)(nil),              // 1: bonanza.model.fetch.SubresourceIntegrity
-	(*Target)(nil),                            // 2: bonanza.model.fetch.Target
-	(*Action)(nil),                            // 3: bonanza.model.fetch.Action
-	(*Result)(nil),                            // 4: bonanza.model.fetch.Result
-	(*Target_Header)(nil),                     // 5: bonanza.model.fetch.Target.Header
-	(*Result_Success)(nil),                    // 6: bonanza.model.fetch.Result.Success
-	(*filesystem.FileCreationParameters)(nil), // 7: bonanza.model.filesystem.FileCreationParameters
-	(*status.Status)(nil),                     // 8: google.rpc.Status
-	(*filesystem.FileContents)(nil),           // 9: bonanza.model.filesystem.FileContents
+	(SubresourceIntegrity_HashAlgorithm)(0),        // 0: bonanza.model.fetch.SubresourceIntegrity.HashAlgorithm
+	(*SubresourceIntegrity)(nil),                   // 1: bonanza.model.fetch.SubresourceIntegrity
+	(*Target)(nil),                                 // 2: bonanza.model.fetch.Target
+	(*GitTarget)(nil),                              // 3: bonanza.model.fetch.GitTarget
+	(*Action)(nil),                                 // 4: bonanza.model.fetch.Action
+	(*Result)(nil),                                 // 5: bonanza.model.fetch.Result
+	(*Target_Header)(nil),                          // 6: bonanza.model.fetch.Target.Header
+	(*Result_Success)(nil),                         // 7: bonanza.model.fetch.Result.Success
+	(*Result_GitSuccess)(nil),                      // 8: bonanza.model.fetch.Result.GitSuccess
+	(*filesystem.FileCreationParameters)(nil),      // 9: bonanza.model.filesystem.FileCreationParameters
+	(*filesystem.DirectoryCreationParameters)(nil), // 10: bonanza.model.filesystem.DirectoryCreationParameters
+	(*status.Status)(nil),                          // 11: google.rpc.Status
+	(*filesystem.FileContents)(nil),                // 12: bonanza.model.filesystem.FileContents
+	(*filesystem.DirectoryReference)(nil),          // 13: bonanza.model.filesystem.DirectoryReference
 }
 var file_bonanza_build_pkg_proto_model_fetch_fetch_proto_depIdxs = []int32{
-	0, // 0: bonanza.model.fetch.SubresourceIntegrity.hash_algorithm:type_name -> bonanza.model.fetch.SubresourceIntegrity.HashAlgorithm
-	1, // 1: bonanza.model.fetch.Target.integrity:type_name -> bonanza.model.fetch.SubresourceIntegrity
-	5, // 2: bonanza.model.fetch.Target.headers:type_name -> bonanza.model.fetch.Target.Header
-	7, // 3: bonanza.model.fetch.Action.file_creation_parameters:type_name -> bonanza.model.filesystem.FileCreationParameters
-	2, // 4: bonanza.model.fetch.Action.target:type_name -> bonanza.model.fetch.Target
-	8, // 5: bonanza.model.fetch.Result.failure:type_name -> google.rpc.Status
-	6, // 6: bonanza.model.fetch.Result.success:type_name -> bonanza.model.fetch.Result.Success
-	9, // 7: bonanza.model.fetch.Result.Success.contents:type_name -> bonanza.model.filesystem.FileContents
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: bonanza.model.fetch.SubresourceIntegrity.hash_algorithm:type_name -> bonanza.model.fetch.SubresourceIntegrity.HashAlgorithm
+	1,  // 1: bonanza.model.fetch.Target.integrity:type_name -> bonanza.model.fetch.SubresourceIntegrity
+	6,  // 2: bonanza.model.fetch.Target.headers:type_name -> bonanza.model.fetch.Target.Header
+	9,  // 3: bonanza.model.fetch.Action.file_creation_parameters:type_name -> bonanza.model.filesystem.FileCreationParameters
+	2,  // 4: bonanza.model.fetch.Action.target:type_name -> bonanza.model.fetch.Target
+	3,  // 5: bonanza.model.fetch.Action.git_target:type_name -> bonanza.model.fetch.GitTarget
+	10, // 6: bonanza.model.fetch.Action.directory_creation_parameters:type_name -> bonanza.model.filesystem.DirectoryCreationParameters
+	11, // 7: bonanza.model.fetch.Result.failure:type_name -> google.rpc.Status
+	7,  // 8: bonanza.model.fetch.Result.success:type_name -> bonanza.model.fetch.Result.Success
+	8,  // 9: bonanza.model.fetch.Result.git_success:type_name -> bonanza.model.fetch.Result.GitSuccess
+	12, // 10: bonanza.model.fetch.Result.Success.contents:type_name -> bonanza.model.filesystem.FileContents
+	13, // 11: bonanza.model.fetch.Result.GitSuccess.root_directory_reference:type_name -> bonanza.model.filesystem.DirectoryReference
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_model_fetch_fetch_proto_init() }
@@ -502,9 +685,10 @@ func file_bonanza_build_pkg_proto_model_fetch_fetch_proto_init() {
 	if File_bonanza_build_pkg_proto_model_fetch_fetch_proto != nil {
 		return
 	}
-	file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[3].OneofWrappers = []any{
+	file_bonanza_build_pkg_proto_model_fetch_fetch_proto_msgTypes[4].OneofWrappers = []any{
 		(*Result_Failure)(nil),
 		(*Result_Success_)(nil),
+		(*Result_GitSuccess_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -512,7 +696,7 @@ func file_bonanza_build_pkg_proto_model_fetch_fetch_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDesc), len(file_bonanza_build_pkg_proto_model_fetch_fetch_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,6 +7,7 @@
 package object
 
 import (
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -24,8 +25,9 @@ const (
 type ReferenceFormat_Value int32
 
 const (
-	ReferenceFormat_UNKNOWN   ReferenceFormat_Value = 0
-	ReferenceFormat_SHA256_V1 ReferenceFormat_Value = 1
+	ReferenceFormat_UNKNOWN       ReferenceFormat_Value = 0
+	ReferenceFormat_SHA256_V1     ReferenceFormat_Value = 1
+	ReferenceFormat_SHA512_256_V1 ReferenceFormat_Value = 2
 )
 
 // Enum value maps for ReferenceFormat_Value.
@@ -33,10 +35,12 @@ var (
 	ReferenceFormat_Value_name = map[int32]string{
 		0: "UNKNOWN",
 		1: "SHA256_V1",
+		2: "SHA512_256_V1",
 	}
 	ReferenceFormat_Value_value = map[string]int32{
-		"UNKNOWN":   0,
-		"SHA256_V1": 1,
+		"UNKNOWN":       0,
+		"SHA256_V1":     1,
+		"SHA512_256_V1": 2,
 	}
 )
 
@@ -251,6 +255,148 @@ func (x *DownloadObjectResponse) GetContents() []byte {
 	return nil
 }
 
+type BatchDownloadObjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Request       *DownloadObjectRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDownloadObjectsRequest) Reset() {
+	*x = BatchDownloadObjectsRequest{}
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDownloadObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDownloadObjectsRequest) ProtoMessage() {}
+
+func (x *BatchDownloadObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDownloadObjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDownloadObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchDownloadObjectsRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *BatchDownloadObjectsRequest) GetRequest() *DownloadObjectRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type BatchDownloadObjectsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*BatchDownloadObjectsResponse_Response
+	//	*BatchDownloadObjectsResponse_Error
+	Result        isBatchDownloadObjectsResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDownloadObjectsResponse) Reset() {
+	*x = BatchDownloadObjectsResponse{}
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDownloadObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDownloadObjectsResponse) ProtoMessage() {}
+
+func (x *BatchDownloadObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDownloadObjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDownloadObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchDownloadObjectsResponse) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *BatchDownloadObjectsResponse) GetResult() isBatchDownloadObjectsResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *BatchDownloadObjectsResponse) GetResponse() *DownloadObjectResponse {
+	if x != nil {
+		if x, ok := x.Result.(*BatchDownloadObjectsResponse_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *BatchDownloadObjectsResponse) GetError() *status.Status {
+	if x != nil {
+		if x, ok := x.Result.(*BatchDownloadObjectsResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isBatchDownloadObjectsResponse_Result interface {
+	isBatchDownloadObjectsResponse_Result()
+}
+
+type BatchDownloadObjectsResponse_Response struct {
+	Response *DownloadObjectResponse `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type BatchDownloadObjectsResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchDownloadObjectsResponse_Response) isBatchDownloadObjectsResponse_Result() {}
+
+func (*BatchDownloadObjectsResponse_Error) isBatchDownloadObjectsResponse_Result() {}
+
 type UploadObjectRequest struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	Namespace                *Namespace             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -264,7 +410,7 @@ type UploadObjectRequest struct {
 
 func (x *UploadObjectRequest) Reset() {
 	*x = UploadObjectRequest{}
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[4]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +422,7 @@ func (x *UploadObjectRequest) String() string {
 func (*UploadObjectRequest) ProtoMessage() {}
 
 func (x *UploadObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[4]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +435,7 @@ func (x *UploadObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadObjectRequest.ProtoReflect.Descriptor instead.
 func (*UploadObjectRequest) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{4}
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UploadObjectRequest) GetNamespace() *Namespace {
@@ -340,7 +486,7 @@ type UploadObjectResponse struct {
 
 func (x *UploadObjectResponse) Reset() {
 	*x = UploadObjectResponse{}
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[5]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +498,7 @@ func (x *UploadObjectResponse) String() string {
 func (*UploadObjectResponse) ProtoMessage() {}
 
 func (x *UploadObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[5]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +511,7 @@ func (x *UploadObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadObjectResponse.ProtoReflect.Descriptor instead.
 func (*UploadObjectResponse) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{5}
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UploadObjectResponse) GetType() isUploadObjectResponse_Type {
@@ -409,6 +555,148 @@ func (*UploadObjectResponse_Complete_) isUploadObjectResponse_Type() {}
 
 func (*UploadObjectResponse_Incomplete_) isUploadObjectResponse_Type() {}
 
+type BatchUploadObjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Request       *UploadObjectRequest   `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUploadObjectsRequest) Reset() {
+	*x = BatchUploadObjectsRequest{}
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUploadObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUploadObjectsRequest) ProtoMessage() {}
+
+func (x *BatchUploadObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUploadObjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUploadObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchUploadObjectsRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *BatchUploadObjectsRequest) GetRequest() *UploadObjectRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type BatchUploadObjectsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*BatchUploadObjectsResponse_Response
+	//	*BatchUploadObjectsResponse_Error
+	Result        isBatchUploadObjectsResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUploadObjectsResponse) Reset() {
+	*x = BatchUploadObjectsResponse{}
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUploadObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUploadObjectsResponse) ProtoMessage() {}
+
+func (x *BatchUploadObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUploadObjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUploadObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchUploadObjectsResponse) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *BatchUploadObjectsResponse) GetResult() isBatchUploadObjectsResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *BatchUploadObjectsResponse) GetResponse() *UploadObjectResponse {
+	if x != nil {
+		if x, ok := x.Result.(*BatchUploadObjectsResponse_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *BatchUploadObjectsResponse) GetError() *status.Status {
+	if x != nil {
+		if x, ok := x.Result.(*BatchUploadObjectsResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isBatchUploadObjectsResponse_Result interface {
+	isBatchUploadObjectsResponse_Result()
+}
+
+type BatchUploadObjectsResponse_Response struct {
+	Response *UploadObjectResponse `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type BatchUploadObjectsResponse_Error struct {
+	Error *status.Status `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchUploadObjectsResponse_Response) isBatchUploadObjectsResponse_Result() {}
+
+func (*BatchUploadObjectsResponse_Error) isBatchUploadObjectsResponse_Result() {}
+
 type Limit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Count         uint32                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -419,7 +707,7 @@ type Limit struct {
 
 func (x *Limit) Reset() {
 	*x = Limit{}
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[6]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -431,7 +719,7 @@ func (x *Limit) String() string {
 func (*Limit) ProtoMessage() {}
 
 func (x *Limit) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[6]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +732,7 @@ func (x *Limit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Limit.ProtoReflect.Descriptor instead.
 func (*Limit) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{6}
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Limit) GetCount() uint32 {
@@ -470,7 +758,7 @@ type UploadObjectResponse_Complete struct {
 
 func (x *UploadObjectResponse_Complete) Reset() {
 	*x = UploadObjectResponse_Complete{}
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[7]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -482,7 +770,7 @@ func (x *UploadObjectResponse_Complete) String() string {
 func (*UploadObjectResponse_Complete) ProtoMessage() {}
 
 func (x *UploadObjectResponse_Complete) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[7]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +783,7 @@ func (x *UploadObjectResponse_Complete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadObjectResponse_Complete.ProtoReflect.Descriptor instead.
 func (*UploadObjectResponse_Complete) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{5, 0}
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *UploadObjectResponse_Complete) GetLease() []byte {
@@ -515,7 +803,7 @@ type UploadObjectResponse_Incomplete struct {
 
 func (x *UploadObjectResponse_Incomplete) Reset() {
 	*x = UploadObjectResponse_Incomplete{}
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[8]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +815,7 @@ func (x *UploadObjectResponse_Incomplete) String() string {
 func (*UploadObjectResponse_Incomplete) ProtoMessage() {}
 
 func (x *UploadObjectResponse_Incomplete) ProtoReflect() protoreflect.Message {
-	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[8]
+	mi := &file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +828,7 @@ func (x *UploadObjectResponse_Incomplete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadObjectResponse_Incomplete.ProtoReflect.Descriptor instead.
 func (*UploadObjectResponse_Incomplete) Descriptor() ([]byte, []int) {
-	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{5, 1}
+	return file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *UploadObjectResponse_Incomplete) GetContents() []byte {
@@ -561,11 +849,12 @@ var File_bonanza_build_pkg_proto_storage_object_object_proto protoreflect.FileDe
 
 const file_bonanza_build_pkg_proto_storage_object_object_proto_rawDesc = "" +
 	"\n" +
-	"3bonanza.build/pkg/proto/storage/object/object.proto\x12\x16bonanza.storage.object\"6\n" +
-	"\x0fReferenceFormat\"#\n" +
+	"3bonanza.build/pkg/proto/storage/object/object.proto\x12\x16bonanza.storage.object\x1a\x17google/rpc/status.proto\"I\n" +
+	"\x0fReferenceFormat\"6\n" +
 	"\x05Value\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\r\n" +
-	"\tSHA256_V1\x10\x01\"\x8a\x01\n" +
+	"\tSHA256_V1\x10\x01\x12\x11\n" +
+	"\rSHA512_256_V1\x10\x02\"\x8a\x01\n" +
 	"\tNamespace\x12#\n" +
 	"\rinstance_name\x18\x01 \x01(\tR\finstanceName\x12X\n" +
 	"\x10reference_format\x18\x02 \x01(\x0e2-.bonanza.storage.object.ReferenceFormat.ValueR\x0freferenceFormat\"v\n" +
@@ -573,7 +862,17 @@ const file_bonanza_build_pkg_proto_storage_object_object_proto_rawDesc = "" +
 	"\tnamespace\x18\x01 \x01(\v2!.bonanza.storage.object.NamespaceR\tnamespace\x12\x1c\n" +
 	"\treference\x18\x02 \x01(\fR\treference\"4\n" +
 	"\x16DownloadObjectResponse\x12\x1a\n" +
-	"\bcontents\x18\x01 \x01(\fR\bcontents\"\x8d\x02\n" +
+	"\bcontents\x18\x01 \x01(\fR\bcontents\"\x85\x01\n" +
+	"\x1bBatchDownloadObjectsRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x04R\trequestId\x12G\n" +
+	"\arequest\x18\x02 \x01(\v2-.bonanza.storage.object.DownloadObjectRequestR\arequest\"\xc1\x01\n" +
+	"\x1cBatchDownloadObjectsResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x04R\trequestId\x12L\n" +
+	"\bresponse\x18\x02 \x01(\v2..bonanza.storage.object.DownloadObjectResponseH\x00R\bresponse\x12*\n" +
+	"\x05error\x18\x03 \x01(\v2\x12.google.rpc.StatusH\x00R\x05errorB\b\n" +
+	"\x06result\"\x8d\x02\n" +
 	"\x13UploadObjectRequest\x12?\n" +
 	"\tnamespace\x18\x01 \x01(\v2!.bonanza.storage.object.NamespaceR\tnamespace\x12\x1c\n" +
 	"\treference\x18\x02 \x01(\fR\treference\x12\x1a\n" +
@@ -591,16 +890,28 @@ const file_bonanza_build_pkg_proto_storage_object_object_proto_rawDesc = "" +
 	"Incomplete\x12\x1a\n" +
 	"\bcontents\x18\x01 \x01(\fR\bcontents\x12E\n" +
 	"\x1fwant_outgoing_references_leases\x18\x02 \x03(\rR\x1cwantOutgoingReferencesLeasesB\x06\n" +
-	"\x04type\"<\n" +
+	"\x04type\"\x81\x01\n" +
+	"\x19BatchUploadObjectsRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x04R\trequestId\x12E\n" +
+	"\arequest\x18\x02 \x01(\v2+.bonanza.storage.object.UploadObjectRequestR\arequest\"\xbd\x01\n" +
+	"\x1aBatchUploadObjectsResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x04R\trequestId\x12J\n" +
+	"\bresponse\x18\x02 \x01(\v2,.bonanza.storage.object.UploadObjectResponseH\x00R\bresponse\x12*\n" +
+	"\x05error\x18\x03 \x01(\v2\x12.google.rpc.StatusH\x00R\x05errorB\b\n" +
+	"\x06result\"<\n" +
 	"\x05Limit\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\rR\x05count\x12\x1d\n" +
 	"\n" +
-	"size_bytes\x18\x02 \x01(\x04R\tsizeBytes2}\n" +
+	"size_bytes\x18\x02 \x01(\x04R\tsizeBytes2\x85\x02\n" +
 	"\n" +
 	"Downloader\x12o\n" +
-	"\x0eDownloadObject\x12-.bonanza.storage.object.DownloadObjectRequest\x1a..bonanza.storage.object.DownloadObjectResponse2u\n" +
+	"\x0eDownloadObject\x12-.bonanza.storage.object.DownloadObjectRequest\x1a..bonanza.storage.object.DownloadObjectResponse\x12\x85\x01\n" +
+	"\x14BatchDownloadObjects\x123.bonanza.storage.object.BatchDownloadObjectsRequest\x1a4.bonanza.storage.object.BatchDownloadObjectsResponse(\x010\x012\xf6\x01\n" +
 	"\bUploader\x12i\n" +
-	"\fUploadObject\x12+.bonanza.storage.object.UploadObjectRequest\x1a,.bonanza.storage.object.UploadObjectResponseB(Z&bonanza.build/pkg/proto/storage/objectb\x06proto3"
+	"\fUploadObject\x12+.bonanza.storage.object.UploadObjectRequest\x1a,.bonanza.storage.object.UploadObjectResponse\x12\x7f\n" +
+	"\x12BatchUploadObjects\x121.bonanza.storage.object.BatchUploadObjectsRequest\x1a2.bonanza.storage.object.BatchUploadObjectsResponse(\x010\x01B(Z&bonanza.build/pkg/proto/storage/objectb\x06proto3"
 
 var (
 	file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescOnce sync.Once
@@ -615,34 +926,49 @@ func file_bonanza_build_pkg_proto_storage_object_object_proto_rawDescGZIP() []by
 }
 
 var file_bonanza_build_pkg_proto_storage_object_object_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_bonanza_build_pkg_proto_storage_object_object_proto_goTypes = []any{
 	(ReferenceFormat_Value)(0),              // 0: bonanza.storage.object.ReferenceFormat.Value
 	(*ReferenceFormat)(nil),                 // 1: bonanza.storage.object.ReferenceFormat
 	(*Namespace)(nil),                       // 2: bonanza.storage.object.Namespace
 	(*DownloadObjectRequest)(nil),           // 3: bonanza.storage.object.DownloadObjectRequest
 	(*DownloadObjectResponse)(nil),          // 4: bonanza.storage.object.DownloadObjectResponse
-	(*UploadObjectRequest)(nil),             // 5: bonanza.storage.object.UploadObjectRequest
-	(*UploadObjectResponse)(nil),            // 6: bonanza.storage.object.UploadObjectResponse
-	(*Limit)(nil),                           // 7: bonanza.storage.object.Limit
-	(*UploadObjectResponse_Complete)(nil),   // 8: bonanza.storage.object.UploadObjectResponse.Complete
-	(*UploadObjectResponse_Incomplete)(nil), // 9: bonanza.storage.object.UploadObjectResponse.Incomplete
+	(*BatchDownloadObjectsRequest)(nil),     // 5: bonanza.storage.object.BatchDownloadObjectsRequest
+	(*BatchDownloadObjectsResponse)(nil),    // 6: bonanza.storage.object.BatchDownloadObjectsResponse
+	(*UploadObjectRequest)(nil),             // 7: bonanza.storage.object.UploadObjectRequest
+	(*UploadObjectResponse)(nil),            // 8: bonanza.storage.object.UploadObjectResponse
+	(*BatchUploadObjectsRequest)(nil),       // 9: bonanza.storage.object.BatchUploadObjectsRequest
+	(*BatchUploadObjectsResponse)(nil),      // 10: bonanza.storage.object.BatchUploadObjectsResponse
+	(*Limit)(nil),                           // 11: bonanza.storage.object.Limit
+	(*UploadObjectResponse_Complete)(nil),   // 12: bonanza.storage.object.UploadObjectResponse.Complete
+	(*UploadObjectResponse_Incomplete)(nil), // 13: bonanza.storage.object.UploadObjectResponse.Incomplete
+	(*status.Status)(nil),                   // 14: google.rpc.Status
 }
 var file_bonanza_build_pkg_proto_storage_object_object_proto_depIdxs = []int32{
-	0, // 0: bonanza.storage.object.Namespace.reference_format:type_name -> bonanza.storage.object.ReferenceFormat.Value
-	2, // 1: bonanza.storage.object.DownloadObjectRequest.namespace:type_name -> bonanza.storage.object.Namespace
-	2, // 2: bonanza.storage.object.UploadObjectRequest.namespace:type_name -> bonanza.storage.object.Namespace
-	8, // 3: bonanza.storage.object.UploadObjectResponse.complete:type_name -> bonanza.storage.object.UploadObjectResponse.Complete
-	9, // 4: bonanza.storage.object.UploadObjectResponse.incomplete:type_name -> bonanza.storage.object.UploadObjectResponse.Incomplete
-	3, // 5: bonanza.storage.object.Downloader.DownloadObject:input_type -> bonanza.storage.object.DownloadObjectRequest
-	5, // 6: bonanza.storage.object.Uploader.UploadObject:input_type -> bonanza.storage.object.UploadObjectRequest
-	4, // 7: bonanza.storage.object.Downloader.DownloadObject:output_type -> bonanza.storage.object.DownloadObjectResponse
-	6, // 8: bonanza.storage.object.Uploader.UploadObject:output_type -> bonanza.storage.object.UploadObjectResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: bonanza.storage.object.Namespace.reference_format:type_name -> bonanza.storage.object.ReferenceFormat.Value
+	2,  // 1: bonanza.storage.object.DownloadObjectRequest.namespace:type_name -> bonanza.storage.object.Namespace
+	3,  // 2: bonanza.storage.object.BatchDownloadObjectsRequest.request:type_name -> bonanza.storage.object.DownloadObjectRequest
+	4,  // 3: bonanza.storage.object.BatchDownloadObjectsResponse.response:type_name -> bonanza.storage.object.DownloadObjectResponse
+	14, // 4: bonanza.storage.object.BatchDownloadObjectsResponse.error:type_name -> google.rpc.Status
+	2,  // 5: bonanza.storage.object.UploadObjectRequest.namespace:type_name -> bonanza.storage.object.Namespace
+	12, // 6: bonanza.storage.object.UploadObjectResponse.complete:type_name -> bonanza.storage.object.UploadObjectResponse.Complete
+	13, // 7: bonanza.storage.object.UploadObjectResponse.incomplete:type_name -> bonanza.storage.object.UploadObjectResponse.Incomplete
+	7,  // 8: bonanza.storage.object.BatchUploadObjectsRequest.request:type_name -> bonanza.storage.object.UploadObjectRequest
+	8,  // 9: bonanza.storage.object.BatchUploadObjectsResponse.response:type_name -> bonanza.storage.object.UploadObjectResponse
+	14, // 10: bonanza.storage.object.BatchUploadObjectsResponse.error:type_name -> google.rpc.Status
+	3,  // 11: bonanza.storage.object.Downloader.DownloadObject:input_type -> bonanza.storage.object.DownloadObjectRequest
+	5,  // 12: bonanza.storage.object.Downloader.BatchDownloadObjects:input_type -> bonanza.storage.object.BatchDownloadObjectsRequest
+	7,  // 13: bonanza.storage.object.Uploader.UploadObject:input_type -> bonanza.storage.object.UploadObjectRequest
+	9,  // 14: bonanza.storage.object.Uploader.BatchUploadObjects:input_type -> bonanza.storage.object.BatchUploadObjectsRequest
+	4,  // 15: bonanza.storage.object.Downloader.DownloadObject:output_type -> bonanza.storage.object.DownloadObjectResponse
+	6,  // 16: bonanza.storage.object.Downloader.BatchDownloadObjects:output_type -> bonanza.storage.object.BatchDownloadObjectsResponse
+	8,  // 17: bonanza.storage.object.Uploader.UploadObject:output_type -> bonanza.storage.object.UploadObjectResponse
+	10, // 18: bonanza.storage.object.Uploader.BatchUploadObjects:output_type -> bonanza.storage.object.BatchUploadObjectsResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_storage_object_object_proto_init() }
@@ -651,16 +977,24 @@ func file_bonanza_build_pkg_proto_storage_object_object_proto_init() {
 		return
 	}
 	file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[5].OneofWrappers = []any{
+		(*BatchDownloadObjectsResponse_Response)(nil),
+		(*BatchDownloadObjectsResponse_Error)(nil),
+	}
+	file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[7].OneofWrappers = []any{
 		(*UploadObjectResponse_Complete_)(nil),
 		(*UploadObjectResponse_Incomplete_)(nil),
 	}
+	file_bonanza_build_pkg_proto_storage_object_object_proto_msgTypes[9].OneofWrappers = []any{
+		(*BatchUploadObjectsResponse_Response)(nil),
+		(*BatchUploadObjectsResponse_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_storage_object_object_proto_rawDesc), len(file_bonanza_build_pkg_proto_storage_object_object_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Downloader_DownloadObject_FullMethodName = "/bonanza.storage.object.Downloader/DownloadObject"
+	Downloader_DownloadObject_FullMethodName       = "/bonanza.storage.object.Downloader/DownloadObject"
+	Downloader_BatchDownloadObjects_FullMethodName = "/bonanza.storage.object.Downloader/BatchDownloadObjects"
 )
 
 // DownloaderClient is the client API for Downloader service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DownloaderClient interface {
 	DownloadObject(ctx context.Context, in *DownloadObjectRequest, opts ...grpc.CallOption) (*DownloadObjectResponse, error)
+	BatchDownloadObjects(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchDownloadObjectsRequest, BatchDownloadObjectsResponse], error)
 }
 
 type downloaderClient struct {
@@ -47,11 +49,25 @@ func (c *downloaderClient) DownloadObject(ctx context.Context, in *DownloadObjec
 	return out, nil
 }
 
+func (c *downloaderClient) BatchDownloadObjects(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchDownloadObjectsRequest, BatchDownloadObjectsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Downloader_ServiceDesc.Streams[0], Downloader_BatchDownloadObjects_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchDownloadObjectsRequest, BatchDownloadObjectsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Downloader_BatchDownloadObjectsClient = grpc.BidiStreamingClient[BatchDownloadObjectsRequest, BatchDownloadObjectsResponse]
+
 // DownloaderServer is the server API for Downloader service.
 // All implementations should embed UnimplementedDownloaderServer
 // for forward compatibility.
 type DownloaderServer interface {
 	DownloadObject(context.Context, *DownloadObjectRequest) (*DownloadObjectResponse, error)
+	BatchDownloadObjects(grpc.BidiStreamingServer[BatchDownloadObjectsRequest, BatchDownloadObjectsResponse]) error
 }
 
 // UnimplementedDownloaderServer should be embedded to have
@@ -64,6 +80,9 @@ type UnimplementedDownloaderServer struct{}
 func (UnimplementedDownloaderServer) DownloadObject(context.Context, *DownloadObjectRequest) (*DownloadObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownloadObject not implemented")
 }
+func (UnimplementedDownloaderServer) BatchDownloadObjects(grpc.BidiStreamingServer[BatchDownloadObjectsRequest, BatchDownloadObjectsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BatchDownloadObjects not implemented")
+}
 func (UnimplementedDownloaderServer) testEmbeddedByValue() {}
 
 // UnsafeDownloaderServer may be embedded to opt out of forward compatibility for this service.
@@ -102,6 +121,13 @@ func _Downloader_DownloadObject_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Downloader_BatchDownloadObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DownloaderServer).BatchDownloadObjects(&grpc.GenericServerStream[BatchDownloadObjectsRequest, BatchDownloadObjectsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Downloader_BatchDownloadObjectsServer = grpc.BidiStreamingServer[BatchDownloadObjectsRequest, BatchDownloadObjectsResponse]
+
 // Downloader_ServiceDesc is the grpc.ServiceDesc for Downloader service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -114,12 +140,20 @@ var Downloader_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Downloader_DownloadObject_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchDownloadObjects",
+			Handler:       _Downloader_BatchDownloadObjects_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "bonanza.build/pkg/proto/storage/object/object.proto",
 }
 
 const (
-	Uploader_UploadObject_FullMethodName = "/bonanza.storage.object.Uploader/UploadObject"
+	Uploader_UploadObject_FullMethodName       = "/bonanza.storage.object.Uploader/UploadObject"
+	Uploader_BatchUploadObjects_FullMethodName = "/bonanza.storage.object.Uploader/BatchUploadObjects"
 )
 
 // UploaderClient is the client API for Uploader service.
@@ -127,6 +161,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UploaderClient interface {
 	UploadObject(ctx context.Context, in *UploadObjectRequest, opts ...grpc.CallOption) (*UploadObjectResponse, error)
+	BatchUploadObjects(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchUploadObjectsRequest, BatchUploadObjectsResponse], error)
 }
 
 type uploaderClient struct {
@@ -147,11 +182,25 @@ func (c *uploaderClient) UploadObject(ctx context.Context, in *UploadObjectReque
 	return out, nil
 }
 
+func (c *uploaderClient) BatchUploadObjects(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchUploadObjectsRequest, BatchUploadObjectsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Uploader_ServiceDesc.Streams[0], Uploader_BatchUploadObjects_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchUploadObjectsRequest, BatchUploadObjectsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Uploader_BatchUploadObjectsClient = grpc.BidiStreamingClient[BatchUploadObjectsRequest, BatchUploadObjectsResponse]
+
 // UploaderServer is the server API for Uploader service.
 // All implementations should embed UnimplementedUploaderServer
 // for forward compatibility.
 type UploaderServer interface {
 	UploadObject(context.Context, *UploadObjectRequest) (*UploadObjectResponse, error)
+	BatchUploadObjects(grpc.BidiStreamingServer[BatchUploadObjectsRequest, BatchUploadObjectsResponse]) error
 }
 
 // UnimplementedUploaderServer should be embedded to have
@@ -164,6 +213,9 @@ type UnimplementedUploaderServer struct{}
 func (UnimplementedUploaderServer) UploadObject(context.Context, *UploadObjectRequest) (*UploadObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadObject not implemented")
 }
+func (UnimplementedUploaderServer) BatchUploadObjects(grpc.BidiStreamingServer[BatchUploadObjectsRequest, BatchUploadObjectsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BatchUploadObjects not implemented")
+}
 func (UnimplementedUploaderServer) testEmbeddedByValue() {}
 
 // UnsafeUploaderServer may be embedded to opt out of forward compatibility for this service.
@@ -202,6 +254,13 @@ func _Uploader_UploadObject_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Uploader_BatchUploadObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UploaderServer).BatchUploadObjects(&grpc.GenericServerStream[BatchUploadObjectsRequest, BatchUploadObjectsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Uploader_BatchUploadObjectsServer = grpc.BidiStreamingServer[BatchUploadObjectsRequest, BatchUploadObjectsResponse]
+
 // Uploader_ServiceDesc is the grpc.ServiceDesc for Uploader service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +273,13 @@ var Uploader_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Uploader_UploadObject_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchUploadObjects",
+			Handler:       _Uploader_BatchUploadObjects_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "bonanza.build/pkg/proto/storage/object/object.proto",
 }
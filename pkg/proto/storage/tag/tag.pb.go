@@ -204,6 +204,138 @@ func (x *UpdateTagRequest) GetOverwrite() bool {
 	return false
 }
 
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_bonanza_build_pkg_proto_storage_tag_tag_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_storage_tag_tag_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDescGZIP(), []int{3}
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tags          []*ListTagsResponse_Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_bonanza_build_pkg_proto_storage_tag_tag_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_storage_tag_tag_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTagsResponse) GetTags() []*ListTagsResponse_Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListTagsResponse_Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *object.Namespace      `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Tag           *anypb.Any             `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse_Tag) Reset() {
+	*x = ListTagsResponse_Tag{}
+	mi := &file_bonanza_build_pkg_proto_storage_tag_tag_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse_Tag) ProtoMessage() {}
+
+func (x *ListTagsResponse_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_bonanza_build_pkg_proto_storage_tag_tag_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse_Tag.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse_Tag) Descriptor() ([]byte, []int) {
+	return file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *ListTagsResponse_Tag) GetNamespace() *object.Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *ListTagsResponse_Tag) GetTag() *anypb.Any {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
 var File_bonanza_build_pkg_proto_storage_tag_tag_proto protoreflect.FileDescriptor
 
 const file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDesc = "" +
@@ -220,12 +352,20 @@ const file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDesc = "" +
 	"\x03tag\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x03tag\x12\x1c\n" +
 	"\treference\x18\x03 \x01(\fR\treference\x12\x14\n" +
 	"\x05lease\x18\x04 \x01(\fR\x05lease\x12\x1c\n" +
-	"\toverwrite\x18\x05 \x01(\bR\toverwrite2i\n" +
+	"\toverwrite\x18\x05 \x01(\bR\toverwrite\"\x11\n" +
+	"\x0fListTagsRequest\"\xc1\x01\n" +
+	"\x10ListTagsResponse\x12=\n" +
+	"\x04tags\x18\x01 \x03(\v2).bonanza.storage.tag.ListTagsResponse.TagR\x04tags\x1an\n" +
+	"\x03Tag\x12?\n" +
+	"\tnamespace\x18\x01 \x01(\v2!.bonanza.storage.object.NamespaceR\tnamespace\x12&\n" +
+	"\x03tag\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x03tag2i\n" +
 	"\bResolver\x12]\n" +
 	"\n" +
 	"ResolveTag\x12&.bonanza.storage.tag.ResolveTagRequest\x1a'.bonanza.storage.tag.ResolveTagResponse2U\n" +
 	"\aUpdater\x12J\n" +
-	"\tUpdateTag\x12%.bonanza.storage.tag.UpdateTagRequest\x1a\x16.google.protobuf.EmptyB%Z#bonanza.build/pkg/proto/storage/tagb\x06proto3"
+	"\tUpdateTag\x12%.bonanza.storage.tag.UpdateTagRequest\x1a\x16.google.protobuf.Empty2c\n" +
+	"\x06Lister\x12Y\n" +
+	"\bListTags\x12$.bonanza.storage.tag.ListTagsRequest\x1a%.bonanza.storage.tag.ListTagsResponse0\x01B%Z#bonanza.build/pkg/proto/storage/tagb\x06proto3"
 
 var (
 	file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDescOnce sync.Once
@@ -239,29 +379,37 @@ func file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDescGZIP() []byte {
 	return file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDescData
 }
 
-var file_bonanza_build_pkg_proto_storage_tag_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_bonanza_build_pkg_proto_storage_tag_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_bonanza_build_pkg_proto_storage_tag_tag_proto_goTypes = []any{
-	(*ResolveTagRequest)(nil),  // 0: bonanza.storage.tag.ResolveTagRequest
-	(*ResolveTagResponse)(nil), // 1: bonanza.storage.tag.ResolveTagResponse
-	(*UpdateTagRequest)(nil),   // 2: bonanza.storage.tag.UpdateTagRequest
-	(*object.Namespace)(nil),   // 3: bonanza.storage.object.Namespace
-	(*anypb.Any)(nil),          // 4: google.protobuf.Any
-	(*emptypb.Empty)(nil),      // 5: google.protobuf.Empty
+	(*ResolveTagRequest)(nil),    // 0: bonanza.storage.tag.ResolveTagRequest
+	(*ResolveTagResponse)(nil),   // 1: bonanza.storage.tag.ResolveTagResponse
+	(*UpdateTagRequest)(nil),     // 2: bonanza.storage.tag.UpdateTagRequest
+	(*ListTagsRequest)(nil),      // 3: bonanza.storage.tag.ListTagsRequest
+	(*ListTagsResponse)(nil),     // 4: bonanza.storage.tag.ListTagsResponse
+	(*ListTagsResponse_Tag)(nil), // 5: bonanza.storage.tag.ListTagsResponse.Tag
+	(*object.Namespace)(nil),     // 6: bonanza.storage.object.Namespace
+	(*anypb.Any)(nil),            // 7: google.protobuf.Any
+	(*emptypb.Empty)(nil),        // 8: google.protobuf.Empty
 }
 var file_bonanza_build_pkg_proto_storage_tag_tag_proto_depIdxs = []int32{
-	3, // 0: bonanza.storage.tag.ResolveTagRequest.namespace:type_name -> bonanza.storage.object.Namespace
-	4, // 1: bonanza.storage.tag.ResolveTagRequest.tag:type_name -> google.protobuf.Any
-	3, // 2: bonanza.storage.tag.UpdateTagRequest.namespace:type_name -> bonanza.storage.object.Namespace
-	4, // 3: bonanza.storage.tag.UpdateTagRequest.tag:type_name -> google.protobuf.Any
-	0, // 4: bonanza.storage.tag.Resolver.ResolveTag:input_type -> bonanza.storage.tag.ResolveTagRequest
-	2, // 5: bonanza.storage.tag.Updater.UpdateTag:input_type -> bonanza.storage.tag.UpdateTagRequest
-	1, // 6: bonanza.storage.tag.Resolver.ResolveTag:output_type -> bonanza.storage.tag.ResolveTagResponse
-	5, // 7: bonanza.storage.tag.Updater.UpdateTag:output_type -> google.protobuf.Empty
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6,  // 0: bonanza.storage.tag.ResolveTagRequest.namespace:type_name -> bonanza.storage.object.Namespace
+	7,  // 1: bonanza.storage.tag.ResolveTagRequest.tag:type_name -> google.protobuf.Any
+	6,  // 2: bonanza.storage.tag.UpdateTagRequest.namespace:type_name -> bonanza.storage.object.Namespace
+	7,  // 3: bonanza.storage.tag.UpdateTagRequest.tag:type_name -> google.protobuf.Any
+	5,  // 4: bonanza.storage.tag.ListTagsResponse.tags:type_name -> bonanza.storage.tag.ListTagsResponse.Tag
+	6,  // 5: bonanza.storage.tag.ListTagsResponse.Tag.namespace:type_name -> bonanza.storage.object.Namespace
+	7,  // 6: bonanza.storage.tag.ListTagsResponse.Tag.tag:type_name -> google.protobuf.Any
+	0,  // 7: bonanza.storage.tag.Resolver.ResolveTag:input_type -> bonanza.storage.tag.ResolveTagRequest
+	2,  // 8: bonanza.storage.tag.Updater.UpdateTag:input_type -> bonanza.storage.tag.UpdateTagRequest
+	3,  // 9: bonanza.storage.tag.Lister.ListTags:input_type -> bonanza.storage.tag.ListTagsRequest
+	1,  // 10: bonanza.storage.tag.Resolver.ResolveTag:output_type -> bonanza.storage.tag.ResolveTagResponse
+	8,  // 11: bonanza.storage.tag.Updater.UpdateTag:output_type -> google.protobuf.Empty
+	4,  // 12: bonanza.storage.tag.Lister.ListTags:output_type -> bonanza.storage.tag.ListTagsResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_bonanza_build_pkg_proto_storage_tag_tag_proto_init() }
@@ -275,9 +423,9 @@ func file_bonanza_build_pkg_proto_storage_tag_tag_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDesc), len(file_bonanza_build_pkg_proto_storage_tag_tag_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_bonanza_build_pkg_proto_storage_tag_tag_proto_goTypes,
 		DependencyIndexes: file_bonanza_build_pkg_proto_storage_tag_tag_proto_depIdxs,
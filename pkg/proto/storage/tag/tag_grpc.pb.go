@@ -218,3 +218,106 @@ var Updater_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bonanza.build/pkg/proto/storage/tag/tag.proto",
 }
+
+const (
+	Lister_ListTags_FullMethodName = "/bonanza.storage.tag.Lister/ListTags"
+)
+
+// ListerClient is the client API for Lister service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ListerClient interface {
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListTagsResponse], error)
+}
+
+type listerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewListerClient(cc grpc.ClientConnInterface) ListerClient {
+	return &listerClient{cc}
+}
+
+func (c *listerClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListTagsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lister_ServiceDesc.Streams[0], Lister_ListTags_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListTagsRequest, ListTagsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lister_ListTagsClient = grpc.ServerStreamingClient[ListTagsResponse]
+
+// ListerServer is the server API for Lister service.
+// All implementations should embed UnimplementedListerServer
+// for forward compatibility.
+type ListerServer interface {
+	ListTags(*ListTagsRequest, grpc.ServerStreamingServer[ListTagsResponse]) error
+}
+
+// UnimplementedListerServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedListerServer struct{}
+
+func (UnimplementedListerServer) ListTags(*ListTagsRequest, grpc.ServerStreamingServer[ListTagsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedListerServer) testEmbeddedByValue() {}
+
+// UnsafeListerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ListerServer will
+// result in compilation errors.
+type UnsafeListerServer interface {
+	mustEmbedUnimplementedListerServer()
+}
+
+func RegisterListerServer(s grpc.ServiceRegistrar, srv ListerServer) {
+	// If the following call pancis, it indicates UnimplementedListerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Lister_ServiceDesc, srv)
+}
+
+func _Lister_ListTags_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTagsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ListerServer).ListTags(m, &grpc.GenericServerStream[ListTagsRequest, ListTagsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lister_ListTagsServer = grpc.ServerStreamingServer[ListTagsResponse]
+
+// Lister_ServiceDesc is the grpc.ServiceDesc for Lister service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Lister_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bonanza.storage.tag.Lister",
+	HandlerType: (*ListerServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListTags",
+			Handler:       _Lister_ListTags_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bonanza.build/pkg/proto/storage/tag/tag.proto",
+}
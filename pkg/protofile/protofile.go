@@ -0,0 +1,87 @@
+package protofile
+
+import (
+	"io"
+	"log"
+	"math"
+	"os"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// Read a Protobuf message from a file stored inside a
+// filesystem.Directory. False is returned if the file does not exist.
+//
+// If the file was read successfully, but its contents cannot be
+// unmarshaled, false is returned as well. As this is not a transient
+// issue, it is better to let the caller reinitialize its state than
+// to let the system remain in a broken state.
+func Read(directory filesystem.Directory, name path.Component, message proto.Message) (bool, error) {
+	f, err := directory.OpenRead(name)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, util.StatusWrapWithCode(err, codes.Internal, "Failed to open file")
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.NewSectionReader(f, 0, math.MaxInt64))
+	if err != nil {
+		return false, util.StatusWrapWithCode(err, codes.Internal, "Failed to read from file")
+	}
+	if err := proto.Unmarshal(data, message); err != nil {
+		log.Printf("Discarding contents of file %#v, as they are corrupted: %s", name.String(), err)
+		proto.Reset(message)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Write a Protobuf message to a file stored inside a
+// filesystem.Directory. The message is first written to a temporary
+// file having suffix ".new", which is moved over the original file
+// after synchronizing it. This ensures that the file is replaced
+// atomically.
+func Write(directory filesystem.Directory, name path.Component, message proto.Message) error {
+	// Marshal the message.
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal data")
+	}
+
+	// Write the message to a temporary file.
+	nameNew := path.MustNewComponent(name.String() + ".new")
+	if err := directory.Remove(nameNew); err != nil && !os.IsNotExist(err) {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to remove previous temporary file")
+	}
+	f, err := directory.OpenAppend(nameNew, filesystem.CreateExcl(0o666))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to create temporary file")
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to temporary file")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize temporary file")
+	}
+	if err := f.Close(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to close temporary file")
+	}
+
+	// Move the new file over the old copy.
+	if err := directory.Rename(nameNew, directory, name); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to rename temporary file")
+	}
+	if err := directory.Sync(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize directory")
+	}
+	return nil
+}
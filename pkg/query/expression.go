@@ -0,0 +1,85 @@
+package query
+
+// Expression in the Bazel query language. Expressions evaluate to a
+// set of targets.
+type Expression interface {
+	isExpression()
+}
+
+// TargetPatternExpression evaluates to the set of targets matched by a
+// target pattern, such as "//foo:bar" or "//foo/...".
+type TargetPatternExpression struct {
+	Pattern string
+}
+
+// VariableExpression evaluates to the value of a variable that was
+// previously bound using a "let" expression, such as "$x".
+type VariableExpression struct {
+	Name string
+}
+
+// LetExpression binds the value of an expression to a variable, and
+// evaluates to the value of a body expression in which the variable
+// may be referenced: "let x = value in body".
+type LetExpression struct {
+	Name  string
+	Value Expression
+	Body  Expression
+}
+
+// SetExpression evaluates to the union of the targets matched by zero
+// or more target patterns: "set(//foo //bar)".
+type SetExpression struct {
+	Patterns []string
+}
+
+// BinaryOperator is an operator that may be used to combine the
+// results of two expressions.
+type BinaryOperator int
+
+const (
+	// BinaryOperatorIntersect computes the intersection of two
+	// sets, written as "x intersect y" or "x ^ y".
+	BinaryOperatorIntersect BinaryOperator = iota
+	// BinaryOperatorUnion computes the union of two sets, written
+	// as "x union y" or "x + y".
+	BinaryOperatorUnion
+	// BinaryOperatorExcept computes the difference of two sets,
+	// written as "x except y" or "x - y".
+	BinaryOperatorExcept
+)
+
+// BinaryOperatorExpression combines the results of two expressions.
+// All binary operators have the same precedence, and are left
+// associative.
+type BinaryOperatorExpression struct {
+	Operator BinaryOperator
+	Left     Expression
+	Right    Expression
+}
+
+// FunctionExpression calls one of the functions provided by the query
+// language, such as "deps(//foo)" or "kind(cc_library, //...)".
+//
+// The number and types of the arguments are validated while parsing,
+// meaning that the evaluator may assume that the arguments match the
+// signature of the function.
+type FunctionExpression struct {
+	Name      string
+	Arguments []Argument
+}
+
+// Argument of a function call. Depending on the signature of the
+// function, either an expression, a word or an integer is set.
+type Argument struct {
+	Expression Expression
+	Word       string
+	Integer    int
+}
+
+func (TargetPatternExpression) isExpression()  {}
+func (VariableExpression) isExpression()       {}
+func (LetExpression) isExpression()            {}
+func (SetExpression) isExpression()            {}
+func (BinaryOperatorExpression) isExpression() {}
+func (FunctionExpression) isExpression()       {}
@@ -0,0 +1,325 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenKindEOF tokenKind = iota
+	tokenKindWord
+	tokenKindLeftParen
+	tokenKindRightParen
+	tokenKindComma
+	tokenKindEquals
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	// quoted is set if the word was surrounded by quotes. Quoted
+	// words are never interpreted as keywords or operators.
+	quoted bool
+	offset int
+}
+
+// tokenize splits a query expression into tokens.
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+	offset := 0
+	for {
+		for offset < len(input) && strings.IndexByte(" \t\r\n", input[offset]) >= 0 {
+			offset++
+		}
+		if offset == len(input) {
+			return append(tokens, token{kind: tokenKindEOF, offset: offset}), nil
+		}
+		switch c := input[offset]; c {
+		case '(':
+			tokens = append(tokens, token{kind: tokenKindLeftParen, offset: offset})
+			offset++
+		case ')':
+			tokens = append(tokens, token{kind: tokenKindRightParen, offset: offset})
+			offset++
+		case ',':
+			tokens = append(tokens, token{kind: tokenKindComma, offset: offset})
+			offset++
+		case '=':
+			tokens = append(tokens, token{kind: tokenKindEquals, offset: offset})
+			offset++
+		case '"', '\'':
+			length := strings.IndexByte(input[offset+1:], c)
+			if length < 0 {
+				return nil, fmt.Errorf("unterminated quoted word at offset %d", offset)
+			}
+			tokens = append(tokens, token{
+				kind:   tokenKindWord,
+				value:  input[offset+1 : offset+1+length],
+				quoted: true,
+				offset: offset,
+			})
+			offset += length + 2
+		default:
+			start := offset
+			for offset < len(input) && strings.IndexByte(" \t\r\n(),='\"", input[offset]) < 0 {
+				offset++
+			}
+			tokens = append(tokens, token{
+				kind:   tokenKindWord,
+				value:  input[start:offset],
+				offset: start,
+			})
+		}
+	}
+}
+
+type argumentType int
+
+const (
+	argumentTypeExpression argumentType = iota
+	argumentTypeWord
+	argumentTypeInteger
+)
+
+type functionSignature struct {
+	mandatoryArguments []argumentType
+	optionalArguments  []argumentType
+}
+
+var functionSignatures = map[string]functionSignature{
+	"allpaths": {
+		mandatoryArguments: []argumentType{argumentTypeExpression, argumentTypeExpression},
+	},
+	"attr": {
+		mandatoryArguments: []argumentType{argumentTypeWord, argumentTypeWord, argumentTypeExpression},
+	},
+	"deps": {
+		mandatoryArguments: []argumentType{argumentTypeExpression},
+		optionalArguments:  []argumentType{argumentTypeInteger},
+	},
+	"kind": {
+		mandatoryArguments: []argumentType{argumentTypeWord, argumentTypeExpression},
+	},
+	"rdeps": {
+		mandatoryArguments: []argumentType{argumentTypeExpression, argumentTypeExpression},
+		optionalArguments:  []argumentType{argumentTypeInteger},
+	},
+	"somepath": {
+		mandatoryArguments: []argumentType{argumentTypeExpression, argumentTypeExpression},
+	},
+}
+
+var binaryOperators = map[string]BinaryOperator{
+	"intersect": BinaryOperatorIntersect,
+	"^":         BinaryOperatorIntersect,
+	"union":     BinaryOperatorUnion,
+	"+":         BinaryOperatorUnion,
+	"except":    BinaryOperatorExcept,
+	"-":         BinaryOperatorExcept,
+}
+
+type parser struct {
+	tokens []token
+}
+
+func (p *parser) peek() token {
+	return p.tokens[0]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[0]
+	if t.kind != tokenKindEOF {
+		p.tokens = p.tokens[1:]
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, description string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return token{}, fmt.Errorf("expected %s at offset %d", description, t.offset)
+	}
+	return t, nil
+}
+
+func (p *parser) parseExpression() (Expression, error) {
+	left, err := p.parsePrimaryExpression()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokenKindWord || t.quoted {
+			return left, nil
+		}
+		operator, ok := binaryOperators[t.value]
+		if !ok {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parsePrimaryExpression()
+		if err != nil {
+			return nil, err
+		}
+		left = BinaryOperatorExpression{
+			Operator: operator,
+			Left:     left,
+			Right:    right,
+		}
+	}
+}
+
+func (p *parser) parsePrimaryExpression() (Expression, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenKindLeftParen:
+		expression, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenKindRightParen, "\")\""); err != nil {
+			return nil, err
+		}
+		return expression, nil
+	case tokenKindWord:
+		if t.quoted {
+			return TargetPatternExpression{Pattern: t.value}, nil
+		}
+		if _, ok := binaryOperators[t.value]; ok {
+			return nil, fmt.Errorf("unexpected operator %#v at offset %d", t.value, t.offset)
+		}
+		if t.value == "let" {
+			return p.parseLetExpression()
+		}
+		if name, ok := strings.CutPrefix(t.value, "$"); ok {
+			if name == "" {
+				return nil, fmt.Errorf("missing variable name at offset %d", t.offset)
+			}
+			return VariableExpression{Name: name}, nil
+		}
+		if p.peek().kind == tokenKindLeftParen {
+			p.next()
+			if t.value == "set" {
+				return p.parseSetExpression()
+			}
+			return p.parseFunctionExpression(t)
+		}
+		return TargetPatternExpression{Pattern: t.value}, nil
+	case tokenKindEOF:
+		return nil, errors.New("unexpected end of query expression")
+	default:
+		return nil, fmt.Errorf("expected expression at offset %d", t.offset)
+	}
+}
+
+func (p *parser) parseLetExpression() (Expression, error) {
+	name, err := p.expect(tokenKindWord, "variable name")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenKindEquals, "\"=\""); err != nil {
+		return nil, err
+	}
+	value, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if in := p.next(); in.kind != tokenKindWord || in.quoted || in.value != "in" {
+		return nil, fmt.Errorf("expected \"in\" at offset %d", in.offset)
+	}
+	body, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	return LetExpression{
+		Name:  name.value,
+		Value: value,
+		Body:  body,
+	}, nil
+}
+
+func (p *parser) parseSetExpression() (Expression, error) {
+	var patterns []string
+	for {
+		t := p.next()
+		switch t.kind {
+		case tokenKindWord:
+			patterns = append(patterns, t.value)
+		case tokenKindRightParen:
+			return SetExpression{Patterns: patterns}, nil
+		default:
+			return nil, fmt.Errorf("expected target pattern or \")\" at offset %d", t.offset)
+		}
+	}
+}
+
+func (p *parser) parseFunctionExpression(name token) (Expression, error) {
+	signature, ok := functionSignatures[name.value]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %#v at offset %d", name.value, name.offset)
+	}
+	var arguments []Argument
+	argumentTypes := append(append([]argumentType(nil), signature.mandatoryArguments...), signature.optionalArguments...)
+	for i, argumentType := range argumentTypes {
+		if i > 0 {
+			t := p.next()
+			if t.kind == tokenKindRightParen && i >= len(signature.mandatoryArguments) {
+				return FunctionExpression{Name: name.value, Arguments: arguments}, nil
+			}
+			if t.kind != tokenKindComma {
+				return nil, fmt.Errorf("expected \",\" in call to function %#v at offset %d", name.value, t.offset)
+			}
+		}
+		switch argumentType {
+		case argumentTypeExpression:
+			expression, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, Argument{Expression: expression})
+		case argumentTypeWord:
+			t, err := p.expect(tokenKindWord, "word")
+			if err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, Argument{Word: t.value})
+		case argumentTypeInteger:
+			t, err := p.expect(tokenKindWord, "integer")
+			if err != nil {
+				return nil, err
+			}
+			v, err := strconv.Atoi(t.value)
+			if err != nil || v < 0 {
+				return nil, fmt.Errorf("expected non-negative integer at offset %d", t.offset)
+			}
+			arguments = append(arguments, Argument{Integer: v})
+		default:
+			panic("unknown argument type")
+		}
+	}
+	if _, err := p.expect(tokenKindRightParen, "\")\""); err != nil {
+		return nil, err
+	}
+	return FunctionExpression{Name: name.value, Arguments: arguments}, nil
+}
+
+// Parse an expression written in the Bazel query language.
+func Parse(input string) (Expression, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	expression, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenKindEOF {
+		return nil, fmt.Errorf("unexpected trailing input at offset %d", t.offset)
+	}
+	return expression, nil
+}
@@ -0,0 +1,120 @@
+package query_test
+
+import (
+	"testing"
+
+	"bonanza.build/pkg/query"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("TargetPattern", func(t *testing.T) {
+		expression, err := query.Parse("//foo/...")
+		require.NoError(t, err)
+		assert.Equal(t, query.TargetPatternExpression{Pattern: "//foo/..."}, expression)
+
+		expression, err = query.Parse("'//foo:bar baz'")
+		require.NoError(t, err)
+		assert.Equal(t, query.TargetPatternExpression{Pattern: "//foo:bar baz"}, expression)
+	})
+
+	t.Run("BinaryOperators", func(t *testing.T) {
+		// Binary operators have equal precedence and are left
+		// associative.
+		expression, err := query.Parse("//a union //b - //c intersect //d")
+		require.NoError(t, err)
+		assert.Equal(t, query.BinaryOperatorExpression{
+			Operator: query.BinaryOperatorIntersect,
+			Left: query.BinaryOperatorExpression{
+				Operator: query.BinaryOperatorExcept,
+				Left: query.BinaryOperatorExpression{
+					Operator: query.BinaryOperatorUnion,
+					Left:     query.TargetPatternExpression{Pattern: "//a"},
+					Right:    query.TargetPatternExpression{Pattern: "//b"},
+				},
+				Right: query.TargetPatternExpression{Pattern: "//c"},
+			},
+			Right: query.TargetPatternExpression{Pattern: "//d"},
+		}, expression)
+
+		expression, err = query.Parse("//a ^ (//b + //c)")
+		require.NoError(t, err)
+		assert.Equal(t, query.BinaryOperatorExpression{
+			Operator: query.BinaryOperatorIntersect,
+			Left:     query.TargetPatternExpression{Pattern: "//a"},
+			Right: query.BinaryOperatorExpression{
+				Operator: query.BinaryOperatorUnion,
+				Left:     query.TargetPatternExpression{Pattern: "//b"},
+				Right:    query.TargetPatternExpression{Pattern: "//c"},
+			},
+		}, expression)
+	})
+
+	t.Run("Functions", func(t *testing.T) {
+		expression, err := query.Parse("deps(//foo, 2)")
+		require.NoError(t, err)
+		assert.Equal(t, query.FunctionExpression{
+			Name: "deps",
+			Arguments: []query.Argument{
+				{Expression: query.TargetPatternExpression{Pattern: "//foo"}},
+				{Integer: 2},
+			},
+		}, expression)
+
+		expression, err = query.Parse("attr(\"tags\", manual, kind(cc_.*, //...))")
+		require.NoError(t, err)
+		assert.Equal(t, query.FunctionExpression{
+			Name: "attr",
+			Arguments: []query.Argument{
+				{Word: "tags"},
+				{Word: "manual"},
+				{Expression: query.FunctionExpression{
+					Name: "kind",
+					Arguments: []query.Argument{
+						{Word: "cc_.*"},
+						{Expression: query.TargetPatternExpression{Pattern: "//..."}},
+					},
+				}},
+			},
+		}, expression)
+	})
+
+	t.Run("LetAndSet", func(t *testing.T) {
+		expression, err := query.Parse("let v = set(//a //b) in somepath($v, //c)")
+		require.NoError(t, err)
+		assert.Equal(t, query.LetExpression{
+			Name:  "v",
+			Value: query.SetExpression{Patterns: []string{"//a", "//b"}},
+			Body: query.FunctionExpression{
+				Name: "somepath",
+				Arguments: []query.Argument{
+					{Expression: query.VariableExpression{Name: "v"}},
+					{Expression: query.TargetPatternExpression{Pattern: "//c"}},
+				},
+			},
+		}, expression)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, input := range []string{
+			"",
+			"(//foo",
+			"//foo)",
+			"+ //foo",
+			"//foo +",
+			"'//foo",
+			"unknown(//foo)",
+			"deps()",
+			"deps(//foo, -1)",
+			"deps(//foo, 1, 2)",
+			"kind(//foo)",
+			"let v = //foo",
+			"$",
+		} {
+			_, err := query.Parse(input)
+			assert.Error(t, err, input)
+		}
+	})
+}
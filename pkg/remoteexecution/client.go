@@ -14,6 +14,10 @@ import (
 // be provided. While the action is execution it is possible to access
 // execution events, which are provided through an iterator. Upon
 // completion, a single result is returned.
+//
+// The priority is forwarded to the scheduler, which uses it to
+// determine the order in which queued actions are executed. Its exact
+// semantics are scheduler dependent.
 type Client[TAction, TEvent, TResult any] interface {
-	RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, action TAction, actionAdditionalData *encryptedaction_pb.Action_AdditionalData, result *TResult, errOut *error) iter.Seq[TEvent]
+	RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, action TAction, actionAdditionalData *encryptedaction_pb.Action_AdditionalData, priority int32, result *TResult, errOut *error) iter.Seq[TEvent]
 }
@@ -50,7 +50,7 @@ func NewProtoClient[
 	}
 }
 
-func (c *protoClient[TAction, TEvent, TResult, TEventPtr, TResultPtr]) RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, action TAction, actionAdditionalData *encryptedaction_pb.Action_AdditionalData, resultOut *TResultPtr, errOut *error) iter.Seq[TEventPtr] {
+func (c *protoClient[TAction, TEvent, TResult, TEventPtr, TResultPtr]) RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, action TAction, actionAdditionalData *encryptedaction_pb.Action_AdditionalData, priority int32, resultOut *TResultPtr, errOut *error) iter.Seq[TEventPtr] {
 	// Marshal the action. We wrap the action in a google.protobuf.Any,
 	// so that the worker can reliably reject the action if it's not
 	// the right type for that kind of worker.
@@ -68,7 +68,7 @@ func (c *protoClient[TAction, TEvent, TResult, TEventPtr, TResultPtr]) RunAction
 	var resultData []byte
 	var baseErr error
 	ctxWithCancel, cancel := context.WithCancel(ctx)
-	baseEvents := c.Client.RunAction(ctxWithCancel, platformECDHPublicKey, actionData, actionAdditionalData, &resultData, &baseErr)
+	baseEvents := c.Client.RunAction(ctxWithCancel, platformECDHPublicKey, actionData, actionAdditionalData, priority, &resultData, &baseErr)
 	return func(yield func(TEventPtr) bool) {
 		defer cancel()
 
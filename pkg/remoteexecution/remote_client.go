@@ -41,7 +41,7 @@ func NewRemoteClient(
 // its execution on a worker. An iterator is returned that yields any
 // execution events reported by the worker. Upon completion, the result
 // is set.
-func (c *remoteClient) RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, actionPlaintext []byte, actionAdditionalData *encryptedaction_pb.Action_AdditionalData, result *[]byte, errOut *error) iter.Seq[[]byte] {
+func (c *remoteClient) RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, actionPlaintext []byte, actionAdditionalData *encryptedaction_pb.Action_AdditionalData, priority int32, result *[]byte, errOut *error) iter.Seq[[]byte] {
 	marshaledPlatformECDHPublicKey, err := x509.MarshalPKIXPublicKey(platformECDHPublicKey)
 	if err != nil {
 		*errOut = util.StatusWrapfWithCode(err, codes.InvalidArgument, "Failed to obtain marshal platform ECDH public key")
@@ -70,8 +70,8 @@ func (c *remoteClient) RunAction(ctx context.Context, platformECDHPublicKey *ecd
 		ctxWithCancel, cancel := context.WithCancel(ctx)
 		defer cancel()
 		client, err := c.executionClient.Execute(ctxWithCancel, &remoteexecution_pb.ExecuteRequest{
-			Action: action,
-			// TODO: Priority.
+			Action:   action,
+			Priority: priority,
 		})
 		if err != nil {
 			*errOut = err
@@ -0,0 +1,109 @@
+package remoteexecution
+
+import (
+	"context"
+	"crypto/ecdh"
+	"iter"
+	"strings"
+
+	encryptedaction_pb "bonanza.build/pkg/proto/encryptedaction"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ToolInvocationIDMetadataKey is the name of the gRPC request
+	// metadata header in which bonanza_bazel provides the ID of the
+	// invocation of the build (i.e., the value of --invocation_id).
+	ToolInvocationIDMetadataKey = "bonanza-tool-invocation-id"
+
+	// CorrelatedInvocationsIDMetadataKey is the name of the gRPC
+	// request metadata header in which bonanza_bazel provides an
+	// identifier that is shared by multiple invocations that are
+	// part of the same build (i.e., the value of --build_request_id).
+	CorrelatedInvocationsIDMetadataKey = "bonanza-correlated-invocations-id"
+
+	// ForwardedMetadataKeyPrefix is the prefix of the names of gRPC
+	// request metadata headers that may always be forwarded to the
+	// scheduler on behalf of clients.
+	ForwardedMetadataKeyPrefix = "bonanza-"
+)
+
+// ValidateMetadataKey returns an error if a string is not a valid name
+// of a gRPC request metadata header that may be set by applications.
+// Names must be lowercase, and may not start with "grpc-", as such
+// headers are reserved by gRPC.
+func ValidateMetadataKey(name string) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "Header name is empty")
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' && c != '.' {
+			return status.Errorf(codes.InvalidArgument, "Header name contains invalid character %#v", string(c))
+		}
+	}
+	if strings.HasPrefix(name, "grpc-") {
+		return status.Error(codes.InvalidArgument, "Header names starting with \"grpc-\" are reserved by gRPC")
+	}
+	return nil
+}
+
+// ValidateForwardedMetadataKey returns an error if a gRPC request
+// metadata header with a given name may not be forwarded to the
+// scheduler on behalf of a client.
+//
+// Components such as bonanza_builder issue execution requests on
+// behalf of clients using their own credentials. Clients must therefore
+// not be able to inject arbitrary headers, such as "authorization" or
+// custom headers carrying credentials (e.g., "x-api-key"). Only headers
+// whose names start with ForwardedMetadataKeyPrefix and headers that
+// are explicitly listed in additionalKeys may be forwarded.
+func ValidateForwardedMetadataKey(name string, additionalKeys map[string]struct{}) error {
+	if err := ValidateMetadataKey(name); err != nil {
+		return err
+	}
+	if _, ok := additionalKeys[name]; ok {
+		return nil
+	}
+	if !strings.HasPrefix(name, ForwardedMetadataKeyPrefix) || name == ForwardedMetadataKeyPrefix {
+		return status.Errorf(codes.PermissionDenied, "Only headers whose names start with %#v or that are explicitly permitted may be forwarded", ForwardedMetadataKeyPrefix)
+	}
+	return nil
+}
+
+type requestMetadataAddingClient[TAction, TEvent, TResult any] struct {
+	base          Client[TAction, TEvent, TResult]
+	metadataPairs []string
+}
+
+// NewRequestMetadataAddingClient creates a decorator for Client that
+// attaches a fixed set of gRPC request metadata headers to all
+// outgoing execution requests. This can be used to forward headers
+// that the scheduler uses to identify the invocation to which the
+// action belongs.
+func NewRequestMetadataAddingClient[TAction, TEvent, TResult any](base Client[TAction, TEvent, TResult], md metadata.MD) Client[TAction, TEvent, TResult] {
+	metadataPairs := make([]string, 0, 2*md.Len())
+	for name, values := range md {
+		for _, value := range values {
+			metadataPairs = append(metadataPairs, name, value)
+		}
+	}
+	return &requestMetadataAddingClient[TAction, TEvent, TResult]{
+		base:          base,
+		metadataPairs: metadataPairs,
+	}
+}
+
+func (c *requestMetadataAddingClient[TAction, TEvent, TResult]) RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, action TAction, actionAdditionalData *encryptedaction_pb.Action_AdditionalData, priority int32, result *TResult, errOut *error) iter.Seq[TEvent] {
+	return c.base.RunAction(
+		metadata.AppendToOutgoingContext(ctx, c.metadataPairs...),
+		platformECDHPublicKey,
+		action,
+		actionAdditionalData,
+		priority,
+		result,
+		errOut,
+	)
+}
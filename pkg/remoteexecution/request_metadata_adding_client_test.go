@@ -0,0 +1,49 @@
+package remoteexecution_test
+
+import (
+	"testing"
+
+	"bonanza.build/pkg/remoteexecution"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateForwardedMetadataKey(t *testing.T) {
+	additionalKeys := map[string]struct{}{
+		"x-ci-job-id": {},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		expectedError error
+	}{
+		{"", status.Error(codes.InvalidArgument, "Header name is empty")},
+		{"Bonanza-Tool-Invocation-Id", status.Error(codes.InvalidArgument, "Header name contains invalid character \"B\"")},
+		{":authority", status.Error(codes.InvalidArgument, "Header name contains invalid character \":\"")},
+		{"grpc-timeout", status.Error(codes.InvalidArgument, "Header names starting with \"grpc-\" are reserved by gRPC")},
+		{"authorization", status.Error(codes.PermissionDenied, "Only headers whose names start with \"bonanza-\" or that are explicitly permitted may be forwarded")},
+		{"cookie", status.Error(codes.PermissionDenied, "Only headers whose names start with \"bonanza-\" or that are explicitly permitted may be forwarded")},
+		{"x-api-key", status.Error(codes.PermissionDenied, "Only headers whose names start with \"bonanza-\" or that are explicitly permitted may be forwarded")},
+		{"bonanza-", status.Error(codes.PermissionDenied, "Only headers whose names start with \"bonanza-\" or that are explicitly permitted may be forwarded")},
+		{"bonanza-tool-invocation-id", nil},
+		{"bonanza-correlated-invocations-id", nil},
+		{"x-ci-job-id", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testutil.RequireEqualStatus(t, tc.expectedError, remoteexecution.ValidateForwardedMetadataKey(tc.name, additionalKeys))
+		})
+	}
+
+	t.Run("NoAdditionalKeys", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Only headers whose names start with \"bonanza-\" or that are explicitly permitted may be forwarded"),
+			remoteexecution.ValidateForwardedMetadataKey("x-ci-job-id", nil),
+		)
+		require.NoError(t, remoteexecution.ValidateForwardedMetadataKey("bonanza-tool-invocation-id", nil))
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,12 +26,14 @@ import (
 	encryptedaction_pb "bonanza.build/pkg/proto/encryptedaction"
 	remoteexecution_pb "bonanza.build/pkg/proto/remoteexecution"
 	remoteworker_pb "bonanza.build/pkg/proto/remoteworker"
+	journal_pb "bonanza.build/pkg/proto/scheduler/journal"
 	"bonanza.build/pkg/scheduler/initialsizeclass"
 	scheduler_invocation "bonanza.build/pkg/scheduler/invocation"
 	"bonanza.build/pkg/scheduler/routing"
 
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/otel"
 	"github.com/buildbarn/bb-storage/pkg/random"
 	"github.com/buildbarn/bb-storage/pkg/util"
@@ -254,6 +257,15 @@ type InMemoryBuildQueue struct {
 	// concurrent requests for the same action.
 	inFlightDeduplicationMap map[[sha256.Size]byte]*task
 
+	// Operations that were restored from a journal after the
+	// scheduler was restarted, and to which no client has
+	// reattached yet. Restored operations that were queued or
+	// executing are also indexed by the deduplication key of their
+	// action, so that clients calling Execute() for the same action
+	// attach to them.
+	restoredOperations               map[string]*restoredOperation
+	restoredInFlightDeduplicationMap map[[sha256.Size]byte]*restoredOperation
+
 	// Time value that is updated during every mutation of build
 	// queue state. This reduces the number of clock accesses, while
 	// also making it easier to test this code.
@@ -263,13 +275,23 @@ type InMemoryBuildQueue struct {
 	// platform queues and operations.
 	cleanupQueue cleanupQueue
 
-	// TODO: Re-add authorization from Buildbarn!
+	// Authorizer used to allow/deny access for certain users
+	// to the Execute and WaitExecution APIs.
+	executeAuthorizer auth.Authorizer
+
+	// Authorizer used to allow/deny access for certain users to
+	// add or remove drains, and to terminate workers.
+	modifyDrainsAuthorizer auth.Authorizer
+
+	// Authorizer used to allow/deny access for certain users to
+	// kill operations.
+	killOperationsAuthorizer auth.Authorizer
 }
 
 // NewInMemoryBuildQueue creates a new InMemoryBuildQueue that is in the
 // initial state. It does not have any queues, workers or queued
 // execution requests. All of these are created by sending it RPCs.
-func NewInMemoryBuildQueue(clock clock.Clock, uuidGenerator util.UUIDGenerator, randomNumberGenerator random.SingleThreadedGenerator, configuration *InMemoryBuildQueueConfiguration, actionRouter routing.ActionRouter) *InMemoryBuildQueue {
+func NewInMemoryBuildQueue(clock clock.Clock, uuidGenerator util.UUIDGenerator, randomNumberGenerator random.SingleThreadedGenerator, configuration *InMemoryBuildQueueConfiguration, actionRouter routing.ActionRouter, executeAuthorizer, modifyDrainsAuthorizer, killOperationsAuthorizer auth.Authorizer) *InMemoryBuildQueue {
 	inMemoryBuildQueuePrometheusMetrics.Do(func() {
 		prometheus.MustRegister(inMemoryBuildQueueInFlightDeduplicationsTotal)
 
@@ -302,6 +324,11 @@ func NewInMemoryBuildQueue(clock clock.Clock, uuidGenerator util.UUIDGenerator,
 		verificationCurveIndices:            map[ecdh.Curve]int{},
 		operationsNameMap:                   map[string]*operation{},
 		inFlightDeduplicationMap:            map[[sha256.Size]byte]*task{},
+		restoredOperations:                  map[string]*restoredOperation{},
+		restoredInFlightDeduplicationMap:    map[[sha256.Size]byte]*restoredOperation{},
+		executeAuthorizer:                   executeAuthorizer,
+		modifyDrainsAuthorizer:              modifyDrainsAuthorizer,
+		killOperationsAuthorizer:            killOperationsAuthorizer,
 	}
 }
 
@@ -366,6 +393,10 @@ func (bq *InMemoryBuildQueue) RegisterPredeclaredPlatformQueue(pkixPublicKeys []
 // blocks until the action is completed.
 func (bq *InMemoryBuildQueue) Execute(in *remoteexecution_pb.ExecuteRequest, out remoteexecution_pb.Execution_ExecuteServer) error {
 	ctx := out.Context()
+	if err := auth.AuthorizeSingleInstanceName(ctx, bq.executeAuthorizer, digest.EmptyInstanceName); err != nil {
+		return util.StatusWrap(err, "Authorization")
+	}
+
 	action := in.Action
 	if action == nil {
 		return status.Error(codes.InvalidArgument, "No action provided")
@@ -388,15 +419,97 @@ func (bq *InMemoryBuildQueue) Execute(in *remoteexecution_pb.ExecuteRequest, out
 		return util.StatusWrap(err, "Failed to route action")
 	}
 
-	marshaledAction, err := proto.Marshal(action)
+	deduplicationKey, err := getDeduplicationKey(action)
 	if err != nil {
-		return util.StatusWrap(err, "Failed to marshal action")
+		return err
 	}
-	deduplicationKey := sha256.Sum256(marshaledAction)
 
 	bq.enter(bq.clock.Now())
 	defer bq.leave()
 
+	return bq.executeLocked(&executeRequest{
+		action:                   action,
+		deduplicationKey:         deduplicationKey,
+		priority:                 in.Priority,
+		invocationKeys:           invocationKeys,
+		initialSizeClassSelector: initialSizeClassSelector,
+		auxiliaryMetadata:        auxiliaryMetadata,
+		w3cTraceContext:          w3cTraceContext,
+	}, out)
+}
+
+// getDeduplicationKey computes the key of an action that is used to
+// perform in-flight deduplication.
+func getDeduplicationKey(action *encryptedaction_pb.Action) ([sha256.Size]byte, error) {
+	marshaledAction, err := proto.Marshal(action)
+	if err != nil {
+		return [sha256.Size]byte{}, util.StatusWrap(err, "Failed to marshal action")
+	}
+	return sha256.Sum256(marshaledAction), nil
+}
+
+// executeRequest contains the properties of an action that needs to be
+// scheduled, either because a client called Execute(), or because a
+// client reattached to an operation that was restored from a journal.
+type executeRequest struct {
+	action                   *encryptedaction_pb.Action
+	deduplicationKey         [sha256.Size]byte
+	priority                 int32
+	invocationKeys           []scheduler_invocation.Key
+	initialSizeClassSelector initialsizeclass.Selector
+	auxiliaryMetadata        []*anypb.Any
+	w3cTraceContext          map[string]string
+
+	// If set, the action is scheduled as the result of a client
+	// reattaching to an operation that was restored from a
+	// journal. The operation's name and queued timestamp are
+	// retained.
+	restoredOperation *restoredOperation
+}
+
+// claimRestoredOperation removes the restored operation from which the
+// request originates, if any. This method is called once it is certain
+// that the action is scheduled, so that clients that reattach to it
+// afterwards wait on the regular operation that replaces it.
+func (r *executeRequest) claimRestoredOperation(bq *InMemoryBuildQueue) {
+	if ro := r.restoredOperation; ro != nil {
+		ro.remove(bq)
+	}
+}
+
+// getOperationName returns the name of the operation that needs to be
+// created as part of scheduling the action.
+func (r *executeRequest) getOperationName(bq *InMemoryBuildQueue) string {
+	if ro := r.restoredOperation; ro != nil {
+		return ro.operation.Name
+	}
+	return util.Must(bq.uuidGenerator()).String()
+}
+
+// getQueuedTimestamp returns the time at which the action was
+// initially queued.
+func (r *executeRequest) getQueuedTimestamp(bq *InMemoryBuildQueue) *timestamppb.Timestamp {
+	if ro := r.restoredOperation; ro != nil && ro.operation.QueuedTimestamp != nil {
+		return ro.operation.QueuedTimestamp
+	}
+	return bq.getCurrentTime()
+}
+
+// executeLocked schedules an action, either by deduplicating it against
+// an existing task or by creating a new one, and waits for its
+// completion. This method must be called while holding the lock.
+func (bq *InMemoryBuildQueue) executeLocked(r *executeRequest, out remoteexecution_pb.Execution_ExecuteServer) error {
+	deduplicationKey := r.deduplicationKey
+	invocationKeys := r.invocationKeys
+	initialSizeClassSelector := r.initialSizeClassSelector
+	if r.restoredOperation == nil {
+		// If the action was queued or executing before the
+		// scheduler was restarted, attach to the operation
+		// that was restored. This ensures that clients that
+		// call WaitExecution() using the name of the restored
+		// operation wait for the same execution.
+		r.restoredOperation = bq.restoredInFlightDeduplicationMap[deduplicationKey]
+	}
 	if t, ok := bq.inFlightDeduplicationMap[deduplicationKey]; ok {
 		// A task for the same action reference already exists
 		// against which we may deduplicate. No need to create a
@@ -413,7 +526,8 @@ func (bq *InMemoryBuildQueue) Execute(in *remoteexecution_pb.ExecuteRequest, out
 		}
 
 		// Create an additional operation for this task.
-		o := t.newOperation(bq, in.Priority, i, false)
+		r.claimRestoredOperation(bq)
+		o := t.newOperation(bq, r.getOperationName(bq), r.priority, i, false)
 		if w := t.currentWorker; w != nil {
 			// The request has been deduplicated against a
 			// task that is already in the executing stage.
@@ -429,7 +543,7 @@ func (bq *InMemoryBuildQueue) Execute(in *remoteexecution_pb.ExecuteRequest, out
 
 	// We need to create a new task. For that we first need to
 	// obtain the size class queue in which we're going to place it.
-	platformPkixPublicKey := action.PlatformPkixPublicKey
+	platformPkixPublicKey := r.action.PlatformPkixPublicKey
 	pq, ok := bq.platformQueues[string(platformPkixPublicKey)]
 	if !ok {
 		code := codes.FailedPrecondition
@@ -452,6 +566,7 @@ func (bq *InMemoryBuildQueue) Execute(in *remoteexecution_pb.ExecuteRequest, out
 			base64.StdEncoding.EncodeToString(platformPkixPublicKey),
 		)
 	}
+	r.claimRestoredOperation(bq)
 	sizeClassIndex, expectedDuration, timeout, initialSizeClassLearner := initialSizeClassSelector.Select(pq.sizeClasses)
 	scq := pq.sizeClassQueues[sizeClassIndex]
 
@@ -461,11 +576,11 @@ func (bq *InMemoryBuildQueue) Execute(in *remoteexecution_pb.ExecuteRequest, out
 		deduplicationKey: deduplicationKey,
 		desiredState: remoteworker_pb.DesiredState_Executing{
 			TaskUuid:                  util.Must(bq.uuidGenerator()).String(),
-			Action:                    action,
+			Action:                    r.action,
 			EffectiveExecutionTimeout: durationpb.New(timeout),
-			QueuedTimestamp:           bq.getCurrentTime(),
-			AuxiliaryMetadata:         auxiliaryMetadata,
-			W3CTraceContext:           w3cTraceContext,
+			QueuedTimestamp:           r.getQueuedTimestamp(bq),
+			AuxiliaryMetadata:         r.auxiliaryMetadata,
+			W3CTraceContext:           r.w3cTraceContext,
 		},
 		expectedDuration:        expectedDuration,
 		initialSizeClassLearner: initialSizeClassLearner,
@@ -474,31 +589,266 @@ func (bq *InMemoryBuildQueue) Execute(in *remoteexecution_pb.ExecuteRequest, out
 	bq.inFlightDeduplicationMap[deduplicationKey] = t
 	scq.inFlightDeduplicationsNew.Inc()
 	i := scq.getOrCreateInvocation(bq, invocationKeys)
-	o := t.newOperation(bq, in.Priority, i, false)
+	o := t.newOperation(bq, r.getOperationName(bq), r.priority, i, false)
 	t.schedule(bq)
 	return o.waitExecution(bq, out)
 }
 
 // WaitExecution attaches to an existing operation that was created by
 // Execute(). This call can be used by the client to reattach to an
-// operation in case of network failure.
+// operation in case of network failure. If operations were restored
+// through RestoreSnapshot(), it can also be used to reattach to
+// operations that were created before the scheduler was restarted.
 func (bq *InMemoryBuildQueue) WaitExecution(in *remoteexecution_pb.WaitExecutionRequest, out remoteexecution_pb.Execution_WaitExecutionServer) error {
+	// This must be done without holding any locks, as the
+	// authorizer may block.
+	if err := auth.AuthorizeSingleInstanceName(out.Context(), bq.executeAuthorizer, digest.EmptyInstanceName); err != nil {
+		return util.StatusWrap(err, "Authorization")
+	}
+
 	bq.enter(bq.clock.Now())
 	for {
-		o, ok := bq.operationsNameMap[in.Name]
+		if o, ok := bq.operationsNameMap[in.Name]; ok {
+			defer bq.leave()
+			return o.waitExecution(bq, out)
+		}
+
+		ro, ok := bq.restoredOperations[in.Name]
 		if !ok {
 			bq.leave()
 			return status.Errorf(codes.NotFound, "Operation with name %#v not found", in.Name)
 		}
+		bq.leave()
+
+		if completed := ro.operation.Completed; completed != nil {
+			// The operation was completed before the
+			// scheduler was restarted. Return its results.
+			if err := status.ErrorProto(completed.Status); err != nil {
+				return err
+			}
+			return out.Send(&remoteexecution_pb.ExecuteResponse{
+				Name: in.Name,
+				Stage: &remoteexecution_pb.ExecuteResponse_Completed_{
+					Completed: &remoteexecution_pb.ExecuteResponse_Completed{
+						CompletionEvent: completed.CompletionEvent,
+					},
+				},
+			})
+		}
+
+		// The operation was queued or executing before the
+		// scheduler was restarted. Schedule it once more. This
+		// requires routing the action, which must be done
+		// without holding any locks, as it may block. The
+		// invocation keys that were originally extracted are
+		// retained.
+		action := ro.operation.Action
+		_, initialSizeClassSelector, err := bq.actionRouter.RouteAction(out.Context(), action)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to route action")
+		}
 
 		bq.enter(bq.clock.Now())
-		if bq.operationsNameMap[in.Name] == o {
+		if bq.restoredOperations[in.Name] == ro {
 			defer bq.leave()
-			return o.waitExecution(bq, out)
+			return bq.executeLocked(&executeRequest{
+				action:                   action,
+				deduplicationKey:         ro.deduplicationKey,
+				priority:                 ro.operation.Priority,
+				invocationKeys:           ro.invocationKeys,
+				initialSizeClassSelector: initialSizeClassSelector,
+				auxiliaryMetadata:        ro.operation.AuxiliaryMetadata,
+				w3cTraceContext:          ro.operation.W3CTraceContext,
+				restoredOperation:        ro,
+			}, out)
+		}
+
+		// Another client reattached to the operation while the
+		// action was being routed. Retry, so that we wait on
+		// the operation that it created.
+		initialSizeClassSelector.Abandoned()
+	}
+}
+
+// CreateSnapshot creates a snapshot of all operations that are
+// currently known by the scheduler, so that they may be written to a
+// journal. Operations that are only created to perform background
+// learning are omitted, as no clients wait for their results.
+func (bq *InMemoryBuildQueue) CreateSnapshot() *journal_pb.Snapshot {
+	bq.enter(bq.clock.Now())
+	defer bq.leave()
+
+	operations := make([]*journal_pb.Operation, 0, len(bq.operationsNameMap)+len(bq.restoredOperations))
+	for _, o := range bq.operationsNameMap {
+		if o.mayExistWithoutWaiters {
+			continue
+		}
+		t := o.task
+		invocationIDs := make([]*anypb.Any, 0, len(o.invocation.invocationKeys))
+		for _, invocationKey := range o.invocation.invocationKeys {
+			invocationIDs = append(invocationIDs, invocationKey.GetID())
+		}
+		operation := &journal_pb.Operation{
+			Name:              o.name,
+			Priority:          o.priority,
+			InvocationIds:     invocationIDs,
+			Action:            t.desiredState.Action,
+			QueuedTimestamp:   t.desiredState.QueuedTimestamp,
+			AuxiliaryMetadata: t.desiredState.AuxiliaryMetadata,
+			W3CTraceContext:   t.desiredState.W3CTraceContext,
+		}
+		if t.initialSizeClassLearner == nil {
+			operation.Completed = &journal_pb.Completed{
+				CompletionEvent: t.lastExecutionEvent,
+				Status:          status.Convert(t.failureErr).Proto(),
+			}
 		}
+		operations = append(operations, operation)
+	}
+	for _, ro := range bq.restoredOperations {
+		operations = append(operations, ro.operation)
+	}
+	slices.SortFunc(operations, func(a, b *journal_pb.Operation) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return &journal_pb.Snapshot{
+		Operations: operations,
 	}
 }
 
+// RestoreSnapshot loads operations from a snapshot that was previously
+// obtained through CreateSnapshot(). This method is typically called
+// at startup, before any clients connect to the scheduler.
+//
+// Restored operations are not scheduled immediately. Completed
+// operations only permit clients to obtain their results through
+// WaitExecution(), while operations that were queued or executing are
+// scheduled once more when a client reattaches to them. Restored
+// operations to which no client reattaches are discarded after the
+// configured operation with no waiters timeout.
+func (bq *InMemoryBuildQueue) RestoreSnapshot(snapshot *journal_pb.Snapshot) error {
+	bq.enter(bq.clock.Now())
+	defer bq.leave()
+
+	removalTime := bq.now.Add(bq.configuration.OperationWithNoWaitersTimeout)
+	for operationIndex, operation := range snapshot.Operations {
+		name := operation.Name
+		if name == "" {
+			return status.Errorf(codes.InvalidArgument, "Operation at index %d has no name", operationIndex)
+		}
+		if _, ok := bq.operationsNameMap[name]; ok {
+			return status.Errorf(codes.AlreadyExists, "Operation with name %#v already exists", name)
+		}
+		if _, ok := bq.restoredOperations[name]; ok {
+			return status.Errorf(codes.AlreadyExists, "Operation with name %#v already exists", name)
+		}
+		if operation.Action == nil {
+			return status.Errorf(codes.InvalidArgument, "Operation with name %#v has no action", name)
+		}
+
+		invocationKeys := make([]scheduler_invocation.Key, 0, len(operation.InvocationIds))
+		for invocationIDIndex, invocationID := range operation.InvocationIds {
+			invocationKey, err := scheduler_invocation.NewKey(invocationID)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid invocation ID at index %d of operation with name %#v", invocationIDIndex, name)
+			}
+			invocationKeys = append(invocationKeys, invocationKey)
+		}
+		deduplicationKey, err := getDeduplicationKey(operation.Action)
+		if err != nil {
+			return util.StatusWrapf(err, "Operation with name %#v", name)
+		}
+
+		ro := &restoredOperation{
+			operation:        operation,
+			invocationKeys:   invocationKeys,
+			deduplicationKey: deduplicationKey,
+		}
+		bq.restoredOperations[name] = ro
+		if operation.Completed == nil {
+			if _, ok := bq.restoredInFlightDeduplicationMap[deduplicationKey]; !ok {
+				bq.restoredInFlightDeduplicationMap[deduplicationKey] = ro
+			}
+		}
+		bq.cleanupQueue.add(&ro.cleanupKey, removalTime, func() {
+			ro.removeFromMaps(bq)
+		})
+	}
+	return nil
+}
+
+// restoredOperation contains the state of an operation that was
+// restored from a journal, and to which no client has reattached yet.
+type restoredOperation struct {
+	operation        *journal_pb.Operation
+	invocationKeys   []scheduler_invocation.Key
+	deduplicationKey [sha256.Size]byte
+	cleanupKey       cleanupKey
+}
+
+// remove a restored operation, so that it can be replaced by a regular
+// operation once a client reattaches to it.
+func (ro *restoredOperation) remove(bq *InMemoryBuildQueue) {
+	ro.removeFromMaps(bq)
+	if ro.cleanupKey.isActive() {
+		bq.cleanupQueue.remove(ro.cleanupKey)
+	}
+}
+
+// removeFromMaps removes a restored operation from the maps in which
+// it is stored, so that it can no longer be looked up by name or by
+// the deduplication key of its action.
+func (ro *restoredOperation) removeFromMaps(bq *InMemoryBuildQueue) {
+	delete(bq.restoredOperations, ro.operation.Name)
+	if bq.restoredInFlightDeduplicationMap[ro.deduplicationKey] == ro {
+		delete(bq.restoredInFlightDeduplicationMap, ro.deduplicationKey)
+	}
+}
+
+// getOperationState returns the state of a restored operation, so that
+// it can be displayed through the BuildQueueState service. As restored
+// operations are not placed in any size class queue until a client
+// reattaches to them, operations that were queued or executing are
+// reported as being queued, without a size class queue name.
+func (ro *restoredOperation) getOperationState(bq *InMemoryBuildQueue) *buildqueuestate_pb.OperationState {
+	operation := ro.operation
+	s := &buildqueuestate_pb.OperationState{
+		Name: operation.Name,
+		InvocationName: &buildqueuestate_pb.InvocationName{
+			Ids: operation.InvocationIds,
+		},
+		QueuedTimestamp: operation.QueuedTimestamp,
+		Action:          operation.Action,
+		Timeout:         bq.cleanupQueue.getTimestamp(ro.cleanupKey),
+		Priority:        operation.Priority,
+	}
+	if operation.Completed != nil {
+		s.Stage = &buildqueuestate_pb.OperationState_Completed{
+			Completed: &emptypb.Empty{},
+		}
+	} else {
+		s.Stage = &buildqueuestate_pb.OperationState_Queued{
+			Queued: &emptypb.Empty{},
+		}
+	}
+	return s
+}
+
+// getStage returns the execution stage in which a restored operation
+// is reported through the BuildQueueState service.
+func (ro *restoredOperation) getStage() buildqueuestate_pb.ListOperationsRequest_ExecutionStage {
+	if ro.operation.Completed != nil {
+		return buildqueuestate_pb.ListOperationsRequest_COMPLETED
+	}
+	return buildqueuestate_pb.ListOperationsRequest_QUEUED
+}
+
+// hasInvocationKey returns whether a restored operation belongs to an
+// invocation having a given key.
+func (ro *restoredOperation) hasInvocationKey(key scheduler_invocation.Key) bool {
+	return slices.Contains(ro.invocationKeys, key)
+}
+
 type verificationPrivateKey struct {
 	privateKey    *ecdh.PrivateKey
 	pkixPublicKey []byte
@@ -920,11 +1270,14 @@ func (bq *InMemoryBuildQueue) GetOperation(ctx context.Context, request *buildqu
 	bq.enter(bq.clock.Now())
 	defer bq.leave()
 
-	o, ok := bq.operationsNameMap[request.OperationName]
-	if !ok {
+	var s *buildqueuestate_pb.OperationState
+	if o, ok := bq.operationsNameMap[request.OperationName]; ok {
+		s = o.getOperationState(bq)
+	} else if ro, ok := bq.restoredOperations[request.OperationName]; ok {
+		s = ro.getOperationState(bq)
+	} else {
 		return nil, status.Errorf(codes.NotFound, "Operation %#v not found", request.OperationName)
 	}
-	s := o.getOperationState(bq)
 	s.Name = ""
 	return &buildqueuestate_pb.GetOperationResponse{
 		Operation: s,
@@ -952,6 +1305,10 @@ func getPaginationInfo(n int, pageSize uint32, f func(int) bool) (*buildqueuesta
 // contacts the scheduler, it is requested to stop executing the
 // operation.
 func (bq *InMemoryBuildQueue) KillOperations(ctx context.Context, request *buildqueuestate_pb.KillOperationsRequest) (*emptypb.Empty, error) {
+	if err := auth.AuthorizeSingleInstanceName(ctx, bq.killOperationsAuthorizer, digest.EmptyInstanceName); err != nil {
+		return nil, util.StatusWrap(err, "Authorization")
+	}
+
 	failureErr := status.ErrorProto(request.Status)
 	if failureErr == nil {
 		return nil, status.Error(codes.InvalidArgument, "Provided status is not an error")
@@ -1002,13 +1359,19 @@ func (bq *InMemoryBuildQueue) ListOperations(ctx context.Context, request *build
 	defer bq.leave()
 
 	// Obtain operation names in sorted order.
-	nameList := make([]string, 0, len(bq.operationsNameMap))
+	nameList := make([]string, 0, len(bq.operationsNameMap)+len(bq.restoredOperations))
 	for name, o := range bq.operationsNameMap {
 		if (invocationKey == nil || o.invocation.hasInvocationKey(*invocationKey)) &&
 			(request.FilterStage == buildqueuestate_pb.ListOperationsRequest_ALL || request.FilterStage == o.task.getStage()) {
 			nameList = append(nameList, name)
 		}
 	}
+	for name, ro := range bq.restoredOperations {
+		if (invocationKey == nil || ro.hasInvocationKey(*invocationKey)) &&
+			(request.FilterStage == buildqueuestate_pb.ListOperationsRequest_ALL || request.FilterStage == ro.getStage()) {
+			nameList = append(nameList, name)
+		}
+	}
 	sort.Strings(nameList)
 	paginationInfo, endIndex := getPaginationInfo(len(nameList), request.PageSize, func(i int) bool {
 		return request.StartAfter == nil || nameList[i] > request.StartAfter.OperationName
@@ -1018,8 +1381,11 @@ func (bq *InMemoryBuildQueue) ListOperations(ctx context.Context, request *build
 	nameListRegion := nameList[paginationInfo.StartIndex:endIndex]
 	operations := make([]*buildqueuestate_pb.OperationState, 0, len(nameListRegion))
 	for _, name := range nameListRegion {
-		o := bq.operationsNameMap[name]
-		operations = append(operations, o.getOperationState(bq))
+		if o, ok := bq.operationsNameMap[name]; ok {
+			operations = append(operations, o.getOperationState(bq))
+		} else {
+			operations = append(operations, bq.restoredOperations[name].getOperationState(bq))
+		}
 	}
 	return &buildqueuestate_pb.ListOperationsResponse{
 		Operations:     operations,
@@ -1310,6 +1676,10 @@ func (bq *InMemoryBuildQueue) ListDrains(ctx context.Context, request *buildqueu
 }
 
 func (bq *InMemoryBuildQueue) modifyDrain(ctx context.Context, request *buildqueuestate_pb.AddOrRemoveDrainRequest, modifyFunc func(scq *sizeClassQueue, drainKey string)) (*emptypb.Empty, error) {
+	if err := auth.AuthorizeSingleInstanceName(ctx, bq.modifyDrainsAuthorizer, digest.EmptyInstanceName); err != nil {
+		return nil, util.StatusWrap(err, "Authorization")
+	}
+
 	drainKey, err := json.Marshal(request.WorkerIdPattern)
 	if err != nil {
 		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to marshal worker ID pattern")
@@ -1364,6 +1734,10 @@ func (bq *InMemoryBuildQueue) RemoveDrain(ctx context.Context, request *buildque
 // operations running on the workers complete, thereby allowing the
 // workers to be terminated without interrupting operations.
 func (bq *InMemoryBuildQueue) TerminateWorkers(ctx context.Context, request *buildqueuestate_pb.TerminateWorkersRequest) (*emptypb.Empty, error) {
+	if err := auth.AuthorizeSingleInstanceName(ctx, bq.modifyDrainsAuthorizer, digest.EmptyInstanceName); err != nil {
+		return nil, util.StatusWrap(err, "Authorization")
+	}
+
 	var completionWakeups []chan struct{}
 	bq.enter(bq.clock.Now())
 	platformQueues := map[*platformQueue]struct{}{}
@@ -2464,9 +2838,9 @@ type task struct {
 // called right after creating a task, but may also be used to attach
 // additional operations to an existing task in case of in-flight
 // deduplication.
-func (t *task) newOperation(bq *InMemoryBuildQueue, priority int32, i *invocation, mayExistWithoutWaiters bool) *operation {
+func (t *task) newOperation(bq *InMemoryBuildQueue, name string, priority int32, i *invocation, mayExistWithoutWaiters bool) *operation {
 	o := &operation{
-		name:                   util.Must(bq.uuidGenerator()).String(),
+		name:                   name,
 		task:                   t,
 		priority:               priority,
 		invocation:             i,
@@ -2689,7 +3063,7 @@ func (t *task) complete(bq *InMemoryBuildQueue, completed *remoteworker_pb.Curre
 						initialSizeClassLearner: backgroundInitialSizeClassLearner,
 						stageChangeWakeup:       make(chan struct{}),
 					}
-					backgroundTask.newOperation(bq, pq.backgroundLearningOperationPriority, backgroundInvocation, true)
+					backgroundTask.newOperation(bq, util.Must(bq.uuidGenerator()).String(), pq.backgroundLearningOperationPriority, backgroundInvocation, true)
 					backgroundTask.schedule(bq)
 				}
 			}
@@ -0,0 +1,491 @@
+package scheduler_test
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	buildqueuestate_pb "bonanza.build/pkg/proto/buildqueuestate"
+	encryptedaction_pb "bonanza.build/pkg/proto/encryptedaction"
+	remoteexecution_pb "bonanza.build/pkg/proto/remoteexecution"
+	journal_pb "bonanza.build/pkg/proto/scheduler/journal"
+	"bonanza.build/pkg/scheduler"
+	"bonanza.build/pkg/scheduler/invocation"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/random"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.uber.org/mock/gomock"
+)
+
+var buildQueueConfigurationForTesting = scheduler.InMemoryBuildQueueConfiguration{
+	ExecutionUpdateInterval:           time.Minute,
+	OperationWithNoWaitersTimeout:     time.Minute,
+	PlatformQueueWithNoWorkersTimeout: 15 * time.Minute,
+	BusyWorkerSynchronizationInterval: 10 * time.Second,
+	GetIdleWorkerSynchronizationInterval: func() time.Duration {
+		return time.Minute
+	},
+	WorkerTaskRetryCount:                9,
+	WorkerWithNoSynchronizationsTimeout: time.Minute,
+}
+
+var allowAllAuthorizer = auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true })
+
+// newSequentialUUIDGenerator creates a UUID generator that returns
+// predictable UUIDs, so that the names of operations can be validated.
+func newSequentialUUIDGenerator() util.UUIDGenerator {
+	var next byte
+	return func() (uuid.UUID, error) {
+		next++
+		return uuid.UUID{15: next}, nil
+	}
+}
+
+func newPlatformPkixPublicKey(t *testing.T) []byte {
+	privateKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pkixPublicKey, err := x509.MarshalPKIXPublicKey(privateKey.PublicKey())
+	require.NoError(t, err)
+	return pkixPublicKey
+}
+
+func TestInMemoryBuildQueueSnapshot(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	platformPkixPublicKey := newPlatformPkixPublicKey(t)
+	action := &encryptedaction_pb.Action{
+		PlatformPkixPublicKey: platformPkixPublicKey,
+		AdditionalData: &encryptedaction_pb.Action_AdditionalData{
+			StableFingerprint: []byte{1, 2, 3},
+		},
+	}
+	invocationID, err := anypb.New(wrapperspb.String("My build"))
+	require.NoError(t, err)
+	invocationKey, err := invocation.NewKey(invocationID)
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+
+	// Let all schedulers share a single UUID generator, so that
+	// operations created by different schedulers have different
+	// names.
+	uuidGenerator := newSequentialUUIDGenerator()
+	newBuildQueue := func() (*scheduler.InMemoryBuildQueue, *MockClock, *MockActionRouter) {
+		clock := NewMockClock(ctrl)
+		clock.EXPECT().Now().Return(now).AnyTimes()
+		actionRouter := NewMockActionRouter(ctrl)
+		buildQueue := scheduler.NewInMemoryBuildQueue(
+			clock,
+			uuidGenerator,
+			random.NewFastSingleThreadedGenerator(),
+			&buildQueueConfigurationForTesting,
+			actionRouter,
+			allowAllAuthorizer,
+			allowAllAuthorizer,
+			allowAllAuthorizer,
+		)
+		require.NoError(t, buildQueue.RegisterPredeclaredPlatformQueue(
+			[][]byte{platformPkixPublicKey},
+			/* workerInvocationStickinessLimits = */ nil,
+			/* maximumQueuedBackgroundLearningOperations = */ 0,
+			/* backgroundLearningOperationPriority = */ 0,
+			/* sizeClasses = */ []uint32{0},
+		))
+		return buildQueue, clock, actionRouter
+	}
+
+	// expectQueued sets up expectations for a client that calls
+	// Execute() or WaitExecution() and disconnects after observing
+	// that the operation is queued.
+	expectQueued := func(clock *MockClock, actionRouter *MockActionRouter, operationName string) *MockExecution_ExecuteServer {
+		ctxWithCancel, cancel := context.WithCancel(ctx)
+		out := NewMockExecution_ExecuteServer(ctrl)
+		out.EXPECT().Context().Return(ctxWithCancel).AnyTimes()
+
+		initialSizeClassSelector := NewMockSelector(ctrl)
+		actionRouter.EXPECT().RouteAction(ctxWithCancel, testutil.EqProto(t, action)).
+			Return([]invocation.Key{invocationKey}, initialSizeClassSelector, nil)
+		initialSizeClassLearner := NewMockLearner(ctrl)
+		initialSizeClassSelector.EXPECT().Select([]uint32{0}).
+			Return(0, 30*time.Second, 60*time.Second, initialSizeClassLearner)
+		out.EXPECT().Send(testutil.EqProto(t, &remoteexecution_pb.ExecuteResponse{
+			Name: operationName,
+			Stage: &remoteexecution_pb.ExecuteResponse_Queued_{
+				Queued: &remoteexecution_pb.ExecuteResponse_Queued{},
+			},
+		})).DoAndReturn(func(*remoteexecution_pb.ExecuteResponse) error {
+			cancel()
+			return nil
+		})
+		timer := NewMockTimer(ctrl)
+		clock.EXPECT().NewTimer(time.Minute).Return(timer, nil)
+		timer.EXPECT().Stop()
+		return out
+	}
+
+	// Enqueue an operation in the original scheduler, and abandon
+	// it. The snapshot should contain the operation.
+	buildQueue1, clock1, actionRouter1 := newBuildQueue()
+	operationName := "00000000-0000-0000-0000-000000000002"
+	testutil.RequireEqualStatus(
+		t,
+		status.Error(codes.Canceled, "context canceled"),
+		buildQueue1.Execute(
+			&remoteexecution_pb.ExecuteRequest{
+				Action:   action,
+				Priority: 5,
+			},
+			expectQueued(clock1, actionRouter1, operationName),
+		),
+	)
+
+	expectedOperation := &journal_pb.Operation{
+		Name:            operationName,
+		Priority:        5,
+		InvocationIds:   []*anypb.Any{invocationID},
+		Action:          action,
+		QueuedTimestamp: timestamppb.New(now),
+	}
+	snapshot := buildQueue1.CreateSnapshot()
+	testutil.RequireEqualProto(t, &journal_pb.Snapshot{
+		Operations: []*journal_pb.Operation{expectedOperation},
+	}, snapshot)
+
+	t.Run("ListOperations", func(t *testing.T) {
+		// Restored operations should be visible through the
+		// BuildQueueState service, even if no client has
+		// reattached to them yet.
+		buildQueue2, _, _ := newBuildQueue()
+		require.NoError(t, buildQueue2.RestoreSnapshot(snapshot))
+
+		expectedOperationState := &buildqueuestate_pb.OperationState{
+			Name: operationName,
+			InvocationName: &buildqueuestate_pb.InvocationName{
+				Ids: []*anypb.Any{invocationID},
+			},
+			QueuedTimestamp: timestamppb.New(now),
+			Action:          action,
+			Timeout:         timestamppb.New(now.Add(time.Minute)),
+			Stage: &buildqueuestate_pb.OperationState_Queued{
+				Queued: &emptypb.Empty{},
+			},
+			Priority: 5,
+		}
+		response, err := buildQueue2.ListOperations(ctx, &buildqueuestate_pb.ListOperationsRequest{
+			PageSize:           10,
+			FilterInvocationId: invocationID,
+			FilterStage:        buildqueuestate_pb.ListOperationsRequest_QUEUED,
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &buildqueuestate_pb.ListOperationsResponse{
+			Operations: []*buildqueuestate_pb.OperationState{expectedOperationState},
+			PaginationInfo: &buildqueuestate_pb.PaginationInfo{
+				StartIndex:   0,
+				TotalEntries: 1,
+			},
+		}, response)
+
+		expectedOperationState.Name = ""
+		getResponse, err := buildQueue2.GetOperation(ctx, &buildqueuestate_pb.GetOperationRequest{
+			OperationName: operationName,
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &buildqueuestate_pb.GetOperationResponse{
+			Operation: expectedOperationState,
+		}, getResponse)
+	})
+
+	t.Run("WaitExecution", func(t *testing.T) {
+		// Reattaching to a restored operation that was queued
+		// should cause it to be scheduled once more, using the
+		// original operation name.
+		buildQueue2, clock2, actionRouter2 := newBuildQueue()
+		require.NoError(t, buildQueue2.RestoreSnapshot(snapshot))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Canceled, "context canceled"),
+			buildQueue2.WaitExecution(
+				&remoteexecution_pb.WaitExecutionRequest{
+					Name: operationName,
+				},
+				expectQueued(clock2, actionRouter2, operationName),
+			),
+		)
+
+		// The operation should now be a regular operation,
+		// meaning that it's included in snapshots as before.
+		testutil.RequireEqualProto(t, snapshot, buildQueue2.CreateSnapshot())
+
+		response, err := buildQueue2.GetOperation(ctx, &buildqueuestate_pb.GetOperationRequest{
+			OperationName: operationName,
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &buildqueuestate_pb.GetOperationResponse{
+			Operation: &buildqueuestate_pb.OperationState{
+				InvocationName: &buildqueuestate_pb.InvocationName{
+					SizeClassQueueName: &buildqueuestate_pb.SizeClassQueueName{
+						PlatformPkixPublicKey: platformPkixPublicKey,
+					},
+					Ids: []*anypb.Any{invocationID},
+				},
+				ExpectedDuration: durationpb.New(30 * time.Second),
+				QueuedTimestamp:  timestamppb.New(now),
+				Action:           action,
+				Timeout:          timestamppb.New(now.Add(time.Minute)),
+				Stage: &buildqueuestate_pb.OperationState_Queued{
+					Queued: &emptypb.Empty{},
+				},
+				Priority: 5,
+			},
+		}, response)
+	})
+
+	t.Run("Execute", func(t *testing.T) {
+		// Calling Execute() for the same action should attach
+		// to the restored operation, as opposed to creating a
+		// new one.
+		buildQueue2, clock2, actionRouter2 := newBuildQueue()
+		require.NoError(t, buildQueue2.RestoreSnapshot(snapshot))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Canceled, "context canceled"),
+			buildQueue2.Execute(
+				&remoteexecution_pb.ExecuteRequest{
+					Action:   action,
+					Priority: 5,
+				},
+				expectQueued(clock2, actionRouter2, operationName),
+			),
+		)
+		testutil.RequireEqualProto(t, snapshot, buildQueue2.CreateSnapshot())
+	})
+
+	t.Run("Completed", func(t *testing.T) {
+		// Reattaching to a restored operation that was
+		// completed should return its results, without
+		// scheduling it once more.
+		buildQueue2, _, _ := newBuildQueue()
+		require.NoError(t, buildQueue2.RestoreSnapshot(&journal_pb.Snapshot{
+			Operations: []*journal_pb.Operation{
+				{
+					Name:            "completed",
+					InvocationIds:   []*anypb.Any{invocationID},
+					Action:          action,
+					QueuedTimestamp: timestamppb.New(now),
+					Completed: &journal_pb.Completed{
+						CompletionEvent: &encryptedaction_pb.Event{
+							Ciphertext: []byte("Result"),
+						},
+					},
+				},
+				{
+					Name:            "failed",
+					InvocationIds:   []*anypb.Any{invocationID},
+					Action:          action,
+					QueuedTimestamp: timestamppb.New(now),
+					Completed: &journal_pb.Completed{
+						Status: status.New(codes.DeadlineExceeded, "Action timed out").Proto(),
+					},
+				},
+			},
+		}))
+
+		out := NewMockExecution_ExecuteServer(ctrl)
+		out.EXPECT().Context().Return(ctx).AnyTimes()
+		out.EXPECT().Send(testutil.EqProto(t, &remoteexecution_pb.ExecuteResponse{
+			Name: "completed",
+			Stage: &remoteexecution_pb.ExecuteResponse_Completed_{
+				Completed: &remoteexecution_pb.ExecuteResponse_Completed{
+					CompletionEvent: &encryptedaction_pb.Event{
+						Ciphertext: []byte("Result"),
+					},
+				},
+			},
+		}))
+		require.NoError(t, buildQueue2.WaitExecution(
+			&remoteexecution_pb.WaitExecutionRequest{Name: "completed"},
+			out,
+		))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.DeadlineExceeded, "Action timed out"),
+			buildQueue2.WaitExecution(
+				&remoteexecution_pb.WaitExecutionRequest{Name: "failed"},
+				out,
+			),
+		)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		buildQueue2, _, _ := newBuildQueue()
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Operation with name \"nameless\" has no action"),
+			buildQueue2.RestoreSnapshot(&journal_pb.Snapshot{
+				Operations: []*journal_pb.Operation{{
+					Name: "nameless",
+				}},
+			}),
+		)
+	})
+}
+
+func TestInMemoryBuildQueueAuthorization(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	platformPkixPublicKey := newPlatformPkixPublicKey(t)
+	sizeClassQueueName := &buildqueuestate_pb.SizeClassQueueName{
+		PlatformPkixPublicKey: platformPkixPublicKey,
+		SizeClass:             0,
+	}
+	workerIDPattern := map[string]string{"hostname": "worker1"}
+	now := time.Unix(1000, 0)
+
+	denyAllAuthorizer := auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return false })
+	newBuildQueue := func(executeAuthorizer, modifyDrainsAuthorizer, killOperationsAuthorizer auth.Authorizer) *scheduler.InMemoryBuildQueue {
+		clock := NewMockClock(ctrl)
+		clock.EXPECT().Now().Return(now).AnyTimes()
+		buildQueue := scheduler.NewInMemoryBuildQueue(
+			clock,
+			newSequentialUUIDGenerator(),
+			random.NewFastSingleThreadedGenerator(),
+			&buildQueueConfigurationForTesting,
+			NewMockActionRouter(ctrl),
+			executeAuthorizer,
+			modifyDrainsAuthorizer,
+			killOperationsAuthorizer,
+		)
+		require.NoError(t, buildQueue.RegisterPredeclaredPlatformQueue(
+			[][]byte{platformPkixPublicKey},
+			/* workerInvocationStickinessLimits = */ nil,
+			/* maximumQueuedBackgroundLearningOperations = */ 0,
+			/* backgroundLearningOperationPriority = */ 0,
+			/* sizeClasses = */ []uint32{0},
+		))
+		return buildQueue
+	}
+	killOperation := func(buildQueue *scheduler.InMemoryBuildQueue) error {
+		_, err := buildQueue.KillOperations(ctx, &buildqueuestate_pb.KillOperationsRequest{
+			Filter: &buildqueuestate_pb.KillOperationsRequest_Filter{
+				Type: &buildqueuestate_pb.KillOperationsRequest_Filter_OperationName{
+					OperationName: "nonexistent",
+				},
+			},
+			Status: status.New(codes.Unavailable, "Operation killed").Proto(),
+		})
+		return err
+	}
+
+	t.Run("Execute", func(t *testing.T) {
+		buildQueue := newBuildQueue(denyAllAuthorizer, allowAllAuthorizer, allowAllAuthorizer)
+
+		// Requests to execute actions or to reattach to
+		// existing operations should be rejected before the
+		// request is inspected.
+		out := NewMockExecution_ExecuteServer(ctrl)
+		out.EXPECT().Context().Return(ctx).AnyTimes()
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Authorization: Permission denied"),
+			buildQueue.Execute(&remoteexecution_pb.ExecuteRequest{}, out),
+		)
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Authorization: Permission denied"),
+			buildQueue.WaitExecution(&remoteexecution_pb.WaitExecutionRequest{
+				Name: "00000000-0000-0000-0000-000000000001",
+			}, out),
+		)
+
+		// Other administrative operations should not be
+		// affected.
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.NotFound, "Operation \"nonexistent\" not found"),
+			killOperation(buildQueue),
+		)
+	})
+
+	t.Run("ModifyDrains", func(t *testing.T) {
+		buildQueue := newBuildQueue(allowAllAuthorizer, denyAllAuthorizer, allowAllAuthorizer)
+
+		_, err := buildQueue.AddDrain(ctx, &buildqueuestate_pb.AddOrRemoveDrainRequest{
+			SizeClassQueueName: sizeClassQueueName,
+			WorkerIdPattern:    workerIDPattern,
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+
+		_, err = buildQueue.RemoveDrain(ctx, &buildqueuestate_pb.AddOrRemoveDrainRequest{
+			SizeClassQueueName: sizeClassQueueName,
+			WorkerIdPattern:    workerIDPattern,
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+
+		_, err = buildQueue.TerminateWorkers(ctx, &buildqueuestate_pb.TerminateWorkersRequest{
+			WorkerIdPattern: workerIDPattern,
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+
+		// The denied requests should not have had any effect.
+		response, err := buildQueue.ListDrains(ctx, &buildqueuestate_pb.ListDrainsRequest{
+			SizeClassQueueName: sizeClassQueueName,
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &buildqueuestate_pb.ListDrainsResponse{
+			Drains: []*buildqueuestate_pb.DrainState{},
+		}, response)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.NotFound, "Operation \"nonexistent\" not found"),
+			killOperation(buildQueue),
+		)
+	})
+
+	t.Run("KillOperations", func(t *testing.T) {
+		buildQueue := newBuildQueue(allowAllAuthorizer, allowAllAuthorizer, denyAllAuthorizer)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Authorization: Permission denied"),
+			killOperation(buildQueue),
+		)
+
+		// Drains should still be modifiable.
+		_, err := buildQueue.AddDrain(ctx, &buildqueuestate_pb.AddOrRemoveDrainRequest{
+			SizeClassQueueName: sizeClassQueueName,
+			WorkerIdPattern:    workerIDPattern,
+		})
+		require.NoError(t, err)
+
+		response, err := buildQueue.ListDrains(ctx, &buildqueuestate_pb.ListDrainsRequest{
+			SizeClassQueueName: sizeClassQueueName,
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &buildqueuestate_pb.ListDrainsResponse{
+			Drains: []*buildqueuestate_pb.DrainState{{
+				WorkerIdPattern:  workerIDPattern,
+				CreatedTimestamp: timestamppb.New(now),
+			}},
+		}, response)
+	})
+}
@@ -3,6 +3,8 @@ package initialsizeclass
 import (
 	pb "bonanza.build/pkg/proto/configuration/scheduler"
 
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/random"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"google.golang.org/grpc/codes"
@@ -11,7 +13,7 @@ import (
 
 // NewAnalyzerFromConfiguration creates a new initial size class
 // analyzer based on options provided in a configuration file.
-func NewAnalyzerFromConfiguration(configuration *pb.InitialSizeClassAnalyzerConfiguration) (Analyzer, error) {
+func NewAnalyzerFromConfiguration(configuration *pb.InitialSizeClassAnalyzerConfiguration, previousExecutionStatsStore PreviousExecutionStatsStore) (Analyzer, error) {
 	if configuration == nil {
 		return nil, status.Error(codes.InvalidArgument, "No initial size class analyzer configuration provided")
 	}
@@ -21,5 +23,45 @@ func NewAnalyzerFromConfiguration(configuration *pb.InitialSizeClassAnalyzerConf
 		return nil, util.StatusWrap(err, "Invalid maximum execution timeout")
 	}
 	actionTimeoutExtractor := NewActionTimeoutExtractor(maximumExecutionTimeout.AsDuration())
+
+	if fdConfiguration := configuration.FeedbackDriven; fdConfiguration != nil {
+		if previousExecutionStatsStore == nil {
+			return nil, status.Error(codes.InvalidArgument, "Feedback driven analysis can only be enabled if a previous execution stats store is configured")
+		}
+		failureCacheDuration := fdConfiguration.FailureCacheDuration
+		if err := failureCacheDuration.CheckValid(); err != nil {
+			return nil, util.StatusWrap(err, "Invalid failure cache duration")
+		}
+		if fdConfiguration.HistorySize <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "History size must be positive")
+		}
+
+		strategyCalculator := SmallestSizeClassStrategyCalculator
+		if pageRankConfiguration := fdConfiguration.PageRank; pageRankConfiguration != nil {
+			minimumExecutionTimeout := pageRankConfiguration.MinimumExecutionTimeout
+			if err := minimumExecutionTimeout.CheckValid(); err != nil {
+				return nil, util.StatusWrap(err, "Invalid minimum execution timeout")
+			}
+			if pageRankConfiguration.MaximumConvergenceError <= 0 {
+				return nil, status.Error(codes.InvalidArgument, "Maximum convergence error must be positive")
+			}
+			strategyCalculator = NewPageRankStrategyCalculator(
+				minimumExecutionTimeout.AsDuration(),
+				pageRankConfiguration.AcceptableExecutionTimeIncreaseExponent,
+				pageRankConfiguration.SmallerSizeClassExecutionTimeoutMultiplier,
+				pageRankConfiguration.MaximumConvergenceError,
+			)
+		}
+
+		return NewFeedbackDrivenAnalyzer(
+			previousExecutionStatsStore,
+			random.NewFastSingleThreadedGenerator(),
+			clock.SystemClock,
+			actionTimeoutExtractor,
+			failureCacheDuration.AsDuration(),
+			strategyCalculator,
+			int(fdConfiguration.HistorySize),
+		), nil
+	}
 	return NewFallbackAnalyzer(actionTimeoutExtractor), nil
 }
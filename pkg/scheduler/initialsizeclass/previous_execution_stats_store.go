@@ -0,0 +1,366 @@
+package initialsizeclass
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+
+	initialsizeclass_pb "bonanza.build/pkg/proto/initialsizeclass"
+	dag_pb "bonanza.build/pkg/proto/storage/dag"
+	"bonanza.build/pkg/storage/dag"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var (
+	storageBackedPreviousExecutionStatsStorePrometheusMetrics sync.Once
+
+	storageBackedPreviousExecutionStatsStoreHandlesCreated = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "scheduler",
+			Name:      "storage_backed_previous_execution_stats_store_handles_created_total",
+			Help:      "Number of previous execution stats handles that were created during Get()",
+		})
+	storageBackedPreviousExecutionStatsStoreHandlesDestroyed = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "scheduler",
+			Name:      "storage_backed_previous_execution_stats_store_handles_destroyed_total",
+			Help:      "Number of previous execution stats handles that were destroyed",
+		})
+	storageBackedPreviousExecutionStatsStoreHandlesDequeued = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "scheduler",
+			Name:      "storage_backed_previous_execution_stats_store_handles_dequeued_total",
+			Help:      "Number of previous execution stats handles that were dequeued for writing during Get()",
+		})
+	storageBackedPreviousExecutionStatsStoreHandlesQueued = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "scheduler",
+			Name:      "storage_backed_previous_execution_stats_store_handles_queued_total",
+			Help:      "Number of previous execution stats handles that were queued for writing",
+		})
+)
+
+// PreviousExecutionStatsStore is used by FeedbackDrivenAnalyzer to
+// gain access to statistics on previous executions of actions, keyed
+// by the actions' stable fingerprints.
+type PreviousExecutionStatsStore interface {
+	Get(ctx context.Context, stableFingerprint []byte) (PreviousExecutionStatsHandle, error)
+}
+
+// PreviousExecutionStatsHandle refers to the statistics on previous
+// executions of a single action, as read from the
+// PreviousExecutionStatsStore.
+type PreviousExecutionStatsHandle interface {
+	// GetMutableProto returns the statistics on previous executions.
+	// The message may be modified, as long as Release() is called
+	// with isDirty set to true afterwards.
+	GetMutableProto() *initialsizeclass_pb.PreviousExecutionStats
+
+	// Release the handle. If isDirty is set, the statistics are
+	// written back into storage.
+	Release(isDirty bool)
+}
+
+type storageBackedPreviousExecutionStatsStore struct {
+	tagResolver                   tag.Resolver[object.Namespace]
+	objectDownloader              object.Downloader[object.GlobalReference]
+	dagUploaderClient             dag_pb.UploaderClient
+	namespace                     object.Namespace
+	objectContentsWalkerSemaphore *semaphore.Weighted
+
+	lock           sync.Mutex
+	handles        map[string]*storageBackedPreviousExecutionStatsHandle
+	handlesToWrite []*storageBackedPreviousExecutionStatsHandle
+}
+
+// NewStorageBackedPreviousExecutionStatsStore creates a
+// PreviousExecutionStatsStore that persists statistics in the object
+// and tag store. Statistics are stored as objects of degree zero, whose
+// payload contains a PreviousExecutionStats message. Objects are
+// referenced by tags containing the stable fingerprint of the action.
+//
+// Handles for the same stable fingerprint are shared between callers,
+// so that concurrent executions of the same action don't cause
+// statistics to be lost. As releasing handles is performed while the
+// scheduler holds its global lock, statistics are not written back
+// into storage immediately. Instead, writes of released handles are
+// performed as part of subsequent calls to Get().
+func NewStorageBackedPreviousExecutionStatsStore(
+	tagResolver tag.Resolver[object.Namespace],
+	objectDownloader object.Downloader[object.GlobalReference],
+	dagUploaderClient dag_pb.UploaderClient,
+	namespace object.Namespace,
+	objectContentsWalkerSemaphore *semaphore.Weighted,
+) PreviousExecutionStatsStore {
+	storageBackedPreviousExecutionStatsStorePrometheusMetrics.Do(func() {
+		prometheus.MustRegister(storageBackedPreviousExecutionStatsStoreHandlesCreated)
+		prometheus.MustRegister(storageBackedPreviousExecutionStatsStoreHandlesDestroyed)
+		prometheus.MustRegister(storageBackedPreviousExecutionStatsStoreHandlesDequeued)
+		prometheus.MustRegister(storageBackedPreviousExecutionStatsStoreHandlesQueued)
+	})
+
+	return &storageBackedPreviousExecutionStatsStore{
+		tagResolver:                   tagResolver,
+		objectDownloader:              objectDownloader,
+		dagUploaderClient:             dagUploaderClient,
+		namespace:                     namespace,
+		objectContentsWalkerSemaphore: objectContentsWalkerSemaphore,
+
+		handles: map[string]*storageBackedPreviousExecutionStatsHandle{},
+	}
+}
+
+// getPreviousExecutionStatsTag returns the tag under which statistics
+// for a given stable fingerprint are stored.
+func getPreviousExecutionStatsTag(stableFingerprint []byte) (*anypb.Any, error) {
+	return anypb.New(&initialsizeclass_pb.PreviousExecutionStatsTag{
+		StableFingerprint: stableFingerprint,
+	})
+}
+
+// readStats reads the statistics for a given stable fingerprint from
+// storage. If no statistics exist, an empty message is returned.
+func (ss *storageBackedPreviousExecutionStatsStore) readStats(ctx context.Context, stableFingerprint []byte) (*initialsizeclass_pb.PreviousExecutionStats, error) {
+	statsTag, err := getPreviousExecutionStatsTag(stableFingerprint)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to create tag")
+	}
+	reference, _, err := ss.tagResolver.ResolveTag(ctx, ss.namespace, statsTag)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &initialsizeclass_pb.PreviousExecutionStats{}, nil
+		}
+		return nil, util.StatusWrap(err, "Failed to resolve tag")
+	}
+	contents, err := ss.objectDownloader.DownloadObject(ctx, ss.namespace.InstanceName.WithLocalReference(reference))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &initialsizeclass_pb.PreviousExecutionStats{}, nil
+		}
+		return nil, util.StatusWrapf(err, "Failed to download object with reference %s", reference)
+	}
+	var stats initialsizeclass_pb.PreviousExecutionStats
+	if err := proto.Unmarshal(contents.GetPayload(), &stats); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to unmarshal previous execution stats")
+	}
+	return &stats, nil
+}
+
+// writeStats writes the statistics for a given stable fingerprint into
+// storage, and updates the tag to point to it.
+func (ss *storageBackedPreviousExecutionStatsStore) writeStats(ctx context.Context, stableFingerprint []byte, stats *initialsizeclass_pb.PreviousExecutionStats) error {
+	statsTag, err := getPreviousExecutionStatsTag(stableFingerprint)
+	if err != nil {
+		return util.StatusWrap(err, "Failed to create tag")
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(stats)
+	if err != nil {
+		return util.StatusWrap(err, "Failed to marshal previous execution stats")
+	}
+	contents, err := ss.namespace.ReferenceFormat.NewContents(nil, data)
+	if err != nil {
+		return util.StatusWrap(err, "Failed to create object contents")
+	}
+	return dag.UploadTaggedDAG(
+		ctx,
+		ss.dagUploaderClient,
+		ss.namespace.InstanceName.WithLocalReference(contents.GetLocalReference()),
+		statsTag,
+		dag.NewSimpleObjectContentsWalker(contents, nil),
+		ss.objectContentsWalkerSemaphore,
+		object.Unlimited,
+	)
+}
+
+type handleToWrite struct {
+	handle         *storageBackedPreviousExecutionStatsHandle
+	stats          *initialsizeclass_pb.PreviousExecutionStats
+	writingVersion int
+}
+
+func (ss *storageBackedPreviousExecutionStatsStore) Get(ctx context.Context, stableFingerprint []byte) (PreviousExecutionStatsHandle, error) {
+	// Acquire a handle if one already exists. Also extract a small
+	// number of handles that need to be written back into storage,
+	// so that writes are performed at the same rate as reads.
+	const writesPerRead = 3
+	handlesToWrite := make([]handleToWrite, 0, writesPerRead)
+	key := string(stableFingerprint)
+
+	ss.lock.Lock()
+	handleToReturn, hasExistingHandle := ss.handles[key]
+	if hasExistingHandle {
+		handleToReturn.increaseUseCount()
+	}
+
+	for i := 0; i < writesPerRead && len(ss.handlesToWrite) > 0; i++ {
+		newLength := len(ss.handlesToWrite) - 1
+		handle := ss.handlesToWrite[newLength]
+		ss.handlesToWrite[newLength] = nil
+		ss.handlesToWrite = ss.handlesToWrite[:newLength]
+		if handle.handlesToWriteIndex != newLength {
+			panic("Handle has bad write index")
+		}
+		handle.handlesToWriteIndex = -1
+		handlesToWrite = append(handlesToWrite, handleToWrite{
+			handle:         handle,
+			stats:          proto.Clone(handle.stats).(*initialsizeclass_pb.PreviousExecutionStats),
+			writingVersion: handle.currentVersion,
+		})
+	}
+	ss.lock.Unlock()
+	storageBackedPreviousExecutionStatsStoreHandlesDequeued.Add(float64(len(handlesToWrite)))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	if !hasExistingHandle {
+		// No handle exists. Create a new handle, containing
+		// the statistics that are currently stored.
+		handleToReturn = &storageBackedPreviousExecutionStatsHandle{
+			store:               ss,
+			stableFingerprint:   stableFingerprint,
+			useCount:            1,
+			handlesToWriteIndex: -1,
+		}
+		group.Go(func() error {
+			stats, err := ss.readStats(groupCtx, stableFingerprint)
+			if err != nil {
+				return err
+			}
+			handleToReturn.stats = stats
+			return nil
+		})
+	}
+
+	// Write statistics of handles that have been released.
+	for _, handleToWrite := range handlesToWrite {
+		group.Go(func() error {
+			err := ss.writeStats(groupCtx, handleToWrite.handle.stableFingerprint, handleToWrite.stats)
+			ss.lock.Lock()
+			if err == nil {
+				handleToWrite.handle.writtenVersion = handleToWrite.writingVersion
+			}
+			handleToWrite.handle.removeOrQueueForWriteLocked()
+			ss.lock.Unlock()
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to write previous execution stats for stable fingerprint %s", hex.EncodeToString(handleToWrite.handle.stableFingerprint))
+			}
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		if hasExistingHandle {
+			ss.lock.Lock()
+			handleToReturn.decreaseUseCount()
+			ss.lock.Unlock()
+		}
+		return nil, err
+	}
+
+	if !hasExistingHandle {
+		// Insert the new handle into our bookkeeping. It may
+		// be the case that another thread beat us to it.
+		// Discard our newly created handle in that case.
+		ss.lock.Lock()
+		if existingHandle, ok := ss.handles[key]; ok {
+			handleToReturn = existingHandle
+			handleToReturn.increaseUseCount()
+		} else {
+			ss.handles[key] = handleToReturn
+			storageBackedPreviousExecutionStatsStoreHandlesCreated.Inc()
+		}
+		ss.lock.Unlock()
+	}
+	return handleToReturn, nil
+}
+
+type storageBackedPreviousExecutionStatsHandle struct {
+	store             *storageBackedPreviousExecutionStatsStore
+	stableFingerprint []byte
+
+	// The number of times we still expect Release() to be called on
+	// the handle.
+	useCount int
+
+	// The statistics, and version numbers to keep track of whether
+	// they have been modified since they were last written.
+	stats          *initialsizeclass_pb.PreviousExecutionStats
+	writtenVersion int
+	currentVersion int
+
+	// The index of this handle in the handlesToWrite list, or -1
+	// if the handle is not queued for writing.
+	handlesToWriteIndex int
+}
+
+func (sh *storageBackedPreviousExecutionStatsHandle) GetMutableProto() *initialsizeclass_pb.PreviousExecutionStats {
+	return sh.stats
+}
+
+func (sh *storageBackedPreviousExecutionStatsHandle) increaseUseCount() {
+	sh.useCount++
+	if i := sh.handlesToWriteIndex; i >= 0 {
+		// Handle was queued for writing. Remove it, as we'll
+		// be writing it once the caller releases it.
+		ss := sh.store
+		newLength := len(ss.handlesToWrite) - 1
+		lastHandle := ss.handlesToWrite[newLength]
+		ss.handlesToWrite[i] = lastHandle
+		if lastHandle.handlesToWriteIndex != newLength {
+			panic("Handle has bad write index")
+		}
+		lastHandle.handlesToWriteIndex = i
+		ss.handlesToWrite[newLength] = nil
+		ss.handlesToWrite = ss.handlesToWrite[:newLength]
+		sh.handlesToWriteIndex = -1
+		storageBackedPreviousExecutionStatsStoreHandlesDequeued.Inc()
+	}
+}
+
+func (sh *storageBackedPreviousExecutionStatsHandle) decreaseUseCount() {
+	sh.useCount--
+	sh.removeOrQueueForWriteLocked()
+}
+
+func (sh *storageBackedPreviousExecutionStatsHandle) removeOrQueueForWriteLocked() {
+	if sh.useCount == 0 {
+		ss := sh.store
+		if sh.writtenVersion == sh.currentVersion {
+			// No changes were made, or all changes have been
+			// written. Remove the handle.
+			delete(ss.handles, string(sh.stableFingerprint))
+			storageBackedPreviousExecutionStatsStoreHandlesDestroyed.Inc()
+		} else if sh.handlesToWriteIndex < 0 {
+			// Changes were made and the handle is not queued
+			// for writing yet. Queue it.
+			sh.handlesToWriteIndex = len(ss.handlesToWrite)
+			ss.handlesToWrite = append(ss.handlesToWrite, sh)
+			storageBackedPreviousExecutionStatsStoreHandlesQueued.Inc()
+		}
+	}
+}
+
+func (sh *storageBackedPreviousExecutionStatsHandle) Release(isDirty bool) {
+	ss := sh.store
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	if isDirty {
+		sh.currentVersion++
+	}
+	sh.decreaseUseCount()
+}
@@ -13,9 +13,14 @@ func NewKeyExtractorFromConfiguration(configuration *pb.InvocationKeyExtractorCo
 	if configuration == nil {
 		return nil, status.Error(codes.InvalidArgument, "No invocation key extractor coniguration provided")
 	}
-	switch configuration.Kind.(type) {
+	switch kind := configuration.Kind.(type) {
 	case *pb.InvocationKeyExtractorConfiguration_AuthenticationMetadata:
 		return AuthenticationMetadataKeyExtractor, nil
+	case *pb.InvocationKeyExtractorConfiguration_RequestMetadataHeader:
+		if kind.RequestMetadataHeader == "" {
+			return nil, status.Error(codes.InvalidArgument, "No request metadata header name provided")
+		}
+		return NewRequestMetadataHeaderKeyExtractor(kind.RequestMetadataHeader), nil
 	default:
 		return nil, status.Error(codes.InvalidArgument, "Configuration did not contain a supported invocation key extractor type")
 	}
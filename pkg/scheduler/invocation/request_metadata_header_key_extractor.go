@@ -0,0 +1,41 @@
+package invocation
+
+import (
+	"context"
+	"strings"
+
+	buildqueuestate_pb "bonanza.build/pkg/proto/buildqueuestate"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type requestMetadataHeaderKeyExtractor struct {
+	name string
+}
+
+// NewRequestMetadataHeaderKeyExtractor creates a KeyExtractor that
+// returns a Key that is based on the values of a gRPC request metadata
+// header. This can, for example, be used to group all operations
+// belonging to the same Bazel invocation together, which ensures fair
+// scheduling between builds, even if they are performed by the same
+// user.
+func NewRequestMetadataHeaderKeyExtractor(name string) KeyExtractor {
+	return &requestMetadataHeaderKeyExtractor{
+		// gRPC metadata keys are case insensitive, and are
+		// always stored in lowercase form.
+		name: strings.ToLower(name),
+	}
+}
+
+func (ke *requestMetadataHeaderKeyExtractor) ExtractKey(ctx context.Context) (Key, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	anyKey, err := anypb.New(&buildqueuestate_pb.RequestMetadataHeader{
+		Name:   ke.name,
+		Values: md.Get(ke.name),
+	})
+	if err != nil {
+		return "", err
+	}
+	return NewKey(anyKey)
+}
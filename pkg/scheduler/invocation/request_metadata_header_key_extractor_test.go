@@ -0,0 +1,49 @@
+package invocation_test
+
+import (
+	"context"
+	"testing"
+
+	"bonanza.build/pkg/scheduler/invocation"
+
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestMetadataHeaderKeyExtractor(t *testing.T) {
+	keyExtractor := invocation.NewRequestMetadataHeaderKeyExtractor("Bonanza-Tool-Invocation-Id")
+	extractKey := func(pairs ...string) invocation.Key {
+		key, err := keyExtractor.ExtractKey(metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...)))
+		require.NoError(t, err)
+		return key
+	}
+
+	t.Run("DifferentValues", func(t *testing.T) {
+		// Requests belonging to different invocations should
+		// yield different keys.
+		require.NotEqual(
+			t,
+			extractKey("bonanza-tool-invocation-id", "6f2a9d57-0b2e-4c0f-9a0c-1d2d6f3c3f11"),
+			extractKey("bonanza-tool-invocation-id", "a1c7d6f0-5b8e-4c43-8d8f-2e5c8d3f9b20"),
+		)
+	})
+
+	t.Run("SameValues", func(t *testing.T) {
+		// Repeated requests belonging to the same invocation
+		// should yield the same key, regardless of other headers.
+		require.Equal(
+			t,
+			extractKey("bonanza-tool-invocation-id", "6f2a9d57-0b2e-4c0f-9a0c-1d2d6f3c3f11"),
+			extractKey("bonanza-tool-invocation-id", "6f2a9d57-0b2e-4c0f-9a0c-1d2d6f3c3f11", "user-agent", "bazel"),
+		)
+	})
+
+	t.Run("MissingHeader", func(t *testing.T) {
+		require.NotEqual(
+			t,
+			extractKey(),
+			extractKey("bonanza-tool-invocation-id", "6f2a9d57-0b2e-4c0f-9a0c-1d2d6f3c3f11"),
+		)
+	})
+}
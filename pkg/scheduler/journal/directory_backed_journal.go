@@ -0,0 +1,45 @@
+package journal
+
+import (
+	"log"
+
+	journal_pb "bonanza.build/pkg/proto/scheduler/journal"
+	"bonanza.build/pkg/protofile"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+)
+
+var componentSnapshot = path.MustNewComponent("snapshot")
+
+type directoryBackedJournal struct {
+	directory filesystem.Directory
+}
+
+// NewDirectoryBackedJournal creates a Journal that writes Snapshot
+// Protobuf messages to a file named "snapshot" stored inside a
+// filesystem.Directory.
+func NewDirectoryBackedJournal(directory filesystem.Directory) Journal {
+	return directoryBackedJournal{
+		directory: directory,
+	}
+}
+
+func (j directoryBackedJournal) ReadSnapshot() (*journal_pb.Snapshot, error) {
+	var snapshot journal_pb.Snapshot
+	found, err := protofile.Read(j.directory, componentSnapshot, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		// No usable snapshot present. Start with an empty
+		// state.
+		log.Print("Reinitializing scheduler journal, as no usable snapshot was found")
+		return &journal_pb.Snapshot{}, nil
+	}
+	return &snapshot, nil
+}
+
+func (j directoryBackedJournal) WriteSnapshot(snapshot *journal_pb.Snapshot) error {
+	return protofile.Write(j.directory, componentSnapshot, snapshot)
+}
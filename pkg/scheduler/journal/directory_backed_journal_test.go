@@ -0,0 +1,104 @@
+package journal_test
+
+import (
+	"io"
+	"syscall"
+	"testing"
+
+	journal_pb "bonanza.build/pkg/proto/scheduler/journal"
+	"bonanza.build/pkg/scheduler/journal"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDirectoryBackedJournal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	directory := NewMockDirectory(ctrl)
+	directoryBackedJournal := journal.NewDirectoryBackedJournal(directory)
+
+	// Example snapshot to read from/write to disk.
+	exampleSnapshot := journal_pb.Snapshot{
+		Operations: []*journal_pb.Operation{{
+			Name: "a",
+		}},
+	}
+	exampleSnapshotBytes := []byte{0x0a, 0x03, 0x0a, 0x01, 0x61}
+
+	t.Run("ReadNotFound", func(t *testing.T) {
+		directory.EXPECT().OpenRead(path.MustNewComponent("snapshot")).Return(nil, syscall.ENOENT)
+
+		snapshot, err := directoryBackedJournal.ReadSnapshot()
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &journal_pb.Snapshot{}, snapshot)
+	})
+
+	t.Run("ReadOpenFailure", func(t *testing.T) {
+		directory.EXPECT().OpenRead(path.MustNewComponent("snapshot")).Return(nil, syscall.EIO)
+
+		_, err := directoryBackedJournal.ReadSnapshot()
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to open file: input/output error"), err)
+	})
+
+	t.Run("ReadCorrupted", func(t *testing.T) {
+		f := NewMockFileReader(ctrl)
+		directory.EXPECT().OpenRead(path.MustNewComponent("snapshot")).Return(f, nil)
+		f.EXPECT().ReadAt(gomock.Any(), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+			return copy(p, "This is not a valid protobuf"), io.EOF
+		})
+		f.EXPECT().Close()
+
+		snapshot, err := directoryBackedJournal.ReadSnapshot()
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &journal_pb.Snapshot{}, snapshot)
+	})
+
+	t.Run("ReadSuccess", func(t *testing.T) {
+		f := NewMockFileReader(ctrl)
+		directory.EXPECT().OpenRead(path.MustNewComponent("snapshot")).Return(f, nil)
+		f.EXPECT().ReadAt(gomock.Any(), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+			return copy(p, exampleSnapshotBytes), io.EOF
+		})
+		f.EXPECT().Close()
+
+		snapshot, err := directoryBackedJournal.ReadSnapshot()
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &exampleSnapshot, snapshot)
+	})
+
+	t.Run("WriteDirectoryRenameFailure", func(t *testing.T) {
+		directory.EXPECT().Remove(path.MustNewComponent("snapshot.new")).Return(syscall.ENOENT)
+		f := NewMockFileAppender(ctrl)
+		directory.EXPECT().OpenAppend(path.MustNewComponent("snapshot.new"), filesystem.CreateExcl(0o666)).Return(f, nil)
+		f.EXPECT().Write(exampleSnapshotBytes).Return(len(exampleSnapshotBytes), nil)
+		f.EXPECT().Sync()
+		f.EXPECT().Close()
+		directory.EXPECT().Rename(path.MustNewComponent("snapshot.new"), directory, path.MustNewComponent("snapshot")).Return(syscall.EACCES)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Failed to rename temporary file: permission denied"),
+			directoryBackedJournal.WriteSnapshot(&exampleSnapshot))
+	})
+
+	t.Run("WriteSuccess", func(t *testing.T) {
+		directory.EXPECT().Remove(path.MustNewComponent("snapshot.new"))
+		f := NewMockFileAppender(ctrl)
+		directory.EXPECT().OpenAppend(path.MustNewComponent("snapshot.new"), filesystem.CreateExcl(0o666)).Return(f, nil)
+		f.EXPECT().Write(exampleSnapshotBytes).Return(len(exampleSnapshotBytes), nil)
+		f.EXPECT().Sync()
+		f.EXPECT().Close()
+		directory.EXPECT().Rename(path.MustNewComponent("snapshot.new"), directory, path.MustNewComponent("snapshot"))
+		directory.EXPECT().Sync()
+
+		require.NoError(t, directoryBackedJournal.WriteSnapshot(&exampleSnapshot))
+	})
+}
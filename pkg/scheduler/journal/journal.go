@@ -0,0 +1,14 @@
+package journal
+
+import (
+	journal_pb "bonanza.build/pkg/proto/scheduler/journal"
+)
+
+// Journal is used by the scheduler to persist snapshots of the
+// operations that it tracks. The most recently written snapshot can be
+// reloaded on startup, so that clients may reattach to operations that
+// were created prior to a restart.
+type Journal interface {
+	ReadSnapshot() (*journal_pb.Snapshot, error)
+	WriteSnapshot(snapshot *journal_pb.Snapshot) error
+}
@@ -0,0 +1,80 @@
+package journal
+
+import (
+	"context"
+	"time"
+
+	journal_pb "bonanza.build/pkg/proto/scheduler/journal"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// SnapshotSource is implemented by the scheduler to provide
+// PeriodicSnapshotter access to the operations that it tracks.
+type SnapshotSource interface {
+	CreateSnapshot() *journal_pb.Snapshot
+}
+
+// PeriodicSnapshotter obtains snapshots from a SnapshotSource at a
+// fixed interval, and writes them to a Journal.
+type PeriodicSnapshotter struct {
+	source      SnapshotSource
+	journal     Journal
+	clock       clock.Clock
+	errorLogger util.ErrorLogger
+	interval    time.Duration
+
+	lastSnapshot *journal_pb.Snapshot
+}
+
+// NewPeriodicSnapshotter creates a new PeriodicSnapshotter according to
+// the arguments provided.
+func NewPeriodicSnapshotter(
+	source SnapshotSource,
+	journal Journal,
+	clock clock.Clock,
+	errorLogger util.ErrorLogger,
+	interval time.Duration,
+) *PeriodicSnapshotter {
+	return &PeriodicSnapshotter{
+		source:      source,
+		journal:     journal,
+		clock:       clock,
+		errorLogger: errorLogger,
+		interval:    interval,
+	}
+}
+
+// WriteNextSnapshot waits for the snapshot interval to elapse, followed
+// by writing a snapshot to the journal. Snapshots are only written if
+// they differ from the one that was written previously.
+//
+// This function must generally be called in a loop in a separate
+// goroutine, so that the journal is updated continuously. The return
+// value of this method denotes whether the caller must continue to
+// call this method. When false, it indicates the provided context was
+// cancelled, due to a shutdown being requested. In that case a final
+// snapshot is written before returning.
+func (ps *PeriodicSnapshotter) WriteNextSnapshot(ctx context.Context) bool {
+	keepGoing := true
+	timer, t := ps.clock.NewTimer(ps.interval)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		keepGoing = false
+	case <-t:
+	}
+
+	snapshot := ps.source.CreateSnapshot()
+	if ps.lastSnapshot == nil || !proto.Equal(ps.lastSnapshot, snapshot) {
+		if err := ps.journal.WriteSnapshot(snapshot); err == nil {
+			ps.lastSnapshot = snapshot
+		} else {
+			ps.errorLogger.Log(util.StatusWrap(err, "Failed to write snapshot"))
+		}
+	}
+	return keepGoing
+}
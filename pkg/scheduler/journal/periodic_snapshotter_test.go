@@ -0,0 +1,105 @@
+package journal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	journal_pb "bonanza.build/pkg/proto/scheduler/journal"
+	"bonanza.build/pkg/scheduler/journal"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPeriodicSnapshotter(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	source := NewMockSnapshotSource(ctrl)
+	baseJournal := NewMockJournal(ctrl)
+	clock := NewMockClock(ctrl)
+	errorLogger := NewMockErrorLogger(ctrl)
+	periodicSnapshotter := journal.NewPeriodicSnapshotter(source, baseJournal, clock, errorLogger, 10*time.Second)
+
+	snapshot1 := &journal_pb.Snapshot{
+		Operations: []*journal_pb.Operation{{
+			Name:     "0ab2fd3b-1c76-4bab-b6e2-f5e9c8f3b1a2",
+			Priority: 5,
+		}},
+	}
+	snapshot2 := &journal_pb.Snapshot{
+		Operations: []*journal_pb.Operation{{
+			Name:      "0ab2fd3b-1c76-4bab-b6e2-f5e9c8f3b1a2",
+			Priority:  5,
+			Completed: &journal_pb.Completed{},
+		}},
+	}
+
+	t.Run("Initial", func(t *testing.T) {
+		// The first snapshot should always be written.
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1010, 0)
+		clock.EXPECT().NewTimer(10*time.Second).Return(nil, timerChannel)
+		source.EXPECT().CreateSnapshot().Return(snapshot1)
+		baseJournal.EXPECT().WriteSnapshot(testutil.EqProto(t, snapshot1))
+
+		require.True(t, periodicSnapshotter.WriteNextSnapshot(ctx))
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		// If the state of the scheduler did not change, there
+		// is no need to write another snapshot.
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1020, 0)
+		clock.EXPECT().NewTimer(10*time.Second).Return(nil, timerChannel)
+		source.EXPECT().CreateSnapshot().Return(&journal_pb.Snapshot{
+			Operations: []*journal_pb.Operation{{
+				Name:     "0ab2fd3b-1c76-4bab-b6e2-f5e9c8f3b1a2",
+				Priority: 5,
+			}},
+		})
+
+		require.True(t, periodicSnapshotter.WriteNextSnapshot(ctx))
+	})
+
+	t.Run("WriteFailure", func(t *testing.T) {
+		// Failures to write snapshots should be logged. The
+		// next iteration should attempt to write the snapshot
+		// once more.
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1030, 0)
+		clock.EXPECT().NewTimer(10*time.Second).Return(nil, timerChannel)
+		source.EXPECT().CreateSnapshot().Return(snapshot2)
+		baseJournal.EXPECT().WriteSnapshot(testutil.EqProto(t, snapshot2)).
+			Return(status.Error(codes.Internal, "Failed to write to temporary file: no space left on device"))
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Internal, "Failed to write snapshot: Failed to write to temporary file: no space left on device")))
+
+		require.True(t, periodicSnapshotter.WriteNextSnapshot(ctx))
+
+		timerChannel <- time.Unix(1040, 0)
+		clock.EXPECT().NewTimer(10*time.Second).Return(nil, timerChannel)
+		source.EXPECT().CreateSnapshot().Return(snapshot2)
+		baseJournal.EXPECT().WriteSnapshot(testutil.EqProto(t, snapshot2))
+
+		require.True(t, periodicSnapshotter.WriteNextSnapshot(ctx))
+	})
+
+	t.Run("Shutdown", func(t *testing.T) {
+		// When the context is cancelled, a final snapshot
+		// should be written immediately.
+		ctxCancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		timer := NewMockTimer(ctrl)
+		clock.EXPECT().NewTimer(10*time.Second).Return(timer, nil)
+		timer.EXPECT().Stop().Return(true)
+		source.EXPECT().CreateSnapshot().Return(snapshot1)
+		baseJournal.EXPECT().WriteSnapshot(testutil.EqProto(t, snapshot1))
+
+		require.False(t, periodicSnapshotter.WriteNextSnapshot(ctxCancelled))
+	})
+}
@@ -13,7 +13,10 @@ import (
 
 // NewActionRouterFromConfiguration creates an ActionRouter based on
 // options specified in a configuration file.
-func NewActionRouterFromConfiguration(configuration *pb.ActionRouterConfiguration) (ActionRouter, error) {
+//
+// A PreviousExecutionStatsStore may be provided, which is used by
+// initial size class analyzers that are feedback driven.
+func NewActionRouterFromConfiguration(configuration *pb.ActionRouterConfiguration, previousExecutionStatsStore initialsizeclass.PreviousExecutionStatsStore) (ActionRouter, error) {
 	if configuration == nil {
 		return nil, status.Error(codes.InvalidArgument, "No action router configuration provided")
 	}
@@ -27,7 +30,7 @@ func NewActionRouterFromConfiguration(configuration *pb.ActionRouterConfiguratio
 			}
 			invocationKeyExtractors = append(invocationKeyExtractors, invocationKeyExtractor)
 		}
-		initialSizeClassAnalyzer, err := initialsizeclass.NewAnalyzerFromConfiguration(kind.Simple.InitialSizeClassAnalyzer)
+		initialSizeClassAnalyzer, err := initialsizeclass.NewAnalyzerFromConfiguration(kind.Simple.InitialSizeClassAnalyzer, previousExecutionStatsStore)
 		if err != nil {
 			return nil, util.StatusWrap(err, "Failed to create initial size class analyzer")
 		}
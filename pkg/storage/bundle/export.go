@@ -0,0 +1,81 @@
+package bundle
+
+import (
+	"context"
+	"sync"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+// ExportDAGs traverses one or more DAGs stored in a given namespace,
+// and writes all objects that are reachable from the provided root
+// objects to a bundle. Objects that are referenced by multiple parents
+// are only written once. The number of concurrent downloads is bounded
+// by the provided semaphore.
+func ExportDAGs(ctx context.Context, downloader object.Downloader[object.GlobalReference], namespace object.Namespace, rootReferences []object.LocalReference, writer *Writer, downloadSemaphore *semaphore.Weighted) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	e := exportPass{
+		downloader:        downloader,
+		namespace:         namespace,
+		writer:            writer,
+		downloadSemaphore: downloadSemaphore,
+		context:           groupCtx,
+		group:             group,
+		objects:           map[object.LocalReference]struct{}{},
+	}
+	for _, rootReference := range rootReferences {
+		e.exportObject(rootReference)
+	}
+	return group.Wait()
+}
+
+// exportPass contains the state of a single call to ExportDAGs().
+type exportPass struct {
+	downloader        object.Downloader[object.GlobalReference]
+	namespace         object.Namespace
+	writer            *Writer
+	downloadSemaphore *semaphore.Weighted
+	context           context.Context
+	group             *errgroup.Group
+
+	lock    sync.Mutex
+	objects map[object.LocalReference]struct{}
+}
+
+// exportObject starts downloading an object and writing it to the
+// bundle in the background, if the object has not been encountered
+// before. Upon completion, its children are exported as well.
+func (e *exportPass) exportObject(reference object.LocalReference) {
+	e.lock.Lock()
+	if _, ok := e.objects[reference]; ok {
+		e.lock.Unlock()
+		return
+	}
+	e.objects[reference] = struct{}{}
+	e.lock.Unlock()
+
+	e.group.Go(func() error {
+		if err := e.downloadSemaphore.Acquire(e.context, 1); err != nil {
+			return util.StatusFromContext(e.context)
+		}
+		contents, err := e.downloader.DownloadObject(e.context, e.namespace.WithLocalReference(reference))
+		e.downloadSemaphore.Release(1)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to download object with reference %s", reference)
+		}
+		if err := e.writer.WriteObject(contents); err != nil {
+			return err
+		}
+
+		degree := contents.GetDegree()
+		for i := 0; i < degree; i++ {
+			e.exportObject(contents.GetOutgoingReference(i))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,138 @@
+package bundle_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	bundle_pb "bonanza.build/pkg/proto/storage/bundle"
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/bundle"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportDAGs(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	namespace, err := object.NewNamespace(&object_pb.Namespace{
+		InstanceName:    "hello/world",
+		ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+	})
+	require.NoError(t, err)
+
+	// A DAG consisting of a root object that references the same
+	// leaf object twice via an intermediate object.
+	leafContents := object.MustNewContents(
+		object_pb.ReferenceFormat_SHA256_V1,
+		object.OutgoingReferencesList[object.LocalReference]{},
+		[]byte("Leaf"),
+	)
+	intermediateContents := object.MustNewContents(
+		object_pb.ReferenceFormat_SHA256_V1,
+		object.OutgoingReferencesList[object.LocalReference]{
+			leafContents.GetLocalReference(),
+		},
+		[]byte("Intermediate"),
+	)
+	rootContents := object.MustNewContents(
+		object_pb.ReferenceFormat_SHA256_V1,
+		object.OutgoingReferencesList[object.LocalReference]{
+			intermediateContents.GetLocalReference(),
+			leafContents.GetLocalReference(),
+		},
+		[]byte("Root"),
+	)
+	rootTag := &anypb.Any{
+		TypeUrl: "example.com/Tag",
+		Value:   []byte("Hello"),
+	}
+	header := &bundle_pb.Header{
+		Namespace: namespace.ToProto(),
+		Roots: []*bundle_pb.Header_Root{{
+			Reference: rootContents.GetRawReference(),
+			Tag:       rootTag,
+		}},
+	}
+
+	t.Run("DownloadFailure", func(t *testing.T) {
+		downloader := NewMockDownloaderForTesting(ctrl)
+		downloader.EXPECT().DownloadObject(gomock.Any(), namespace.WithLocalReference(rootContents.GetLocalReference())).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+
+		writer, err := bundle.NewWriter(&bytes.Buffer{}, header)
+		require.NoError(t, err)
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.NotFound, "Failed to download object with reference "+rootContents.GetLocalReference().String()+": Object not found"),
+			bundle.ExportDAGs(ctx, downloader, namespace, []object.LocalReference{rootContents.GetLocalReference()}, writer, semaphore.NewWeighted(1)),
+		)
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		// Export the DAG. Even though the leaf object is
+		// referenced twice, it should only be downloaded once.
+		downloader := NewMockDownloaderForTesting(ctrl)
+		for _, contents := range []*object.Contents{rootContents, intermediateContents, leafContents} {
+			downloader.EXPECT().DownloadObject(gomock.Any(), namespace.WithLocalReference(contents.GetLocalReference())).
+				Return(contents, nil)
+		}
+
+		var buffer bytes.Buffer
+		writer, err := bundle.NewWriter(&buffer, header)
+		require.NoError(t, err)
+		require.NoError(t, bundle.ExportDAGs(ctx, downloader, namespace, []object.LocalReference{rootContents.GetLocalReference()}, writer, semaphore.NewWeighted(2)))
+		require.NoError(t, writer.Flush())
+
+		// Read the bundle back. The namespace and roots
+		// should be preserved.
+		reader, err := bundle.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+		require.NoError(t, err)
+		require.Equal(t, namespace, reader.GetNamespace())
+		require.Len(t, reader.GetRoots(), 1)
+		testutil.RequireEqualProto(t, header.Roots[0], reader.GetRoots()[0])
+
+		// Walking the DAG should yield all objects.
+		rootWalker := bundle.NewObjectContentsWalker(reader, rootContents.GetLocalReference())
+		contents, walkers, err := rootWalker.GetContents(ctx)
+		require.NoError(t, err)
+		require.Equal(t, rootContents.GetFullData(), contents.GetFullData())
+		require.Len(t, walkers, 2)
+
+		contents, walkers, err = walkers[0].GetContents(ctx)
+		require.NoError(t, err)
+		require.Equal(t, intermediateContents.GetFullData(), contents.GetFullData())
+		require.Len(t, walkers, 1)
+
+		contents, walkers, err = walkers[0].GetContents(ctx)
+		require.NoError(t, err)
+		require.Equal(t, leafContents.GetFullData(), contents.GetFullData())
+		require.Empty(t, walkers)
+
+		// Objects that are not part of the bundle cannot be
+		// read.
+		otherReference := object.MustNewSHA256V1LocalReference("185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5, 0, 0, 0)
+		_, err = reader.ReadObject(otherReference)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object with reference "+otherReference.String()+" is not contained in the bundle"), err)
+	})
+
+	t.Run("TruncatedBundle", func(t *testing.T) {
+		var buffer bytes.Buffer
+		writer, err := bundle.NewWriter(&buffer, header)
+		require.NoError(t, err)
+		require.NoError(t, writer.WriteObject(leafContents))
+		require.NoError(t, writer.Flush())
+
+		_, err = bundle.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()-1))
+		require.Error(t, err)
+	})
+}
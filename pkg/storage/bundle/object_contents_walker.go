@@ -0,0 +1,39 @@
+package bundle
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/dag"
+	"bonanza.build/pkg/storage/object"
+)
+
+type objectContentsWalker struct {
+	reader    *Reader
+	reference object.LocalReference
+}
+
+// NewObjectContentsWalker creates a dag.ObjectContentsWalker that
+// reads the contents of an object and its children from a bundle. It
+// can be provided to dag.UploadDAG() to import the DAG contained in a
+// bundle into storage.
+func NewObjectContentsWalker(reader *Reader, reference object.LocalReference) dag.ObjectContentsWalker {
+	return &objectContentsWalker{
+		reader:    reader,
+		reference: reference,
+	}
+}
+
+func (w *objectContentsWalker) GetContents(ctx context.Context) (*object.Contents, []dag.ObjectContentsWalker, error) {
+	contents, err := w.reader.ReadObject(w.reference)
+	if err != nil {
+		return nil, nil, err
+	}
+	degree := contents.GetDegree()
+	walkers := make([]dag.ObjectContentsWalker, 0, degree)
+	for i := 0; i < degree; i++ {
+		walkers = append(walkers, NewObjectContentsWalker(w.reader, contents.GetOutgoingReference(i)))
+	}
+	return contents, walkers, nil
+}
+
+func (objectContentsWalker) Discard() {}
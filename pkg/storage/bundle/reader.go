@@ -0,0 +1,140 @@
+package bundle
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	bundle_pb "bonanza.build/pkg/proto/storage/bundle"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// maximumMessageSizeBytes is the maximum size of messages that are
+// accepted when reading bundles. It is large enough to hold objects
+// of the maximum size permitted by any reference format.
+const maximumMessageSizeBytes = 4 << 20
+
+type objectLocation struct {
+	offsetBytes int64
+	sizeBytes   int
+}
+
+// Reader of bundles that were created using Writer. Upon creation, the
+// bundle is scanned to construct an index of all objects. Afterwards,
+// objects can be read in random order.
+type Reader struct {
+	r         io.ReaderAt
+	header    *bundle_pb.Header
+	namespace object.Namespace
+	objects   map[object.LocalReference]objectLocation
+}
+
+// NewReader creates a Reader for a bundle of a given size.
+func NewReader(r io.ReaderAt, sizeBytes int64) (*Reader, error) {
+	br := bufio.NewReader(io.NewSectionReader(r, 0, sizeBytes))
+	offsetBytes := int64(0)
+	readMessage := func(m proto.Message) (objectLocation, error) {
+		messageSizeBytes, err := binary.ReadUvarint(br)
+		if err != nil {
+			return objectLocation{}, err
+		}
+		if messageSizeBytes > maximumMessageSizeBytes {
+			return objectLocation{}, status.Errorf(codes.InvalidArgument, "Message at offset %d is %d bytes in size, which exceeds the maximum of %d bytes", offsetBytes, messageSizeBytes, maximumMessageSizeBytes)
+		}
+		location := objectLocation{
+			offsetBytes: offsetBytes + int64(protowire.SizeVarint(messageSizeBytes)),
+			sizeBytes:   int(messageSizeBytes),
+		}
+		data := make([]byte, messageSizeBytes)
+		if _, err := io.ReadFull(br, data); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return objectLocation{}, err
+		}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return objectLocation{}, util.StatusWrapfWithCode(err, codes.InvalidArgument, "Invalid message at offset %d", offsetBytes)
+		}
+		offsetBytes = location.offsetBytes + int64(location.sizeBytes)
+		return location, nil
+	}
+
+	var header bundle_pb.Header
+	if _, err := readMessage(&header); err != nil {
+		return nil, util.StatusWrap(err, "Failed to read header")
+	}
+	namespace, err := object.NewNamespace(header.Namespace)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Invalid namespace")
+	}
+	for i, root := range header.Roots {
+		if _, err := namespace.ReferenceFormat.NewLocalReference(root.Reference); err != nil {
+			return nil, util.StatusWrapf(err, "Invalid reference for root at index %d", i)
+		}
+	}
+
+	// Construct an index of all objects contained in the bundle.
+	objects := map[object.LocalReference]objectLocation{}
+	for {
+		var objectMessage bundle_pb.Object
+		location, err := readMessage(&objectMessage)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, util.StatusWrapf(err, "Failed to read object at offset %d", offsetBytes)
+		}
+		reference, err := namespace.ReferenceFormat.NewLocalReference(objectMessage.Reference)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid reference for object at offset %d", location.offsetBytes)
+		}
+		objects[reference] = location
+	}
+
+	return &Reader{
+		r:         r,
+		header:    &header,
+		namespace: namespace,
+		objects:   objects,
+	}, nil
+}
+
+// GetNamespace returns the namespace in which the objects contained in
+// the bundle were stored at the time the bundle was created.
+func (r *Reader) GetNamespace() object.Namespace {
+	return r.namespace
+}
+
+// GetRoots returns the roots of the DAGs contained in the bundle, and
+// the tags that pointed to them at the time the bundle was created.
+func (r *Reader) GetRoots() []*bundle_pb.Header_Root {
+	return r.header.Roots
+}
+
+// ReadObject reads the contents of a single object from the bundle.
+func (r *Reader) ReadObject(reference object.LocalReference) (*object.Contents, error) {
+	location, ok := r.objects[reference]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Object with reference %s is not contained in the bundle", reference)
+	}
+	data := make([]byte, location.sizeBytes)
+	if _, err := r.r.ReadAt(data, location.offsetBytes); err != nil {
+		return nil, util.StatusWrapf(err, "Failed to read object with reference %s", reference)
+	}
+	var objectMessage bundle_pb.Object
+	if err := proto.Unmarshal(data, &objectMessage); err != nil {
+		return nil, util.StatusWrapfWithCode(err, codes.InvalidArgument, "Invalid message for object with reference %s", reference)
+	}
+	contents, err := object.NewContentsFromFullData(reference, objectMessage.Contents)
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Invalid contents for object with reference %s", reference)
+	}
+	return contents, nil
+}
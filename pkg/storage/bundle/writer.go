@@ -0,0 +1,58 @@
+package bundle
+
+import (
+	"bufio"
+	"io"
+	"sync"
+
+	bundle_pb "bonanza.build/pkg/proto/storage/bundle"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// Writer of bundles. Bundles are files containing one or more DAGs of
+// objects, which can be used to copy objects between storage clusters
+// that are not connected to each other.
+type Writer struct {
+	lock sync.Mutex
+	w    *bufio.Writer
+}
+
+// NewWriter creates a Writer that writes a bundle to a file. The
+// provided header is written immediately.
+func NewWriter(w io.Writer, header *bundle_pb.Header) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+	if _, err := protodelim.MarshalTo(bw, header); err != nil {
+		return nil, util.StatusWrap(err, "Failed to write header")
+	}
+	return &Writer{
+		w: bw,
+	}, nil
+}
+
+// WriteObject appends the contents of a single object to the bundle.
+// It is safe to call this method concurrently.
+func (w *Writer) WriteObject(contents *object.Contents) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if _, err := protodelim.MarshalTo(w.w, &bundle_pb.Object{
+		Reference: contents.GetRawReference(),
+		Contents:  contents.GetFullData(),
+	}); err != nil {
+		return util.StatusWrapf(err, "Failed to write object with reference %s", contents.GetLocalReference())
+	}
+	return nil
+}
+
+// Flush any buffered data to the underlying file. This method needs to
+// be called after all objects have been written.
+func (w *Writer) Flush() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.w.Flush()
+}
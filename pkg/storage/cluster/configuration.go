@@ -0,0 +1,121 @@
+package cluster
+
+import (
+	"bonanza.build/pkg/proto/configuration/bonanza_storage_frontend"
+	object_grpc_pb "bonanza.build/pkg/proto/configuration/storage/object/grpc"
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	tag_pb "bonanza.build/pkg/proto/storage/tag"
+	"bonanza.build/pkg/storage/object"
+	object_grpc "bonanza.build/pkg/storage/object/grpc"
+	object_resharding "bonanza.build/pkg/storage/object/resharding"
+	object_sharded "bonanza.build/pkg/storage/object/sharded"
+	"bonanza.build/pkg/storage/tag"
+	tag_grpc "bonanza.build/pkg/storage/tag/grpc"
+	tag_resharding "bonanza.build/pkg/storage/tag/resharding"
+	tag_sharded "bonanza.build/pkg/storage/tag/sharded"
+
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewReplicaFromConfiguration creates an object store and a tag store
+// for a single replica of a storage cluster, consisting of one or more
+// bonanza_storage_shard processes. In addition to that, it returns a
+// tag lister that can be used to enumerate all tags contained in the
+// replica.
+//
+// If the replica is in the process of being resharded, the returned
+// stores read data from the previous set of shards if it cannot be
+// found in the current set of shards, while writes only go to the
+// current set of shards.
+func NewReplicaFromConfiguration(grpcClientFactory bb_grpc.ClientFactory, configuration *bonanza_storage_frontend.ApplicationConfiguration_Replica, batchingConfiguration *object_grpc_pb.BatchingConfiguration, dependenciesGroup program.Group) (object.Store[object.GlobalReference, []byte], tag.Store[object.Namespace, object.GlobalReference, []byte], tag.Lister, error) {
+	objectStore, tagStore, tagLister, err := newShardedStores(grpcClientFactory, configuration.Shards, batchingConfiguration, dependenciesGroup)
+	if err != nil {
+		return nil, nil, nil, util.StatusWrap(err, "Current shards")
+	}
+	if len(configuration.PreviousShards) == 0 {
+		return objectStore, tagStore, tagLister, nil
+	}
+
+	previousObjectStore, previousTagStore, previousTagLister, err := newShardedStores(grpcClientFactory, configuration.PreviousShards, batchingConfiguration, dependenciesGroup)
+	if err != nil {
+		return nil, nil, nil, util.StatusWrap(err, "Previous shards")
+	}
+	return object.NewStore(
+			object_resharding.NewDownloader(previousObjectStore, objectStore),
+			object_resharding.NewUploader(previousObjectStore, objectStore),
+		),
+		tag.NewStore(
+			tag_resharding.NewResolver(previousTagStore, tagStore),
+			tagStore,
+		),
+		tag_resharding.NewLister(previousTagLister, tagLister),
+		nil
+}
+
+func newShardedStores(grpcClientFactory bb_grpc.ClientFactory, shards map[string]*bonanza_storage_frontend.ApplicationConfiguration_Shard, batchingConfiguration *object_grpc_pb.BatchingConfiguration, dependenciesGroup program.Group) (object.Store[object.GlobalReference, []byte], tag.Store[object.Namespace, object.GlobalReference, []byte], tag.Lister, error) {
+	// Create object & tag stores for each shard.
+	shardNames := make([]string, 0, len(shards))
+	weightedShards := make([]object_sharded.WeightedShard, 0, len(shards))
+	objectDownloaders := make([]object.Downloader[object.GlobalReference], 0, len(shards))
+	objectUploaders := make([]object.Uploader[object.GlobalReference, []byte], 0, len(shards))
+	tagResolvers := make([]tag.Resolver[object.Namespace], 0, len(shards))
+	tagUpdaters := make([]tag.Updater[object.GlobalReference, []byte], 0, len(shards))
+	tagListers := make([]tag.Lister, 0, len(shards))
+	for key, shard := range shards {
+		grpcClient, err := grpcClientFactory.NewClientFromConfiguration(shard.Client, dependenciesGroup)
+		if err != nil {
+			return nil, nil, nil, util.StatusWrapf(err, "Failed to create gRPC client for shard with key %#v", key)
+		}
+
+		shardNames = append(shardNames, key)
+		weightedShards = append(weightedShards, object_sharded.WeightedShard{
+			Key:    []byte(key),
+			Weight: shard.Weight,
+		})
+		objectDownloaders = append(objectDownloaders, object_grpc.NewGRPCDownloaderFromConfiguration(
+			object_pb.NewDownloaderClient(grpcClient),
+			batchingConfiguration,
+		))
+		objectUploaders = append(objectUploaders, object_grpc.NewGRPCUploaderFromConfiguration(
+			object_pb.NewUploaderClient(grpcClient),
+			batchingConfiguration,
+		))
+		tagResolvers = append(tagResolvers, tag_grpc.NewGRPCResolver(
+			tag_pb.NewResolverClient(grpcClient),
+		))
+		tagUpdaters = append(tagUpdaters, tag_grpc.NewGRPCUpdater(
+			tag_pb.NewUpdaterClient(grpcClient),
+		))
+		tagListers = append(tagListers, tag_grpc.NewGRPCLister(
+			tag_pb.NewListerClient(grpcClient),
+		))
+	}
+
+	// If we have multiple stores, instantiate the sharded backend.
+	switch len(shards) {
+	case 0:
+		return nil, nil, nil, status.Error(codes.InvalidArgument, "No shards provided")
+	case 1:
+		return object.NewStore(objectDownloaders[0], objectUploaders[0]),
+			tag.NewStore(tagResolvers[0], tagUpdaters[0]),
+			tagListers[0],
+			nil
+	default:
+		picker := object_sharded.NewWeightedRendezvousPicker(weightedShards)
+		return object.NewStore(
+				object_sharded.NewShardedDownloader(objectDownloaders, shardNames, picker),
+				object_sharded.NewShardedUploader[object.GlobalReference, []byte](objectUploaders, shardNames, picker),
+			),
+			tag.NewStore(
+				tag_sharded.NewShardedResolver(tagResolvers, shardNames, picker),
+				tag_sharded.NewShardedUpdater[object.GlobalReference, []byte](tagUpdaters, shardNames, picker),
+			),
+			tag_sharded.NewShardedLister(tagListers, shardNames),
+			nil
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // ObjectContentsWalker is called into by UploadDAG to request the
@@ -86,6 +87,13 @@ type requestableObjectState struct {
 
 // UploadDAG uploads a single DAG of objects to a server via gRPC.
 func UploadDAG(ctx context.Context, client dag_pb.UploaderClient, rootReference object.GlobalReference, rootObjectContentsWalker ObjectContentsWalker, objectContentsWalkerSemaphore *semaphore.Weighted, maximumUnfinalizedParentsLimit object.Limit) error {
+	return UploadTaggedDAG(ctx, client, rootReference, nil, rootObjectContentsWalker, objectContentsWalkerSemaphore, maximumUnfinalizedParentsLimit)
+}
+
+// UploadTaggedDAG uploads a single DAG of objects to a server via
+// gRPC. If a tag is provided, the server updates the tag to point to
+// the root object after the DAG has been uploaded successfully.
+func UploadTaggedDAG(ctx context.Context, client dag_pb.UploaderClient, rootReference object.GlobalReference, rootTag *anypb.Any, rootObjectContentsWalker ObjectContentsWalker, objectContentsWalkerSemaphore *semaphore.Weighted, maximumUnfinalizedParentsLimit object.Limit) error {
 	return program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
 		// State associated with all requestable objects. Ensure
 		// that all walkers that traversed are discarded upon
@@ -151,6 +159,7 @@ func UploadDAG(ctx context.Context, client dag_pb.UploaderClient, rootReference
 			Type: &dag_pb.UploadDagsRequest_InitiateDag_{
 				InitiateDag: &dag_pb.UploadDagsRequest_InitiateDag{
 					RootReference: rootReference.GetRawReference(),
+					RootTag:       rootTag,
 				},
 			},
 		}); err != nil {
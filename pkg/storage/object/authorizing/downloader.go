@@ -0,0 +1,32 @@
+package authorizing
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type downloader struct {
+	base       object.Downloader[object.GlobalReference]
+	authorizer auth.Authorizer
+}
+
+// NewDownloader creates a decorator for object.Downloader that only
+// permits objects to be downloaded if the caller is authorized to
+// access the instance name in which the object is stored.
+func NewDownloader(base object.Downloader[object.GlobalReference], authorizer auth.Authorizer) object.Downloader[object.GlobalReference] {
+	return &downloader{
+		base:       base,
+		authorizer: authorizer,
+	}
+}
+
+func (d *downloader) DownloadObject(ctx context.Context, reference object.GlobalReference) (*object.Contents, error) {
+	if err := AuthorizeInstanceName(ctx, d.authorizer, reference.InstanceName); err != nil {
+		return nil, util.StatusWrap(err, "Authorization")
+	}
+	return d.base.DownloadObject(ctx, reference)
+}
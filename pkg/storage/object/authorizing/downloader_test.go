@@ -0,0 +1,57 @@
+package authorizing_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/authorizing"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDownloader(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseDownloader := NewMockDownloaderForTesting(ctrl)
+	downloader := authorizing.NewDownloader(
+		baseDownloader,
+		auth.NewStaticAuthorizer(func(instanceName digest.InstanceName) bool {
+			return instanceName.String() == "allowed"
+		}),
+	)
+
+	contents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello World"))
+
+	t.Run("Allowed", func(t *testing.T) {
+		reference := object.NewInstanceName("allowed").WithLocalReference(contents.LocalReference)
+		baseDownloader.EXPECT().DownloadObject(ctx, reference).Return(contents, nil)
+
+		downloadedContents, err := downloader.DownloadObject(ctx, reference)
+		require.NoError(t, err)
+		require.Equal(t, contents, downloadedContents)
+	})
+
+	t.Run("Denied", func(t *testing.T) {
+		// Requests for instance names that are not permitted
+		// by the authorizer should not be forwarded.
+		_, err := downloader.DownloadObject(ctx, object.NewInstanceName("denied").WithLocalReference(contents.LocalReference))
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+	})
+
+	t.Run("InvalidInstanceName", func(t *testing.T) {
+		// Instance names that cannot be represented as REv2
+		// instance names cannot be authorized.
+		_, err := downloader.DownloadObject(ctx, object.NewInstanceName("hello/blobs/world").WithLocalReference(contents.LocalReference))
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Instance name \"hello/blobs/world\" cannot be authorized: Instance name contains reserved keyword \"blobs\""), err)
+	})
+}
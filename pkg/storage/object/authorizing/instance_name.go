@@ -0,0 +1,28 @@
+package authorizing
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+)
+
+// AuthorizeInstanceName checks whether the caller is permitted to
+// access a given instance name.
+//
+// The authorizers provided by Buildbarn operate on REv2 instance
+// names, which are path-like and may not contain certain keywords.
+// Instance names that cannot be converted to REv2 instance names are
+// therefore always rejected.
+func AuthorizeInstanceName(ctx context.Context, authorizer auth.Authorizer, instanceName object.InstanceName) error {
+	digestInstanceName, err := digest.NewInstanceName(instanceName.String())
+	if err != nil {
+		return util.StatusWrapfWithCode(err, codes.PermissionDenied, "Instance name %#v cannot be authorized", instanceName.String())
+	}
+	return auth.AuthorizeSingleInstanceName(ctx, authorizer, digestInstanceName)
+}
@@ -0,0 +1,36 @@
+package authorizing
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type uploader[TLease any] struct {
+	base       object.Uploader[object.GlobalReference, TLease]
+	authorizer auth.Authorizer
+}
+
+// NewUploader creates a decorator for object.Uploader that only
+// permits objects to be uploaded if the caller is authorized to access
+// the instance name in which the object is stored.
+//
+// Requests that don't provide any contents are subject to
+// authorization as well, as they can be used to obtain leases on
+// existing objects.
+func NewUploader[TLease any](base object.Uploader[object.GlobalReference, TLease], authorizer auth.Authorizer) object.Uploader[object.GlobalReference, TLease] {
+	return &uploader[TLease]{
+		base:       base,
+		authorizer: authorizer,
+	}
+}
+
+func (u *uploader[TLease]) UploadObject(ctx context.Context, reference object.GlobalReference, contents *object.Contents, childrenLeases []TLease, wantContentsIfIncomplete bool) (object.UploadObjectResult[TLease], error) {
+	if err := AuthorizeInstanceName(ctx, u.authorizer, reference.InstanceName); err != nil {
+		return nil, util.StatusWrap(err, "Authorization")
+	}
+	return u.base.UploadObject(ctx, reference, contents, childrenLeases, wantContentsIfIncomplete)
+}
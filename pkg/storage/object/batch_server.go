@@ -0,0 +1,62 @@
+package object
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+// maximumConcurrentBatchRequests is the maximum number of requests
+// that are processed concurrently as part of a single call to
+// BatchDownloadObjects() or BatchUploadObjects(). This prevents a
+// single client from exhausting resources on the server.
+const maximumConcurrentBatchRequests = 100
+
+// serveBatch implements the server side of BatchDownloadObjects() and
+// BatchUploadObjects(). It receives requests from the client, processes
+// them concurrently, and sends responses back in the order in which
+// processing completes.
+func serveBatch[TRequest, TResponse any](
+	ctx context.Context,
+	receive func() (TRequest, error),
+	process func(ctx context.Context, request TRequest) TResponse,
+	send func(TResponse) error,
+) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	concurrency := semaphore.NewWeighted(maximumConcurrentBatchRequests)
+	var sendLock sync.Mutex
+	errReceive := func() error {
+		for {
+			request, err := receive()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			if concurrency.Acquire(groupCtx, 1) != nil {
+				return util.StatusFromContext(groupCtx)
+			}
+			group.Go(func() error {
+				defer concurrency.Release(1)
+				response := process(groupCtx, request)
+
+				sendLock.Lock()
+				defer sendLock.Unlock()
+				return send(response)
+			})
+		}
+	}()
+
+	// Wait for all requests to be processed, as the stream may not
+	// be used after returning.
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	return errReceive
+}
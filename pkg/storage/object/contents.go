@@ -2,7 +2,6 @@ package object
 
 import (
 	"bytes"
-	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
 	"math"
@@ -39,9 +38,10 @@ func NewContentsFromFullData(reference LocalReference, data []byte) (*Contents,
 	if expectedSizeBytes := reference.GetSizeBytes(); len(c.data) != expectedSizeBytes {
 		return nil, status.Errorf(codes.InvalidArgument, "Data is %d bytes in size, while %d bytes were expected", len(c.data), expectedSizeBytes)
 	}
-	actualHash := sha256.Sum256(c.data)
+	referenceFormat := reference.GetReferenceFormat()
+	actualHash := referenceFormat.computeHash(c.data)
 	if expectedHash := reference.GetHash(); !bytes.Equal(actualHash[:], expectedHash) {
-		return nil, status.Errorf(codes.InvalidArgument, "Data has SHA-256 hash %s, while %s was expected", hex.EncodeToString(actualHash[:]), hex.EncodeToString(expectedHash))
+		return nil, status.Errorf(codes.InvalidArgument, "Data has %s hash %s, while %s was expected", referenceFormat.getHashName(), hex.EncodeToString(actualHash[:]), hex.EncodeToString(expectedHash))
 	}
 
 	if err := c.validateOutgoingReferences(); err != nil {
@@ -66,7 +66,8 @@ func MustNewContents(referenceFormatValue object.ReferenceFormat_Value, referenc
 func (c *Contents) GetOutgoingReference(i int) LocalReference {
 	outgoingReferences := c.data[:c.GetDegree()*referenceSizeBytes]
 	return LocalReference{
-		rawReference: *(*[referenceSizeBytes]byte)(outgoingReferences[i*referenceSizeBytes:]),
+		rawReference:    *(*[referenceSizeBytes]byte)(outgoingReferences[i*referenceSizeBytes:]),
+		referenceFormat: c.GetReferenceFormat(),
 	}
 }
 
@@ -75,10 +76,12 @@ func (c *Contents) GetOutgoingReference(i int) LocalReference {
 // collected while keeping the outgoing references available.
 func (c *Contents) DetachOutgoingReferences() OutgoingReferences[LocalReference] {
 	degree := c.GetDegree()
+	referenceFormat := c.GetReferenceFormat()
 	l := make(OutgoingReferencesList[LocalReference], 0, degree)
 	for i := 0; i < degree; i++ {
 		l = append(l, LocalReference{
-			rawReference: *(*[referenceSizeBytes]byte)(c.data[i*referenceSizeBytes:]),
+			rawReference:    *(*[referenceSizeBytes]byte)(c.data[i*referenceSizeBytes:]),
+			referenceFormat: referenceFormat,
 		})
 	}
 	return l
@@ -163,6 +166,9 @@ func (c *Contents) Unflatten(newReference LocalReference) (*Contents, error) {
 	if *(*[35]byte)(newReference.rawReference[:]) != *(*[35]byte)(c.LocalReference.rawReference[:]) {
 		return nil, status.Error(codes.InvalidArgument, "Hash and size of flattened and unflattened references do not match")
 	}
+	if newReference.referenceFormat != c.LocalReference.referenceFormat {
+		return nil, status.Error(codes.InvalidArgument, "Reference formats of flattened and unflattened references do not match")
+	}
 	cFlat := c.cloneWithReference(newReference)
 	if err := cFlat.validateOutgoingReferences(); err != nil {
 		return nil, err
@@ -6,6 +6,9 @@ import (
 	"bonanza.build/pkg/proto/storage/object"
 
 	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type downloaderServer struct {
@@ -38,3 +41,30 @@ func (s *downloaderServer) DownloadObject(ctx context.Context, request *object.D
 		Contents: contents.GetFullData(),
 	}, nil
 }
+
+func (s *downloaderServer) BatchDownloadObjects(stream object.Downloader_BatchDownloadObjectsServer) error {
+	return serveBatch(
+		stream.Context(),
+		stream.Recv,
+		func(ctx context.Context, request *object.BatchDownloadObjectsRequest) *object.BatchDownloadObjectsResponse {
+			response := &object.BatchDownloadObjectsResponse{
+				RequestId: request.RequestId,
+			}
+			if request.Request == nil {
+				response.Result = &object.BatchDownloadObjectsResponse_Error{
+					Error: status.New(codes.InvalidArgument, "No request provided").Proto(),
+				}
+			} else if downloadResponse, err := s.DownloadObject(ctx, request.Request); err != nil {
+				response.Result = &object.BatchDownloadObjectsResponse_Error{
+					Error: status.Convert(err).Proto(),
+				}
+			} else {
+				response.Result = &object.BatchDownloadObjectsResponse_Response{
+					Response: downloadResponse,
+				}
+			}
+			return response
+		},
+		stream.Send,
+	)
+}
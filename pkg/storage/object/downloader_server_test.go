@@ -2,6 +2,7 @@ package object_test
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	object_pb "bonanza.build/pkg/proto/storage/object"
@@ -126,4 +127,61 @@ func TestDownloaderServer(t *testing.T) {
 			Contents: []byte("Hello"),
 		}, response)
 	})
+
+	t.Run("BatchDownloadObjects", func(t *testing.T) {
+		// Requests sent as part of a batch should be processed
+		// independently. Failures of individual requests should
+		// be reported as part of the response, as opposed to
+		// causing the stream to terminate.
+		stream := NewMockDownloader_BatchDownloadObjectsServer(ctrl)
+		stream.EXPECT().Context().Return(ctx).AnyTimes()
+		gomock.InOrder(
+			stream.EXPECT().Recv().Return(&object_pb.BatchDownloadObjectsRequest{
+				RequestId: 7,
+				Request: &object_pb.DownloadObjectRequest{
+					Namespace: &object_pb.Namespace{
+						InstanceName:    "hello/world",
+						ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+					},
+					Reference: []byte{
+						// SHA-256 hash.
+						0x18, 0x5f, 0x8d, 0xb3, 0x22, 0x71, 0xfe, 0x25,
+						0xf5, 0x61, 0xa6, 0xfc, 0x93, 0x8b, 0x2e, 0x26,
+						0x43, 0x06, 0xec, 0x30, 0x4e, 0xda, 0x51, 0x80,
+						0x07, 0xd1, 0x76, 0x48, 0x26, 0x38, 0x19, 0x69,
+						// Size in bytes.
+						0x05, 0x00, 0x00,
+						// Height.
+						0x00,
+						// Degree.
+						0x00, 0x00,
+						// Maximum parents total size in bytes.
+						0x00, 0x00,
+					},
+				},
+			}, nil),
+			stream.EXPECT().Recv().Return(&object_pb.BatchDownloadObjectsRequest{
+				RequestId: 8,
+			}, nil),
+			stream.EXPECT().Recv().Return(nil, io.EOF),
+		)
+		downloader.EXPECT().DownloadObject(gomock.Any(), object.MustNewSHA256V1GlobalReference("hello/world", "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5, 0, 0, 0)).
+			Return(object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello")), nil)
+		stream.EXPECT().Send(testutil.EqProto(t, &object_pb.BatchDownloadObjectsResponse{
+			RequestId: 7,
+			Result: &object_pb.BatchDownloadObjectsResponse_Response{
+				Response: &object_pb.DownloadObjectResponse{
+					Contents: []byte("Hello"),
+				},
+			},
+		}))
+		stream.EXPECT().Send(testutil.EqProto(t, &object_pb.BatchDownloadObjectsResponse{
+			RequestId: 8,
+			Result: &object_pb.BatchDownloadObjectsResponse_Error{
+				Error: status.New(codes.InvalidArgument, "No request provided").Proto(),
+			},
+		}))
+
+		require.NoError(t, server.BatchDownloadObjects(stream))
+	})
 }
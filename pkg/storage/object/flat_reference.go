@@ -20,7 +20,8 @@ const SHA256V1FlatReferenceSizeBytes = 35
 // FlatReference is sufficient for the read caching and local storage
 // backends, which are oblivious of references between objects.
 type FlatReference struct {
-	rawReference [SHA256V1FlatReferenceSizeBytes]byte
+	rawReference    [SHA256V1FlatReferenceSizeBytes]byte
+	referenceFormat ReferenceFormat
 }
 
 // MustNewSHA256V1LocalReference creates a flat reference that uses
@@ -42,8 +43,8 @@ func MustNewSHA256V1FlatReference(hash string, sizeBytes uint32) (flatReference
 
 // GetReferenceFormat returns the reference format that was used to
 // generate the reference.
-func (FlatReference) GetReferenceFormat() ReferenceFormat {
-	return ReferenceFormat{}
+func (r FlatReference) GetReferenceFormat() ReferenceFormat {
+	return r.referenceFormat
 }
 
 // GetRawFlatReference returns the flat reference in binary form, so
@@ -56,6 +57,7 @@ func (r FlatReference) GetRawFlatReference() []byte {
 // reference having height zero.
 func (r FlatReference) GetLocalReference() (localReference LocalReference) {
 	copy(localReference.rawReference[:], r.rawReference[:])
+	localReference.referenceFormat = r.referenceFormat
 	return localReference
 }
 
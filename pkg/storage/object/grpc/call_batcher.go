@@ -0,0 +1,200 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	status_pb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// batchedCall contains the state of a single call to DownloadObject()
+// or UploadObject() that is performed as part of a batch.
+type batchedCall[TRequest, TResponse any] struct {
+	ctx     context.Context
+	request TRequest
+
+	response TResponse
+	err      error
+	done     chan struct{}
+}
+
+func (c *batchedCall[TRequest, TResponse]) complete(response TResponse, err error) {
+	c.response = response
+	c.err = err
+	close(c.done)
+}
+
+// callBatcher coalesces calls that are performed concurrently, so that
+// they can be sent to the server as part of a single streaming RPC.
+//
+// Batching is performed opportunistically. If fewer than the maximum
+// number of batches are in flight, calls are dispatched immediately.
+// Otherwise, they are queued and sent as part of the next batch.
+type callBatcher[TRequest, TResponse any] struct {
+	maximumBatchSize         int
+	maximumConcurrentBatches int
+	performBatch             func(ctx context.Context, calls []*batchedCall[TRequest, TResponse])
+
+	lock          sync.Mutex
+	pendingCalls  []*batchedCall[TRequest, TResponse]
+	activeBatches int
+}
+
+func newCallBatcher[TRequest, TResponse any](maximumBatchSize, maximumConcurrentBatches int, performBatch func(ctx context.Context, calls []*batchedCall[TRequest, TResponse])) *callBatcher[TRequest, TResponse] {
+	return &callBatcher[TRequest, TResponse]{
+		maximumBatchSize:         max(maximumBatchSize, 1),
+		maximumConcurrentBatches: max(maximumConcurrentBatches, 1),
+		performBatch:             performBatch,
+	}
+}
+
+func (b *callBatcher[TRequest, TResponse]) call(ctx context.Context, request TRequest) (TResponse, error) {
+	c := &batchedCall[TRequest, TResponse]{
+		ctx:     ctx,
+		request: request,
+		done:    make(chan struct{}),
+	}
+
+	b.lock.Lock()
+	b.pendingCalls = append(b.pendingCalls, c)
+	if b.activeBatches < b.maximumConcurrentBatches {
+		b.activeBatches++
+		go b.processPendingCalls()
+	}
+	b.lock.Unlock()
+
+	select {
+	case <-c.done:
+		return c.response, c.err
+	case <-ctx.Done():
+		var badResponse TResponse
+		return badResponse, util.StatusFromContext(ctx)
+	}
+}
+
+func (b *callBatcher[TRequest, TResponse]) processPendingCalls() {
+	b.lock.Lock()
+	for len(b.pendingCalls) > 0 {
+		// Extract the next batch of calls, skipping the ones
+		// whose callers are no longer waiting.
+		batchSize := min(len(b.pendingCalls), b.maximumBatchSize)
+		calls := make([]*batchedCall[TRequest, TResponse], 0, batchSize)
+		for _, c := range b.pendingCalls[:batchSize] {
+			if c.ctx.Err() == nil {
+				calls = append(calls, c)
+			}
+		}
+		b.pendingCalls = b.pendingCalls[batchSize:]
+		b.lock.Unlock()
+
+		if len(calls) > 0 {
+			b.performBatchForCalls(calls)
+		}
+
+		b.lock.Lock()
+	}
+	b.pendingCalls = nil
+	b.activeBatches--
+	b.lock.Unlock()
+}
+
+func (b *callBatcher[TRequest, TResponse]) performBatchForCalls(calls []*batchedCall[TRequest, TResponse]) {
+	// The batch contains calls of multiple callers, meaning it
+	// cannot be performed using the context of any one of them.
+	// Doing so would cause deadlines and values (e.g., gRPC
+	// metadata) of one caller to apply to the calls of others.
+	// Instead, use a separate context that is only canceled if all
+	// callers have stopped waiting.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var remainingCalls atomic.Int64
+	remainingCalls.Store(int64(len(calls)))
+	stopFuncs := make([]func() bool, 0, len(calls))
+	for _, c := range calls {
+		stopFuncs = append(stopFuncs, context.AfterFunc(c.ctx, func() {
+			if remainingCalls.Add(-1) == 0 {
+				cancel()
+			}
+		}))
+	}
+	defer func() {
+		for _, stop := range stopFuncs {
+			stop()
+		}
+	}()
+
+	b.performBatch(ctx, calls)
+}
+
+// newBatchResponseError converts the error status contained in a
+// response of BatchDownloadObjects() or BatchUploadObjects() to an
+// error.
+func newBatchResponseError(s *status_pb.Status) error {
+	if err := status.ErrorProto(s); err != nil {
+		return err
+	}
+	return status.Error(codes.Internal, "Server returned an error response with status code OK")
+}
+
+// batchStream is the subset of grpc.BidiStreamingClient that is used by
+// performStreamingBatch().
+type batchStream[TStreamRequest, TStreamResponse any] interface {
+	Send(*TStreamRequest) error
+	Recv() (*TStreamResponse, error)
+	CloseSend() error
+}
+
+// performStreamingBatch sends the requests of all calls contained in a
+// batch over a bidirectional stream, and completes the calls as the
+// corresponding responses are received.
+func performStreamingBatch[TRequest, TResponse, TStreamRequest, TStreamResponse any](
+	stream batchStream[TStreamRequest, TStreamResponse],
+	calls []*batchedCall[TRequest, TResponse],
+	newStreamRequest func(requestID uint64, request TRequest) *TStreamRequest,
+	getRequestID func(response *TStreamResponse) uint64,
+	convertStreamResponse func(request TRequest, response *TStreamResponse) (TResponse, error),
+) {
+	// Send requests in the background, as the server may already
+	// start returning responses before all requests are sent.
+	go func() {
+		for i, c := range calls {
+			if stream.Send(newStreamRequest(uint64(i), c.request)) != nil {
+				// Errors are reported by Recv().
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+
+	var badResponse TResponse
+	pendingCalls := make(map[uint64]*batchedCall[TRequest, TResponse], len(calls))
+	for i, c := range calls {
+		pendingCalls[uint64(i)] = c
+	}
+	for len(pendingCalls) > 0 {
+		response, err := stream.Recv()
+		if err != nil {
+			for _, c := range pendingCalls {
+				c.complete(badResponse, err)
+			}
+			return
+		}
+		requestID := getRequestID(response)
+		c, ok := pendingCalls[requestID]
+		if !ok {
+			err := status.Errorf(codes.Internal, "Server returned a response for request %d, which was not expected", requestID)
+			for _, c := range pendingCalls {
+				c.complete(badResponse, err)
+			}
+			return
+		}
+		delete(pendingCalls, requestID)
+		c.complete(convertStreamResponse(c.request, response))
+	}
+}
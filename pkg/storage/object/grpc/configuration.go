@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	pb "bonanza.build/pkg/proto/configuration/storage/object/grpc"
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/prefetching"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// NewGRPCDownloaderFromConfiguration creates an object downloader that
+// forwards requests to a remote server using gRPC. If a batching
+// configuration is provided, requests are coalesced and sent using
+// BatchDownloadObjects(). Subtrees of downloaded objects may
+// optionally be prefetched.
+func NewGRPCDownloaderFromConfiguration(client object_pb.DownloaderClient, configuration *pb.BatchingConfiguration) object.Downloader[object.GlobalReference] {
+	if configuration == nil {
+		return NewGRPCDownloader(client)
+	}
+	downloader := NewGRPCBatchDownloader(
+		client,
+		int(configuration.MaximumBatchSize),
+		int(configuration.MaximumConcurrentBatches),
+	)
+	if prefetchingConfiguration := configuration.Prefetching; prefetchingConfiguration != nil {
+		downloader = prefetching.NewDownloader(
+			downloader,
+			semaphore.NewWeighted(prefetchingConfiguration.Concurrency),
+			int(prefetchingConfiguration.MaximumDepth),
+			int(prefetchingConfiguration.MaximumCount),
+		)
+	}
+	return downloader
+}
+
+// NewGRPCUploaderFromConfiguration creates an object uploader that
+// forwards requests to a remote server using gRPC. If a batching
+// configuration is provided, requests are coalesced and sent using
+// BatchUploadObjects().
+func NewGRPCUploaderFromConfiguration(client object_pb.UploaderClient, configuration *pb.BatchingConfiguration) object.Uploader[object.GlobalReference, []byte] {
+	if configuration == nil {
+		return NewGRPCUploader(client)
+	}
+	return NewGRPCBatchUploader(
+		client,
+		int(configuration.MaximumBatchSize),
+		int(configuration.MaximumConcurrentBatches),
+	)
+}
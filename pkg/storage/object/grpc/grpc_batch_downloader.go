@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcBatchDownloader struct {
+	client  object_pb.DownloaderClient
+	batcher *callBatcher[object.GlobalReference, *object.Contents]
+}
+
+// NewGRPCBatchDownloader creates an object downloader that forwards
+// requests to fetch objects to a remote server using gRPC. Unlike the
+// downloader returned by NewGRPCDownloader(), requests that are issued
+// concurrently are coalesced and sent to the server using
+// BatchDownloadObjects(). This reduces the number of round trips when
+// walking DAGs in parallel.
+//
+// As requests of multiple callers may be sent as part of a single
+// stream, this implementation should only be used if all callers are
+// permitted to access the same objects.
+func NewGRPCBatchDownloader(client object_pb.DownloaderClient, maximumBatchSize, maximumConcurrentBatches int) object.Downloader[object.GlobalReference] {
+	d := &grpcBatchDownloader{
+		client: client,
+	}
+	d.batcher = newCallBatcher(maximumBatchSize, maximumConcurrentBatches, d.performBatch)
+	return d
+}
+
+func (d *grpcBatchDownloader) DownloadObject(ctx context.Context, reference object.GlobalReference) (*object.Contents, error) {
+	return d.batcher.call(ctx, reference)
+}
+
+func (d *grpcBatchDownloader) performBatch(ctx context.Context, calls []*batchedCall[object.GlobalReference, *object.Contents]) {
+	stream, err := d.client.BatchDownloadObjects(ctx)
+	if err != nil {
+		for _, c := range calls {
+			c.complete(nil, err)
+		}
+		return
+	}
+
+	performStreamingBatch(
+		stream,
+		calls,
+		func(requestID uint64, reference object.GlobalReference) *object_pb.BatchDownloadObjectsRequest {
+			return &object_pb.BatchDownloadObjectsRequest{
+				RequestId: requestID,
+				Request: &object_pb.DownloadObjectRequest{
+					Namespace: reference.GetNamespace().ToProto(),
+					Reference: reference.GetRawReference(),
+				},
+			}
+		},
+		(*object_pb.BatchDownloadObjectsResponse).GetRequestId,
+		func(reference object.GlobalReference, response *object_pb.BatchDownloadObjectsResponse) (*object.Contents, error) {
+			switch result := response.Result.(type) {
+			case *object_pb.BatchDownloadObjectsResponse_Response:
+				contents, err := object.NewContentsFromFullData(reference.LocalReference, result.Response.GetContents())
+				if err != nil {
+					return nil, util.StatusWrapWithCode(err, codes.Internal, "Server returned invalid object contents")
+				}
+				return contents, nil
+			case *object_pb.BatchDownloadObjectsResponse_Error:
+				return nil, newBatchResponseError(result.Error)
+			default:
+				return nil, status.Error(codes.Internal, "Server returned a response of an unknown type")
+			}
+		},
+	)
+}
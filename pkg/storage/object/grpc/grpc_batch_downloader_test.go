@@ -0,0 +1,138 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/grpc"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestGRPCBatchDownloader(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	client := NewMockDownloaderClient(ctrl)
+	downloader := grpc.NewGRPCBatchDownloader(client, 10, 1)
+	reference := object.MustNewSHA256V1GlobalReference("hello/world", "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5, 0, 0, 0)
+	request := &object_pb.BatchDownloadObjectsRequest{
+		RequestId: 0,
+		Request: &object_pb.DownloadObjectRequest{
+			Namespace: &object_pb.Namespace{
+				InstanceName:    "hello/world",
+				ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+			},
+			Reference: []byte{
+				// SHA-256 hash.
+				0x18, 0x5f, 0x8d, 0xb3, 0x22, 0x71, 0xfe, 0x25,
+				0xf5, 0x61, 0xa6, 0xfc, 0x93, 0x8b, 0x2e, 0x26,
+				0x43, 0x06, 0xec, 0x30, 0x4e, 0xda, 0x51, 0x80,
+				0x07, 0xd1, 0x76, 0x48, 0x26, 0x38, 0x19, 0x69,
+				// Size in bytes.
+				0x05, 0x00, 0x00,
+				// Height.
+				0x00,
+				// Degree.
+				0x00, 0x00,
+				// Maximum parents total size in bytes.
+				0x00, 0x00,
+			},
+		},
+	}
+
+	// expectBatch sets up expectations for a stream over which a
+	// single request is sent. The response is only returned after
+	// the client has closed the stream for sending.
+	expectBatch := func(response *object_pb.BatchDownloadObjectsResponse, err error) {
+		stream := NewMockDownloader_BatchDownloadObjectsClient(ctrl)
+		client.EXPECT().BatchDownloadObjects(gomock.Any()).Return(stream, nil)
+		closed := make(chan struct{})
+		stream.EXPECT().Send(testutil.EqProto(t, request))
+		stream.EXPECT().CloseSend().DoAndReturn(func() error {
+			close(closed)
+			return nil
+		})
+		stream.EXPECT().Recv().DoAndReturn(func() (*object_pb.BatchDownloadObjectsResponse, error) {
+			<-closed
+			return response, err
+		})
+	}
+
+	t.Run("StreamCreationFailure", func(t *testing.T) {
+		client.EXPECT().BatchDownloadObjects(gomock.Any()).Return(nil, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("StreamFailure", func(t *testing.T) {
+		expectBatch(nil, status.Error(codes.Unavailable, "Connection reset"))
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Connection reset"), err)
+	})
+
+	t.Run("UnexpectedRequestID", func(t *testing.T) {
+		expectBatch(&object_pb.BatchDownloadObjectsResponse{
+			RequestId: 42,
+			Result: &object_pb.BatchDownloadObjectsResponse_Response{
+				Response: &object_pb.DownloadObjectResponse{
+					Contents: []byte("Hello"),
+				},
+			},
+		}, nil)
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Server returned a response for request 42, which was not expected"), err)
+	})
+
+	t.Run("ObjectError", func(t *testing.T) {
+		// Errors of individual objects should be propagated.
+		expectBatch(&object_pb.BatchDownloadObjectsResponse{
+			RequestId: 0,
+			Result: &object_pb.BatchDownloadObjectsResponse_Error{
+				Error: status.New(codes.NotFound, "Object not found").Proto(),
+			},
+		}, nil)
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("InvalidContents", func(t *testing.T) {
+		expectBatch(&object_pb.BatchDownloadObjectsResponse{
+			RequestId: 0,
+			Result: &object_pb.BatchDownloadObjectsResponse_Response{
+				Response: &object_pb.DownloadObjectResponse{
+					Contents: []byte("Hallo"),
+				},
+			},
+		}, nil)
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Server returned invalid object contents: Data has SHA-256 hash 753692ec36adb4c794c973945eb2a99c1649703ea6f76bf259abb4fb838e013e, while 185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969 was expected"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		expectBatch(&object_pb.BatchDownloadObjectsResponse{
+			RequestId: 0,
+			Result: &object_pb.BatchDownloadObjectsResponse_Response{
+				Response: &object_pb.DownloadObjectResponse{
+					Contents: []byte("Hello"),
+				},
+			},
+		}, nil)
+
+		contents, err := downloader.DownloadObject(ctx, reference)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), contents.GetFullData())
+	})
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadObjectCall contains the arguments of a call to UploadObject()
+// that is performed as part of a batch.
+type uploadObjectCall struct {
+	reference                object.GlobalReference
+	hasContents              bool
+	wantContentsIfIncomplete bool
+	request                  *object_pb.UploadObjectRequest
+}
+
+type grpcBatchUploader struct {
+	client  object_pb.UploaderClient
+	batcher *callBatcher[uploadObjectCall, object.UploadObjectResult[[]byte]]
+}
+
+// NewGRPCBatchUploader creates an object uploader that forwards
+// requests to store objects to a remote server using gRPC. Unlike the
+// uploader returned by NewGRPCUploader(), requests that are issued
+// concurrently are coalesced and sent to the server using
+// BatchUploadObjects().
+//
+// As requests of multiple callers may be sent as part of a single
+// stream, this implementation should only be used if all callers are
+// permitted to access the same objects.
+func NewGRPCBatchUploader(client object_pb.UploaderClient, maximumBatchSize, maximumConcurrentBatches int) object.Uploader[object.GlobalReference, []byte] {
+	u := &grpcBatchUploader{
+		client: client,
+	}
+	u.batcher = newCallBatcher(maximumBatchSize, maximumConcurrentBatches, u.performBatch)
+	return u
+}
+
+func (u *grpcBatchUploader) UploadObject(ctx context.Context, reference object.GlobalReference, contents *object.Contents, childrenLeases [][]byte, wantContentsIfIncomplete bool) (object.UploadObjectResult[[]byte], error) {
+	return uploadObjectWithSplitLeases(
+		reference,
+		contents,
+		childrenLeases,
+		wantContentsIfIncomplete,
+		func(request *object_pb.UploadObjectRequest, hasContents, wantContentsIfIncomplete bool) (object.UploadObjectResult[[]byte], error) {
+			return u.batcher.call(ctx, uploadObjectCall{
+				reference:                reference,
+				hasContents:              hasContents,
+				wantContentsIfIncomplete: wantContentsIfIncomplete,
+				request:                  request,
+			})
+		},
+	)
+}
+
+func (u *grpcBatchUploader) performBatch(ctx context.Context, calls []*batchedCall[uploadObjectCall, object.UploadObjectResult[[]byte]]) {
+	stream, err := u.client.BatchUploadObjects(ctx)
+	if err != nil {
+		for _, c := range calls {
+			c.complete(nil, err)
+		}
+		return
+	}
+
+	performStreamingBatch(
+		stream,
+		calls,
+		func(requestID uint64, call uploadObjectCall) *object_pb.BatchUploadObjectsRequest {
+			return &object_pb.BatchUploadObjectsRequest{
+				RequestId: requestID,
+				Request:   call.request,
+			}
+		},
+		(*object_pb.BatchUploadObjectsResponse).GetRequestId,
+		func(call uploadObjectCall, response *object_pb.BatchUploadObjectsResponse) (object.UploadObjectResult[[]byte], error) {
+			switch result := response.Result.(type) {
+			case *object_pb.BatchUploadObjectsResponse_Response:
+				return convertUploadObjectResponse(call.reference, call.hasContents, call.wantContentsIfIncomplete, result.Response, nil)
+			case *object_pb.BatchUploadObjectsResponse_Error:
+				return convertUploadObjectResponse(call.reference, call.hasContents, call.wantContentsIfIncomplete, nil, newBatchResponseError(result.Error))
+			default:
+				return nil, status.Error(codes.Internal, "Server returned a response of an unknown type")
+			}
+		},
+	)
+}
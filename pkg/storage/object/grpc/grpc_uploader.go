@@ -10,6 +10,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 type grpcUploader struct {
@@ -25,22 +26,112 @@ func NewGRPCUploader(client object_pb.UploaderClient) object.Uploader[object.Glo
 }
 
 func (u *grpcUploader) UploadObject(ctx context.Context, reference object.GlobalReference, contents *object.Contents, childrenLeases [][]byte, wantContentsIfIncomplete bool) (object.UploadObjectResult[[]byte], error) {
+	return uploadObjectWithSplitLeases(
+		reference,
+		contents,
+		childrenLeases,
+		wantContentsIfIncomplete,
+		func(request *object_pb.UploadObjectRequest, hasContents, wantContentsIfIncomplete bool) (object.UploadObjectResult[[]byte], error) {
+			response, err := u.client.UploadObject(ctx, request)
+			return convertUploadObjectResponse(reference, hasContents, wantContentsIfIncomplete, response, err)
+		},
+	)
+}
+
+// maximumLeasesSizeBytes is the maximum combined size of the leases of
+// outgoing references that are sent as part of a single
+// UploadObjectRequest. It is chosen well below gRPC's default maximum
+// message size of 4 MB, so that sufficient space remains for the
+// contents of the object.
+const maximumLeasesSizeBytes = 1 << 20
+
+// splitChildrenLeases splits the leases of the outgoing references of
+// an object into batches, so that the combined size of the leases in
+// each batch does not exceed maximumLeasesSizeBytes. Each batch has
+// the same length as the original list. Leases that are part of other
+// batches are left empty, which the server interprets as not wanting
+// to update them.
+func splitChildrenLeases(childrenLeases [][]byte) [][][]byte {
+	var batches [][][]byte
+	batchStart, batchSizeBytes := 0, 0
+	for i, lease := range childrenLeases {
+		leaseSizeBytes := protowire.SizeBytes(len(lease))
+		if i > batchStart && batchSizeBytes+leaseSizeBytes > maximumLeasesSizeBytes {
+			batch := make([][]byte, len(childrenLeases))
+			copy(batch[batchStart:i], childrenLeases[batchStart:i])
+			batches = append(batches, batch)
+			batchStart, batchSizeBytes = i, 0
+		}
+		batchSizeBytes += leaseSizeBytes
+	}
+	if len(batches) == 0 {
+		return [][][]byte{childrenLeases}
+	}
+	batch := make([][]byte, len(childrenLeases))
+	copy(batch[batchStart:], childrenLeases[batchStart:])
+	return append(batches, batch)
+}
+
+// uploadObjectWithSplitLeases performs a call to UploadObject(). If
+// the combined size of the leases of the outgoing references of the
+// object is too large to be sent as part of a single request, the
+// server is called repeatedly, providing the leases in batches.
+func uploadObjectWithSplitLeases(
+	reference object.GlobalReference,
+	contents *object.Contents,
+	childrenLeases [][]byte,
+	wantContentsIfIncomplete bool,
+	uploadObject func(request *object_pb.UploadObjectRequest, hasContents, wantContentsIfIncomplete bool) (object.UploadObjectResult[[]byte], error),
+) (object.UploadObjectResult[[]byte], error) {
+	leasesBatches := splitChildrenLeases(childrenLeases)
+	lastBatch := len(leasesBatches) - 1
+	for _, leasesBatch := range leasesBatches[:lastBatch] {
+		result, err := uploadObject(
+			newUploadObjectRequest(reference, contents, leasesBatch, false),
+			contents != nil,
+			/* wantContentsIfIncomplete = */ false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := result.(object.UploadObjectIncomplete[[]byte]); !ok {
+			// The object is either missing, or the server
+			// already has valid leases for all outgoing
+			// references.
+			return result, nil
+		}
+		// The contents only need to be provided once.
+		contents = nil
+	}
+	return uploadObject(
+		newUploadObjectRequest(reference, contents, leasesBatches[lastBatch], wantContentsIfIncomplete),
+		contents != nil,
+		wantContentsIfIncomplete,
+	)
+}
+
+// newUploadObjectRequest creates the gRPC request message that
+// corresponds to a call to UploadObject().
+func newUploadObjectRequest(reference object.GlobalReference, contents *object.Contents, childrenLeases [][]byte, wantContentsIfIncomplete bool) *object_pb.UploadObjectRequest {
 	request := &object_pb.UploadObjectRequest{
-		Namespace: reference.GetNamespace().ToProto(),
-		Reference: reference.GetRawReference(),
-		// TODO: Issue multiple gRPC calls if the combined size
-		// of all leases exceeds gRPC's maximum message size.
+		Namespace:                reference.GetNamespace().ToProto(),
+		Reference:                reference.GetRawReference(),
 		OutgoingReferencesLeases: childrenLeases,
 		WantContentsIfIncomplete: wantContentsIfIncomplete,
 	}
 	if contents != nil {
 		request.Contents = contents.GetFullData()
 	}
+	return request
+}
 
-	response, err := u.client.UploadObject(ctx, request)
+// convertUploadObjectResponse validates the gRPC response message
+// returned by the server, and converts it to the result of
+// UploadObject().
+func convertUploadObjectResponse(reference object.GlobalReference, hasContents, wantContentsIfIncomplete bool, response *object_pb.UploadObjectResponse, err error) (object.UploadObjectResult[[]byte], error) {
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
-			if contents != nil {
+			if hasContents {
 				return nil, util.StatusWrapWithCode(err, codes.Internal, "Server reported the object as missing, even though contents were provided")
 			}
 			return object.UploadObjectMissing[[]byte]{}, nil
@@ -48,7 +139,7 @@ func (u *grpcUploader) UploadObject(ctx context.Context, reference object.Global
 		return nil, err
 	}
 
-	switch responseType := response.Type.(type) {
+	switch responseType := response.GetType().(type) {
 	case *object_pb.UploadObjectResponse_Complete_:
 		lease := responseType.Complete.Lease
 		if len(lease) == 0 {
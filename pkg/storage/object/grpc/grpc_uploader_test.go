@@ -1,6 +1,7 @@
 package grpc_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -577,4 +578,55 @@ func TestGRPCUploader(t *testing.T) {
 			WantOutgoingReferencesLeases: []int{3, 7},
 		}, result)
 	})
+
+	t.Run("SplitLeases", func(t *testing.T) {
+		// If the combined size of the leases exceeds the maximum
+		// size of a gRPC message, they should be provided to
+		// the server in batches.
+		reference := object.MustNewSHA256V1GlobalReference("hello/world", "3ae1ec3a8baa2a2fb5e6d3ab14b0a9fa7b4b58c72d9c7a07b1b0d3e4b3a9b5e8", 320, 1, 2, 0)
+		lease1 := bytes.Repeat([]byte{1}, 600000)
+		lease2 := bytes.Repeat([]byte{2}, 600000)
+		gomock.InOrder(
+			client.EXPECT().UploadObject(ctx, testutil.EqProto(t, &object_pb.UploadObjectRequest{
+				Namespace: &object_pb.Namespace{
+					InstanceName:    "hello/world",
+					ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+				},
+				Reference:                reference.GetRawReference(),
+				OutgoingReferencesLeases: [][]byte{lease1, nil},
+			})).Return(&object_pb.UploadObjectResponse{
+				Type: &object_pb.UploadObjectResponse_Incomplete_{
+					Incomplete: &object_pb.UploadObjectResponse_Incomplete{
+						WantOutgoingReferencesLeases: []uint32{1},
+					},
+				},
+			}, nil),
+			client.EXPECT().UploadObject(ctx, testutil.EqProto(t, &object_pb.UploadObjectRequest{
+				Namespace: &object_pb.Namespace{
+					InstanceName:    "hello/world",
+					ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+				},
+				Reference:                reference.GetRawReference(),
+				OutgoingReferencesLeases: [][]byte{nil, lease2},
+			})).Return(&object_pb.UploadObjectResponse{
+				Type: &object_pb.UploadObjectResponse_Complete_{
+					Complete: &object_pb.UploadObjectResponse_Complete{
+						Lease: []byte{3},
+					},
+				},
+			}, nil),
+		)
+
+		result, err := uploader.UploadObject(
+			ctx,
+			reference,
+			/* contents = */ nil,
+			[][]byte{lease1, lease2},
+			/* wantContentsIfIncomplete = */ false,
+		)
+		require.NoError(t, err)
+		require.Equal(t, object.UploadObjectComplete[[]byte]{
+			Lease: []byte{3},
+		}, result)
+	})
 }
@@ -4,7 +4,6 @@ import (
 	"encoding/binary"
 
 	"bonanza.build/pkg/ds/lossymap"
-	object_pb "bonanza.build/pkg/proto/storage/object"
 	"bonanza.build/pkg/storage/object"
 
 	"github.com/buildbarn/bb-storage/pkg/blockdevice"
@@ -21,7 +20,7 @@ const (
 	// fields:
 	//
 	// - Epoch ID                     4 bytes
-	// - SHA256_V1 flat reference    35 bytes
+	// - Flat reference              35 bytes
 	// - Hash table probing attempt   1 bytes
 	// - Object location              8 bytes
 	// - Record checksum              8 bytes
@@ -29,6 +28,24 @@ const (
 	BlockDeviceBackedReferenceLocationRecordSize = 4 + object.SHA256V1FlatReferenceSizeBytes + 1 + 8 + 8
 )
 
+// referenceFormatChecksumSeeds contains the reference formats that can
+// be stored in a ReferenceLocationRecord. As all reference formats use
+// the same serialized representation, the reference format is encoded
+// by XORing a format specific value into the initialization of the
+// record checksum. As every step of FNV-1a is a bijection, records
+// written using one reference format can never have a valid checksum
+// for another reference format.
+//
+// SHA256_V1 uses value zero, so that records written prior to the
+// introduction of other reference formats remain valid.
+var referenceFormatChecksumSeeds = [...]struct {
+	referenceFormat object.ReferenceFormat
+	checksumSeed    uint64
+}{
+	{object.SHA256V1ReferenceFormat, 0},
+	{object.SHA512_256V1ReferenceFormat, 0x9e3779b97f4a7c15},
+}
+
 type blockDeviceBackedLocationRecordArray struct {
 	device blockdevice.BlockDevice
 }
@@ -72,13 +89,19 @@ func (lra *blockDeviceBackedLocationRecordArray) Get(index uint64, resolver Epoc
 	// Discard entries for which the checksum of the record doesn't
 	// match up with what's expected. Such records may have either
 	// been corrupted or correspond to objects that weren't flushed
-	// before some previous shutdown.
-	if computeChecksumForRecord(&record, epochState.HashSeed) != binary.LittleEndian.Uint64(record[4+object.SHA256V1FlatReferenceSizeBytes+1+8:]) {
+	// before some previous shutdown. The checksum also determines
+	// the reference format of the record.
+	checksum := binary.LittleEndian.Uint64(record[4+object.SHA256V1FlatReferenceSizeBytes+1+8:])
+	formatIndex := 0
+	for formatIndex < len(referenceFormatChecksumSeeds) && computeChecksumForRecord(&record, epochState.HashSeed^referenceFormatChecksumSeeds[formatIndex].checksumSeed) != checksum {
+		formatIndex++
+	}
+	if formatIndex == len(referenceFormatChecksumSeeds) {
 		return ReferenceLocationRecord{}, lossymap.ErrRecordInvalidOrExpired
 	}
 
 	// Deserialize the read record into a ReferenceLocationRecord.
-	reference, err := object.SHA256V1ReferenceFormat.NewFlatReference(
+	reference, err := referenceFormatChecksumSeeds[formatIndex].referenceFormat.NewFlatReference(
 		record[4 : 4+object.SHA256V1FlatReferenceSizeBytes],
 	)
 	if err != nil {
@@ -103,8 +126,13 @@ func (lra *blockDeviceBackedLocationRecordArray) Get(index uint64, resolver Epoc
 
 func (lra *blockDeviceBackedLocationRecordArray) Put(index uint64, record ReferenceLocationRecord, resolver EpochIDResolver) error {
 	reference := record.RecordKey.Key
-	if reference.GetReferenceFormat().ToProto() != object_pb.ReferenceFormat_SHA256_V1 {
-		return status.Error(codes.Unimplemented, "This implementation only supports reference format SHA256_V1")
+	referenceFormat := reference.GetReferenceFormat()
+	formatIndex := 0
+	for formatIndex < len(referenceFormatChecksumSeeds) && referenceFormatChecksumSeeds[formatIndex].referenceFormat != referenceFormat {
+		formatIndex++
+	}
+	if formatIndex == len(referenceFormatChecksumSeeds) {
+		return status.Errorf(codes.Unimplemented, "This implementation does not support reference format %s", referenceFormat)
 	}
 
 	epochState, epochID := resolver.GetCurrentEpochState()
@@ -116,7 +144,7 @@ func (lra *blockDeviceBackedLocationRecordArray) Put(index uint64, record Refere
 		copy(rawRecord[4:], record.RecordKey.Key.GetRawFlatReference())
 		rawRecord[4+object.SHA256V1FlatReferenceSizeBytes] = record.RecordKey.Attempt
 		binary.LittleEndian.PutUint64(rawRecord[4+object.SHA256V1FlatReferenceSizeBytes+1:], location)
-		binary.LittleEndian.PutUint64(rawRecord[4+object.SHA256V1FlatReferenceSizeBytes+1+8:], computeChecksumForRecord(&rawRecord, epochState.HashSeed))
+		binary.LittleEndian.PutUint64(rawRecord[4+object.SHA256V1FlatReferenceSizeBytes+1+8:], computeChecksumForRecord(&rawRecord, epochState.HashSeed^referenceFormatChecksumSeeds[formatIndex].checksumSeed))
 
 		if _, err := lra.device.WriteAt(rawRecord[:], int64(index)*BlockDeviceBackedReferenceLocationRecordSize); err != nil {
 			return util.StatusWrap(err, "Failed to write location record")
@@ -0,0 +1,94 @@
+package local_test
+
+import (
+	"testing"
+
+	"bonanza.build/pkg/ds/lossymap"
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/local"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+type fixedEpochIDResolver struct {
+	epochState local.EpochState
+}
+
+func (r fixedEpochIDResolver) GetEpochStateForEpochID(epochID uint32) (local.EpochState, bool) {
+	return r.epochState, epochID == 7
+}
+
+func (r fixedEpochIDResolver) GetCurrentEpochState() (local.EpochState, uint32) {
+	return r.epochState, 7
+}
+
+func TestBlockDeviceBackedReferenceLocationRecordArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Let the block device behave like a plain byte array.
+	var storage [2 * local.BlockDeviceBackedReferenceLocationRecordSize]byte
+	blockDevice := NewMockBlockDevice(ctrl)
+	blockDevice.EXPECT().ReadAt(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(p []byte, offset int64) (int, error) {
+			return copy(p, storage[offset:]), nil
+		}).
+		AnyTimes()
+	blockDevice.EXPECT().WriteAt(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(p []byte, offset int64) (int, error) {
+			return copy(storage[offset:], p), nil
+		}).
+		AnyTimes()
+
+	recordArray := local.NewBlockDeviceBackedReferenceLocationRecordArray(blockDevice)
+	resolver := fixedEpochIDResolver{
+		epochState: local.EpochState{
+			HashSeed:        0x5d2e8bb9b6e6e1a4,
+			MinimumLocation: 100,
+			MaximumLocation: 1000,
+		},
+	}
+
+	t.Run("ReferenceFormats", func(t *testing.T) {
+		// Records of both reference formats should be stored
+		// using the same layout. The reference format should be
+		// preserved when reading them back.
+		sha256Reference := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello")).Flatten()
+		sha512_256Reference := object.MustNewContents(object_pb.ReferenceFormat_SHA512_256_V1, nil, []byte("Hello")).Flatten()
+
+		for index, reference := range []object.FlatReference{sha256Reference, sha512_256Reference} {
+			record := local.ReferenceLocationRecord{
+				RecordKey: lossymap.RecordKey[object.FlatReference]{
+					Key:     reference,
+					Attempt: 3,
+				},
+				Value: 500,
+			}
+			require.NoError(t, recordArray.Put(uint64(index), record, resolver))
+
+			actualRecord, err := recordArray.Get(uint64(index), resolver)
+			require.NoError(t, err)
+			require.Equal(t, record, actualRecord)
+			require.Equal(t, reference.GetReferenceFormat(), actualRecord.RecordKey.Key.GetReferenceFormat())
+		}
+	})
+
+	t.Run("CorruptedRecord", func(t *testing.T) {
+		// Records whose checksum does not match for any of the
+		// reference formats should be ignored.
+		storage[10] ^= 0x01
+		_, err := recordArray.Get(0, resolver)
+		require.Equal(t, lossymap.ErrRecordInvalidOrExpired, err)
+		storage[10] ^= 0x01
+	})
+
+	t.Run("DifferentHashSeed", func(t *testing.T) {
+		// Changing the hash seed should invalidate all records.
+		otherResolver := resolver
+		otherResolver.epochState.HashSeed++
+		_, err := recordArray.Get(1, otherResolver)
+		require.Equal(t, lossymap.ErrRecordInvalidOrExpired, err)
+	})
+}
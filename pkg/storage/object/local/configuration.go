@@ -23,10 +23,22 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// NewStoreFromConfiguration creates a new local object store that uses
-// the block devices and parameters specified in a Protobuf
-// configuration message.
-func NewStoreFromConfiguration(terminationGroup program.Group, configuration *configuration_pb.StoreConfiguration) (object.Store[object.FlatReference, struct{}], error) {
+// storeBackends contains the data stores backing a local object store,
+// and the persistent state that was reloaded from disk.
+type storeBackends struct {
+	persistentStateStore         PersistentStateStore
+	initialPersistentState       *pb.PersistentState
+	referenceLocationRecordArray ReferenceLocationRecordArray
+	referenceLocationMapEntries  uint64
+	locationBlobMap              LocationBlobMap
+	maximumLocationSpan          uint64
+	dataSyncer                   DataSyncer
+}
+
+// newStoreBackendsFromConfiguration creates the reference-location
+// map and location-blob map of a local object store. If persistency is
+// enabled, the persistent state is reloaded from disk as well.
+func newStoreBackendsFromConfiguration(configuration *configuration_pb.StoreConfiguration) (*storeBackends, error) {
 	if configuration == nil {
 		return nil, status.Error(codes.InvalidArgument, "No configuration provided")
 	}
@@ -74,40 +86,6 @@ func NewStoreFromConfiguration(terminationGroup program.Group, configuration *co
 		return nil, status.Error(codes.InvalidArgument, "No reference-location map backend provided")
 	}
 
-	referenceLocationMapHashInitialization := initialPersistentState.ReferenceLocationMapHashInitialization
-	locationComparator := func(a, b *uint64) int {
-		if *a < *b {
-			return -1
-		}
-		if *a > *b {
-			return 1
-		}
-		return 0
-	}
-	referenceLocationMap := lossymap.NewHashMap(
-		referenceLocationRecordArray,
-		/* recordKeyHasher = */ func(k *lossymap.RecordKey[object.FlatReference]) uint64 {
-			// Compute a FNV-1a hash of the record key.
-			h := referenceLocationMapHashInitialization
-			for _, c := range k.Key.GetRawFlatReference() {
-				h ^= uint64(c)
-				h *= 1099511628211
-			}
-			attempt := k.Attempt
-			for i := 0; i < 4; i++ {
-				h ^= uint64(attempt & 0xff)
-				h *= 1099511628211
-				attempt >>= 8
-			}
-			return h
-		},
-		referenceLocationMapEntries,
-		locationComparator,
-		uint8(configuration.ReferenceLocationMapMaximumGetAttempts),
-		int(configuration.ReferenceLocationMapMaximumPutAttempts),
-		"ReferenceLocationMap",
-	)
-
 	// Construct the location-blob map that stores the contents of
 	// the objects.
 	var dataSyncer DataSyncer = func() error { return nil }
@@ -150,6 +128,62 @@ func NewStoreFromConfiguration(terminationGroup program.Group, configuration *co
 		return nil, status.Error(codes.InvalidArgument, "No reference-location map backend provided")
 	}
 
+	return &storeBackends{
+		persistentStateStore:         persistentStateStore,
+		initialPersistentState:       initialPersistentState,
+		referenceLocationRecordArray: referenceLocationRecordArray,
+		referenceLocationMapEntries:  referenceLocationMapEntries,
+		locationBlobMap:              locationBlobMap,
+		maximumLocationSpan:          maximumLocationSpan,
+		dataSyncer:                   dataSyncer,
+	}, nil
+}
+
+// NewStoreFromConfiguration creates a new local object store that uses
+// the block devices and parameters specified in a Protobuf
+// configuration message.
+func NewStoreFromConfiguration(terminationGroup program.Group, configuration *configuration_pb.StoreConfiguration) (object.Store[object.FlatReference, struct{}], error) {
+	backends, err := newStoreBackendsFromConfiguration(configuration)
+	if err != nil {
+		return nil, err
+	}
+	initialPersistentState := backends.initialPersistentState
+	maximumLocationSpan := backends.maximumLocationSpan
+
+	referenceLocationMapHashInitialization := initialPersistentState.ReferenceLocationMapHashInitialization
+	locationComparator := func(a, b *uint64) int {
+		if *a < *b {
+			return -1
+		}
+		if *a > *b {
+			return 1
+		}
+		return 0
+	}
+	referenceLocationMap := lossymap.NewHashMap(
+		backends.referenceLocationRecordArray,
+		/* recordKeyHasher = */ func(k *lossymap.RecordKey[object.FlatReference]) uint64 {
+			// Compute a FNV-1a hash of the record key.
+			h := referenceLocationMapHashInitialization
+			for _, c := range k.Key.GetRawFlatReference() {
+				h ^= uint64(c)
+				h *= 1099511628211
+			}
+			attempt := k.Attempt
+			for i := 0; i < 4; i++ {
+				h ^= uint64(attempt & 0xff)
+				h *= 1099511628211
+				attempt >>= 8
+			}
+			return h
+		},
+		backends.referenceLocationMapEntries,
+		locationComparator,
+		uint8(configuration.ReferenceLocationMapMaximumGetAttempts),
+		int(configuration.ReferenceLocationMapMaximumPutAttempts),
+		"ReferenceLocationMap",
+	)
+
 	// Construct the epoch list that tracks which objects whose
 	// contents have been synced to disk properly. This is necessary
 	// to reliably continue after restarts.
@@ -173,13 +207,13 @@ func NewStoreFromConfiguration(terminationGroup program.Group, configuration *co
 		periodicSyncer := NewPeriodicSyncer(
 			persistentEpochList,
 			&globalLock,
-			persistentStateStore,
+			backends.persistentStateStore,
 			clock.SystemClock,
 			util.DefaultErrorLogger,
 			/* errorRetryInterval = */ 10*time.Second,
 			minimumEpochInterval.AsDuration(),
 			referenceLocationMapHashInitialization,
-			dataSyncer,
+			backends.dataSyncer,
 		)
 		terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
 			for periodicSyncer.ProcessLocationsChanged(ctx) {
@@ -196,10 +230,119 @@ func NewStoreFromConfiguration(terminationGroup program.Group, configuration *co
 		)
 	}
 
+	// Determine which objects need to be refreshed when accessed.
+	// If the sizes of the regions are left unset, never refresh
+	// any objects.
+	var refreshPolicy RefreshPolicy = NeverRefreshPolicy
+	if configuration.OldRegionSizeRatio > 0 || configuration.CurrentRegionSizeRatio > 0 || configuration.NewRegionSizeRatio > 0 {
+		refreshPolicy = NewRegionBasedRefreshPolicy(
+			maximumLocationSpan,
+			configuration.OldRegionSizeRatio,
+			configuration.CurrentRegionSizeRatio,
+			configuration.NewRegionSizeRatio,
+			random.FastThreadSafeGenerator,
+		)
+		if rateLimit := configuration.RefreshRateLimit; rateLimit != nil {
+			refreshPolicy = NewRateLimitingRefreshPolicy(
+				refreshPolicy,
+				clock.SystemClock,
+				rateLimit.BytesPerSecond,
+				rateLimit.BurstSizeBytes,
+			)
+		}
+	}
+
+	// If enabled, periodically validate the contents of all
+	// objects, so that corruption is detected proactively.
+	if scfg := configuration.Scrubbing; scfg != nil {
+		interval := scfg.Interval
+		if err := interval.CheckValid(); err != nil {
+			return nil, util.StatusWrap(err, "Invalid scrubbing interval")
+		}
+		scrubber := NewScrubber(
+			&globalLock,
+			backends.referenceLocationRecordArray,
+			backends.referenceLocationMapEntries,
+			backends.locationBlobMap,
+			epochList,
+			clock.SystemClock,
+			scfg.BytesPerSecond,
+		)
+		terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			for {
+				if _, err := scrubber.ScrubAll(ctx); err != nil && ctx.Err() == nil {
+					util.DefaultErrorLogger.Log(util.StatusWrap(err, "Failed to scrub local object store"))
+				}
+				timer, t := clock.SystemClock.NewTimer(interval.AsDuration())
+				select {
+				case <-t:
+				case <-ctx.Done():
+					timer.Stop()
+					return nil
+				}
+			}
+		})
+	}
+
 	return NewStore(
 		&globalLock,
 		referenceLocationMap,
-		locationBlobMap,
+		backends.locationBlobMap,
 		epochList,
+		refreshPolicy,
 	), nil
 }
+
+// CheckStoreFromConfiguration performs an offline integrity check of
+// a persistent local object store, similar to fsck. It validates the
+// contents of all objects referenced by the reference-location map.
+// This function may only be called while the local object store is not
+// in use by any other process.
+//
+// If discardCorruptedObjects is set and corruption is detected, the
+// persistent state is updated to discard all data up to and including
+// the location of the last corrupted object.
+func CheckStoreFromConfiguration(ctx context.Context, configuration *configuration_pb.StoreConfiguration, discardCorruptedObjects bool) (ScrubResult, error) {
+	if configuration != nil && configuration.Persistent == nil {
+		return ScrubResult{}, status.Error(codes.InvalidArgument, "Only local object stores that have persistency enabled can be checked")
+	}
+	backends, err := newStoreBackendsFromConfiguration(configuration)
+	if err != nil {
+		return ScrubResult{}, err
+	}
+	initialPersistentState := backends.initialPersistentState
+	epochList := NewPersistentEpochList(
+		backends.maximumLocationSpan,
+		random.NewFastSingleThreadedGenerator(),
+		initialPersistentState.MinimumEpochId,
+		initialPersistentState.MinimumLocation,
+		initialPersistentState.Epochs,
+	)
+
+	var globalLock sync.RWMutex
+	result, err := NewScrubber(
+		&globalLock,
+		backends.referenceLocationRecordArray,
+		backends.referenceLocationMapEntries,
+		backends.locationBlobMap,
+		epochList,
+		clock.SystemClock,
+		/* bytesPerSecond = */ 0,
+	).ScrubAll(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	if discardCorruptedObjects && result.CorruptedObjects > 0 {
+		minimumEpochID, minimumLocation, epochs := epochList.GetPersistentState()
+		if err := backends.persistentStateStore.WritePersistentState(&pb.PersistentState{
+			MinimumEpochId:                         minimumEpochID,
+			MinimumLocation:                        minimumLocation,
+			Epochs:                                 epochs,
+			ReferenceLocationMapHashInitialization: initialPersistentState.ReferenceLocationMapHashInitialization,
+		}); err != nil {
+			return result, util.StatusWrap(err, "Failed to write persistent state")
+		}
+	}
+	return result, nil
+}
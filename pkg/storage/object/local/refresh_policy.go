@@ -0,0 +1,181 @@
+package local
+
+import (
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/random"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	refreshPolicyPrometheusMetrics sync.Once
+
+	refreshPolicyDecisions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "object_local",
+			Name:      "refresh_policy_decisions_total",
+			Help:      "Number of times objects were accessed, grouped by the region of the location-blob map in which they were stored, and whether they needed to be refreshed",
+		},
+		[]string{"region", "decision"},
+	)
+
+	refreshPolicyRateLimitedObjects = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "object_local",
+			Name:      "refresh_policy_rate_limited_objects_total",
+			Help:      "Number of objects that needed to be refreshed, but were not refreshed due to rate limiting",
+		},
+	)
+	refreshPolicyRateLimitedBytes = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "object_local",
+			Name:      "refresh_policy_rate_limited_bytes_total",
+			Help:      "Total size of objects in bytes that needed to be refreshed, but were not refreshed due to rate limiting",
+		},
+	)
+)
+
+// RefreshPolicy is called into by the local object store whenever an
+// object is accessed, to determine whether the object needs to be
+// refreshed. Refreshing an object causes it to be copied to the write
+// cursor of the location-blob map, so that it is not overwritten when
+// the location-blob map wraps around.
+//
+// Implementations of RefreshPolicy need to be safe for concurrent use.
+type RefreshPolicy interface {
+	ShouldRefresh(location uint64, sizeBytes int, epochState *EpochState) bool
+}
+
+type neverRefreshPolicy struct{}
+
+func (neverRefreshPolicy) ShouldRefresh(location uint64, sizeBytes int, epochState *EpochState) bool {
+	return false
+}
+
+// NeverRefreshPolicy is an implementation of RefreshPolicy that never
+// refreshes objects, causing the local object store to behave like a
+// FIFO.
+var NeverRefreshPolicy RefreshPolicy = neverRefreshPolicy{}
+
+type regionBasedRefreshPolicy struct {
+	newRegionSizeBytes     uint64
+	currentRegionSizeBytes uint64
+	randomNumberGenerator  random.ThreadSafeGenerator
+
+	newRegionKeep        prometheus.Counter
+	currentRegionKeep    prometheus.Counter
+	currentRegionRefresh prometheus.Counter
+	oldRegionRefresh     prometheus.Counter
+}
+
+// NewRegionBasedRefreshPolicy creates a RefreshPolicy that partitions
+// the location-blob map into "old", "current" and "new" regions, as
+// described in the configuration of the local object store. Objects in
+// the "old" region are always refreshed, while objects in the "new"
+// region are never refreshed. Objects in the "current" region are
+// refreshed with a probability that increases linearly the closer they
+// get to the "old" region.
+func NewRegionBasedRefreshPolicy(maximumLocationSpan uint64, oldRegionSizeRatio, currentRegionSizeRatio, newRegionSizeRatio uint32, randomNumberGenerator random.ThreadSafeGenerator) RefreshPolicy {
+	refreshPolicyPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(refreshPolicyDecisions)
+		prometheus.MustRegister(refreshPolicyRateLimitedObjects)
+		prometheus.MustRegister(refreshPolicyRateLimitedBytes)
+	})
+
+	totalRatio := uint64(oldRegionSizeRatio) + uint64(currentRegionSizeRatio) + uint64(newRegionSizeRatio)
+	return &regionBasedRefreshPolicy{
+		newRegionSizeBytes:     maximumLocationSpan / totalRatio * uint64(newRegionSizeRatio),
+		currentRegionSizeBytes: maximumLocationSpan / totalRatio * uint64(currentRegionSizeRatio),
+		randomNumberGenerator:  randomNumberGenerator,
+
+		newRegionKeep:        refreshPolicyDecisions.WithLabelValues("New", "Keep"),
+		currentRegionKeep:    refreshPolicyDecisions.WithLabelValues("Current", "Keep"),
+		currentRegionRefresh: refreshPolicyDecisions.WithLabelValues("Current", "Refresh"),
+		oldRegionRefresh:     refreshPolicyDecisions.WithLabelValues("Old", "Refresh"),
+	}
+}
+
+func (rp *regionBasedRefreshPolicy) ShouldRefresh(location uint64, sizeBytes int, epochState *EpochState) bool {
+	// Compute how far the object is removed from the write cursor.
+	distance := epochState.MaximumLocation - location
+	if distance <= rp.newRegionSizeBytes {
+		rp.newRegionKeep.Inc()
+		return false
+	}
+	distance -= rp.newRegionSizeBytes
+	if distance < rp.currentRegionSizeBytes {
+		if uint64(rp.randomNumberGenerator.Int64N(int64(rp.currentRegionSizeBytes))) >= distance {
+			rp.currentRegionKeep.Inc()
+			return false
+		}
+		rp.currentRegionRefresh.Inc()
+		return true
+	}
+	rp.oldRegionRefresh.Inc()
+	return true
+}
+
+type rateLimitingRefreshPolicy struct {
+	base           RefreshPolicy
+	clock          clock.Clock
+	bytesPerSecond float64
+	burstSizeBytes float64
+
+	lock           sync.Mutex
+	availableBytes float64
+	lastRefill     time.Time
+}
+
+// NewRateLimitingRefreshPolicy creates a decorator for RefreshPolicy
+// that limits the rate at which objects are refreshed, using a token
+// bucket whose size is expressed in bytes. This prevents situations
+// where reading large amounts of data stored in the "old" region causes
+// so much data to be refreshed that it starves regular writes, or
+// causes other objects to be pushed out of storage prematurely.
+func NewRateLimitingRefreshPolicy(base RefreshPolicy, clock clock.Clock, bytesPerSecond, burstSizeBytes uint64) RefreshPolicy {
+	refreshPolicyPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(refreshPolicyDecisions)
+		prometheus.MustRegister(refreshPolicyRateLimitedObjects)
+		prometheus.MustRegister(refreshPolicyRateLimitedBytes)
+	})
+
+	return &rateLimitingRefreshPolicy{
+		base:           base,
+		clock:          clock,
+		bytesPerSecond: float64(bytesPerSecond),
+		burstSizeBytes: float64(burstSizeBytes),
+
+		availableBytes: float64(burstSizeBytes),
+		lastRefill:     clock.Now(),
+	}
+}
+
+func (rp *rateLimitingRefreshPolicy) ShouldRefresh(location uint64, sizeBytes int, epochState *EpochState) bool {
+	if !rp.base.ShouldRefresh(location, sizeBytes, epochState) {
+		return false
+	}
+
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+
+	// Refill the token bucket based on the amount of time that
+	// passed since the last refresh.
+	now := rp.clock.Now()
+	if elapsed := now.Sub(rp.lastRefill); elapsed > 0 {
+		rp.availableBytes = min(rp.availableBytes+elapsed.Seconds()*rp.bytesPerSecond, rp.burstSizeBytes)
+		rp.lastRefill = now
+	}
+
+	if size := float64(sizeBytes); rp.availableBytes >= size {
+		rp.availableBytes -= size
+		return true
+	}
+	refreshPolicyRateLimitedObjects.Inc()
+	refreshPolicyRateLimitedBytes.Add(float64(sizeBytes))
+	return false
+}
@@ -0,0 +1,97 @@
+package local_test
+
+import (
+	"testing"
+	"time"
+
+	"bonanza.build/pkg/storage/object/local"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRegionBasedRefreshPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Use a location-blob map of 1000 bytes, of which 200 bytes
+	// are "old", 200 bytes are "current" and 600 bytes are "new".
+	randomNumberGenerator := NewMockThreadSafeGenerator(ctrl)
+	refreshPolicy := local.NewRegionBasedRefreshPolicy(1000, 1, 1, 3, randomNumberGenerator)
+	epochState := local.EpochState{
+		MinimumLocation: 9000,
+		MaximumLocation: 10000,
+	}
+
+	t.Run("NewRegion", func(t *testing.T) {
+		require.False(t, refreshPolicy.ShouldRefresh(9999, 1, &epochState))
+		require.False(t, refreshPolicy.ShouldRefresh(9400, 100, &epochState))
+	})
+
+	t.Run("CurrentRegion", func(t *testing.T) {
+		// The probability of refreshing increases linearly
+		// with the distance from the "new" region.
+		randomNumberGenerator.EXPECT().Int64N(int64(200)).Return(int64(50))
+		require.False(t, refreshPolicy.ShouldRefresh(9350, 100, &epochState))
+
+		randomNumberGenerator.EXPECT().Int64N(int64(200)).Return(int64(49))
+		require.True(t, refreshPolicy.ShouldRefresh(9350, 100, &epochState))
+
+		randomNumberGenerator.EXPECT().Int64N(int64(200)).Return(int64(198))
+		require.True(t, refreshPolicy.ShouldRefresh(9201, 100, &epochState))
+	})
+
+	t.Run("OldRegion", func(t *testing.T) {
+		require.True(t, refreshPolicy.ShouldRefresh(9200, 100, &epochState))
+		require.True(t, refreshPolicy.ShouldRefresh(9000, 100, &epochState))
+	})
+}
+
+func TestRateLimitingRefreshPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	baseRefreshPolicy := NewMockRefreshPolicy(ctrl)
+	clock := NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	refreshPolicy := local.NewRateLimitingRefreshPolicy(baseRefreshPolicy, clock, 100, 300)
+	epochState := local.EpochState{
+		MinimumLocation: 9000,
+		MaximumLocation: 10000,
+	}
+
+	t.Run("NoRefreshNeeded", func(t *testing.T) {
+		// Objects that don't need to be refreshed should not
+		// consume any of the available capacity.
+		baseRefreshPolicy.EXPECT().ShouldRefresh(uint64(9900), 50, &epochState).Return(false)
+
+		require.False(t, refreshPolicy.ShouldRefresh(9900, 50, &epochState))
+	})
+
+	t.Run("Burst", func(t *testing.T) {
+		// Initially the full burst size is available.
+		baseRefreshPolicy.EXPECT().ShouldRefresh(gomock.Any(), 200, &epochState).Return(true).Times(2)
+		clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(2)
+
+		require.True(t, refreshPolicy.ShouldRefresh(9000, 200, &epochState))
+		require.False(t, refreshPolicy.ShouldRefresh(9200, 200, &epochState))
+	})
+
+	t.Run("Refill", func(t *testing.T) {
+		// After one second, 100 bytes of capacity are added,
+		// meaning that 200 bytes are available.
+		baseRefreshPolicy.EXPECT().ShouldRefresh(uint64(9200), 200, &epochState).Return(true)
+		clock.EXPECT().Now().Return(time.Unix(1001, 0))
+
+		require.True(t, refreshPolicy.ShouldRefresh(9200, 200, &epochState))
+	})
+
+	t.Run("RefillUpToBurstSize", func(t *testing.T) {
+		// Capacity should not exceed the burst size, even if
+		// the policy is idle for a long time.
+		baseRefreshPolicy.EXPECT().ShouldRefresh(gomock.Any(), 300, &epochState).Return(true).Times(2)
+		clock.EXPECT().Now().Return(time.Unix(2000, 0)).Times(2)
+
+		require.True(t, refreshPolicy.ShouldRefresh(9000, 300, &epochState))
+		require.False(t, refreshPolicy.ShouldRefresh(9300, 300, &epochState))
+	})
+}
@@ -0,0 +1,202 @@
+package local
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bonanza.build/pkg/ds/lossymap"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	scrubberPrometheusMetrics sync.Once
+
+	scrubberObjects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "object_local",
+			Name:      "scrubber_objects_total",
+			Help:      "Number of objects whose contents were validated by the scrubber",
+		},
+		[]string{"outcome"},
+	)
+	scrubberObjectsValid       = scrubberObjects.WithLabelValues("Valid")
+	scrubberObjectsCorrupted   = scrubberObjects.WithLabelValues("Corrupted")
+	scrubberObjectsOverwritten = scrubberObjects.WithLabelValues("Overwritten")
+
+	scrubberBytes = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "object_local",
+			Name:      "scrubber_bytes_total",
+			Help:      "Total size of objects in bytes whose contents were validated by the scrubber",
+		},
+	)
+	scrubberPassesCompleted = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "object_local",
+			Name:      "scrubber_passes_completed_total",
+			Help:      "Number of times the scrubber validated all objects referenced by the reference-location map",
+		},
+	)
+)
+
+// ScrubResult contains the number of objects that were validated by
+// Scrubber.ScrubAll(), grouped by outcome.
+type ScrubResult struct {
+	ValidObjects     uint64
+	CorruptedObjects uint64
+}
+
+// Scrubber validates the contents of all objects stored in the
+// location-blob map against their references.
+//
+// The local object store only detects data corruption when clients
+// attempt to read corrupted objects. Scrubber can be used to detect
+// corruption proactively. Like the local object store, it discards all
+// data up to and including the location of a corrupted object once
+// corruption is detected, as that data can no longer be trusted.
+//
+// As the location-blob map does not store the boundaries between
+// objects, Scrubber iterates over all entries of the
+// reference-location map, and validates the objects to which they
+// refer.
+type Scrubber struct {
+	lock                         *sync.RWMutex
+	referenceLocationRecordArray ReferenceLocationRecordArray
+	referenceLocationMapEntries  uint64
+	locationBlobMap              LocationBlobMap
+	epochList                    EpochList
+	clock                        clock.Clock
+	bytesPerSecond               uint64
+}
+
+// NewScrubber creates a Scrubber for a local object store. If
+// bytesPerSecond is non-zero, the rate at which data is read from the
+// location-blob map is limited, so that scrubbing does not starve
+// regular reads and writes.
+func NewScrubber(
+	lock *sync.RWMutex,
+	referenceLocationRecordArray ReferenceLocationRecordArray,
+	referenceLocationMapEntries uint64,
+	locationBlobMap LocationBlobMap,
+	epochList EpochList,
+	clock clock.Clock,
+	bytesPerSecond uint64,
+) *Scrubber {
+	scrubberPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(scrubberObjects)
+		prometheus.MustRegister(scrubberBytes)
+		prometheus.MustRegister(scrubberPassesCompleted)
+	})
+
+	return &Scrubber{
+		lock:                         lock,
+		referenceLocationRecordArray: referenceLocationRecordArray,
+		referenceLocationMapEntries:  referenceLocationMapEntries,
+		locationBlobMap:              locationBlobMap,
+		epochList:                    epochList,
+		clock:                        clock,
+		bytesPerSecond:               bytesPerSecond,
+	}
+}
+
+// ScrubAll performs a single pass over all entries in the
+// reference-location map, validating the contents of the objects to
+// which they refer.
+func (s *Scrubber) ScrubAll(ctx context.Context) (ScrubResult, error) {
+	var result ScrubResult
+	startTime := s.clock.Now()
+	scrubbedBytes := uint64(0)
+	for index := uint64(0); index < s.referenceLocationMapEntries; index++ {
+		if ctx.Err() != nil {
+			return result, util.StatusFromContext(ctx)
+		}
+
+		s.lock.RLock()
+		record, err := s.referenceLocationRecordArray.Get(index, s.epochList)
+		s.lock.RUnlock()
+		if err == lossymap.ErrRecordInvalidOrExpired {
+			continue
+		} else if err != nil {
+			return result, util.StatusWrapf(err, "Failed to read reference-location map entry at index %d", index)
+		}
+
+		sizeBytes := record.RecordKey.Key.GetSizeBytes()
+		outcome, err := s.scrubObject(index, record)
+		if err != nil {
+			return result, err
+		}
+		switch outcome {
+		case scrubOutcomeValid:
+			result.ValidObjects++
+		case scrubOutcomeCorrupted:
+			result.CorruptedObjects++
+		}
+		scrubberBytes.Add(float64(sizeBytes))
+
+		// Apply rate limiting by sleeping until the amount of
+		// time that has passed corresponds with the amount of
+		// data that has been read.
+		if s.bytesPerSecond > 0 {
+			scrubbedBytes += uint64(sizeBytes)
+			if delay := startTime.Add(time.Duration(float64(scrubbedBytes) / float64(s.bytesPerSecond) * float64(time.Second))).Sub(s.clock.Now()); delay > 0 {
+				timer, t := s.clock.NewTimer(delay)
+				select {
+				case <-t:
+				case <-ctx.Done():
+					timer.Stop()
+					return result, util.StatusFromContext(ctx)
+				}
+			}
+		}
+	}
+	scrubberPassesCompleted.Inc()
+	return result, nil
+}
+
+type scrubOutcome int
+
+const (
+	scrubOutcomeValid scrubOutcome = iota
+	scrubOutcomeCorrupted
+	scrubOutcomeOverwritten
+)
+
+// scrubObject validates the contents of a single object. If the
+// contents are invalid, all data up to and including the object is
+// discarded.
+func (s *Scrubber) scrubObject(index uint64, record ReferenceLocationRecord) (scrubOutcome, error) {
+	reference := record.RecordKey.Key
+	location := record.Value
+	sizeBytes := reference.GetSizeBytes()
+	data, err := s.locationBlobMap.Get(location, sizeBytes)
+	if err != nil {
+		return 0, util.StatusWrapf(err, "Failed to read object with reference %s at location %d", reference.GetLocalReference(), location)
+	}
+	if _, err := object.NewContentsFromFullData(reference.GetLocalReference(), data); err == nil {
+		scrubberObjectsValid.Inc()
+		return scrubOutcomeValid, nil
+	}
+
+	// The object's contents are invalid. It may be the case that
+	// the object was overwritten while being read. Only report the
+	// object as being corrupted if the entry in the
+	// reference-location map is still valid.
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if currentRecord, err := s.referenceLocationRecordArray.Get(index, s.epochList); err != nil || currentRecord != record {
+		scrubberObjectsOverwritten.Inc()
+		return scrubOutcomeOverwritten, nil
+	}
+	s.epochList.DiscardUpToLocation(location + uint64(sizeBytes))
+	scrubberObjectsCorrupted.Inc()
+	return scrubOutcomeCorrupted, nil
+}
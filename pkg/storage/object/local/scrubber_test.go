@@ -0,0 +1,86 @@
+package local_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"bonanza.build/pkg/ds/lossymap"
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/local"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrubber(t *testing.T) {
+	ctx := context.Background()
+
+	var lock sync.RWMutex
+	referenceLocationRecordArray := local.NewInMemoryReferenceLocationRecordArray(4)
+	locationBlobMap := local.NewInMemoryLocationBlobMap(100)
+	epochList := local.NewVolatileEpochList(100, random.NewFastSingleThreadedGenerator())
+	scrubber := local.NewScrubber(
+		&lock,
+		referenceLocationRecordArray,
+		/* referenceLocationMapEntries = */ 4,
+		locationBlobMap,
+		epochList,
+		clock.SystemClock,
+		/* bytesPerSecond = */ 0,
+	)
+
+	// putObject writes data into the location-blob map, and
+	// creates an entry in the reference-location map pointing to
+	// it using the provided reference.
+	putObject := func(index uint64, reference object.LocalReference, data []byte) {
+		location, err := locationBlobMap.Put(data)
+		require.NoError(t, err)
+		require.NoError(t, epochList.FinalizeWriteUpToLocation(location+uint64(len(data))))
+		require.NoError(t, referenceLocationRecordArray.Put(index, local.ReferenceLocationRecord{
+			RecordKey: local.ReferenceLocationRecordKey{Key: reference.Flatten()},
+			Value:     location,
+		}, epochList))
+	}
+
+	contents1 := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, object.OutgoingReferencesList[object.LocalReference]{}, []byte("Hello"))
+	contents2 := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, object.OutgoingReferencesList[object.LocalReference]{}, []byte("World"))
+	contents3 := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, object.OutgoingReferencesList[object.LocalReference]{}, []byte("Final"))
+
+	t.Run("Valid", func(t *testing.T) {
+		putObject(0, contents1.GetLocalReference(), contents1.GetFullData())
+		putObject(2, contents2.GetLocalReference(), contents2.GetFullData())
+
+		result, err := scrubber.ScrubAll(ctx)
+		require.NoError(t, err)
+		require.Equal(t, local.ScrubResult{ValidObjects: 2}, result)
+	})
+
+	t.Run("Corrupted", func(t *testing.T) {
+		// Let the entry at index 1 refer to data that does not
+		// match its reference. This should cause all data up to
+		// and including the corrupted object to be discarded,
+		// meaning the entries at indices 0 and 2 become invalid.
+		putObject(1, contents2.GetLocalReference(), []byte("Wurld"))
+		putObject(3, contents3.GetLocalReference(), contents3.GetFullData())
+
+		result, err := scrubber.ScrubAll(ctx)
+		require.NoError(t, err)
+		require.Equal(t, local.ScrubResult{ValidObjects: 2, CorruptedObjects: 1}, result)
+
+		for _, index := range []uint64{0, 1, 2} {
+			_, err := referenceLocationRecordArray.Get(index, epochList)
+			require.Equal(t, lossymap.ErrRecordInvalidOrExpired, err)
+		}
+		_, err = referenceLocationRecordArray.Get(3, epochList)
+		require.NoError(t, err)
+
+		// A subsequent pass should only observe the object
+		// that was written after the corrupted object.
+		result, err = scrubber.ScrubAll(ctx)
+		require.NoError(t, err)
+		require.Equal(t, local.ScrubResult{ValidObjects: 1}, result)
+	})
+}
@@ -7,15 +7,39 @@ import (
 	"bonanza.build/pkg/ds/lossymap"
 	"bonanza.build/pkg/storage/object"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+var (
+	storePrometheusMetrics sync.Once
+
+	storeRefreshedObjects = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "object_local",
+			Name:      "store_refreshed_objects_total",
+			Help:      "Number of objects that were copied to the write cursor of the location-blob map to prevent them from being overwritten",
+		},
+	)
+	storeRefreshedBytes = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "object_local",
+			Name:      "store_refreshed_bytes_total",
+			Help:      "Total size of objects in bytes that were copied to the write cursor of the location-blob map to prevent them from being overwritten",
+		},
+	)
+)
+
 type store struct {
 	lock                 *sync.RWMutex
 	referenceLocationMap lossymap.Map[object.FlatReference, uint64, EpochIDResolver]
 	locationBlobMap      LocationBlobMap
 	epochList            EpochList
+	refreshPolicy        RefreshPolicy
 
 	writeLocks [1 << 8]sync.Mutex
 }
@@ -27,22 +51,44 @@ func NewStore(
 	referenceLocationMap lossymap.Map[object.FlatReference, uint64, EpochIDResolver],
 	locationBlobMap LocationBlobMap,
 	epochList EpochList,
+	refreshPolicy RefreshPolicy,
 ) object.Store[object.FlatReference, struct{}] {
+	storePrometheusMetrics.Do(func() {
+		prometheus.MustRegister(storeRefreshedObjects)
+		prometheus.MustRegister(storeRefreshedBytes)
+	})
+
 	return &store{
 		lock:                 lock,
 		referenceLocationMap: referenceLocationMap,
 		locationBlobMap:      locationBlobMap,
 		epochList:            epochList,
+		refreshPolicy:        refreshPolicy,
 	}
 }
 
+// lookupObjectLocation returns the location at which an object is
+// stored in the location-blob map.
+func (s *store) lookupObjectLocation(reference object.FlatReference) (uint64, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.referenceLocationMap.Get(reference, s.epochList)
+}
+
+// getObjectLocation returns the location at which an object is stored
+// in the location-blob map, and whether the object needs to be
+// refreshed to prevent it from being overwritten.
 func (s *store) getObjectLocation(reference object.FlatReference) (uint64, bool, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
 	location, err := s.referenceLocationMap.Get(reference, s.epochList)
-	// TODO: Determine whether object needs to be refreshed.
-	return location, false, err
+	if err != nil {
+		return 0, false, err
+	}
+	epochState, _ := s.epochList.GetCurrentEpochState()
+	return location, s.refreshPolicy.ShouldRefresh(location, reference.GetSizeBytes(), &epochState), nil
 }
 
 func (s *store) readObjectAtLocation(reference object.FlatReference, location uint64) (*object.Contents, error) {
@@ -75,10 +121,11 @@ func (s *store) readObjectAtLocation(reference object.FlatReference, location ui
 }
 
 // maybeWriteObject writes an object to storage, if and only if it does
-// not exist or needs to be refreshed. This filters out redundant object
-// uploads or refreshes, which may occur if multiple clients interact
-// with the same object.
-func (s *store) maybeWriteObject(reference object.FlatReference, contents *object.Contents) error {
+// not exist or is still stored at the location at which it was observed
+// to need a refresh. This filters out redundant object uploads or
+// refreshes, which may occur if multiple clients interact with the same
+// object.
+func (s *store) maybeWriteObject(reference object.FlatReference, contents *object.Contents, staleLocation *uint64) error {
 	// Acquire a lock throughout the entire process to ensure that
 	// no data is written redundantly. Let the first byte of the
 	// reference determine which lock to acquire, so that we can
@@ -88,16 +135,18 @@ func (s *store) maybeWriteObject(reference object.FlatReference, contents *objec
 	defer writeLock.Unlock()
 
 	// Check whether the object exists or needs to be refreshed.
-	if _, needsRefresh, err := s.getObjectLocation(reference); err != nil {
+	if location, err := s.lookupObjectLocation(reference); err != nil {
 		if status.Code(err) != codes.NotFound {
 			return err
 		}
-	} else if !needsRefresh {
+		staleLocation = nil
+	} else if staleLocation == nil || location != *staleLocation {
 		return nil
 	}
 
-	// Object does not exist. Allocate space for storing the object
-	// and write its contents to disk.
+	// Object does not exist, or still needs to be refreshed.
+	// Allocate space for storing the object and write its contents
+	// to disk.
 	data := contents.GetFullData()
 	location, err := s.locationBlobMap.Put(data)
 	if err != nil {
@@ -110,6 +159,10 @@ func (s *store) maybeWriteObject(reference object.FlatReference, contents *objec
 		err = s.referenceLocationMap.Put(reference, location, s.epochList)
 	}
 	s.lock.Unlock()
+	if err == nil && staleLocation != nil {
+		storeRefreshedObjects.Inc()
+		storeRefreshedBytes.Add(float64(len(data)))
+	}
 	return err
 }
 
@@ -123,7 +176,7 @@ func (s *store) DownloadObject(ctx context.Context, reference object.FlatReferen
 		return nil, err
 	}
 	if needsRefresh {
-		if err := s.maybeWriteObject(reference, contents); err != nil {
+		if err := s.maybeWriteObject(reference, contents, &location); err != nil {
 			return nil, err
 		}
 	}
@@ -139,7 +192,7 @@ func (s *store) UploadObject(ctx context.Context, reference object.FlatReference
 		if contents == nil {
 			return object.UploadObjectMissing[struct{}]{}, nil
 		}
-		if err := s.maybeWriteObject(reference, contents); err != nil {
+		if err := s.maybeWriteObject(reference, contents, nil); err != nil {
 			return nil, err
 		}
 	} else if needsRefresh {
@@ -152,7 +205,7 @@ func (s *store) UploadObject(ctx context.Context, reference object.FlatReference
 				return object.UploadObjectMissing[struct{}]{}, nil
 			}
 		}
-		if err := s.maybeWriteObject(reference, contents); err != nil {
+		if err := s.maybeWriteObject(reference, contents, &location); err != nil {
 			return nil, err
 		}
 	}
@@ -44,7 +44,8 @@ func init() {
 // LocalReference uniquely identifies an object stored within a single
 // storage namespace.
 type LocalReference struct {
-	rawReference [referenceSizeBytes]byte
+	rawReference    [referenceSizeBytes]byte
+	referenceFormat ReferenceFormat
 }
 
 var _ BasicReference = LocalReference{}
@@ -73,8 +74,8 @@ func MustNewSHA256V1LocalReference(hash string, sizeBytes uint32, height uint8,
 
 // GetReferenceFormat returns the reference format that was used to
 // generate the reference.
-func (LocalReference) GetReferenceFormat() ReferenceFormat {
-	return ReferenceFormat{}
+func (r LocalReference) GetReferenceFormat() ReferenceFormat {
+	return r.referenceFormat
 }
 
 // GetRawReference returns the reference in binary form, so that it may
@@ -85,7 +86,8 @@ func (r LocalReference) GetRawReference() []byte {
 
 func (r LocalReference) String() string {
 	return fmt.Sprintf(
-		"SHA256=%s:S=%d:H=%d:D=%d:M=%d",
+		"%s=%s:S=%d:H=%d:D=%d:M=%d",
+		r.referenceFormat.getHashPrefix(),
 		hex.EncodeToString(r.GetHash()),
 		r.GetSizeBytes(),
 		r.GetHeight(),
@@ -150,6 +152,7 @@ func (r LocalReference) GetMaximumTotalParentsSizeBytes(includeSelf bool) int {
 // no need to track any leases.
 func (r LocalReference) Flatten() (flatReference FlatReference) {
 	copy(flatReference.rawReference[:], r.rawReference[:])
+	flatReference.referenceFormat = r.referenceFormat
 	return flatReference
 }
 
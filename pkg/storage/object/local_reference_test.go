@@ -222,4 +222,49 @@ func TestLocalReference(t *testing.T) {
 			}, reference.Flatten().GetLocalReference().GetRawReference())
 		})
 	})
+
+	t.Run("SHA512_256_V1", func(t *testing.T) {
+		referenceFormat := util.Must(object.NewReferenceFormat(object_pb.ReferenceFormat_SHA512_256_V1))
+		require.Equal(t, object_pb.ReferenceFormat_SHA512_256_V1, referenceFormat.ToProto())
+
+		t.Run("InvalidLength", func(t *testing.T) {
+			_, err := referenceFormat.NewLocalReference([]byte{1, 2, 3})
+			testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Reference is 3 bytes in size, while SHA512_256_V1 references are 40 bytes in size"), err)
+		})
+
+		t.Run("Contents", func(t *testing.T) {
+			// Objects should be hashed using SHA-512/256,
+			// and the reference format should be retained
+			// by derived references.
+			contents, err := referenceFormat.NewContents(nil, []byte("Hello"))
+			require.NoError(t, err)
+			require.Equal(t, referenceFormat, contents.GetReferenceFormat())
+			require.Equal(t, "SHA512_256=7e75b18b88d2cb8be95b05ec611e54e2460408a2dcf858f945686446c9d07aac:S=5:H=0:D=0:M=0", contents.String())
+			require.Equal(t, referenceFormat, contents.Flatten().GetReferenceFormat())
+			require.Equal(t, contents.LocalReference, contents.Flatten().GetLocalReference())
+
+			_, err = object.NewContentsFromFullData(contents.LocalReference, []byte("Hello"))
+			require.NoError(t, err)
+			_, err = object.NewContentsFromFullData(contents.LocalReference, []byte("Hallo"))
+			testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Data has SHA-512/256 hash fa7c9a24d0b81b587051c39addda2bd30427f3dba693d0448f9ddf8bf2b5c8ad, while 7e75b18b88d2cb8be95b05ec611e54e2460408a2dcf858f945686446c9d07aac was expected"), err)
+
+			// The same data stored under a reference of
+			// another format must not validate.
+			sha256Reference := object.MustNewSHA256V1LocalReference("7e75b18b88d2cb8be95b05ec611e54e2460408a2dcf858f945686446c9d07aac", 5, 0, 0, 0)
+			_, err = object.NewContentsFromFullData(sha256Reference, []byte("Hello"))
+			testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Data has SHA-256 hash 185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969, while 7e75b18b88d2cb8be95b05ec611e54e2460408a2dcf858f945686446c9d07aac was expected"), err)
+
+			// Objects may not have outgoing references
+			// that use another reference format.
+			_, err = referenceFormat.NewContents([]object.LocalReference{sha256Reference}, []byte("Hello"))
+			testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Outgoing reference at index 0 uses reference format SHA256_V1, while SHA512_256_V1 was expected"), err)
+
+			// Parents should propagate the reference
+			// format to their children.
+			parent, err := referenceFormat.NewContents([]object.LocalReference{contents.LocalReference}, []byte("World"))
+			require.NoError(t, err)
+			require.Equal(t, contents.LocalReference, parent.GetOutgoingReference(0))
+			require.Equal(t, contents.LocalReference, parent.DetachOutgoingReferences().GetOutgoingReference(0))
+		})
+	})
 }
@@ -0,0 +1,156 @@
+package prefetching
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// Reference is a constraint for the types of references that are
+// accepted by NewDownloader().
+type Reference[T any] interface {
+	comparable
+	object.BasicReference
+
+	WithLocalReference(localReference object.LocalReference) T
+}
+
+// prefetchedObject is an object that is either in the process of being
+// downloaded in the background, or has been downloaded and has not yet
+// been requested by any caller.
+type prefetchedObject[TReference any] struct {
+	reference TReference
+	element   *list.Element
+
+	done     chan struct{}
+	contents *object.Contents
+	err      error
+}
+
+type downloader[TReference Reference[TReference]] struct {
+	base              object.Downloader[TReference]
+	prefetchSemaphore *semaphore.Weighted
+	maximumDepth      int
+	maximumCount      int
+
+	lock    sync.Mutex
+	objects map[TReference]*prefetchedObject[TReference]
+	order   list.List
+}
+
+// NewDownloader creates a decorator for object.Downloader that
+// prefetches the subtrees of objects that are downloaded.
+//
+// Whenever an object is downloaded, its children are downloaded in the
+// background, up to a given depth. As these downloads are issued
+// concurrently, a downloader that coalesces requests (e.g., the one
+// returned by object_grpc.NewGRPCBatchDownloader()) is capable of
+// fetching every level of the subtree in a single round trip. This
+// significantly speeds up the traversal of DAGs by clients that are
+// located far away from storage.
+//
+// Prefetched objects are held in memory until they are requested.
+// Every object is only returned once, as callers are expected to cache
+// objects themselves (e.g., using model_parser.ParsedObjectPool). If
+// the number of objects held in memory exceeds the maximum count, the
+// least recently prefetched objects are discarded.
+func NewDownloader[TReference Reference[TReference]](base object.Downloader[TReference], prefetchSemaphore *semaphore.Weighted, maximumDepth, maximumCount int) object.Downloader[TReference] {
+	return &downloader[TReference]{
+		base:              base,
+		prefetchSemaphore: prefetchSemaphore,
+		maximumDepth:      maximumDepth,
+		maximumCount:      maximumCount,
+
+		objects: map[TReference]*prefetchedObject[TReference]{},
+	}
+}
+
+func (d *downloader[TReference]) DownloadObject(ctx context.Context, reference TReference) (*object.Contents, error) {
+	d.lock.Lock()
+	o, ok := d.objects[reference]
+	if ok {
+		d.removeLocked(o)
+	}
+	d.lock.Unlock()
+
+	if ok {
+		select {
+		case <-o.done:
+			if o.err == nil {
+				d.prefetchChildren(reference, o.contents, d.maximumDepth)
+				return o.contents, nil
+			}
+			// Prefetching the object failed. Download it once
+			// more, so that the error is reported in the
+			// context of the current request.
+		case <-ctx.Done():
+			return nil, util.StatusFromContext(ctx)
+		}
+	}
+
+	contents, err := d.base.DownloadObject(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	d.prefetchChildren(reference, contents, d.maximumDepth)
+	return contents, nil
+}
+
+// removeLocked removes a prefetched object from the set of objects
+// that are held in memory.
+func (d *downloader[TReference]) removeLocked(o *prefetchedObject[TReference]) {
+	delete(d.objects, o.reference)
+	d.order.Remove(o.element)
+}
+
+// prefetchChildren downloads the children of an object in the
+// background. Once downloaded, their children are prefetched as well,
+// until the provided depth is reached.
+func (d *downloader[TReference]) prefetchChildren(reference TReference, contents *object.Contents, depth int) {
+	if depth <= 0 {
+		return
+	}
+
+	degree := contents.GetDegree()
+	for i := 0; i < degree; i++ {
+		childReference := reference.WithLocalReference(contents.GetOutgoingReference(i))
+
+		d.lock.Lock()
+		if _, ok := d.objects[childReference]; ok {
+			d.lock.Unlock()
+			continue
+		}
+		if !d.prefetchSemaphore.TryAcquire(1) {
+			d.lock.Unlock()
+			return
+		}
+		o := &prefetchedObject[TReference]{
+			reference: childReference,
+			done:      make(chan struct{}),
+		}
+		o.element = d.order.PushBack(o)
+		d.objects[childReference] = o
+		for len(d.objects) > d.maximumCount {
+			d.removeLocked(d.order.Front().Value.(*prefetchedObject[TReference]))
+		}
+		d.lock.Unlock()
+
+		go func() {
+			// Prefetching is performed on behalf of all
+			// future callers, meaning it cannot use the
+			// context of the current one.
+			o.contents, o.err = d.base.DownloadObject(context.Background(), childReference)
+			close(o.done)
+			d.prefetchSemaphore.Release(1)
+			if o.err == nil {
+				d.prefetchChildren(childReference, o.contents, depth-1)
+			}
+		}()
+	}
+}
@@ -0,0 +1,74 @@
+package prefetching_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/prefetching"
+
+	"github.com/stretchr/testify/require"
+
+	"golang.org/x/sync/semaphore"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDownloader(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseDownloader := NewMockDownloaderForTesting(ctrl)
+	prefetchSemaphore := semaphore.NewWeighted(1)
+	downloader := prefetching.NewDownloader[object.GlobalReference](
+		baseDownloader,
+		prefetchSemaphore,
+		/* maximumDepth = */ 1,
+		/* maximumCount = */ 10,
+	)
+
+	instanceName := object.NewInstanceName("example")
+	grandchildContents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Grandchild"))
+	grandchildReference := instanceName.WithLocalReference(grandchildContents.LocalReference)
+	childContents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, []object.LocalReference{grandchildContents.LocalReference}, []byte("Child"))
+	childReference := instanceName.WithLocalReference(childContents.LocalReference)
+	parentContents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, []object.LocalReference{childContents.LocalReference}, []byte("Parent"))
+	parentReference := instanceName.WithLocalReference(parentContents.LocalReference)
+
+	waitForPrefetching := func() {
+		require.NoError(t, prefetchSemaphore.Acquire(ctx, 1))
+		prefetchSemaphore.Release(1)
+	}
+
+	// Downloading the parent object should cause the child object
+	// to be prefetched. As the maximum depth is set to one, the
+	// grandchild should not be prefetched yet.
+	baseDownloader.EXPECT().DownloadObject(ctx, parentReference).Return(parentContents, nil)
+	baseDownloader.EXPECT().DownloadObject(gomock.Any(), childReference).Return(childContents, nil)
+
+	objectContents, err := downloader.DownloadObject(ctx, parentReference)
+	require.NoError(t, err)
+	require.Equal(t, parentContents, objectContents)
+	waitForPrefetching()
+
+	// Requesting the child object should return the prefetched
+	// copy, and cause the grandchild to be prefetched.
+	baseDownloader.EXPECT().DownloadObject(gomock.Any(), grandchildReference).Return(grandchildContents, nil)
+
+	objectContents, err = downloader.DownloadObject(ctx, childReference)
+	require.NoError(t, err)
+	require.Equal(t, childContents, objectContents)
+	waitForPrefetching()
+
+	objectContents, err = downloader.DownloadObject(ctx, grandchildReference)
+	require.NoError(t, err)
+	require.Equal(t, grandchildContents, objectContents)
+
+	// Prefetched objects are only returned once. Subsequent
+	// requests should be forwarded to the backend.
+	baseDownloader.EXPECT().DownloadObject(ctx, grandchildReference).Return(grandchildContents, nil)
+
+	objectContents, err = downloader.DownloadObject(ctx, grandchildReference)
+	require.NoError(t, err)
+	require.Equal(t, grandchildContents, objectContents)
+}
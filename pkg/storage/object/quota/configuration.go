@@ -0,0 +1,57 @@
+package quota
+
+import (
+	pb "bonanza.build/pkg/proto/configuration/storage/object/quota"
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newQuotaFromConfiguration converts a quota stored in a configuration
+// file to its native representation.
+func newQuotaFromConfiguration(configuration *pb.Quota) (Quota, error) {
+	if configuration.BytesPerSecond == 0 {
+		return Quota{}, status.Error(codes.InvalidArgument, "Bytes per second must be positive")
+	}
+	if maximumObjectSizeBytes := uint64(object.SHA256V1ReferenceFormat.GetMaximumObjectSizeBytes()); configuration.BurstSizeBytes < maximumObjectSizeBytes {
+		return Quota{}, status.Errorf(codes.InvalidArgument, "Burst size must be at least %d bytes, as objects of the maximum size could otherwise never be written", maximumObjectSizeBytes)
+	}
+	return Quota{
+		BytesPerSecond: configuration.BytesPerSecond,
+		BurstSizeBytes: configuration.BurstSizeBytes,
+	}, nil
+}
+
+// NewUploaderFromConfiguration creates a decorator for object.Uploader
+// that performs per instance name accounting, and enforces the quotas
+// specified in a configuration file. If no configuration is provided,
+// only accounting is performed.
+func NewUploaderFromConfiguration[TLease any](base object.Uploader[object.GlobalReference, TLease], configuration *pb.QuotaConfiguration) (object.Uploader[object.GlobalReference, TLease], error) {
+	quotas := map[object.InstanceName]Quota{}
+	for instanceName, quotaConfiguration := range configuration.GetInstanceNames() {
+		quota, err := newQuotaFromConfiguration(quotaConfiguration)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid quota for instance name %#v", instanceName)
+		}
+		quotas[object.NewInstanceName(instanceName)] = quota
+	}
+
+	var defaultQuota *Quota
+	maximumDefaultQuotaInstanceNames := configuration.GetMaximumDefaultQuotaInstanceNames()
+	if defaultQuotaConfiguration := configuration.GetDefaultQuota(); defaultQuotaConfiguration != nil {
+		quota, err := newQuotaFromConfiguration(defaultQuotaConfiguration)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Invalid default quota")
+		}
+		defaultQuota = &quota
+		if maximumDefaultQuotaInstanceNames == 0 {
+			return nil, status.Error(codes.InvalidArgument, "Maximum number of instance names using the default quota must be positive")
+		}
+	}
+
+	return NewUploader(base, clock.SystemClock, quotas, defaultQuota, int(maximumDefaultQuotaInstanceNames)), nil
+}
@@ -0,0 +1,241 @@
+package quota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	uploaderPrometheusMetrics sync.Once
+
+	uploaderObjectsWritten = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "quota",
+			Name:      "uploader_objects_written_total",
+			Help:      "Number of objects whose contents were written, per instance name for which a quota is configured",
+		},
+		[]string{"instance_name"},
+	)
+	uploaderBytesWritten = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "quota",
+			Name:      "uploader_bytes_written_total",
+			Help:      "Total size of objects whose contents were written, per instance name for which a quota is configured",
+		},
+		[]string{"instance_name"},
+	)
+	uploaderObjectsRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "quota",
+			Name:      "uploader_objects_rejected_total",
+			Help:      "Number of objects whose contents were not written, due to the instance name exceeding its quota",
+		},
+		[]string{"instance_name"},
+	)
+)
+
+// otherInstanceNamesLabel is the value of the "instance_name" label of
+// metrics that are reported for instance names for which no explicit
+// quota is configured. Using a single label value for these prevents
+// clients from creating an unbounded number of metrics.
+const otherInstanceNamesLabel = "(other)"
+
+// Quota on the rate at which an instance name may write object
+// contents, expressed as the parameters of a token bucket.
+type Quota struct {
+	BytesPerSecond uint64
+	BurstSizeBytes uint64
+}
+
+// instanceNameMetrics contains the Prometheus counters that are
+// reported for one or more instance names.
+type instanceNameMetrics struct {
+	objectsWritten  prometheus.Counter
+	bytesWritten    prometheus.Counter
+	objectsRejected prometheus.Counter
+}
+
+func newInstanceNameMetrics(instanceNameLabel string) *instanceNameMetrics {
+	return &instanceNameMetrics{
+		objectsWritten:  uploaderObjectsWritten.WithLabelValues(instanceNameLabel),
+		bytesWritten:    uploaderBytesWritten.WithLabelValues(instanceNameLabel),
+		objectsRejected: uploaderObjectsRejected.WithLabelValues(instanceNameLabel),
+	}
+}
+
+// instanceNameState contains the token bucket and metrics of a single
+// instance name.
+type instanceNameState struct {
+	quota          *Quota
+	availableBytes float64
+	lastRefill     time.Time
+	metrics        *instanceNameMetrics
+}
+
+type uploader[TLease any] struct {
+	base                       object.Uploader[object.GlobalReference, TLease]
+	clock                      clock.Clock
+	quotas                     map[object.InstanceName]Quota
+	defaultQuota               *Quota
+	maximumOtherInstanceNames  int
+	otherInstanceNamesMetrics  *instanceNameMetrics
+	unlimitedInstanceNameState instanceNameState
+
+	lock                          sync.Mutex
+	configuredInstanceNames       map[object.InstanceName]*instanceNameState
+	otherInstanceNames            map[object.InstanceName]*instanceNameState
+	otherInstanceNamesEvictionSet eviction.Set[object.InstanceName]
+}
+
+// NewUploader creates a decorator for object.Uploader that keeps track
+// of the number of objects and bytes written per instance name, and
+// exposes these as Prometheus metrics. Metrics are only reported
+// separately for instance names for which a quota is provided. All
+// other instance names share a single set of metrics.
+//
+// Optionally, quotas can be enforced on the rate at which instance
+// names write object contents. This prevents a single instance name
+// from causing the data of other instance names to be pushed out of
+// storage. Quotas are soft, in the sense that uploads are only
+// rejected if the token bucket is already depleted. Uploads that are
+// processed concurrently may cause the quota to be exceeded slightly.
+//
+// If a default quota is provided, token buckets are created for
+// instance names without an explicit quota as well. At most
+// maximumOtherInstanceNames of these token buckets are retained. If
+// more instance names are used, the token buckets of the least
+// recently used ones are discarded, causing them to be refilled.
+func NewUploader[TLease any](base object.Uploader[object.GlobalReference, TLease], clock clock.Clock, quotas map[object.InstanceName]Quota, defaultQuota *Quota, maximumOtherInstanceNames int) object.Uploader[object.GlobalReference, TLease] {
+	uploaderPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(uploaderObjectsWritten)
+		prometheus.MustRegister(uploaderBytesWritten)
+		prometheus.MustRegister(uploaderObjectsRejected)
+	})
+
+	otherInstanceNamesMetrics := newInstanceNameMetrics(otherInstanceNamesLabel)
+	return &uploader[TLease]{
+		base:                      base,
+		clock:                     clock,
+		quotas:                    quotas,
+		defaultQuota:              defaultQuota,
+		maximumOtherInstanceNames: maximumOtherInstanceNames,
+		otherInstanceNamesMetrics: otherInstanceNamesMetrics,
+		unlimitedInstanceNameState: instanceNameState{
+			metrics: otherInstanceNamesMetrics,
+		},
+
+		configuredInstanceNames:       map[object.InstanceName]*instanceNameState{},
+		otherInstanceNames:            map[object.InstanceName]*instanceNameState{},
+		otherInstanceNamesEvictionSet: eviction.NewLRUSet[object.InstanceName](),
+	}
+}
+
+// getInstanceNameState returns the state of an instance name, creating
+// it if it does not exist. This method must be called while holding
+// the lock.
+func (u *uploader[TLease]) getInstanceNameState(instanceName object.InstanceName, now time.Time) *instanceNameState {
+	// Instance names with an explicit quota. The number of these
+	// is bounded by the size of the configuration.
+	if s, ok := u.configuredInstanceNames[instanceName]; ok {
+		return s
+	}
+	if quota, ok := u.quotas[instanceName]; ok {
+		s := &instanceNameState{
+			quota:          &quota,
+			availableBytes: float64(quota.BurstSizeBytes),
+			lastRefill:     now,
+			metrics:        newInstanceNameMetrics(instanceName.String()),
+		}
+		u.configuredInstanceNames[instanceName] = s
+		return s
+	}
+
+	// Instance names without an explicit quota. If no default
+	// quota is provided, there is no need to track them
+	// individually.
+	if u.defaultQuota == nil {
+		return &u.unlimitedInstanceNameState
+	}
+	if s, ok := u.otherInstanceNames[instanceName]; ok {
+		u.otherInstanceNamesEvictionSet.Touch(instanceName)
+		return s
+	}
+	for len(u.otherInstanceNames) >= u.maximumOtherInstanceNames {
+		delete(u.otherInstanceNames, u.otherInstanceNamesEvictionSet.Peek())
+		u.otherInstanceNamesEvictionSet.Remove()
+	}
+	s := &instanceNameState{
+		quota:          u.defaultQuota,
+		availableBytes: float64(u.defaultQuota.BurstSizeBytes),
+		lastRefill:     now,
+		metrics:        u.otherInstanceNamesMetrics,
+	}
+	u.otherInstanceNames[instanceName] = s
+	u.otherInstanceNamesEvictionSet.Insert(instanceName)
+	return s
+}
+
+func (u *uploader[TLease]) UploadObject(ctx context.Context, reference object.GlobalReference, contents *object.Contents, childrenLeases []TLease, wantContentsIfIncomplete bool) (object.UploadObjectResult[TLease], error) {
+	if contents == nil {
+		// Requests that don't provide any contents don't cause
+		// any data to be written. There is no need to perform
+		// any accounting.
+		return u.base.UploadObject(ctx, reference, contents, childrenLeases, wantContentsIfIncomplete)
+	}
+
+	// Check whether the instance name has sufficient quota left.
+	sizeBytes := reference.GetSizeBytes()
+	u.lock.Lock()
+	now := u.clock.Now()
+	s := u.getInstanceNameState(reference.InstanceName, now)
+	if quota := s.quota; quota != nil {
+		// Refill the token bucket based on the amount of time
+		// that passed since the last upload.
+		if elapsed := now.Sub(s.lastRefill); elapsed > 0 {
+			s.availableBytes = min(s.availableBytes+elapsed.Seconds()*float64(quota.BytesPerSecond), float64(quota.BurstSizeBytes))
+			s.lastRefill = now
+		}
+		if s.availableBytes < float64(sizeBytes) {
+			u.lock.Unlock()
+			s.metrics.objectsRejected.Inc()
+			return nil, status.Errorf(
+				codes.ResourceExhausted,
+				"Instance name %#v has exceeded its quota of %d bytes per second with a burst size of %d bytes",
+				reference.InstanceName.String(),
+				quota.BytesPerSecond,
+				quota.BurstSizeBytes,
+			)
+		}
+	}
+	u.lock.Unlock()
+
+	result, err := u.base.UploadObject(ctx, reference, contents, childrenLeases, wantContentsIfIncomplete)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only charge the instance name for the upload after it
+	// succeeds, so that failed uploads don't count towards the
+	// quota.
+	if s.quota != nil {
+		u.lock.Lock()
+		s.availableBytes -= float64(sizeBytes)
+		u.lock.Unlock()
+	}
+	s.metrics.objectsWritten.Inc()
+	s.metrics.bytesWritten.Add(float64(sizeBytes))
+	return result, nil
+}
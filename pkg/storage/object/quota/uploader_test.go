@@ -0,0 +1,160 @@
+package quota_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/quota"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUploader(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseUploader := NewMockStoreForTesting(ctrl)
+	clock := NewMockClock(ctrl)
+	uploader := quota.NewUploader[any](
+		baseUploader,
+		clock,
+		map[object.InstanceName]quota.Quota{
+			object.NewInstanceName("limited"): {
+				BytesPerSecond: 10,
+				BurstSizeBytes: 15,
+			},
+		},
+		/* defaultQuota = */ nil,
+		/* maximumOtherInstanceNames = */ 0,
+	)
+
+	contents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello World"))
+	limitedReference := object.NewInstanceName("limited").WithLocalReference(contents.LocalReference)
+	unlimitedReference := object.NewInstanceName("unlimited").WithLocalReference(contents.LocalReference)
+
+	t.Run("Unlimited", func(t *testing.T) {
+		// Instance names without a quota may write data
+		// without any restrictions.
+		for i := 0; i < 10; i++ {
+			clock.EXPECT().Now().Return(time.Unix(1000, 0))
+			baseUploader.EXPECT().UploadObject(ctx, unlimitedReference, contents, nil, false).
+				Return(object.UploadObjectComplete[any]{Lease: 123}, nil)
+
+			result, err := uploader.UploadObject(ctx, unlimitedReference, contents, nil, false)
+			require.NoError(t, err)
+			require.Equal(t, object.UploadObjectComplete[any]{Lease: 123}, result)
+		}
+	})
+
+	t.Run("WithinQuota", func(t *testing.T) {
+		// The token bucket starts out being full, meaning that
+		// the first object of 11 bytes can be written.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		baseUploader.EXPECT().UploadObject(ctx, limitedReference, contents, nil, false).
+			Return(object.UploadObjectComplete[any]{Lease: 123}, nil)
+
+		result, err := uploader.UploadObject(ctx, limitedReference, contents, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, object.UploadObjectComplete[any]{Lease: 123}, result)
+	})
+
+	t.Run("LeasesOnly", func(t *testing.T) {
+		// Requests that don't provide the object's contents
+		// should never be rejected, as they don't cause data
+		// to be written.
+		baseUploader.EXPECT().UploadObject(ctx, limitedReference, nil, nil, false).
+			Return(object.UploadObjectMissing[any]{}, nil)
+
+		result, err := uploader.UploadObject(ctx, limitedReference, nil, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, object.UploadObjectMissing[any]{}, result)
+	})
+
+	t.Run("QuotaExceeded", func(t *testing.T) {
+		// Only 4 bytes are left in the token bucket, meaning
+		// the next upload should be rejected.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+
+		_, err := uploader.UploadObject(ctx, limitedReference, contents, nil, false)
+		testutil.RequireEqualStatus(t, status.Error(codes.ResourceExhausted, "Instance name \"limited\" has exceeded its quota of 10 bytes per second with a burst size of 15 bytes"), err)
+	})
+
+	t.Run("BaseFailure", func(t *testing.T) {
+		// After one second, the token bucket has been refilled
+		// sufficiently to permit the next upload. Failures of
+		// the underlying uploader should not count towards the
+		// quota.
+		clock.EXPECT().Now().Return(time.Unix(1001, 0))
+		baseUploader.EXPECT().UploadObject(ctx, limitedReference, contents, nil, false).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := uploader.UploadObject(ctx, limitedReference, contents, nil, false)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+
+		clock.EXPECT().Now().Return(time.Unix(1001, 0))
+		baseUploader.EXPECT().UploadObject(ctx, limitedReference, contents, nil, false).
+			Return(object.UploadObjectComplete[any]{Lease: 456}, nil)
+
+		result, err := uploader.UploadObject(ctx, limitedReference, contents, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, object.UploadObjectComplete[any]{Lease: 456}, result)
+	})
+}
+
+func TestUploaderDefaultQuota(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseUploader := NewMockStoreForTesting(ctrl)
+	clock := NewMockClock(ctrl)
+	uploader := quota.NewUploader[any](
+		baseUploader,
+		clock,
+		/* quotas = */ nil,
+		&quota.Quota{
+			BytesPerSecond: 10,
+			BurstSizeBytes: 15,
+		},
+		/* maximumOtherInstanceNames = */ 1,
+	)
+
+	contents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello World"))
+	reference1 := object.NewInstanceName("instance1").WithLocalReference(contents.LocalReference)
+	reference2 := object.NewInstanceName("instance2").WithLocalReference(contents.LocalReference)
+
+	// Each instance name should get its own token bucket, meaning
+	// that both instance names can write a single object.
+	for _, reference := range []object.GlobalReference{reference1, reference2} {
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		baseUploader.EXPECT().UploadObject(ctx, reference, contents, nil, false).
+			Return(object.UploadObjectComplete[any]{Lease: 123}, nil)
+
+		result, err := uploader.UploadObject(ctx, reference, contents, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, object.UploadObjectComplete[any]{Lease: 123}, result)
+	}
+
+	// The token bucket of the second instance name is depleted.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+
+	_, err := uploader.UploadObject(ctx, reference2, contents, nil, false)
+	testutil.RequireEqualStatus(t, status.Error(codes.ResourceExhausted, "Instance name \"instance2\" has exceeded its quota of 10 bytes per second with a burst size of 15 bytes"), err)
+
+	// As only a single token bucket is retained, the token bucket
+	// of the first instance name was discarded when the second
+	// instance name was used. It should start out being full.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	baseUploader.EXPECT().UploadObject(ctx, reference1, contents, nil, false).
+		Return(object.UploadObjectComplete[any]{Lease: 456}, nil)
+
+	result, err := uploader.UploadObject(ctx, reference1, contents, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, object.UploadObjectComplete[any]{Lease: 456}, result)
+}
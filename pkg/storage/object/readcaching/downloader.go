@@ -2,18 +2,43 @@ package readcaching
 
 import (
 	"context"
+	"sync"
 
 	"bonanza.build/pkg/storage/object"
 
 	"github.com/buildbarn/bb-storage/pkg/util"
 
+	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-type downloader[TReference object.BasicReference, TLeaseFast any] struct {
-	slow object.Downloader[TReference]
-	fast object.Store[object.FlatReference, TLeaseFast]
+// Reference is a constraint for the types of references that are
+// accepted by NewDownloader().
+type Reference[T any] interface {
+	comparable
+	object.BasicReference
+
+	WithLocalReference(localReference object.LocalReference) T
+}
+
+type downloader[TReference Reference[TReference], TLeaseFast any] struct {
+	slow              object.Downloader[TReference]
+	fast              object.Store[object.FlatReference, TLeaseFast]
+	prefetchSemaphore *semaphore.Weighted
+
+	lock         sync.Mutex
+	replications map[TReference]*replication
+}
+
+// replication of a single object from the slow backend to the fast
+// backend that is in progress. Concurrent requests for the same object
+// wait for the replication to complete, as opposed to also downloading
+// the object from the slow backend.
+type replication struct {
+	done     chan struct{}
+	contents *object.Contents
+	err      error
 }
 
 // NewDownloader creates a decorator for object.Downloader that adds
@@ -21,41 +46,104 @@ type downloader[TReference object.BasicReference, TLeaseFast any] struct {
 //
 // Read requests first go to a fast backend. If that backend returns
 // NOT_FOUND, the data is read from the slow backend and written into
-// the fast backend prior to returning.
+// the fast backend prior to returning. Concurrent requests for the same
+// object are coalesced, meaning that the object is only read from the
+// slow backend once.
+//
+// If a prefetch semaphore is provided, children of objects that are
+// read from the slow backend are replicated to the fast backend in the
+// background. This reduces the number of round trips against the slow
+// backend when traversing DAGs while the fast backend is cold. The
+// semaphore bounds the number of prefetches that are performed
+// concurrently. Prefetches that would exceed this limit are skipped.
 //
 // Writes always go to the fast backend.
-func NewDownloader[TReference object.BasicReference, TLeaseFast any](slow object.Downloader[TReference], fast object.Store[object.FlatReference, TLeaseFast]) object.Downloader[TReference] {
+func NewDownloader[TReference Reference[TReference], TLeaseFast any](slow object.Downloader[TReference], fast object.Store[object.FlatReference, TLeaseFast], prefetchSemaphore *semaphore.Weighted) object.Downloader[TReference] {
 	return &downloader[TReference, TLeaseFast]{
-		slow: slow,
-		fast: fast,
+		slow:              slow,
+		fast:              fast,
+		prefetchSemaphore: prefetchSemaphore,
+
+		replications: map[TReference]*replication{},
 	}
 }
 
 func (d *downloader[TReference, TLeaseFast]) DownloadObject(ctx context.Context, reference TReference) (*object.Contents, error) {
+	objectContents, replicated, err := d.downloadObject(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	if replicated && d.prefetchSemaphore != nil {
+		d.prefetchChildren(ctx, reference, objectContents)
+	}
+	return objectContents, nil
+}
+
+// downloadObject downloads an object from the fast backend. If the
+// object is not present, it is replicated from the slow backend.
+func (d *downloader[TReference, TLeaseFast]) downloadObject(ctx context.Context, reference TReference) (*object.Contents, bool, error) {
 	// Attempt to load object from the fast backend.
-	flatReference := reference.Flatten()
-	if flatObjectContents, err := d.fast.DownloadObject(ctx, flatReference); err == nil {
+	if flatObjectContents, err := d.fast.DownloadObject(ctx, reference.Flatten()); err == nil {
 		objectContents, err := flatObjectContents.Unflatten(reference.GetLocalReference())
 		if err != nil {
-			return nil, util.StatusWrap(err, "Unflatten object contents")
+			return nil, false, util.StatusWrap(err, "Unflatten object contents")
 		}
-		return objectContents, nil
+		return objectContents, false, nil
 	} else if status.Code(err) != codes.NotFound {
-		return nil, util.StatusWrap(err, "Get object from fast backend")
+		return nil, false, util.StatusWrap(err, "Get object from fast backend")
 	}
 
 	// Object not found in the fast backend. Get it from the slow
-	// backend and replicate it to the fast backend.
-	//
-	// TODO: Add a mechanism to prevent parallel replication of the
-	// same object.
+	// backend and replicate it to the fast backend. If another
+	// request is already replicating the same object, wait for it
+	// to complete.
+	for {
+		d.lock.Lock()
+		r, ok := d.replications[reference]
+		if !ok {
+			r = &replication{
+				done: make(chan struct{}),
+			}
+			d.replications[reference] = r
+			d.lock.Unlock()
+
+			r.contents, r.err = d.replicateObject(ctx, reference)
+
+			d.lock.Lock()
+			delete(d.replications, reference)
+			d.lock.Unlock()
+			close(r.done)
+			return r.contents, true, r.err
+		}
+		d.lock.Unlock()
+
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			return nil, false, util.StatusFromContext(ctx)
+		}
+		if r.err == nil {
+			return r.contents, false, nil
+		}
+		if code := status.Code(r.err); code != codes.Canceled && code != codes.DeadlineExceeded {
+			return nil, false, r.err
+		}
+		// The request that performed the replication was
+		// canceled. This does not imply that the current
+		// request needs to fail. Retry.
+	}
+}
+
+// replicateObject downloads an object from the slow backend and writes
+// it into the fast backend.
+func (d *downloader[TReference, TLeaseFast]) replicateObject(ctx context.Context, reference TReference) (*object.Contents, error) {
 	objectContents, err := d.slow.DownloadObject(ctx, reference)
 	if err != nil {
 		return nil, util.StatusWrap(err, "Get object from slow backend")
 	}
 	result, err := d.fast.UploadObject(
 		ctx,
-		flatReference,
+		reference.Flatten(),
 		objectContents.FlattenContents(),
 		/* childrenLeases = */ nil,
 		/* wantContentsIfIncomplete = */ false,
@@ -72,3 +160,25 @@ func (d *downloader[TReference, TLeaseFast]) DownloadObject(ctx context.Context,
 		panic("unexpected upload object result type")
 	}
 }
+
+// prefetchChildren replicates the children of an object from the slow
+// backend to the fast backend in the background. Only a single level
+// of children is prefetched, so that the amount of data that is
+// prefetched remains proportional to the amount of data that is
+// requested by clients.
+func (d *downloader[TReference, TLeaseFast]) prefetchChildren(ctx context.Context, reference TReference, objectContents *object.Contents) {
+	// Prefetching should continue after the request that triggered
+	// it completes.
+	prefetchCtx := context.WithoutCancel(ctx)
+	degree := objectContents.GetDegree()
+	for i := 0; i < degree; i++ {
+		if !d.prefetchSemaphore.TryAcquire(1) {
+			return
+		}
+		childReference := reference.WithLocalReference(objectContents.GetOutgoingReference(i))
+		go func() {
+			d.downloadObject(prefetchCtx, childReference)
+			d.prefetchSemaphore.Release(1)
+		}()
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"golang.org/x/sync/semaphore"
+
 	"go.uber.org/mock/gomock"
 )
 
@@ -22,7 +24,11 @@ func TestDownloader(t *testing.T) {
 
 	slowDownloader := NewMockDownloaderForTesting(ctrl)
 	fastStore := NewMockFlatStoreForTesting(ctrl)
-	downloader := readcaching.NewDownloader(slowDownloader, fastStore)
+	downloader := readcaching.NewDownloader(
+		slowDownloader,
+		fastStore,
+		/* prefetchSemaphore = */ nil,
+	)
 
 	t.Run("FastBackendGetFailure", func(t *testing.T) {
 		// Internal errors returned by the fast storage backend
@@ -257,3 +263,112 @@ func TestDownloader(t *testing.T) {
 		require.Equal(t, []byte("Hello"), objectContents.GetPayload())
 	})
 }
+
+func TestDownloaderConcurrentReplication(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	slowDownloader := NewMockDownloaderForTesting(ctrl)
+	fastStore := NewMockFlatStoreForTesting(ctrl)
+	downloader := readcaching.NewDownloader(
+		slowDownloader,
+		fastStore,
+		/* prefetchSemaphore = */ nil,
+	)
+
+	contents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello"))
+	reference := object.NewInstanceName("example").WithLocalReference(contents.LocalReference)
+
+	// If a request for an object arrives while the same object is
+	// already being replicated, it should wait for the replication
+	// to complete instead of contacting the slow backend. Let the
+	// second request be canceled while waiting, so that we can
+	// observe that it blocks.
+	fastStore.EXPECT().DownloadObject(gomock.Any(), reference.Flatten()).
+		Return(nil, status.Error(codes.NotFound, "Object not found")).
+		Times(2)
+	slowDownloader.EXPECT().DownloadObject(ctx, reference).
+		DoAndReturn(func(ctx context.Context, reference object.GlobalReference) (*object.Contents, error) {
+			canceledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			_, err := downloader.DownloadObject(canceledCtx, reference)
+			testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "context canceled"), err)
+			return contents, nil
+		})
+	fastStore.EXPECT().UploadObject(ctx, reference.Flatten(), gomock.Any(), gomock.Len(0), false).
+		Return(object.UploadObjectComplete[struct{}]{}, nil)
+
+	objectContents, err := downloader.DownloadObject(ctx, reference)
+	require.NoError(t, err)
+	require.Equal(t, contents, objectContents)
+}
+
+func TestDownloaderPrefetching(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	slowDownloader := NewMockDownloaderForTesting(ctrl)
+	fastStore := NewMockFlatStoreForTesting(ctrl)
+	prefetchSemaphore := semaphore.NewWeighted(1)
+	downloader := readcaching.NewDownloader(slowDownloader, fastStore, prefetchSemaphore)
+
+	instanceName := object.NewInstanceName("example")
+	childContents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello"))
+	childReference := instanceName.WithLocalReference(childContents.LocalReference)
+	parentContents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, []object.LocalReference{childContents.LocalReference}, []byte("World"))
+	parentReference := instanceName.WithLocalReference(parentContents.LocalReference)
+
+	t.Run("Success", func(t *testing.T) {
+		// When a parent object is replicated from the slow
+		// backend, its children should be replicated in the
+		// background.
+		fastStore.EXPECT().DownloadObject(ctx, parentReference.Flatten()).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+		slowDownloader.EXPECT().DownloadObject(ctx, parentReference).
+			Return(parentContents, nil)
+		fastStore.EXPECT().UploadObject(ctx, parentReference.Flatten(), gomock.Any(), gomock.Len(0), false).
+			Return(object.UploadObjectComplete[struct{}]{}, nil)
+
+		fastStore.EXPECT().DownloadObject(gomock.Any(), childReference.Flatten()).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+		slowDownloader.EXPECT().DownloadObject(gomock.Any(), childReference).
+			Return(childContents, nil)
+		fastStore.EXPECT().UploadObject(gomock.Any(), childReference.Flatten(), gomock.Any(), gomock.Len(0), false).
+			Return(object.UploadObjectComplete[struct{}]{}, nil)
+
+		objectContents, err := downloader.DownloadObject(ctx, parentReference)
+		require.NoError(t, err)
+		require.Equal(t, parentContents, objectContents)
+
+		// Wait for prefetching to complete.
+		require.NoError(t, prefetchSemaphore.Acquire(ctx, 1))
+		prefetchSemaphore.Release(1)
+	})
+
+	t.Run("ConcurrencyExceeded", func(t *testing.T) {
+		// If the maximum number of concurrent prefetches is
+		// reached, prefetching should be skipped.
+		require.True(t, prefetchSemaphore.TryAcquire(1))
+		defer prefetchSemaphore.Release(1)
+
+		fastStore.EXPECT().DownloadObject(ctx, parentReference.Flatten()).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+		slowDownloader.EXPECT().DownloadObject(ctx, parentReference).
+			Return(parentContents, nil)
+		fastStore.EXPECT().UploadObject(ctx, parentReference.Flatten(), gomock.Any(), gomock.Len(0), false).
+			Return(object.UploadObjectComplete[struct{}]{}, nil)
+
+		objectContents, err := downloader.DownloadObject(ctx, parentReference)
+		require.NoError(t, err)
+		require.Equal(t, parentContents, objectContents)
+	})
+
+	t.Run("FastBackendHit", func(t *testing.T) {
+		// Objects that are already present in the fast backend
+		// should not cause their children to be prefetched.
+		fastStore.EXPECT().DownloadObject(ctx, parentReference.Flatten()).
+			Return(parentContents.FlattenContents(), nil)
+
+		objectContents, err := downloader.DownloadObject(ctx, parentReference)
+		require.NoError(t, err)
+		require.Equal(t, parentContents, objectContents)
+	})
+}
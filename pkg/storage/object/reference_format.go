@@ -2,6 +2,7 @@ package object
 
 import (
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/binary"
 
 	"bonanza.build/pkg/encoding/float16"
@@ -11,30 +12,100 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+type hashAlgorithm uint8
+
+const (
+	hashAlgorithmSHA256 hashAlgorithm = iota
+	hashAlgorithmSHA512_256
+)
+
 // ReferenceFormat describes the algorithm to use to compute the
-// LocalReference of an object. Right now only a single algorithm is
-// supported, called "SHA256_V1".
-type ReferenceFormat struct{}
+// LocalReference of an object. Two algorithms are supported, called
+// "SHA256_V1" and "SHA512_256_V1". Both algorithms use the same
+// layout, only differing in the hash function that is used to compute
+// the hash of the object's contents.
+type ReferenceFormat struct {
+	hashAlgorithm hashAlgorithm
+}
+
+var (
+	// SHA256V1ReferenceFormat is a predeclared instance of
+	// ReferenceFormat that uses algorithm "SHA256_V1".
+	SHA256V1ReferenceFormat = ReferenceFormat{hashAlgorithm: hashAlgorithmSHA256}
 
-// SHA256V1ReferenceFormat is a predeclared instance of ReferenceFormat
-// that uses algorithm "SHA256_V1".
-var SHA256V1ReferenceFormat = ReferenceFormat{}
+	// SHA512_256V1ReferenceFormat is a predeclared instance of
+	// ReferenceFormat that uses algorithm "SHA512_256_V1".
+	SHA512_256V1ReferenceFormat = ReferenceFormat{hashAlgorithm: hashAlgorithmSHA512_256}
+)
 
 // NewReferenceFormat converts a ReferenceFormat enumeration value that
-// is used as part of gRPC requests to a native type. Right now it
-// merely validates that the provided enumeration value corresponds to
-// SHA256_V1.
+// is used as part of gRPC requests to a native type.
 func NewReferenceFormat(value object.ReferenceFormat_Value) (ReferenceFormat, error) {
-	if value != object.ReferenceFormat_SHA256_V1 {
-		return ReferenceFormat{}, status.Error(codes.InvalidArgument, "This implementation only supports reference format SHA256_V1")
+	switch value {
+	case object.ReferenceFormat_SHA256_V1:
+		return SHA256V1ReferenceFormat, nil
+	case object.ReferenceFormat_SHA512_256_V1:
+		return SHA512_256V1ReferenceFormat, nil
+	default:
+		return ReferenceFormat{}, status.Error(codes.InvalidArgument, "This implementation only supports reference formats SHA256_V1 and SHA512_256_V1")
 	}
-	return ReferenceFormat{}, nil
 }
 
 // ToProto converts a ReferenceFormat to an enumeration value that can
 // be embedded into gRPC request messages.
-func (ReferenceFormat) ToProto() object.ReferenceFormat_Value {
-	return object.ReferenceFormat_SHA256_V1
+func (rf ReferenceFormat) ToProto() object.ReferenceFormat_Value {
+	switch rf.hashAlgorithm {
+	case hashAlgorithmSHA256:
+		return object.ReferenceFormat_SHA256_V1
+	case hashAlgorithmSHA512_256:
+		return object.ReferenceFormat_SHA512_256_V1
+	default:
+		panic("unknown hash algorithm")
+	}
+}
+
+// String returns the name of the reference format, as it is declared
+// in the ReferenceFormat enumeration.
+func (rf ReferenceFormat) String() string {
+	return rf.ToProto().String()
+}
+
+// getHashName returns a human readable name of the hash function
+// that is used by the reference format.
+func (rf ReferenceFormat) getHashName() string {
+	switch rf.hashAlgorithm {
+	case hashAlgorithmSHA256:
+		return "SHA-256"
+	case hashAlgorithmSHA512_256:
+		return "SHA-512/256"
+	default:
+		panic("unknown hash algorithm")
+	}
+}
+
+// getHashPrefix returns the prefix that is used when converting
+// references to strings.
+func (rf ReferenceFormat) getHashPrefix() string {
+	switch rf.hashAlgorithm {
+	case hashAlgorithmSHA256:
+		return "SHA256"
+	case hashAlgorithmSHA512_256:
+		return "SHA512_256"
+	default:
+		panic("unknown hash algorithm")
+	}
+}
+
+// computeHash computes the hash of the contents of an object.
+func (rf ReferenceFormat) computeHash(data []byte) [32]byte {
+	switch rf.hashAlgorithm {
+	case hashAlgorithmSHA256:
+		return sha256.Sum256(data)
+	case hashAlgorithmSHA512_256:
+		return sha512.Sum512_256(data)
+	default:
+		panic("unknown hash algorithm")
+	}
 }
 
 // GetReferenceSizeBytes returns the size in bytes of references encoded
@@ -46,17 +117,19 @@ func (ReferenceFormat) GetReferenceSizeBytes() int {
 // NewLocalReference converts a reference that is stored in binary
 // format to an in-memory representation. It also validates that all
 // fields contained in the reference are within bounds.
-func (ReferenceFormat) NewLocalReference(rawReference []byte) (r LocalReference, err error) {
+func (rf ReferenceFormat) NewLocalReference(rawReference []byte) (r LocalReference, err error) {
 	// Construct the reference.
 	if len(rawReference) != referenceSizeBytes {
 		return LocalReference{}, status.Errorf(
 			codes.InvalidArgument,
-			"Reference is %d bytes in size, while SHA256_V1 references are %d bytes in size",
+			"Reference is %d bytes in size, while %s references are %d bytes in size",
 			len(rawReference),
+			rf,
 			referenceSizeBytes,
 		)
 	}
 	r.rawReference = *(*[referenceSizeBytes]byte)(rawReference)
+	r.referenceFormat = rf
 
 	sizeBytes := r.GetSizeBytes()
 	if sizeBytes < minimumObjectSizeBytes || sizeBytes > maximumObjectSizeBytes {
@@ -106,17 +179,22 @@ func (ReferenceFormat) NewLocalReference(rawReference []byte) (r LocalReference,
 	return r, err
 }
 
-func (ReferenceFormat) NewFlatReference(rawReference []byte) (r FlatReference, err error) {
+// NewFlatReference converts a flat reference that is stored in binary
+// format to an in-memory representation. It also validates that the
+// size contained in the reference is within bounds.
+func (rf ReferenceFormat) NewFlatReference(rawReference []byte) (r FlatReference, err error) {
 	// Construct the reference.
 	if len(rawReference) != SHA256V1FlatReferenceSizeBytes {
 		return FlatReference{}, status.Errorf(
 			codes.InvalidArgument,
-			"Reference is %d bytes in size, while SHA256_V1 flat references are %d bytes in size",
+			"Reference is %d bytes in size, while %s flat references are %d bytes in size",
 			len(rawReference),
+			rf,
 			SHA256V1FlatReferenceSizeBytes,
 		)
 	}
 	r.rawReference = *(*[SHA256V1FlatReferenceSizeBytes]byte)(rawReference)
+	r.referenceFormat = rf
 
 	sizeBytes := r.GetSizeBytes()
 	if sizeBytes < minimumObjectSizeBytes || sizeBytes > maximumObjectSizeBytes {
@@ -162,7 +240,10 @@ func (rf ReferenceFormat) NewContents(outgoingReferences []LocalReference, paylo
 		data = payload
 	} else {
 		data = make([]byte, 0, sizeBytes)
-		for _, outgoingReference := range outgoingReferences {
+		for i, outgoingReference := range outgoingReferences {
+			if childReferenceFormat := outgoingReference.GetReferenceFormat(); childReferenceFormat != rf {
+				return nil, status.Errorf(codes.InvalidArgument, "Outgoing reference at index %d uses reference format %s, while %s was expected", i, childReferenceFormat, rf)
+			}
 			if err := rcs.addChildReference(outgoingReference); err != nil {
 				return nil, err
 			}
@@ -172,12 +253,15 @@ func (rf ReferenceFormat) NewContents(outgoingReferences []LocalReference, paylo
 	}
 
 	var rawReference [referenceSizeBytes]byte
-	*(*[32]byte)(rawReference[:]) = sha256.Sum256(data)
+	*(*[32]byte)(rawReference[:]) = rf.computeHash(data)
 	binary.LittleEndian.PutUint32(rawReference[32:], uint32(sizeBytes))
 	*(*[5]byte)(rawReference[35:]) = rcs.getStats()
 
 	return &Contents{
-		LocalReference: LocalReference{rawReference: rawReference},
-		data:           data,
+		LocalReference: LocalReference{
+			rawReference:    rawReference,
+			referenceFormat: rf,
+		},
+		data: data,
 	}, nil
 }
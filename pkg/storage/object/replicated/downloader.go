@@ -0,0 +1,133 @@
+package replicated
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	downloaderPrometheusMetrics sync.Once
+
+	downloaderReadRepairs = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "replicated",
+			Name:      "downloader_read_repairs_total",
+			Help:      "Number of attempts to copy an object to a replica while it was being downloaded, because the replica was missing it",
+		},
+		[]string{"replica", "result"},
+	)
+)
+
+type downloader[TReference, TLease any] struct {
+	replicas    []object.Store[TReference, TLease]
+	readQuorum  int
+	errorLogger util.ErrorLogger
+	round       atomic.Uint32
+
+	readRepairsSucceeded []prometheus.Counter
+	readRepairsFailed    []prometheus.Counter
+}
+
+// NewDownloader creates a decorator for object.Downloader that attempts
+// to read objects from any of a set of replicas. Replicas are queried
+// sequentially, starting with a replica that is chosen in round-robin
+// fashion to spread out the load.
+//
+// If an object is absent in some of the replicas that were queried
+// before it was found, it is automatically copied to them. This ensures
+// that the object remains available if the replica containing it were
+// to experience data loss.
+//
+// Replicas that report an object as being absent do not prevent it
+// from being read from the remaining replicas. An object is only
+// reported as being absent if none of the replicas have it, or if so
+// many replicas report it as absent that fewer than readQuorum
+// replicas can possibly contain it. Replicas that fail for other
+// reasons are skipped, meaning that reads can proceed if one or more
+// replicas are unavailable.
+//
+// Failures to copy objects to replicas that are missing them are
+// counted, and reported through the provided ErrorLogger. As these may
+// occur for every object that is downloaded while a replica is
+// unavailable, the ErrorLogger should not log excessively.
+func NewDownloader[TReference, TLease any](replicas []object.Store[TReference, TLease], readQuorum int, errorLogger util.ErrorLogger) object.Downloader[TReference] {
+	downloaderPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(downloaderReadRepairs)
+	})
+
+	readRepairsSucceeded := make([]prometheus.Counter, 0, len(replicas))
+	readRepairsFailed := make([]prometheus.Counter, 0, len(replicas))
+	for replicaIndex := range replicas {
+		replica := strconv.FormatInt(int64(replicaIndex), 10)
+		readRepairsSucceeded = append(readRepairsSucceeded, downloaderReadRepairs.WithLabelValues(replica, "Succeeded"))
+		readRepairsFailed = append(readRepairsFailed, downloaderReadRepairs.WithLabelValues(replica, "Failed"))
+	}
+	return &downloader[TReference, TLease]{
+		replicas:             replicas,
+		readQuorum:           readQuorum,
+		errorLogger:          errorLogger,
+		readRepairsSucceeded: readRepairsSucceeded,
+		readRepairsFailed:    readRepairsFailed,
+	}
+}
+
+func (d *downloader[TReference, TLease]) DownloadObject(ctx context.Context, reference TReference) (*object.Contents, error) {
+	replicasCount := len(d.replicas)
+	firstReplicaIndex := int(d.round.Add(1) % uint32(replicasCount))
+	var missingReplicaIndices []int
+	var errFirst error
+	for i := 0; i < replicasCount; i++ {
+		replicaIndex := (firstReplicaIndex + i) % replicasCount
+		contents, err := d.replicas[replicaIndex].DownloadObject(ctx, reference)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				missingReplicaIndices = append(missingReplicaIndices, replicaIndex)
+			} else if errFirst == nil {
+				errFirst = util.StatusWrapf(err, "Replica %d", replicaIndex)
+			}
+			continue
+		}
+
+		// Replicate the object to the replicas that were
+		// missing it. As we don't replicate any of its
+		// children, we can't provide leases to make the object
+		// complete. This is good enough to make subsequent
+		// calls to DownloadObject() work.
+		//
+		// Failing to do so should not cause the read to fail,
+		// as the object was obtained successfully.
+		for _, missingReplicaIndex := range missingReplicaIndices {
+			if _, err := d.replicas[missingReplicaIndex].UploadObject(
+				ctx,
+				reference,
+				contents,
+				/* childrenLeases = */ nil,
+				/* wantContentsIfIncomplete = */ false,
+			); err == nil {
+				d.readRepairsSucceeded[missingReplicaIndex].Inc()
+			} else {
+				d.readRepairsFailed[missingReplicaIndex].Inc()
+				d.errorLogger.Log(util.StatusWrapf(err, "Failed to repair object %s in replica %d", contents.GetLocalReference(), missingReplicaIndex))
+			}
+		}
+		return contents, nil
+	}
+
+	// As readQuorum is at least 1, this also holds if all replicas
+	// reported the object as being absent.
+	if len(missingReplicaIndices) > replicasCount-d.readQuorum {
+		return nil, status.Errorf(codes.NotFound, "Object not found in %d replicas", len(missingReplicaIndices))
+	}
+	return nil, util.StatusWrapf(errFirst, "Object not found in %d replicas, while at least %d are required to report it as absent", len(missingReplicaIndices), replicasCount-d.readQuorum+1)
+}
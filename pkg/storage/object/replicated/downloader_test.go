@@ -0,0 +1,92 @@
+package replicated_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/replicated"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDownloader(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	replica0 := NewMockStoreForTesting(ctrl)
+	replica1 := NewMockStoreForTesting(ctrl)
+	replica2 := NewMockStoreForTesting(ctrl)
+	errorLogger := NewMockErrorLogger(ctrl)
+	downloader := replicated.NewDownloader(
+		[]object.Store[object.GlobalReference, any]{replica0, replica1, replica2},
+		/* readQuorum = */ 2,
+		errorLogger,
+	)
+
+	contents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello"))
+	reference := object.NewInstanceName("hello/world").WithLocalReference(contents.LocalReference)
+
+	// Replicas are queried in round-robin fashion. The first call
+	// starts at replica 1.
+	t.Run("FoundAfterMissing", func(t *testing.T) {
+		// Even if the object is absent in as many replicas as
+		// the read quorum, the remaining replicas should still
+		// be queried. The object should be repaired in the
+		// replicas that were missing it. Failures to do so
+		// should be logged, but not cause the read to fail.
+		gomock.InOrder(
+			replica1.EXPECT().DownloadObject(ctx, reference).
+				Return(nil, status.Error(codes.NotFound, "Object not found")),
+			replica2.EXPECT().DownloadObject(ctx, reference).
+				Return(nil, status.Error(codes.NotFound, "Object not found")),
+			replica0.EXPECT().DownloadObject(ctx, reference).
+				Return(contents, nil),
+		)
+		replica1.EXPECT().UploadObject(ctx, reference, contents, nil, false).
+			Return(object.UploadObjectComplete[any]{Lease: "Lease 1"}, nil)
+		replica2.EXPECT().UploadObject(ctx, reference, contents, nil, false).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to repair object SHA256=185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969:S=5:H=0:D=0:M=0 in replica 2: Server offline")))
+
+		objectContents, err := downloader.DownloadObject(ctx, reference)
+		require.NoError(t, err)
+		require.Equal(t, contents, objectContents)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		// If one replica is unavailable, two replicas reporting
+		// the object as absent are sufficient to conclude that
+		// fewer than two replicas contain it.
+		replica2.EXPECT().DownloadObject(ctx, reference).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+		replica0.EXPECT().DownloadObject(ctx, reference).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+		replica1.EXPECT().DownloadObject(ctx, reference).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found in 2 replicas"), err)
+	})
+
+	t.Run("TooManyFailures", func(t *testing.T) {
+		// If only one replica reports the object as absent, the
+		// object may still be present in the replicas that
+		// failed. The error should be propagated.
+		replica0.EXPECT().DownloadObject(ctx, reference).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+		replica1.EXPECT().DownloadObject(ctx, reference).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+		replica2.EXPECT().DownloadObject(ctx, reference).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Object not found in 1 replicas, while at least 2 are required to report it as absent: Replica 0: Server offline"), err)
+	})
+}
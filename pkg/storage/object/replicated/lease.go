@@ -0,0 +1,18 @@
+package replicated
+
+// Lease type that is returned by Uploader. It contains a lease for each
+// of the replicas. Replicas for which no lease could be obtained (e.g.,
+// due to the replica being unavailable) have their lease set to the
+// zero value.
+type Lease[TLease any] []TLease
+
+// GetReplicaLease returns the lease of a single replica. If the lease
+// was obtained when fewer replicas were configured, the zero value is
+// returned.
+func (l Lease[TLease]) GetReplicaLease(replicaIndex int) TLease {
+	if replicaIndex < len(l) {
+		return l[replicaIndex]
+	}
+	var zeroLease TLease
+	return zeroLease
+}
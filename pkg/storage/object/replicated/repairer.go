@@ -0,0 +1,319 @@
+package replicated
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	repairerPrometheusMetrics sync.Once
+
+	repairerObjectsChecked = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "replicated",
+			Name:      "repairer_objects_checked_total",
+			Help:      "Number of objects whose presence in all replicas was checked by the repairer",
+		},
+	)
+	repairerObjectsRepaired = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "replicated",
+			Name:      "repairer_objects_repaired_total",
+			Help:      "Number of objects that were copied to a replica by the repairer, because the replica was missing them",
+		},
+		[]string{"replica"},
+	)
+)
+
+// Repairer of DAGs that are stored in a set of replicas.
+//
+// Whereas Downloader and Uploader only repair inconsistencies between
+// replicas for objects that are accessed by clients, Repairer can be
+// used to proactively traverse DAGs in their entirety. Every object
+// that is reachable from the root object is checked for presence in
+// all replicas, and copied to replicas that are missing it. This allows
+// a replica whose contents were lost (e.g., due to a storage device
+// being replaced) to converge quickly, even if no client traffic is
+// present.
+//
+// Objects for which all replicas report that they are complete (e.g.,
+// because they were repaired by an earlier pass and their leases have
+// not expired yet) are not traversed any further. This means that
+// repeatedly repairing the same DAG only requires traversing the parts
+// of the DAG that changed or got lost in the meantime.
+type Repairer[TLease any] struct {
+	replicas             []object.Store[object.GlobalReference, TLease]
+	downloader           object.Downloader[object.GlobalReference]
+	objectStoreSemaphore *semaphore.Weighted
+	goroutinesSemaphore  *semaphore.Weighted
+	objectsRepaired      []prometheus.Counter
+}
+
+// NewRepairer creates a Repairer that is capable of repairing DAGs
+// stored in a set of replicas. The number of concurrent operations
+// against the replicas and the number of objects that are repaired in
+// parallel are bounded by the provided concurrency, ensuring that
+// repairing does not starve client traffic.
+//
+// The provided ErrorLogger is used to report failures to copy objects
+// to replicas while they are downloaded, which are retried as part of
+// repairing the object.
+func NewRepairer[TLease any](replicas []object.Store[object.GlobalReference, TLease], concurrency int64, errorLogger util.ErrorLogger) *Repairer[TLease] {
+	repairerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(repairerObjectsChecked)
+		prometheus.MustRegister(repairerObjectsRepaired)
+	})
+
+	objectsRepaired := make([]prometheus.Counter, 0, len(replicas))
+	for replicaIndex := range replicas {
+		objectsRepaired = append(objectsRepaired, repairerObjectsRepaired.WithLabelValues(strconv.FormatInt(int64(replicaIndex), 10)))
+	}
+	return &Repairer[TLease]{
+		replicas:             replicas,
+		downloader:           NewDownloader(replicas, len(replicas), errorLogger),
+		objectStoreSemaphore: semaphore.NewWeighted(concurrency),
+		goroutinesSemaphore:  semaphore.NewWeighted(concurrency),
+		objectsRepaired:      objectsRepaired,
+	}
+}
+
+// RepairDAG traverses a DAG and ensures that all of its objects are
+// present in all replicas. Upon success, a lease is returned that
+// contains valid leases for all replicas. This lease may be used to
+// update tags referencing the root object.
+func (r *Repairer[TLease]) RepairDAG(ctx context.Context, rootReference object.GlobalReference) (Lease[TLease], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	p := repairPass[TLease]{
+		repairer: r,
+		context:  ctx,
+		cancel:   cancel,
+		objects:  map[object.LocalReference]*repairObjectState[TLease]{},
+	}
+	o := p.getOrCreateObjectState(rootReference)
+	p.goroutines.Wait()
+	if p.err != nil {
+		return nil, p.err
+	}
+	select {
+	case <-o.done:
+		return o.lease, nil
+	default:
+		return nil, util.StatusFromContext(ctx)
+	}
+}
+
+// repairPass contains the state of a single call to RepairDAG(). It
+// ensures that objects that are referenced by multiple parents are only
+// repaired once.
+type repairPass[TLease any] struct {
+	repairer   *Repairer[TLease]
+	context    context.Context
+	cancel     context.CancelFunc
+	goroutines sync.WaitGroup
+
+	lock    sync.Mutex
+	objects map[object.LocalReference]*repairObjectState[TLease]
+	err     error
+}
+
+type repairObjectState[TLease any] struct {
+	done  chan struct{}
+	lease Lease[TLease]
+}
+
+// getOrCreateObjectState returns the state of an object that is part
+// of the DAG that is being repaired. If the object has not been
+// encountered before, it is repaired in the background. If the
+// maximum number of objects are already being repaired in the
+// background, it is repaired synchronously instead. This bounds the
+// number of goroutines, while guaranteeing progress.
+func (p *repairPass[TLease]) getOrCreateObjectState(reference object.GlobalReference) *repairObjectState[TLease] {
+	p.lock.Lock()
+	if o, ok := p.objects[reference.LocalReference]; ok {
+		p.lock.Unlock()
+		return o
+	}
+	o := &repairObjectState[TLease]{
+		done: make(chan struct{}),
+	}
+	p.objects[reference.LocalReference] = o
+	p.lock.Unlock()
+
+	if goroutinesSemaphore := p.repairer.goroutinesSemaphore; goroutinesSemaphore.TryAcquire(1) {
+		p.goroutines.Add(1)
+		go func() {
+			p.repairObjectState(o, reference)
+			goroutinesSemaphore.Release(1)
+			p.goroutines.Done()
+		}()
+	} else {
+		p.repairObjectState(o, reference)
+	}
+	return o
+}
+
+// repairObjectState repairs a single object, and either marks its
+// state as done or causes the pass to fail.
+func (p *repairPass[TLease]) repairObjectState(o *repairObjectState[TLease], reference object.GlobalReference) {
+	lease, err := p.repairObject(reference)
+	if err != nil {
+		p.lock.Lock()
+		if p.err == nil {
+			p.err = util.StatusWrapf(err, "Object with reference %s", reference)
+			p.cancel()
+		}
+		p.lock.Unlock()
+		return
+	}
+	o.lease = lease
+	close(o.done)
+}
+
+// acquireObjectStoreSemaphore waits until fewer than the maximum
+// number of requests against the object stores are in flight.
+func (p *repairPass[TLease]) acquireObjectStoreSemaphore() error {
+	if err := p.repairer.objectStoreSemaphore.Acquire(p.context, 1); err != nil {
+		return util.StatusFromContext(p.context)
+	}
+	return nil
+}
+
+func (p *repairPass[TLease]) downloadObject(reference object.GlobalReference) (*object.Contents, error) {
+	if err := p.acquireObjectStoreSemaphore(); err != nil {
+		return nil, err
+	}
+	defer p.repairer.objectStoreSemaphore.Release(1)
+	return p.repairer.downloader.DownloadObject(p.context, reference)
+}
+
+func (p *repairPass[TLease]) uploadObject(replicaIndex int, reference object.GlobalReference, contents *object.Contents, childrenLeases []TLease) (object.UploadObjectResult[TLease], error) {
+	if err := p.acquireObjectStoreSemaphore(); err != nil {
+		return nil, err
+	}
+	defer p.repairer.objectStoreSemaphore.Release(1)
+	return p.repairer.replicas[replicaIndex].UploadObject(
+		p.context,
+		reference,
+		contents,
+		childrenLeases,
+		/* wantContentsIfIncomplete = */ false,
+	)
+}
+
+// repairObject repairs a single object in all replicas. If the object
+// is not complete in all replicas, its children are repaired first, so
+// that the leases of the children can be provided when updating the
+// object.
+func (p *repairPass[TLease]) repairObject(reference object.GlobalReference) (Lease[TLease], error) {
+	r := p.repairer
+	repairerObjectsChecked.Inc()
+
+	// Check whether the object is already complete in all
+	// replicas. If so, there is no need to traverse its children.
+	replicasCount := len(r.replicas)
+	lease := make(Lease[TLease], replicasCount)
+	var incompleteReplicaIndices []int
+	for replicaIndex := range r.replicas {
+		result, err := p.uploadObject(replicaIndex, reference, nil, nil)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Replica %d", replicaIndex)
+		}
+		if resultType, ok := result.(object.UploadObjectComplete[TLease]); ok {
+			lease[replicaIndex] = resultType.Lease
+		} else {
+			incompleteReplicaIndices = append(incompleteReplicaIndices, replicaIndex)
+		}
+	}
+	if len(incompleteReplicaIndices) == 0 {
+		return lease, nil
+	}
+
+	// Repair all children of the object, and wait for them to
+	// complete. The object's contents need to be downloaded to
+	// determine the references of its children.
+	var contents *object.Contents
+	var childrenLeases []Lease[TLease]
+	if degree := reference.GetDegree(); degree > 0 {
+		var err error
+		contents, err = p.downloadObject(reference)
+		if err != nil {
+			return nil, err
+		}
+
+		children := make([]*repairObjectState[TLease], 0, degree)
+		for i := 0; i < degree; i++ {
+			children = append(children, p.getOrCreateObjectState(reference.WithLocalReference(contents.GetOutgoingReference(i))))
+		}
+		childrenLeases = make([]Lease[TLease], 0, degree)
+		for _, child := range children {
+			select {
+			case <-child.done:
+				childrenLeases = append(childrenLeases, child.lease)
+			case <-p.context.Done():
+				return nil, util.StatusFromContext(p.context)
+			}
+		}
+	}
+
+	// Provide the leases of the children to each of the replicas
+	// that reported the object as being incomplete. If a replica is
+	// missing the object, upload its contents.
+	for _, replicaIndex := range incompleteReplicaIndices {
+		var replicaChildrenLeases []TLease
+		if len(childrenLeases) > 0 {
+			replicaChildrenLeases = make([]TLease, 0, len(childrenLeases))
+			for _, childLease := range childrenLeases {
+				replicaChildrenLeases = append(replicaChildrenLeases, childLease.GetReplicaLease(replicaIndex))
+			}
+		}
+
+		// Objects without any children can only be incomplete
+		// if they are missing.
+		var result object.UploadObjectResult[TLease] = object.UploadObjectMissing[TLease]{}
+		var err error
+		if len(replicaChildrenLeases) > 0 {
+			result, err = p.uploadObject(replicaIndex, reference, nil, replicaChildrenLeases)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Replica %d", replicaIndex)
+			}
+		}
+		if _, ok := result.(object.UploadObjectMissing[TLease]); ok {
+			if contents == nil {
+				contents, err = p.downloadObject(reference)
+				if err != nil {
+					return nil, err
+				}
+			}
+			result, err = p.uploadObject(replicaIndex, reference, contents, replicaChildrenLeases)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Replica %d", replicaIndex)
+			}
+			r.objectsRepaired[replicaIndex].Inc()
+		}
+
+		switch resultType := result.(type) {
+		case object.UploadObjectComplete[TLease]:
+			lease[replicaIndex] = resultType.Lease
+		case object.UploadObjectIncomplete[TLease]:
+			return nil, status.Errorf(codes.Internal, "Replica %d reported the object as being incomplete, even though leases for all children were provided", replicaIndex)
+		case object.UploadObjectMissing[TLease]:
+			return nil, status.Errorf(codes.Internal, "Replica %d reported the object as being missing, even though its contents were provided", replicaIndex)
+		default:
+			panic("unexpected upload object result type")
+		}
+	}
+	return lease, nil
+}
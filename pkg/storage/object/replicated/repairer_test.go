@@ -0,0 +1,170 @@
+package replicated_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/replicated"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRepairer(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	replica0 := NewMockStoreForTesting(ctrl)
+	replica1 := NewMockStoreForTesting(ctrl)
+	errorLogger := NewMockErrorLogger(ctrl)
+	repairer := replicated.NewRepairer(
+		[]object.Store[object.GlobalReference, any]{replica0, replica1},
+		/* concurrency = */ 1,
+		errorLogger,
+	)
+
+	t.Run("ReplicaFailure", func(t *testing.T) {
+		// If one of the replicas fails, the DAG cannot be
+		// repaired. The error should be propagated.
+		reference := object.MustNewSHA256V1GlobalReference("hello/world", "9ac1fefc063b1334861bfe138a89259785df02cef445c36f7e33c8f7c9b56a2c", 48583, 0, 0, 0)
+		replica0.EXPECT().UploadObject(
+			gomock.Any(),
+			reference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{
+			Lease: "Lease 0",
+		}, nil)
+		replica1.EXPECT().UploadObject(
+			gomock.Any(),
+			reference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(nil, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := repairer.RepairDAG(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Object with reference SHA256=9ac1fefc063b1334861bfe138a89259785df02cef445c36f7e33c8f7c9b56a2c:S=48583:H=0:D=0:M=0: Replica 1: Server offline"), err)
+	})
+
+	t.Run("RepairMissingChild", func(t *testing.T) {
+		// The root object is present in both replicas, but
+		// its child is only present in replica 0. The child
+		// should be copied to replica 1, and the leases of
+		// the child should be provided to the root object in
+		// replica 1.
+		leafContents := object.MustNewContents(
+			object_pb.ReferenceFormat_SHA256_V1,
+			object.OutgoingReferencesList[object.LocalReference]{},
+			[]byte("Hello"),
+		)
+		leafReference := object.NewInstanceName("hello/world").WithLocalReference(leafContents.GetLocalReference())
+		rootContents := object.MustNewContents(
+			object_pb.ReferenceFormat_SHA256_V1,
+			object.OutgoingReferencesList[object.LocalReference]{
+				leafContents.GetLocalReference(),
+			},
+			[]byte("World"),
+		)
+		rootReference := object.NewInstanceName("hello/world").WithLocalReference(rootContents.GetLocalReference())
+
+		replica0.EXPECT().UploadObject(
+			gomock.Any(),
+			rootReference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{
+			Lease: "Root lease 0",
+		}, nil)
+		replica1.EXPECT().UploadObject(
+			gomock.Any(),
+			rootReference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectIncomplete[any]{
+			WantOutgoingReferencesLeases: []int{0},
+		}, nil)
+		replica1.EXPECT().DownloadObject(gomock.Any(), rootReference).
+			Return(rootContents, nil)
+
+		replica0.EXPECT().UploadObject(
+			gomock.Any(),
+			leafReference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{
+			Lease: "Leaf lease 0",
+		}, nil)
+		replica1.EXPECT().UploadObject(
+			gomock.Any(),
+			leafReference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectMissing[any]{}, nil)
+		replica0.EXPECT().DownloadObject(gomock.Any(), leafReference).
+			Return(leafContents, nil)
+		replica1.EXPECT().UploadObject(
+			gomock.Any(),
+			leafReference,
+			leafContents,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{
+			Lease: "Leaf lease 1",
+		}, nil)
+
+		replica1.EXPECT().UploadObject(
+			gomock.Any(),
+			rootReference,
+			/* contents = */ nil,
+			[]any{"Leaf lease 1"},
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{
+			Lease: "Root lease 1",
+		}, nil)
+
+		lease, err := repairer.RepairDAG(ctx, rootReference)
+		require.NoError(t, err)
+		require.Equal(t, replicated.Lease[any]{"Root lease 0", "Root lease 1"}, lease)
+	})
+
+	t.Run("CompleteInAllReplicas", func(t *testing.T) {
+		// If the root object is complete in all replicas, its
+		// children are known to be present. There is no need
+		// to traverse them.
+		reference := object.MustNewSHA256V1GlobalReference("hello/world", "2a9f0d6ac2d5e81e8e5bd60a0b6df77b5e0bd01c4ff3baf79c5e1f2b1e2a6f43", 1200, 3, 5, 4096)
+		replica0.EXPECT().UploadObject(
+			gomock.Any(),
+			reference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{
+			Lease: "Lease 0",
+		}, nil)
+		replica1.EXPECT().UploadObject(
+			gomock.Any(),
+			reference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{
+			Lease: "Lease 1",
+		}, nil)
+
+		lease, err := repairer.RepairDAG(ctx, reference)
+		require.NoError(t, err)
+		require.Equal(t, replicated.Lease[any]{"Lease 0", "Lease 1"}, lease)
+	})
+}
@@ -0,0 +1,174 @@
+package replicated
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type uploader[TReference, TLease any] struct {
+	replicas    []object.Store[TReference, TLease]
+	writeQuorum int
+}
+
+// NewUploader creates a decorator for object.Uploader that writes all
+// objects to a set of replicas. If any inconsistencies between the
+// replicas are detected (e.g., the object is only present in some of
+// the replicas), they are repaired automatically.
+//
+// Writes only need to succeed for writeQuorum replicas. Leases of
+// replicas for which writes failed are left unset. This causes objects
+// to be reported as incomplete when the replica becomes available once
+// again, which in turn causes lease renewing to repair them.
+func NewUploader[TReference, TLease any](replicas []object.Store[TReference, TLease], writeQuorum int) object.Uploader[TReference, Lease[TLease]] {
+	return &uploader[TReference, TLease]{
+		replicas:    replicas,
+		writeQuorum: writeQuorum,
+	}
+}
+
+func (u *uploader[TReference, TLease]) UploadObject(ctx context.Context, reference TReference, contents *object.Contents, childrenLeases []Lease[TLease], wantContentsIfIncomplete bool) (object.UploadObjectResult[Lease[TLease]], error) {
+	// Decompose the leases into separate lists for each replica.
+	replicasCount := len(u.replicas)
+	childrenLeasesPerReplica := make([][]TLease, replicasCount)
+	if len(childrenLeases) > 0 {
+		for replicaIndex := range childrenLeasesPerReplica {
+			replicaChildrenLeases := make([]TLease, 0, len(childrenLeases))
+			for _, lease := range childrenLeases {
+				replicaChildrenLeases = append(replicaChildrenLeases, lease.GetReplicaLease(replicaIndex))
+			}
+			childrenLeasesPerReplica[replicaIndex] = replicaChildrenLeases
+		}
+	}
+
+	// Forward the original request to all replicas. Don't cancel
+	// requests if one of the replicas fails, as we may still reach
+	// the write quorum.
+	results := make([]object.UploadObjectResult[TLease], replicasCount)
+	errs := make([]error, replicasCount)
+	var wg sync.WaitGroup
+	for replicaIndex, replica := range u.replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[replicaIndex], errs[replicaIndex] = replica.UploadObject(ctx, reference, contents, childrenLeasesPerReplica[replicaIndex], wantContentsIfIncomplete)
+		}()
+	}
+	wg.Wait()
+
+	// If one of the results contains the object's contents, extract
+	// it. This prevents the need for calling DownloadObject() if the
+	// object needs to be replicated.
+	var presentReplicaIndices, missingReplicaIndices []int
+	for replicaIndex, result := range results {
+		if errs[replicaIndex] != nil {
+			continue
+		}
+		switch resultData := result.(type) {
+		case object.UploadObjectComplete[TLease]:
+			presentReplicaIndices = append(presentReplicaIndices, replicaIndex)
+		case object.UploadObjectIncomplete[TLease]:
+			if contents == nil {
+				contents = resultData.Contents
+			}
+			presentReplicaIndices = append(presentReplicaIndices, replicaIndex)
+		case object.UploadObjectMissing[TLease]:
+			missingReplicaIndices = append(missingReplicaIndices, replicaIndex)
+		default:
+			panic("unexpected upload object result type")
+		}
+	}
+
+	if len(presentReplicaIndices) > 0 && len(missingReplicaIndices) > 0 {
+		// Object is only present in some of the replicas.
+		// Replicate it to the replicas that are missing it.
+		if contents == nil {
+			var err error
+			contents, err = u.replicas[presentReplicaIndices[0]].DownloadObject(ctx, reference)
+			if err != nil {
+				if status.Code(err) != codes.NotFound {
+					return nil, util.StatusWrapf(err, "Replica %d", presentReplicaIndices[0])
+				}
+				return object.UploadObjectMissing[Lease[TLease]]{}, nil
+			}
+		}
+
+		for _, replicaIndex := range missingReplicaIndices {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[replicaIndex], errs[replicaIndex] = u.replicas[replicaIndex].UploadObject(
+					ctx,
+					reference,
+					contents,
+					childrenLeasesPerReplica[replicaIndex],
+					/* wantContentsIfIncomplete = */ false,
+				)
+			}()
+		}
+		wg.Wait()
+	}
+
+	// Require that the write quorum is met.
+	succeededReplicasCount := 0
+	var errFirst error
+	for replicaIndex, err := range errs {
+		if err == nil {
+			succeededReplicasCount++
+		} else if errFirst == nil {
+			errFirst = util.StatusWrapf(err, "Replica %d", replicaIndex)
+		}
+	}
+	if succeededReplicasCount < u.writeQuorum {
+		return nil, util.StatusWrapf(errFirst, "Only %d of %d replicas succeeded, while a write quorum of %d is required", succeededReplicasCount, replicasCount, u.writeQuorum)
+	}
+
+	// Combine the results from all replicas. If the object is
+	// incomplete in any of the replicas, request leases for the
+	// union of all outgoing references that are incomplete.
+	leases := make(Lease[TLease], replicasCount)
+	var wantOutgoingReferencesLeases []int
+	isIncomplete, isMissing := false, false
+	for replicaIndex, result := range results {
+		if errs[replicaIndex] != nil {
+			continue
+		}
+		switch resultData := result.(type) {
+		case object.UploadObjectComplete[TLease]:
+			leases[replicaIndex] = resultData.Lease
+		case object.UploadObjectIncomplete[TLease]:
+			isIncomplete = true
+			wantOutgoingReferencesLeases = append(wantOutgoingReferencesLeases, resultData.WantOutgoingReferencesLeases...)
+		case object.UploadObjectMissing[TLease]:
+			isMissing = true
+		default:
+			panic("unexpected upload object result type")
+		}
+	}
+	if isMissing {
+		// The object is missing in all replicas. Report the
+		// object as missing.
+		return object.UploadObjectMissing[Lease[TLease]]{}, nil
+	}
+	if isIncomplete {
+		var contentsIfIncomplete *object.Contents
+		if wantContentsIfIncomplete {
+			contentsIfIncomplete = contents
+		}
+		slices.Sort(wantOutgoingReferencesLeases)
+		return object.UploadObjectIncomplete[Lease[TLease]]{
+			Contents:                     contentsIfIncomplete,
+			WantOutgoingReferencesLeases: slices.Compact(wantOutgoingReferencesLeases),
+		}, nil
+	}
+	return object.UploadObjectComplete[Lease[TLease]]{
+		Lease: leases,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package resharding
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type downloader[TReference, TLease any] struct {
+	previous object.Downloader[TReference]
+	current  object.Store[TReference, TLease]
+}
+
+// NewDownloader creates a decorator for object.Downloader that can be
+// used while objects are being migrated from a previous to a current
+// set of shards (e.g., after adding shards or changing their weights).
+//
+// Read requests first go to the current set of shards. If the object
+// cannot be found, it is read from the previous set of shards and
+// written into the current set of shards prior to returning.
+func NewDownloader[TReference, TLease any](previous object.Downloader[TReference], current object.Store[TReference, TLease]) object.Downloader[TReference] {
+	reshardingPrometheusMetrics.Do(registerPrometheusMetrics)
+
+	return &downloader[TReference, TLease]{
+		previous: previous,
+		current:  current,
+	}
+}
+
+func (d *downloader[TReference, TLease]) DownloadObject(ctx context.Context, reference TReference) (*object.Contents, error) {
+	contents, err := d.current.DownloadObject(ctx, reference)
+	if err == nil || status.Code(err) != codes.NotFound {
+		return contents, err
+	}
+
+	// Object not found in the current set of shards. Get it from the
+	// previous set of shards and migrate it. As we don't migrate
+	// any of its children, we can't provide leases to make the
+	// object complete. This is good enough to make subsequent
+	// calls to DownloadObject() work.
+	contents, err = d.previous.DownloadObject(ctx, reference)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Previous shards")
+	}
+	if _, err := d.current.UploadObject(
+		ctx,
+		reference,
+		contents,
+		/* childrenLeases = */ nil,
+		/* wantContentsIfIncomplete = */ false,
+	); err != nil {
+		return nil, util.StatusWrap(err, "Failed to migrate object to current shards")
+	}
+	objectsMigratedDownload.Inc()
+	return contents, nil
+}
@@ -0,0 +1,82 @@
+package resharding_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/resharding"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDownloader(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	previous := NewMockDownloaderForTesting(ctrl)
+	current := NewMockStoreForTesting(ctrl)
+	downloader := resharding.NewDownloader[object.GlobalReference, any](previous, current)
+
+	contents := object.MustNewContents(
+		object_pb.ReferenceFormat_SHA256_V1,
+		object.OutgoingReferencesList[object.LocalReference]{},
+		[]byte("Hello"),
+	)
+	reference := object.NewInstanceName("hello/world").WithLocalReference(contents.GetLocalReference())
+
+	t.Run("FoundInCurrent", func(t *testing.T) {
+		// Objects that are present in the current set of
+		// shards should be returned directly.
+		current.EXPECT().DownloadObject(gomock.Any(), reference).Return(contents, nil)
+
+		actualContents, err := downloader.DownloadObject(ctx, reference)
+		require.NoError(t, err)
+		require.Equal(t, contents, actualContents)
+	})
+
+	t.Run("FailureCurrent", func(t *testing.T) {
+		// Errors other than NOT_FOUND should not cause the
+		// previous set of shards to be consulted.
+		current.EXPECT().DownloadObject(gomock.Any(), reference).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("NotFoundBoth", func(t *testing.T) {
+		current.EXPECT().DownloadObject(gomock.Any(), reference).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+		previous.EXPECT().DownloadObject(gomock.Any(), reference).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Previous shards: Object not found"), err)
+	})
+
+	t.Run("Migrated", func(t *testing.T) {
+		// Objects that are only present in the previous set of
+		// shards should be copied to the current set of shards.
+		current.EXPECT().DownloadObject(gomock.Any(), reference).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+		previous.EXPECT().DownloadObject(gomock.Any(), reference).Return(contents, nil)
+		current.EXPECT().UploadObject(
+			gomock.Any(),
+			reference,
+			contents,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{Lease: "Lease"}, nil)
+
+		actualContents, err := downloader.DownloadObject(ctx, reference)
+		require.NoError(t, err)
+		require.Equal(t, contents, actualContents)
+	})
+}
@@ -0,0 +1,83 @@
+package resharding
+
+import (
+	"context"
+	"sync"
+
+	"bonanza.build/pkg/storage/object"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	reshardingPrometheusMetrics sync.Once
+
+	objectsMigrated = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "resharding",
+			Name:      "objects_migrated_total",
+			Help:      "Number of objects that were copied from the previous set of shards to the current set of shards",
+		},
+		[]string{"operation"},
+	)
+	objectsMigratedDownload = objectsMigrated.WithLabelValues("Download")
+	objectsMigratedUpload   = objectsMigrated.WithLabelValues("Upload")
+)
+
+func registerPrometheusMetrics() {
+	prometheus.MustRegister(objectsMigrated)
+}
+
+type uploader[TReference, TLease any] struct {
+	previous object.Downloader[TReference]
+	current  object.Uploader[TReference, TLease]
+}
+
+// NewUploader creates a decorator for object.Uploader that can be used
+// while objects are being migrated from a previous to a current set of
+// shards.
+//
+// Writes always go to the current set of shards. If the current set of
+// shards reports an object as missing and no contents are provided,
+// an attempt is made to read the object from the previous set of
+// shards. This prevents clients from needing to upload objects that
+// are still present in storage, but have not been migrated yet.
+func NewUploader[TReference, TLease any](previous object.Downloader[TReference], current object.Uploader[TReference, TLease]) object.Uploader[TReference, TLease] {
+	reshardingPrometheusMetrics.Do(registerPrometheusMetrics)
+
+	return &uploader[TReference, TLease]{
+		previous: previous,
+		current:  current,
+	}
+}
+
+func (u *uploader[TReference, TLease]) UploadObject(ctx context.Context, reference TReference, contents *object.Contents, childrenLeases []TLease, wantContentsIfIncomplete bool) (object.UploadObjectResult[TLease], error) {
+	result, err := u.current.UploadObject(ctx, reference, contents, childrenLeases, wantContentsIfIncomplete)
+	if err != nil || contents != nil {
+		return result, err
+	}
+	if _, ok := result.(object.UploadObjectMissing[TLease]); !ok {
+		return result, nil
+	}
+
+	// Object is missing in the current set of shards. Attempt to
+	// migrate it from the previous set of shards.
+	contents, err = u.previous.DownloadObject(ctx, reference)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return result, nil
+		}
+		return nil, util.StatusWrap(err, "Previous shards")
+	}
+	result, err = u.current.UploadObject(ctx, reference, contents, childrenLeases, wantContentsIfIncomplete)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to migrate object to current shards")
+	}
+	objectsMigratedUpload.Inc()
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package resharding_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/object/resharding"
+
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUploader(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	previous := NewMockDownloaderForTesting(ctrl)
+	current := NewMockUploaderForTesting(ctrl)
+	uploader := resharding.NewUploader[object.GlobalReference, any](previous, current)
+
+	contents := object.MustNewContents(
+		object_pb.ReferenceFormat_SHA256_V1,
+		object.OutgoingReferencesList[object.LocalReference]{},
+		[]byte("Hello"),
+	)
+	reference := object.NewInstanceName("hello/world").WithLocalReference(contents.GetLocalReference())
+
+	t.Run("MissingBoth", func(t *testing.T) {
+		// If the object is absent in both sets of shards, it
+		// should be reported as missing, so that the client
+		// uploads it.
+		current.EXPECT().UploadObject(
+			gomock.Any(),
+			reference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectMissing[any]{}, nil)
+		previous.EXPECT().DownloadObject(gomock.Any(), reference).
+			Return(nil, status.Error(codes.NotFound, "Object not found"))
+
+		result, err := uploader.UploadObject(
+			ctx,
+			reference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		)
+		require.NoError(t, err)
+		require.Equal(t, object.UploadObjectMissing[any]{}, result)
+	})
+
+	t.Run("Migrated", func(t *testing.T) {
+		// If the object is only present in the previous set of
+		// shards, it should be copied to the current set of
+		// shards, so that the client doesn't need to upload it.
+		current.EXPECT().UploadObject(
+			gomock.Any(),
+			reference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectMissing[any]{}, nil)
+		previous.EXPECT().DownloadObject(gomock.Any(), reference).Return(contents, nil)
+		current.EXPECT().UploadObject(
+			gomock.Any(),
+			reference,
+			contents,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		).Return(object.UploadObjectComplete[any]{Lease: "Lease"}, nil)
+
+		result, err := uploader.UploadObject(
+			ctx,
+			reference,
+			/* contents = */ nil,
+			/* childrenLeases = */ nil,
+			/* wantContentsIfIncomplete = */ false,
+		)
+		require.NoError(t, err)
+		require.Equal(t, object.UploadObjectComplete[any]{Lease: "Lease"}, result)
+	})
+}
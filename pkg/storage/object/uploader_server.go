@@ -98,3 +98,30 @@ func (s *uploaderServer) UploadObject(ctx context.Context, request *object.Uploa
 		panic("unexpected upload object result")
 	}
 }
+
+func (s *uploaderServer) BatchUploadObjects(stream object.Uploader_BatchUploadObjectsServer) error {
+	return serveBatch(
+		stream.Context(),
+		stream.Recv,
+		func(ctx context.Context, request *object.BatchUploadObjectsRequest) *object.BatchUploadObjectsResponse {
+			response := &object.BatchUploadObjectsResponse{
+				RequestId: request.RequestId,
+			}
+			if request.Request == nil {
+				response.Result = &object.BatchUploadObjectsResponse_Error{
+					Error: status.New(codes.InvalidArgument, "No request provided").Proto(),
+				}
+			} else if uploadResponse, err := s.UploadObject(ctx, request.Request); err != nil {
+				response.Result = &object.BatchUploadObjectsResponse_Error{
+					Error: status.Convert(err).Proto(),
+				}
+			} else {
+				response.Result = &object.BatchUploadObjectsResponse_Response{
+					Response: uploadResponse,
+				}
+			}
+			return response
+		},
+		stream.Send,
+	)
+}
@@ -0,0 +1,37 @@
+package authorizing
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+	object_authorizing "bonanza.build/pkg/storage/object/authorizing"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type resolver struct {
+	base       tag.Resolver[object.Namespace]
+	authorizer auth.Authorizer
+}
+
+// NewResolver creates a decorator for tag.Resolver that only permits
+// tags to be resolved if the caller is authorized to access the
+// instance name of the namespace in which the tag is stored.
+func NewResolver(base tag.Resolver[object.Namespace], authorizer auth.Authorizer) tag.Resolver[object.Namespace] {
+	return &resolver{
+		base:       base,
+		authorizer: authorizer,
+	}
+}
+
+func (r *resolver) ResolveTag(ctx context.Context, namespace object.Namespace, tag *anypb.Any) (object.LocalReference, bool, error) {
+	if err := object_authorizing.AuthorizeInstanceName(ctx, r.authorizer, namespace.InstanceName); err != nil {
+		var badReference object.LocalReference
+		return badReference, false, util.StatusWrap(err, "Authorization")
+	}
+	return r.base.ResolveTag(ctx, namespace, tag)
+}
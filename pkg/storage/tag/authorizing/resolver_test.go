@@ -0,0 +1,66 @@
+package authorizing_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag/authorizing"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolver(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseResolver := NewMockResolverForTesting(ctrl)
+	resolver := authorizing.NewResolver(
+		baseResolver,
+		auth.NewStaticAuthorizer(func(instanceName digest.InstanceName) bool {
+			return instanceName.String() == "allowed"
+		}),
+	)
+
+	tag, err := anypb.New(&emptypb.Empty{})
+	require.NoError(t, err)
+	contents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello World"))
+
+	t.Run("Allowed", func(t *testing.T) {
+		namespace := object.NewInstanceName("allowed").WithLocalReference(contents.LocalReference).GetNamespace()
+		baseResolver.EXPECT().ResolveTag(ctx, namespace, tag).Return(contents.LocalReference, true, nil)
+
+		reference, complete, err := resolver.ResolveTag(ctx, namespace, tag)
+		require.NoError(t, err)
+		require.Equal(t, contents.LocalReference, reference)
+		require.True(t, complete)
+	})
+
+	t.Run("Denied", func(t *testing.T) {
+		// Requests for instance names that are not permitted
+		// by the authorizer should not be forwarded.
+		namespace := object.NewInstanceName("denied").WithLocalReference(contents.LocalReference).GetNamespace()
+
+		_, _, err := resolver.ResolveTag(ctx, namespace, tag)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+	})
+
+	t.Run("InvalidInstanceName", func(t *testing.T) {
+		// Instance names that cannot be represented as REv2
+		// instance names cannot be authorized.
+		namespace := object.NewInstanceName("hello/blobs/world").WithLocalReference(contents.LocalReference).GetNamespace()
+
+		_, _, err := resolver.ResolveTag(ctx, namespace, tag)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Instance name \"hello/blobs/world\" cannot be authorized: Instance name contains reserved keyword \"blobs\""), err)
+	})
+}
@@ -0,0 +1,36 @@
+package authorizing
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+	object_authorizing "bonanza.build/pkg/storage/object/authorizing"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type updater[TLease any] struct {
+	base       tag.Updater[object.GlobalReference, TLease]
+	authorizer auth.Authorizer
+}
+
+// NewUpdater creates a decorator for tag.Updater that only permits
+// tags to be updated if the caller is authorized to access the
+// instance name of the object to which the tag is assigned.
+func NewUpdater[TLease any](base tag.Updater[object.GlobalReference, TLease], authorizer auth.Authorizer) tag.Updater[object.GlobalReference, TLease] {
+	return &updater[TLease]{
+		base:       base,
+		authorizer: authorizer,
+	}
+}
+
+func (u *updater[TLease]) UpdateTag(ctx context.Context, tag *anypb.Any, reference object.GlobalReference, lease TLease, overwrite bool) error {
+	if err := object_authorizing.AuthorizeInstanceName(ctx, u.authorizer, reference.InstanceName); err != nil {
+		return util.StatusWrap(err, "Authorization")
+	}
+	return u.base.UpdateTag(ctx, tag, reference, lease, overwrite)
+}
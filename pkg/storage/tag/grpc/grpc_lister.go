@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"io"
+
+	tag_pb "bonanza.build/pkg/proto/storage/tag"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type grpcLister struct {
+	client tag_pb.ListerClient
+}
+
+// NewGRPCLister creates a tag lister that forwards all requests to
+// enumerate tags to a remote server using gRPC.
+func NewGRPCLister(client tag_pb.ListerClient) tag.Lister {
+	return &grpcLister{
+		client: client,
+	}
+}
+
+func (l *grpcLister) ListTags(ctx context.Context, yield func(namespace object.Namespace, tag *anypb.Any) error) error {
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := l.client.ListTags(ctxWithCancel, &tag_pb.ListTagsRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		for _, responseTag := range response.Tags {
+			namespace, err := object.NewNamespace(responseTag.Namespace)
+			if err != nil {
+				return util.StatusWrapWithCode(err, codes.Internal, "Server returned an invalid namespace")
+			}
+			if responseTag.Tag == nil {
+				return status.Error(codes.Internal, "Server returned an entry without a tag")
+			}
+			if err := yield(namespace, responseTag.Tag); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package tag
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Lister of tags. Unlike Resolver and Updater, which operate on
+// individual tags, Lister can be used to enumerate all tags contained
+// in storage. This is needed by processes that traverse all data
+// stored in a cluster, such as the replica repairer.
+type Lister interface {
+	// ListTags calls the provided function for every tag that is
+	// stored. Tags may be reported in any order. If the function
+	// returns an error, listing stops and the error is returned.
+	ListTags(ctx context.Context, yield func(namespace object.Namespace, tag *anypb.Any) error) error
+}
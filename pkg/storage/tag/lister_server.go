@@ -0,0 +1,48 @@
+package tag
+
+import (
+	"bonanza.build/pkg/proto/storage/tag"
+	"bonanza.build/pkg/storage/object"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// listTagsResponseMaximumTags is the maximum number of tags that are
+// returned as part of a single ListTagsResponse. It is chosen so that
+// responses remain well below gRPC's maximum message size.
+const listTagsResponseMaximumTags = 1000
+
+type listerServer struct {
+	lister Lister
+}
+
+// NewListerServer creates a gRPC server that is capable of enumerating
+// all tags contained in the tag store.
+func NewListerServer(lister Lister) tag.ListerServer {
+	return &listerServer{
+		lister: lister,
+	}
+}
+
+func (s *listerServer) ListTags(request *tag.ListTagsRequest, stream grpc.ServerStreamingServer[tag.ListTagsResponse]) error {
+	var tags []*tag.ListTagsResponse_Tag
+	if err := s.lister.ListTags(stream.Context(), func(namespace object.Namespace, t *anypb.Any) error {
+		tags = append(tags, &tag.ListTagsResponse_Tag{
+			Namespace: namespace.ToProto(),
+			Tag:       t,
+		})
+		if len(tags) < listTagsResponseMaximumTags {
+			return nil
+		}
+		err := stream.Send(&tag.ListTagsResponse{Tags: tags})
+		tags = nil
+		return err
+	}); err != nil {
+		return err
+	}
+	if len(tags) > 0 {
+		return stream.Send(&tag.ListTagsResponse{Tags: tags})
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package local
+
+import (
+	"context"
+	"math"
+	"time"
+
+	configuration_pb "bonanza.build/pkg/proto/configuration/storage/object/local"
+	pb "bonanza.build/pkg/proto/storage/tag/local"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewStoreFromConfiguration creates a new local tag store. If the
+// configuration of the local object store enables persistency, tags
+// are written to the same state directory, so that they can be
+// reloaded after a restart.
+func NewStoreFromConfiguration(
+	terminationGroup program.Group,
+	configuration *configuration_pb.StoreConfiguration,
+	clock clock.Clock,
+	leaseCompletenessDuration time.Duration,
+) (*Store, error) {
+	if configuration == nil {
+		return nil, status.Error(codes.InvalidArgument, "No configuration provided")
+	}
+
+	// If persistency is enabled, reload the tags from disk so that
+	// we can resume where the previous run left off.
+	var persistentStateStore PersistentStateStore
+	var initialTags []*pb.TagState
+	if pcfg := configuration.Persistent; pcfg != nil {
+		stateDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(pcfg.StateDirectoryPath))
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to open persistent state directory")
+		}
+		persistentStateStore = NewDirectoryBackedPersistentStateStore(stateDirectory)
+
+		initialPersistentState, err := persistentStateStore.ReadPersistentState()
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to read persistent state")
+		}
+		initialTags = initialPersistentState.Tags
+	}
+
+	maximumTags := configuration.MaximumTags
+	if maximumTags > math.MaxInt {
+		return nil, status.Error(codes.InvalidArgument, "Maximum number of tags is too large")
+	}
+	store, err := NewStore(clock, leaseCompletenessDuration, int(maximumTags), initialTags)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to restore tags from persistent state")
+	}
+
+	if pcfg := configuration.Persistent; pcfg != nil {
+		minimumEpochInterval := pcfg.MinimumEpochInterval
+		if err := minimumEpochInterval.CheckValid(); err != nil {
+			return nil, util.StatusWrap(err, "Invalid minimum epoch interval")
+		}
+
+		periodicSyncer := NewPeriodicSyncer(
+			store,
+			persistentStateStore,
+			clock,
+			util.DefaultErrorLogger,
+			/* errorRetryInterval = */ 10*time.Second,
+			minimumEpochInterval.AsDuration(),
+		)
+		terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			for periodicSyncer.ProcessTagsChanged(ctx) {
+			}
+			return nil
+		})
+	}
+	return store, nil
+}
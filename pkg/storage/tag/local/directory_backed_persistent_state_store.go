@@ -0,0 +1,48 @@
+package local
+
+import (
+	"log"
+
+	pb "bonanza.build/pkg/proto/storage/tag/local"
+	"bonanza.build/pkg/protofile"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+)
+
+var componentTags = path.MustNewComponent("tags")
+
+type directoryBackedPersistentStateStore struct {
+	directory filesystem.Directory
+}
+
+// NewDirectoryBackedPersistentStateStore creates a PersistentStateStore
+// that writes PersistentState Protobuf messages to a file named "tags"
+// stored inside a filesystem.Directory.
+//
+// This makes it possible to store tags in the same directory as the
+// one used by the local object store to hold its persistent state.
+func NewDirectoryBackedPersistentStateStore(directory filesystem.Directory) PersistentStateStore {
+	return directoryBackedPersistentStateStore{
+		directory: directory,
+	}
+}
+
+func (pss directoryBackedPersistentStateStore) ReadPersistentState() (*pb.PersistentState, error) {
+	var persistentState pb.PersistentState
+	found, err := protofile.Read(pss.directory, componentTags, &persistentState)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		// No usable tags file present. Start with an empty
+		// tag store.
+		log.Print("Reinitializing tag store, as no usable persistent state was found")
+		return &pb.PersistentState{}, nil
+	}
+	return &persistentState, nil
+}
+
+func (pss directoryBackedPersistentStateStore) WritePersistentState(persistentState *pb.PersistentState) error {
+	return protofile.Write(pss.directory, componentTags, persistentState)
+}
@@ -0,0 +1,34 @@
+package local
+
+// notificationChannel is a helper type to manage the channels returned
+// by GetTagsChangedWakeup. For each of the channels that these
+// functions hand out, we must make sure that we call close() exactly
+// once.
+//
+// Forgetting to call close() may cause PeriodicSyncer's goroutines to
+// get stuck indefinitely. Calling close() more than once causes us to
+// crash.
+type notificationChannel struct {
+	channel    chan struct{}
+	isBlocking bool
+}
+
+func newNotificationChannel() notificationChannel {
+	return notificationChannel{
+		channel:    make(chan struct{}, 1),
+		isBlocking: true,
+	}
+}
+
+func (nc *notificationChannel) block() {
+	if !nc.isBlocking {
+		*nc = newNotificationChannel()
+	}
+}
+
+func (nc *notificationChannel) unblock() {
+	if nc.isBlocking {
+		close(nc.channel)
+		nc.isBlocking = false
+	}
+}
@@ -0,0 +1,112 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	pb "bonanza.build/pkg/proto/storage/tag/local"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// PeriodicSyncer can be used to monitor Store for tags being created or
+// changed. When such events occur, the tags contained in the Store are
+// extracted and written to disk. This allows its contents to be
+// recovered after a restart.
+type PeriodicSyncer struct {
+	source              *Store
+	store               PersistentStateStore
+	clock               clock.Clock
+	errorLogger         util.ErrorLogger
+	errorRetryInterval  time.Duration
+	minimumSyncInterval time.Duration
+
+	lastSynchronizationTime time.Time
+}
+
+// NewPeriodicSyncer creates a new PeriodicSyncer according to the
+// arguments provided.
+func NewPeriodicSyncer(
+	source *Store,
+	store PersistentStateStore,
+	clock clock.Clock,
+	errorLogger util.ErrorLogger,
+	errorRetryInterval,
+	minimumSyncInterval time.Duration,
+) *PeriodicSyncer {
+	return &PeriodicSyncer{
+		source:              source,
+		store:               store,
+		clock:               clock,
+		errorLogger:         errorLogger,
+		errorRetryInterval:  errorRetryInterval,
+		minimumSyncInterval: minimumSyncInterval,
+
+		lastSynchronizationTime: clock.Now(),
+	}
+}
+
+func (ps *PeriodicSyncer) writePersistentStateRetrying() {
+	persistentState := &pb.PersistentState{
+		Tags: ps.source.GetPersistentState(),
+	}
+	for {
+		err := ps.store.WritePersistentState(persistentState)
+		if err == nil {
+			break
+		}
+		ps.errorLogger.Log(util.StatusWrap(err, "Failed to write persistent state"))
+		_, t := ps.clock.NewTimer(ps.errorRetryInterval)
+		<-t
+	}
+}
+
+// ProcessTagsChanged waits for tags to be created or changed in a
+// Store. It causes the persistent state stored on disk to be updated
+// after a certain amount of time.
+//
+// This function must generally be called in a loop in a separate
+// goroutine, so that the persistent state is updated continuously.
+// The return value of this method denotes whether the caller must
+// continue to call this method. When false, it indicates the provided
+// context was cancelled, due to a shutdown being requested.
+func (ps *PeriodicSyncer) ProcessTagsChanged(ctx context.Context) bool {
+	ch := ps.source.GetTagsChangedWakeup()
+
+	// Insert a delay prior to updating the persistent state. Tags
+	// tend to be updated in bursts, and we don't want to rewrite
+	// the full set of tags for every one of them.
+	keepGoing := true
+	var t <-chan time.Time
+	select {
+	case <-ch:
+		// Tags were already changed at the start of
+		// ProcessTagsChanged(). At least make sure that we
+		// respect the minimum sync interval.
+		_, t = ps.clock.NewTimer(
+			ps.lastSynchronizationTime.
+				Add(ps.minimumSyncInterval).
+				Sub(ps.clock.Now()))
+	default:
+		select {
+		case <-ctx.Done():
+			keepGoing = false
+		case <-ch:
+			_, t = ps.clock.NewTimer(ps.minimumSyncInterval)
+		}
+	}
+	if keepGoing {
+		select {
+		case <-ctx.Done():
+			keepGoing = false
+		case ps.lastSynchronizationTime = <-t:
+		}
+	}
+
+	// Always write the persistent state, even when shutting down.
+	// This ensures that tags created right before shutdown are
+	// not lost.
+	ps.writePersistentStateRetrying()
+	return keepGoing
+}
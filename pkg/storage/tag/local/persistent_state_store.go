@@ -0,0 +1,13 @@
+package local
+
+import (
+	pb "bonanza.build/pkg/proto/storage/tag/local"
+)
+
+// PersistentStateStore is used by PeriodicSyncer to write the tags
+// contained in Store to disk. This state can be reloaded on startup to
+// make it possible to resolve tags that were created in the past.
+type PersistentStateStore interface {
+	ReadPersistentState() (*pb.PersistentState, error)
+	WritePersistentState(persistentState *pb.PersistentState) error
+}
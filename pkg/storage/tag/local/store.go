@@ -2,25 +2,288 @@ package local
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	pb "bonanza.build/pkg/proto/storage/tag/local"
 	"bonanza.build/pkg/storage/object"
 	object_flatbacked "bonanza.build/pkg/storage/object/flatbacked"
 	"bonanza.build/pkg/storage/tag"
 
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
-type store struct{}
+// tagKey is the key type of the map that is used by Store. Tags are
+// keyed by their deterministically marshaled Protobuf message, as
+// anypb.Any itself cannot be used as a map key.
+type tagKey struct {
+	namespace object.Namespace
+	tag       string
+}
+
+type tagValue struct {
+	key       tagKey
+	tag       *anypb.Any
+	reference object.LocalReference
+	lease     object_flatbacked.Lease
+
+	// Pointers to neighbouring tags in a doubly linked list that
+	// is sorted by the time at which tags were last updated. This
+	// list is used to evict tags if the number of tags exceeds the
+	// configured maximum.
+	previous *tagValue
+	next     *tagValue
+}
+
+// insertBefore inserts a tag into the list of tags, right before the
+// provided tag.
+func (tv *tagValue) insertBefore(other *tagValue) {
+	tv.previous = other.previous
+	tv.next = other
+	other.previous.next = tv
+	other.previous = tv
+}
+
+// remove a tag from the list of tags.
+func (tv *tagValue) remove() {
+	tv.previous.next = tv.next
+	tv.next.previous = tv.previous
+	tv.previous = nil
+	tv.next = nil
+}
+
+// Store of tags that is kept in memory. Its contents can be extracted
+// by calling GetPersistentState(), so that tags may be written to disk
+// and reloaded after a restart.
+type Store struct {
+	clock                     clock.Clock
+	leaseCompletenessDuration object_flatbacked.Lease
+	maximumTags               int
+
+	lock              sync.RWMutex
+	tags              map[tagKey]*tagValue
+	tagsList          tagValue
+	tagsChangedWakeup notificationChannel
+}
+
+var (
+	_ tag.Store[object.Namespace, object.GlobalReference, object_flatbacked.Lease] = (*Store)(nil)
+	_ tag.Lister                                                                   = (*Store)(nil)
+)
+
+// NewStore creates a tag store that is backed by memory. The store is
+// initialized with tags that were extracted from a previous instance
+// by calling GetPersistentState(). As leases are not preserved, these
+// tags are reported as being incomplete until they are updated.
+//
+// If the number of tags exceeds maximumTags, the tags that were least
+// recently created or updated are discarded. A maximumTags of zero
+// causes the number of tags to be unbounded.
+func NewStore(clock clock.Clock, leaseCompletenessDuration time.Duration, maximumTags int, initialTags []*pb.TagState) (*Store, error) {
+	s := &Store{
+		clock:                     clock,
+		leaseCompletenessDuration: object_flatbacked.Lease(leaseCompletenessDuration.Nanoseconds()),
+		maximumTags:               maximumTags,
+		tags:                      make(map[tagKey]*tagValue, len(initialTags)),
+		tagsChangedWakeup:         newNotificationChannel(),
+	}
+	s.tagsList.previous = &s.tagsList
+	s.tagsList.next = &s.tagsList
+
+	// Tags are stored in the persistent state in the order in which
+	// they were last updated, meaning that any excess tags can be
+	// dropped from the start.
+	if maximumTags > 0 && len(initialTags) > maximumTags {
+		initialTags = initialTags[len(initialTags)-maximumTags:]
+	}
+	for i, tagState := range initialTags {
+		namespace, err := object.NewNamespace(tagState.Namespace)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid namespace for tag at index %d", i)
+		}
+		key, err := newTagKey(namespace, tagState.Tag)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid tag at index %d", i)
+		}
+		reference, err := namespace.NewGlobalReference(tagState.Reference)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid reference for tag at index %d", i)
+		}
+		if value, ok := s.tags[key]; ok {
+			value.reference = reference.LocalReference
+			value.remove()
+			value.insertBefore(&s.tagsList)
+		} else {
+			value := &tagValue{
+				key:       key,
+				tag:       tagState.Tag,
+				reference: reference.LocalReference,
+			}
+			s.tags[key] = value
+			value.insertBefore(&s.tagsList)
+		}
+	}
+	return s, nil
+}
+
+func newTagKey(namespace object.Namespace, tag *anypb.Any) (tagKey, error) {
+	if tag == nil {
+		return tagKey{}, status.Error(codes.InvalidArgument, "No tag provided")
+	}
+	marshaledTag, err := proto.MarshalOptions{Deterministic: true}.Marshal(tag)
+	if err != nil {
+		return tagKey{}, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to marshal tag")
+	}
+	return tagKey{
+		namespace: namespace,
+		tag:       string(marshaledTag),
+	}, nil
+}
+
+// ResolveTag returns the reference of the object that is associated
+// with a tag. The tag is reported as being complete if its lease has
+// not yet expired.
+func (s *Store) ResolveTag(ctx context.Context, namespace object.Namespace, tag *anypb.Any) (object.LocalReference, bool, error) {
+	key, err := newTagKey(namespace, tag)
+	if err != nil {
+		var badReference object.LocalReference
+		return badReference, false, err
+	}
+
+	s.lock.RLock()
+	value, ok := s.tags[key]
+	var reference object.LocalReference
+	var lease object_flatbacked.Lease
+	if ok {
+		reference, lease = value.reference, value.lease
+	}
+	s.lock.RUnlock()
+
+	if !ok {
+		var badReference object.LocalReference
+		return badReference, false, status.Error(codes.NotFound, "Tag not found")
+	}
+	leaseIncompleteCutoff := object_flatbacked.Lease(s.clock.Now().UnixNano()) - s.leaseCompletenessDuration
+	return reference, lease != 0 && lease >= leaseIncompleteCutoff, nil
+}
+
+// ListTags calls the provided function for every tag contained in the
+// store, in the order in which they were last created or updated.
+func (s *Store) ListTags(ctx context.Context, yield func(namespace object.Namespace, tag *anypb.Any) error) error {
+	// Don't hold the lock while calling into the provided function,
+	// as it may block for an extended period of time.
+	s.lock.RLock()
+	tags := make([]*tagValue, 0, len(s.tags))
+	for value := s.tagsList.next; value != &s.tagsList; value = value.next {
+		tags = append(tags, value)
+	}
+	s.lock.RUnlock()
 
-// NewStore creates a tag store that is backed by local disks.
-func NewStore() tag.Store[object.Namespace, object.GlobalReference, object_flatbacked.Lease] {
-	return &store{}
+	for _, value := range tags {
+		if err := yield(value.key.namespace, value.tag); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (s *store) ResolveTag(ctx context.Context, namespace object.Namespace, tag *anypb.Any) (reference object.LocalReference, complete bool, err error) {
-	panic("TODO")
+// UpdateTag associates a tag with an object. If the tag already points
+// to another object, the tag is only updated if overwrite is set. If
+// the tag already points to the same object, only its lease is
+// updated.
+func (s *Store) UpdateTag(ctx context.Context, tag *anypb.Any, reference object.GlobalReference, lease object_flatbacked.Lease, overwrite bool) error {
+	key, err := newTagKey(reference.GetNamespace(), tag)
+	if err != nil {
+		return err
+	}
+
+	// Don't allow clients to provide leases that lie in the
+	// future, as that would cause tags to be reported as complete
+	// for longer than permitted.
+	if leaseNow := object_flatbacked.Lease(s.clock.Now().UnixNano()); lease > leaseNow {
+		lease = leaseNow
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if value, ok := s.tags[key]; ok {
+		if value.reference != reference.LocalReference {
+			if !overwrite {
+				return nil
+			}
+			value.reference = reference.LocalReference
+			value.lease = lease
+			s.tagsChangedWakeup.unblock()
+		} else if value.lease < lease {
+			// Leases are not persisted, so there is no need
+			// to trigger a write of the persistent state.
+			value.lease = lease
+		}
+		value.remove()
+		value.insertBefore(&s.tagsList)
+		return nil
+	}
+
+	value := &tagValue{
+		key:       key,
+		tag:       tag,
+		reference: reference.LocalReference,
+		lease:     lease,
+	}
+	s.tags[key] = value
+	value.insertBefore(&s.tagsList)
+
+	// Discard the least recently updated tags if the maximum
+	// number of tags is exceeded.
+	if s.maximumTags > 0 {
+		for len(s.tags) > s.maximumTags {
+			oldest := s.tagsList.next
+			oldest.remove()
+			delete(s.tags, oldest.key)
+		}
+	}
+	s.tagsChangedWakeup.unblock()
+	return nil
+}
+
+// GetTagsChangedWakeup returns a channel that is closed when one or
+// more tags have been created or changed since the last call to
+// GetPersistentState().
+func (s *Store) GetTagsChangedWakeup() <-chan struct{} {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.tagsChangedWakeup.channel
 }
 
-func (s *store) UpdateTag(ctx context.Context, tag *anypb.Any, reference object.GlobalReference, lease object_flatbacked.Lease, overwrite bool) error {
-	panic("TODO")
+// GetPersistentState returns all tags contained in the store, so that
+// they may be written to disk and reloaded after a restart. Tags are
+// returned in the order in which they were last updated.
+func (s *Store) GetPersistentState() []*pb.TagState {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.tagsChangedWakeup.block()
+	namespaces := map[object.Namespace]*object_pb.Namespace{}
+	tagStates := make([]*pb.TagState, 0, len(s.tags))
+	for value := s.tagsList.next; value != &s.tagsList; value = value.next {
+		namespace, ok := namespaces[value.key.namespace]
+		if !ok {
+			namespace = value.key.namespace.ToProto()
+			namespaces[value.key.namespace] = namespace
+		}
+		tagStates = append(tagStates, &pb.TagState{
+			Namespace: namespace,
+			Tag:       value.tag,
+			Reference: value.reference.GetRawReference(),
+		})
+	}
+	return tagStates
 }
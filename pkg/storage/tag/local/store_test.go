@@ -0,0 +1,197 @@
+package local_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag/local"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestStore(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	clock := NewMockClock(ctrl)
+	store, err := local.NewStore(clock, time.Minute, 0, nil)
+	require.NoError(t, err)
+
+	namespace := util.Must(object.NewNamespace(&object_pb.Namespace{
+		InstanceName:    "hello",
+		ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+	}))
+	tag1, err := anypb.New(&wrapperspb.StringValue{Value: "tag1"})
+	require.NoError(t, err)
+	reference1 := object.MustNewSHA256V1GlobalReference("hello", "c5327e9e4fd4d73b2ac7d1ab1ffb2a23ec5fd9cd1bd6e2b3ea9bdb8d6f7ff9fa", 595814, 58, 12, 7883322)
+	reference2 := object.MustNewSHA256V1GlobalReference("hello", "2f1c1dbbd44f3ad7cce0ab2e1a8f0dc9c2f1a1dcf4ddbf68f5e2fe42d7a9d8c1", 595814, 58, 12, 7883322)
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, _, err := store.ResolveTag(ctx, namespace, tag1)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Tag not found"), err)
+	})
+
+	t.Run("Complete", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		require.NoError(t, store.UpdateTag(ctx, tag1, reference1, 1000e9, false))
+
+		clock.EXPECT().Now().Return(time.Unix(1030, 0))
+		reference, complete, err := store.ResolveTag(ctx, namespace, tag1)
+		require.NoError(t, err)
+		require.Equal(t, reference1.LocalReference, reference)
+		require.True(t, complete)
+	})
+
+	t.Run("LeaseExpired", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1061, 0))
+		reference, complete, err := store.ResolveTag(ctx, namespace, tag1)
+		require.NoError(t, err)
+		require.Equal(t, reference1.LocalReference, reference)
+		require.False(t, complete)
+	})
+
+	t.Run("NoOverwrite", func(t *testing.T) {
+		// Without overwrite set, the tag must continue to point
+		// to the original object.
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		require.NoError(t, store.UpdateTag(ctx, tag1, reference2, 1100e9, false))
+
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		reference, complete, err := store.ResolveTag(ctx, namespace, tag1)
+		require.NoError(t, err)
+		require.Equal(t, reference1.LocalReference, reference)
+		require.False(t, complete)
+	})
+
+	t.Run("Renew", func(t *testing.T) {
+		// Updating the tag to point to the same object should
+		// extend its lease.
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		require.NoError(t, store.UpdateTag(ctx, tag1, reference1, 1100e9, false))
+
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		reference, complete, err := store.ResolveTag(ctx, namespace, tag1)
+		require.NoError(t, err)
+		require.Equal(t, reference1.LocalReference, reference)
+		require.True(t, complete)
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		require.NoError(t, store.UpdateTag(ctx, tag1, reference2, 1100e9, true))
+
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		reference, complete, err := store.ResolveTag(ctx, namespace, tag1)
+		require.NoError(t, err)
+		require.Equal(t, reference2.LocalReference, reference)
+		require.True(t, complete)
+	})
+
+	t.Run("PersistentState", func(t *testing.T) {
+		select {
+		case <-store.GetTagsChangedWakeup():
+		default:
+			t.Fatal("Tags changed wakeup should have been triggered")
+		}
+
+		// Reloading the tags from the persistent state should
+		// yield the same references, but the tags should be
+		// reported as being incomplete.
+		tagStates := store.GetPersistentState()
+		require.Len(t, tagStates, 1)
+
+		select {
+		case <-store.GetTagsChangedWakeup():
+			t.Fatal("Tags changed wakeup should have been reset")
+		default:
+		}
+
+		reloadedStore, err := local.NewStore(clock, time.Minute, 0, tagStates)
+		require.NoError(t, err)
+
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		reference, complete, err := reloadedStore.ResolveTag(ctx, namespace, tag1)
+		require.NoError(t, err)
+		require.Equal(t, reference2.LocalReference, reference)
+		require.False(t, complete)
+	})
+
+	t.Run("LeaseInFuture", func(t *testing.T) {
+		// Leases provided by clients that lie in the future
+		// should be capped to the current time.
+		tag2, err := anypb.New(&wrapperspb.StringValue{Value: "tag2"})
+		require.NoError(t, err)
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		require.NoError(t, store.UpdateTag(ctx, tag2, reference1, 5000e9, false))
+
+		clock.EXPECT().Now().Return(time.Unix(1161, 0))
+		reference, complete, err := store.ResolveTag(ctx, namespace, tag2)
+		require.NoError(t, err)
+		require.Equal(t, reference1.LocalReference, reference)
+		require.False(t, complete)
+	})
+}
+
+func TestStoreMaximumTags(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	clock := NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).AnyTimes()
+	store, err := local.NewStore(clock, time.Minute, 2, nil)
+	require.NoError(t, err)
+
+	namespace := util.Must(object.NewNamespace(&object_pb.Namespace{
+		InstanceName:    "hello",
+		ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+	}))
+	reference := object.MustNewSHA256V1GlobalReference("hello", "c5327e9e4fd4d73b2ac7d1ab1ffb2a23ec5fd9cd1bd6e2b3ea9bdb8d6f7ff9fa", 595814, 58, 12, 7883322)
+	var tags []*anypb.Any
+	for _, name := range []string{"tag1", "tag2", "tag3"} {
+		tag, err := anypb.New(&wrapperspb.StringValue{Value: name})
+		require.NoError(t, err)
+		tags = append(tags, tag)
+	}
+
+	// Create two tags and renew the first one. This should cause
+	// the second tag to become the least recently updated one.
+	require.NoError(t, store.UpdateTag(ctx, tags[0], reference, 1000e9, false))
+	require.NoError(t, store.UpdateTag(ctx, tags[1], reference, 1000e9, false))
+	require.NoError(t, store.UpdateTag(ctx, tags[0], reference, 1000e9, false))
+
+	// Creating a third tag should cause the second tag to be
+	// discarded.
+	require.NoError(t, store.UpdateTag(ctx, tags[2], reference, 1000e9, false))
+
+	_, _, err = store.ResolveTag(ctx, namespace, tags[0])
+	require.NoError(t, err)
+	_, _, err = store.ResolveTag(ctx, namespace, tags[1])
+	testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Tag not found"), err)
+	_, _, err = store.ResolveTag(ctx, namespace, tags[2])
+	require.NoError(t, err)
+
+	// The persistent state should list tags in the order in which
+	// they were last updated. Reloading it into a store with a
+	// lower limit should only retain the most recently updated tags.
+	tagStates := store.GetPersistentState()
+	require.Len(t, tagStates, 2)
+	testutil.RequireEqualProto(t, tags[0], tagStates[0].Tag)
+	testutil.RequireEqualProto(t, tags[2], tagStates[1].Tag)
+
+	reloadedStore, err := local.NewStore(clock, time.Minute, 1, tagStates)
+	require.NoError(t, err)
+	_, _, err = reloadedStore.ResolveTag(ctx, namespace, tags[0])
+	testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Tag not found"), err)
+	_, _, err = reloadedStore.ResolveTag(ctx, namespace, tags[2])
+	require.NoError(t, err)
+}
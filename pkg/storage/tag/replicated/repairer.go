@@ -0,0 +1,169 @@
+package replicated
+
+import (
+	"context"
+	"sync"
+
+	"bonanza.build/pkg/storage/object"
+	object_replicated "bonanza.build/pkg/storage/object/replicated"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var (
+	repairerPrometheusMetrics sync.Once
+
+	repairerListedTags = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "bonanza",
+			Subsystem: "replicated",
+			Name:      "repairer_listed_tags",
+			Help:      "Number of distinct tags that were listed by the repairer during its last pass",
+		},
+	)
+	repairerListingFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "replicated",
+			Name:      "repairer_listing_failures_total",
+			Help:      "Number of times the repairer failed to list the tags contained in a replica",
+		},
+	)
+	repairerTagsRepaired = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bonanza",
+			Subsystem: "replicated",
+			Name:      "repairer_tags_repaired_total",
+			Help:      "Number of tags for which the repairer traversed the DAG to which they point",
+		},
+		[]string{"outcome"},
+	)
+	repairerTagsRepairedSucceeded = repairerTagsRepaired.WithLabelValues("Succeeded")
+	repairerTagsRepairedNotFound  = repairerTagsRepaired.WithLabelValues("NotFound")
+	repairerTagsRepairedFailed    = repairerTagsRepaired.WithLabelValues("Failed")
+)
+
+// listedTagKey is the key of tags that are listed by Repairer. As
+// anypb.Any is not comparable, the type URL and value are stored
+// separately.
+type listedTagKey struct {
+	namespace object.Namespace
+	typeURL   string
+	value     string
+}
+
+// Repairer of tags that are stored in a set of replicas.
+//
+// During every pass, Repairer enumerates the tags contained in each of
+// the replicas. For each of these tags, the DAG to which it points is
+// repaired using object/replicated.Repairer, after which the tag is
+// written to all replicas, using the newly obtained lease. This
+// ensures that replicas that lost their contents converge, regardless
+// of whether the tags are accessed by clients.
+type Repairer[TLease any] struct {
+	listers        []tag.Lister
+	resolver       tag.Resolver[object.Namespace]
+	updater        tag.Updater[object.GlobalReference, object_replicated.Lease[TLease]]
+	objectRepairer *object_replicated.Repairer[TLease]
+	errorLogger    util.ErrorLogger
+}
+
+// NewRepairer creates a Repairer that repairs all tags that are
+// reported by a set of listers, typically one for each replica.
+// Failures to list or repair tags are reported through the provided
+// error logger.
+func NewRepairer[TLease any](listers []tag.Lister, resolver tag.Resolver[object.Namespace], updater tag.Updater[object.GlobalReference, object_replicated.Lease[TLease]], objectRepairer *object_replicated.Repairer[TLease], errorLogger util.ErrorLogger) *Repairer[TLease] {
+	repairerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(repairerListedTags)
+		prometheus.MustRegister(repairerListingFailures)
+		prometheus.MustRegister(repairerTagsRepaired)
+	})
+
+	return &Repairer[TLease]{
+		listers:        listers,
+		resolver:       resolver,
+		updater:        updater,
+		objectRepairer: objectRepairer,
+		errorLogger:    errorLogger,
+	}
+}
+
+// RepairAllTags performs a single pass over all tags contained in the
+// replicas, repairing the DAGs to which they point. Failures to list
+// the tags in individual replicas or to repair individual tags are
+// reported, but do not cause the pass to be interrupted. The number of
+// these failures is returned, so that callers can determine whether
+// the pass completed successfully.
+func (r *Repairer[TLease]) RepairAllTags(ctx context.Context) (int, error) {
+	// Obtain the union of all tags contained in the replicas. Tags
+	// that are missing in some of the replicas need to be repaired
+	// as well.
+	failuresCount := 0
+	keys := map[listedTagKey]struct{}{}
+	for i, lister := range r.listers {
+		if err := lister.ListTags(ctx, func(namespace object.Namespace, tag *anypb.Any) error {
+			keys[listedTagKey{
+				namespace: namespace,
+				typeURL:   tag.TypeUrl,
+				value:     string(tag.Value),
+			}] = struct{}{}
+			return nil
+		}); err != nil {
+			if ctx.Err() != nil {
+				return 0, util.StatusFromContext(ctx)
+			}
+			failuresCount++
+			repairerListingFailures.Inc()
+			r.errorLogger.Log(util.StatusWrapf(err, "Failed to list tags in replica %d", i))
+		}
+	}
+	repairerListedTags.Set(float64(len(keys)))
+
+	for key := range keys {
+		err := r.repairTag(ctx, key.namespace, &anypb.Any{
+			TypeUrl: key.typeURL,
+			Value:   []byte(key.value),
+		})
+		if ctx.Err() != nil {
+			return 0, util.StatusFromContext(ctx)
+		}
+		if err == nil {
+			repairerTagsRepairedSucceeded.Inc()
+		} else if status.Code(err) == codes.NotFound {
+			repairerTagsRepairedNotFound.Inc()
+		} else {
+			failuresCount++
+			repairerTagsRepairedFailed.Inc()
+			r.errorLogger.Log(util.StatusWrapf(err, "Failed to repair tag of type %#v in namespace %#v", key.typeURL, key.namespace.InstanceName.String()))
+		}
+	}
+	return failuresCount, nil
+}
+
+func (r *Repairer[TLease]) repairTag(ctx context.Context, namespace object.Namespace, tag *anypb.Any) error {
+	localReference, _, err := r.resolver.ResolveTag(ctx, namespace, tag)
+	if err != nil {
+		return err
+	}
+	globalReference := namespace.WithLocalReference(localReference)
+	lease, err := r.objectRepairer.RepairDAG(ctx, globalReference)
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to repair object with reference %s", localReference)
+	}
+	if err := r.updater.UpdateTag(
+		ctx,
+		tag,
+		globalReference,
+		lease,
+		/* overwrite = */ false,
+	); err != nil {
+		return util.StatusWrapf(err, "Failed to update tag with lease for object with reference %s", localReference)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package replicated_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	object_replicated "bonanza.build/pkg/storage/object/replicated"
+	"bonanza.build/pkg/storage/tag"
+	tag_replicated "bonanza.build/pkg/storage/tag/replicated"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRepairer(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	lister0 := NewMockLister(ctrl)
+	lister1 := NewMockLister(ctrl)
+	resolver := NewMockResolverForTesting(ctrl)
+	updater0 := NewMockUpdaterForTesting(ctrl)
+	updater1 := NewMockUpdaterForTesting(ctrl)
+	objectStore0 := NewMockStoreForTesting(ctrl)
+	objectStore1 := NewMockStoreForTesting(ctrl)
+	errorLogger := NewMockErrorLogger(ctrl)
+	repairer := tag_replicated.NewRepairer(
+		[]tag.Lister{lister0, lister1},
+		resolver,
+		tag_replicated.NewUpdater(
+			[]tag.Updater[object.GlobalReference, any]{updater0, updater1},
+			/* writeQuorum = */ 2,
+		),
+		object_replicated.NewRepairer(
+			[]object.Store[object.GlobalReference, any]{objectStore0, objectStore1},
+			/* concurrency = */ 1,
+			errorLogger,
+		),
+		errorLogger,
+	)
+
+	tag1, err := anypb.New(&emptypb.Empty{})
+	require.NoError(t, err)
+	tag2, err := anypb.New(wrapperspb.String("Tag 2"))
+	require.NoError(t, err)
+	contents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello"))
+	reference := object.NewInstanceName("hello/world").WithLocalReference(contents.LocalReference)
+	namespace := reference.GetNamespace()
+
+	t.Run("ListingFailure", func(t *testing.T) {
+		// Failures to list the tags in a replica should be
+		// logged, but should not prevent tags listed by other
+		// replicas from being repaired. Tags that no longer
+		// exist should be skipped silently.
+		lister0.EXPECT().ListTags(ctx, gomock.Any()).
+			Return(status.Error(codes.Unavailable, "Server offline"))
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to list tags in replica 0: Server offline")))
+		lister1.EXPECT().ListTags(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, yield func(object.Namespace, *anypb.Any) error) error {
+				return yield(namespace, tag2)
+			})
+		resolver.EXPECT().ResolveTag(ctx, namespace, testutil.EqProto(t, tag2)).
+			Return(object.LocalReference{}, false, status.Error(codes.NotFound, "Tag not found"))
+
+		failuresCount, err := repairer.RepairAllTags(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, failuresCount)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Tags reported by multiple replicas should only be
+		// repaired once. If the object to which the tag points
+		// is already complete in all replicas, the tag should be
+		// written to all replicas using the existing leases.
+		for _, lister := range []*MockLister{lister0, lister1} {
+			lister.EXPECT().ListTags(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, yield func(object.Namespace, *anypb.Any) error) error {
+					return yield(namespace, tag1)
+				})
+		}
+		resolver.EXPECT().ResolveTag(ctx, namespace, testutil.EqProto(t, tag1)).
+			Return(contents.LocalReference, false, nil)
+		objectStore0.EXPECT().UploadObject(gomock.Any(), reference, nil, nil, false).
+			Return(object.UploadObjectComplete[any]{Lease: "Lease 0"}, nil)
+		objectStore1.EXPECT().UploadObject(gomock.Any(), reference, nil, nil, false).
+			Return(object.UploadObjectComplete[any]{Lease: "Lease 1"}, nil)
+		updater0.EXPECT().UpdateTag(gomock.Any(), testutil.EqProto(t, tag1), reference, "Lease 0", false)
+		updater1.EXPECT().UpdateTag(gomock.Any(), testutil.EqProto(t, tag1), reference, "Lease 1", false)
+
+		failuresCount, err := repairer.RepairAllTags(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, failuresCount)
+	})
+}
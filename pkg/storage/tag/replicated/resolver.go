@@ -0,0 +1,101 @@
+package replicated
+
+import (
+	"context"
+	"sync"
+
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type resolver[TNamespace any] struct {
+	replicas   []tag.Resolver[TNamespace]
+	readQuorum int
+}
+
+// NewResolver creates a decorator for tag.Resolver that forwards
+// requests to resolve tags to a set of backends that are configured to
+// replicate each other's contents.
+//
+// Requests only need to succeed for readQuorum replicas. If the tag is
+// absent in some of the replicas or some of the replicas failed, the
+// tag is reported as being incomplete. This causes lease renewing to
+// replicate the tag to all replicas.
+func NewResolver[TNamespace any](replicas []tag.Resolver[TNamespace], readQuorum int) tag.Resolver[TNamespace] {
+	return &resolver[TNamespace]{
+		replicas:   replicas,
+		readQuorum: readQuorum,
+	}
+}
+
+func (r *resolver[TNamespace]) ResolveTag(ctx context.Context, namespace TNamespace, tag *anypb.Any) (object.LocalReference, bool, error) {
+	// Send request to all replicas. Don't cancel requests if one of
+	// the replicas fails, as we may still reach the read quorum.
+	replicasCount := len(r.replicas)
+	references := make([]object.LocalReference, replicasCount)
+	completes := make([]bool, replicasCount)
+	errs := make([]error, replicasCount)
+	var wg sync.WaitGroup
+	for replicaIndex, replica := range r.replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			references[replicaIndex], completes[replicaIndex], errs[replicaIndex] = replica.ResolveTag(ctx, namespace, tag)
+		}()
+	}
+	wg.Wait()
+
+	// Combine results from all replicas.
+	//
+	// If the tag is present in only some of the replicas, we return
+	// it, but announce it as being incomplete.
+	//
+	// If the tag is present in multiple replicas, but resolves to a
+	// different reference, we suppress it. This should cause the
+	// caller to recreate the data and overwrite the tag in all
+	// replicas, causing the tag to be consistent once more.
+	var badReference object.LocalReference
+	respondedReplicasCount := 0
+	foundReplicaIndex := -1
+	complete := true
+	var errFirst error
+	for replicaIndex, err := range errs {
+		if err != nil {
+			complete = false
+			if status.Code(err) != codes.NotFound {
+				if errFirst == nil {
+					errFirst = util.StatusWrapf(err, "Replica %d", replicaIndex)
+				}
+				continue
+			}
+		} else if foundReplicaIndex < 0 {
+			foundReplicaIndex = replicaIndex
+			complete = complete && completes[replicaIndex]
+		} else if references[replicaIndex] != references[foundReplicaIndex] {
+			return badReference, false, status.Errorf(
+				codes.NotFound,
+				"Replica %d resolves tag to object with reference %s, while replica %d resolves tag to object with reference %s",
+				foundReplicaIndex,
+				references[foundReplicaIndex],
+				replicaIndex,
+				references[replicaIndex],
+			)
+		} else {
+			complete = complete && completes[replicaIndex]
+		}
+		respondedReplicasCount++
+	}
+	if respondedReplicasCount < r.readQuorum {
+		return badReference, false, util.StatusWrapf(errFirst, "Only %d of %d replicas succeeded, while a read quorum of %d is required", respondedReplicasCount, replicasCount, r.readQuorum)
+	}
+	if foundReplicaIndex < 0 {
+		return badReference, false, status.Error(codes.NotFound, "Tag not found")
+	}
+	return references[foundReplicaIndex], complete, nil
+}
@@ -0,0 +1,159 @@
+package replicated_test
+
+import (
+	"context"
+	"testing"
+
+	object_pb "bonanza.build/pkg/proto/storage/object"
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag"
+	"bonanza.build/pkg/storage/tag/replicated"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolver(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	replica0 := NewMockResolverForTesting(ctrl)
+	replica1 := NewMockResolverForTesting(ctrl)
+	replica2 := NewMockResolverForTesting(ctrl)
+	resolver := replicated.NewResolver(
+		[]tag.Resolver[object.Namespace]{replica0, replica1, replica2},
+		/* readQuorum = */ 2,
+	)
+
+	namespace := util.Must(object.NewNamespace(&object_pb.Namespace{
+		InstanceName:    "hello/world",
+		ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+	}))
+	var badReference object.LocalReference
+
+	t.Run("ReadQuorumNotMet", func(t *testing.T) {
+		// If fewer replicas than the read quorum respond, the
+		// error of the first failing replica should be
+		// propagated.
+		tag, err := anypb.New(&emptypb.Empty{})
+		require.NoError(t, err)
+		replica0.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(
+				object.MustNewSHA256V1LocalReference("2572ad3fb952a78dffe4988445912245fcb4acd998750f956a3a069911aa2da6", 595814, 58, 12, 7883322),
+				/* complete = */ true,
+				nil,
+			)
+		replica1.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(badReference, false, status.Error(codes.Unavailable, "Server offline"))
+		replica2.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(badReference, false, status.Error(codes.PermissionDenied, "User is not permitted to resolve tags"))
+
+		_, _, err = resolver.ResolveTag(ctx, namespace, tag)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Only 1 of 3 replicas succeeded, while a read quorum of 2 is required: Replica 1: Server offline"), err)
+	})
+
+	t.Run("NotFoundAll", func(t *testing.T) {
+		// If all replicas return NOT_FOUND, then there is
+		// nothing meaningful we can return.
+		tag, err := anypb.New(&emptypb.Empty{})
+		require.NoError(t, err)
+		for _, replica := range []*MockResolverForTesting{replica0, replica1, replica2} {
+			replica.EXPECT().
+				ResolveTag(gomock.Any(), namespace, tag).
+				Return(badReference, false, status.Error(codes.NotFound, "Tag does not exist"))
+		}
+
+		_, _, err = resolver.ResolveTag(ctx, namespace, tag)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Tag not found"), err)
+	})
+
+	t.Run("ReplicaUnavailable", func(t *testing.T) {
+		// If one of the replicas is unavailable, the tag may
+		// still be returned. It should be reported as being
+		// incomplete, so that lease renewing causes the tag to
+		// be written to all replicas once again.
+		tag, err := anypb.New(&emptypb.Empty{})
+		require.NoError(t, err)
+		replica0.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(
+				object.MustNewSHA256V1LocalReference("7077118ca64ca957196c5f42537af08c7bba85171912bed4e6d8e4de428bd917", 595814, 58, 12, 7883322),
+				/* complete = */ true,
+				nil,
+			)
+		replica1.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(badReference, false, status.Error(codes.Unavailable, "Server offline"))
+		replica2.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(
+				object.MustNewSHA256V1LocalReference("7077118ca64ca957196c5f42537af08c7bba85171912bed4e6d8e4de428bd917", 595814, 58, 12, 7883322),
+				/* complete = */ true,
+				nil,
+			)
+
+		reference, complete, err := resolver.ResolveTag(ctx, namespace, tag)
+		require.NoError(t, err)
+		require.Equal(t, object.MustNewSHA256V1LocalReference("7077118ca64ca957196c5f42537af08c7bba85171912bed4e6d8e4de428bd917", 595814, 58, 12, 7883322), reference)
+		require.False(t, complete)
+	})
+
+	t.Run("MismatchingReferences", func(t *testing.T) {
+		// If replicas return different references, we suppress
+		// it. This should cause the client to recompute the
+		// data and write a new reference to all replicas.
+		tag, err := anypb.New(&emptypb.Empty{})
+		require.NoError(t, err)
+		replica0.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(badReference, false, status.Error(codes.NotFound, "Tag does not exist"))
+		replica1.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(
+				object.MustNewSHA256V1LocalReference("a844d50dc523d1d1e77d5dc6fae4d367561e8eae8e756b11ad51f9ab064456dd", 595814, 58, 12, 7883322),
+				/* complete = */ true,
+				nil,
+			)
+		replica2.EXPECT().
+			ResolveTag(gomock.Any(), namespace, tag).
+			Return(
+				object.MustNewSHA256V1LocalReference("6408a2db979aaaf3c66814eadcd3e9115b4fce294287a777a43089db35fe8f34", 595814, 58, 12, 7883322),
+				/* complete = */ true,
+				nil,
+			)
+
+		_, _, err = resolver.ResolveTag(ctx, namespace, tag)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Replica 1 resolves tag to object with reference SHA256=a844d50dc523d1d1e77d5dc6fae4d367561e8eae8e756b11ad51f9ab064456dd:S=595814:H=58:D=12:M=7884800, while replica 2 resolves tag to object with reference SHA256=6408a2db979aaaf3c66814eadcd3e9115b4fce294287a777a43089db35fe8f34:S=595814:H=58:D=12:M=7884800"), err)
+	})
+
+	t.Run("SuccessComplete", func(t *testing.T) {
+		// If all replicas return the same reference and report
+		// it as being complete, we may do the same.
+		tag, err := anypb.New(&emptypb.Empty{})
+		require.NoError(t, err)
+		for _, replica := range []*MockResolverForTesting{replica0, replica1, replica2} {
+			replica.EXPECT().
+				ResolveTag(gomock.Any(), namespace, tag).
+				Return(
+					object.MustNewSHA256V1LocalReference("5b6723cc264c3d4605f359fa4e1a041f7e0fcd98763eb2b0c49bb2181a8e67f7", 595814, 58, 12, 7883322),
+					/* complete = */ true,
+					nil,
+				)
+		}
+
+		reference, complete, err := resolver.ResolveTag(ctx, namespace, tag)
+		require.NoError(t, err)
+		require.Equal(t, object.MustNewSHA256V1LocalReference("5b6723cc264c3d4605f359fa4e1a041f7e0fcd98763eb2b0c49bb2181a8e67f7", 595814, 58, 12, 7883322), reference)
+		require.True(t, complete)
+	})
+}
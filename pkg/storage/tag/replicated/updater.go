@@ -0,0 +1,61 @@
+package replicated
+
+import (
+	"context"
+	"sync"
+
+	"bonanza.build/pkg/storage/object/replicated"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type updater[TReference, TLease any] struct {
+	replicas    []tag.Updater[TReference, TLease]
+	writeQuorum int
+}
+
+// NewUpdater creates a decorator for tag.Updater that forwards requests
+// to update tags to a set of backends that are configured to replicate
+// each other's contents.
+//
+// Requests only need to succeed for writeQuorum replicas. Replicas that
+// did not receive the update report the tag as absent or stale, which
+// is repaired by the resolver returned by NewResolver().
+func NewUpdater[TReference, TLease any](replicas []tag.Updater[TReference, TLease], writeQuorum int) tag.Updater[TReference, replicated.Lease[TLease]] {
+	return &updater[TReference, TLease]{
+		replicas:    replicas,
+		writeQuorum: writeQuorum,
+	}
+}
+
+func (u *updater[TReference, TLease]) UpdateTag(ctx context.Context, tag *anypb.Any, reference TReference, lease replicated.Lease[TLease], overwrite bool) error {
+	// Forward the request to all replicas in parallel.
+	replicasCount := len(u.replicas)
+	errs := make([]error, replicasCount)
+	var wg sync.WaitGroup
+	for replicaIndex, replica := range u.replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[replicaIndex] = replica.UpdateTag(ctx, tag, reference, lease.GetReplicaLease(replicaIndex), overwrite)
+		}()
+	}
+	wg.Wait()
+
+	succeededReplicasCount := 0
+	var errFirst error
+	for replicaIndex, err := range errs {
+		if err == nil {
+			succeededReplicasCount++
+		} else if errFirst == nil {
+			errFirst = util.StatusWrapf(err, "Replica %d", replicaIndex)
+		}
+	}
+	if succeededReplicasCount < u.writeQuorum {
+		return util.StatusWrapf(errFirst, "Only %d of %d replicas succeeded, while a write quorum of %d is required", succeededReplicasCount, replicasCount, u.writeQuorum)
+	}
+	return nil
+}
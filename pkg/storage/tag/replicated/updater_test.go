@@ -0,0 +1,123 @@
+package replicated_test
+
+import (
+	"context"
+	"testing"
+
+	"bonanza.build/pkg/storage/object"
+	object_replicated "bonanza.build/pkg/storage/object/replicated"
+	"bonanza.build/pkg/storage/tag"
+	tag_replicated "bonanza.build/pkg/storage/tag/replicated"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdater(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	replica0 := NewMockUpdaterForTesting(ctrl)
+	replica1 := NewMockUpdaterForTesting(ctrl)
+	replica2 := NewMockUpdaterForTesting(ctrl)
+	updater := tag_replicated.NewUpdater(
+		[]tag.Updater[object.GlobalReference, any]{replica0, replica1, replica2},
+		/* writeQuorum = */ 2,
+	)
+
+	t.Run("WriteQuorumNotMet", func(t *testing.T) {
+		// If updating the tag succeeds for fewer replicas than
+		// the write quorum, the error of the first failing
+		// replica should be propagated.
+		tag, err := anypb.New(&emptypb.Empty{})
+		require.NoError(t, err)
+		reference := object.MustNewSHA256V1GlobalReference("hello/world", "8ed6814114c216e75bef25dcba0d6b6c9600f2d49f07e3ae970697effae188d1", 595814, 58, 12, 7883322)
+		replica0.EXPECT().
+			UpdateTag(
+				gomock.Any(),
+				tag,
+				reference,
+				"Lease 0",
+				/* overwrite = */ true,
+			).
+			Return(status.Error(codes.PermissionDenied, "User is not permitted to update tags"))
+		replica1.EXPECT().
+			UpdateTag(
+				gomock.Any(),
+				tag,
+				reference,
+				"Lease 1",
+				/* overwrite = */ true,
+			)
+		replica2.EXPECT().
+			UpdateTag(
+				gomock.Any(),
+				tag,
+				reference,
+				"Lease 2",
+				/* overwrite = */ true,
+			).
+			Return(status.Error(codes.Unavailable, "Server offline"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Only 1 of 3 replicas succeeded, while a write quorum of 2 is required: Replica 0: User is not permitted to update tags"),
+			updater.UpdateTag(
+				ctx,
+				tag,
+				reference,
+				object_replicated.Lease[any]{"Lease 0", "Lease 1", "Lease 2"},
+				/* overwrite = */ true,
+			),
+		)
+	})
+
+	t.Run("ReplicaUnavailable", func(t *testing.T) {
+		// If one of the replicas is unavailable, the update
+		// should still succeed, as the write quorum is met.
+		// Replicas for which no lease is provided should
+		// receive the zero value.
+		tag, err := anypb.New(&emptypb.Empty{})
+		require.NoError(t, err)
+		reference := object.MustNewSHA256V1GlobalReference("hello/world", "abcba052c8c920fd06461136e1ab188d02a2302fde2b25fb8dc4b638203a6b9b", 595814, 58, 12, 7883322)
+		replica0.EXPECT().
+			UpdateTag(
+				gomock.Any(),
+				tag,
+				reference,
+				"Lease 0",
+				/* overwrite = */ false,
+			)
+		replica1.EXPECT().
+			UpdateTag(
+				gomock.Any(),
+				tag,
+				reference,
+				"Lease 1",
+				/* overwrite = */ false,
+			).
+			Return(status.Error(codes.Unavailable, "Server offline"))
+		replica2.EXPECT().
+			UpdateTag(
+				gomock.Any(),
+				tag,
+				reference,
+				nil,
+				/* overwrite = */ false,
+			)
+
+		require.NoError(t, updater.UpdateTag(
+			ctx,
+			tag,
+			reference,
+			object_replicated.Lease[any]{"Lease 0", "Lease 1"},
+			/* overwrite = */ false,
+		))
+	})
+}
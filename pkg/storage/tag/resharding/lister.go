@@ -0,0 +1,39 @@
+package resharding
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type lister struct {
+	previous tag.Lister
+	current  tag.Lister
+}
+
+// NewLister creates a decorator for tag.Lister that can be used while
+// tags are being migrated from a previous to a current set of shards.
+// It enumerates the tags in both sets of shards, meaning that tags
+// that have not been migrated yet are reported as well. Tags that are
+// present in both sets of shards are reported twice.
+func NewLister(previous, current tag.Lister) tag.Lister {
+	return &lister{
+		previous: previous,
+		current:  current,
+	}
+}
+
+func (l *lister) ListTags(ctx context.Context, yield func(namespace object.Namespace, tag *anypb.Any) error) error {
+	if err := l.current.ListTags(ctx, yield); err != nil {
+		return err
+	}
+	if err := l.previous.ListTags(ctx, yield); err != nil {
+		return util.StatusWrap(err, "Previous shards")
+	}
+	return nil
+}
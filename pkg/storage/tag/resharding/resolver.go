@@ -0,0 +1,49 @@
+package resharding
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type resolver[TNamespace any] struct {
+	previous tag.Resolver[TNamespace]
+	current  tag.Resolver[TNamespace]
+}
+
+// NewResolver creates a decorator for tag.Resolver that can be used
+// while tags are being migrated from a previous to a current set of
+// shards (e.g., after adding shards or changing their weights).
+//
+// Requests first go to the current set of shards. If the tag cannot be
+// found, it is resolved using the previous set of shards. Tags obtained
+// from the previous set of shards are always reported as incomplete.
+// This causes lease renewing to write the tag into the current set of
+// shards.
+func NewResolver[TNamespace any](previous, current tag.Resolver[TNamespace]) tag.Resolver[TNamespace] {
+	return &resolver[TNamespace]{
+		previous: previous,
+		current:  current,
+	}
+}
+
+func (r *resolver[TNamespace]) ResolveTag(ctx context.Context, namespace TNamespace, tag *anypb.Any) (object.LocalReference, bool, error) {
+	reference, complete, err := r.current.ResolveTag(ctx, namespace, tag)
+	if err == nil || status.Code(err) != codes.NotFound {
+		return reference, complete, err
+	}
+
+	reference, _, err = r.previous.ResolveTag(ctx, namespace, tag)
+	if err != nil {
+		var badReference object.LocalReference
+		return badReference, false, util.StatusWrap(err, "Previous shards")
+	}
+	return reference, false, nil
+}
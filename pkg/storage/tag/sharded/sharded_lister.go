@@ -0,0 +1,35 @@
+package sharded
+
+import (
+	"context"
+
+	"bonanza.build/pkg/storage/object"
+	"bonanza.build/pkg/storage/tag"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type shardedLister struct {
+	shards     []tag.Lister
+	shardNames []string
+}
+
+// NewShardedLister creates a decorator for one or more tag.Listers
+// that enumerates the tags contained in all shards.
+func NewShardedLister(shards []tag.Lister, shardNames []string) tag.Lister {
+	return &shardedLister{
+		shards:     shards,
+		shardNames: shardNames,
+	}
+}
+
+func (l *shardedLister) ListTags(ctx context.Context, yield func(namespace object.Namespace, tag *anypb.Any) error) error {
+	for shardIndex, shard := range l.shards {
+		if err := shard.ListTags(ctx, yield); err != nil {
+			return util.StatusWrapf(err, "Shard %#v", l.shardNames[shardIndex])
+		}
+	}
+	return nil
+}
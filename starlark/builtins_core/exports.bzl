@@ -612,7 +612,28 @@ platform = rule(
 )
 
 def _sh_test_impl(ctx):
-    fail("TODO: implement")
+    if len(ctx.files.srcs) != 1:
+        fail("srcs must contain exactly one file")
+
+    # Expose the script under the name of the target, so that it
+    # can be invoked directly.
+    executable = ctx.actions.declare_file(ctx.label.name)
+    ctx.actions.symlink(
+        output = executable,
+        target_file = ctx.files.srcs[0],
+        is_executable = True,
+    )
+
+    return [DefaultInfo(
+        executable = executable,
+        files = depset([executable]),
+        runfiles = ctx.runfiles(
+            files = ctx.files.srcs + ctx.files.data,
+        ).merge_all([
+            dep[DefaultInfo].default_runfiles
+            for dep in ctx.attr.data + ctx.attr.deps
+        ]),
+    )]
 
 sh_test = rule(
     _sh_test_impl,
@@ -638,7 +659,9 @@ starlark_doc_extract = rule(
 )
 
 def _test_suite_impl(ctx):
-    fail("TODO: implement")
+    # Tests contained in test suites are expanded by the build
+    # driver, as opposed to being dependencies of the test suite.
+    return [DefaultInfo()]
 
 test_suite = rule(
     _test_suite_impl,